@@ -0,0 +1,9 @@
+// Package bindings holds the Go bindings of the Solidity contracts,
+// generated from their ABIs in abi/. After changing a contract, rebuild it
+// and regenerate the bindings, which also refreshes abi/ from the forge
+// artifacts:
+//
+//	forge build && go generate ./bindings
+package bindings
+
+//go:generate go run ./gen -artifacts ../out -abi abi -out .
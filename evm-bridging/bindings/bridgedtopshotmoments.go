@@ -0,0 +1,3206 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BridgedTopShotMomentsRoyaltyInfo is an auto generated low-level Go binding around an user-defined struct.
+type BridgedTopShotMomentsRoyaltyInfo struct {
+	RoyaltyAddress common.Address
+	RoyaltyBps     *big.Int
+}
+
+// BridgedTopShotMomentsMetaData contains all meta data concerning the BridgedTopShotMoments contract.
+var BridgedTopShotMomentsMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"allowsBridging\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"burn\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"cadenceNFTAddress\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"cadenceNFTIdentifier\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"contractMetadata\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"contractURI\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"depositFor\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIds\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"exists\",\"inputs\":[{\"name\":\"_id\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"fulfillToEVM\",\"inputs\":[{\"name\":\"_to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_id\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getApproved\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCadenceAddress\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCadenceIdentifier\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTransferValidationFunction\",\"inputs\":[],\"outputs\":[{\"name\":\"functionSignature\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"},{\"name\":\"isViewFunction\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getTransferValidator\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"underlyingNftContractAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"vmBridgeAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"name_\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"symbol_\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"baseTokenURI_\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"_cadenceNFTAddress\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"_cadenceNFTIdentifier\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"_contractMetadata\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isApprovedForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isEscrowed\",\"inputs\":[{\"name\":\"_id\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"onERC721Received\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ownerOf\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"royaltyAddress\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"royaltyBasisPoints\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"royaltyInfo\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_salePrice\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"royaltyAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setApprovalForAll\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setBaseTokenURI\",\"inputs\":[{\"name\":\"newBaseTokenURI\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setBridgePermissions\",\"inputs\":[{\"name\":\"permissions\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setContractURI\",\"inputs\":[{\"name\":\"newMetadata\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setRoyaltyInfo\",\"inputs\":[{\"name\":\"newInfo\",\"type\":\"tuple\",\"internalType\":\"structBridgedTopShotMoments.RoyaltyInfo\",\"components\":[{\"name\":\"royaltyAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"royaltyBps\",\"type\":\"uint96\",\"internalType\":\"uint96\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setSymbol\",\"inputs\":[{\"name\":\"newSymbol\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTransferValidator\",\"inputs\":[{\"name\":\"newValidator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenByIndex\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenOfOwnerByIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenURI\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"underlying\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIERC721\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vmBridgeAddress\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdrawTo\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIds\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ApprovalForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchMetadataUpdate\",\"inputs\":[{\"name\":\"_fromTokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"_toTokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ContractURIUpdated\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FulfilledToEVM\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MetadataUpdate\",\"inputs\":[{\"name\":\"_tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermissionsUpdated\",\"inputs\":[{\"name\":\"newPermissions\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoyaltyInfoUpdated\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"bps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TransferValidatorUpdated\",\"inputs\":[{\"name\":\"oldValidator\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"newValidator\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"CrossVMBridgeCallableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"CrossVMBridgeCallableZeroInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"FulfillmentFailedTokenNotEscrowed\",\"inputs\":[{\"name\":\"id\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"escrowAddress\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"InvalidRoyaltyBasisPoints\",\"inputs\":[{\"name\":\"basisPoints\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidUnderlyingTokenAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"RoyaltyAddressCannotBeZeroAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SameTransferValidator\",\"inputs\":[]}]",
+}
+
+// BridgedTopShotMomentsABI is the input ABI used to generate the binding from.
+// Deprecated: Use BridgedTopShotMomentsMetaData.ABI instead.
+var BridgedTopShotMomentsABI = BridgedTopShotMomentsMetaData.ABI
+
+// BridgedTopShotMoments is an auto generated Go binding around an Ethereum contract.
+type BridgedTopShotMoments struct {
+	BridgedTopShotMomentsCaller     // Read-only binding to the contract
+	BridgedTopShotMomentsTransactor // Write-only binding to the contract
+	BridgedTopShotMomentsFilterer   // Log filterer for contract events
+}
+
+// BridgedTopShotMomentsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BridgedTopShotMomentsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BridgedTopShotMomentsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BridgedTopShotMomentsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BridgedTopShotMomentsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BridgedTopShotMomentsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BridgedTopShotMomentsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BridgedTopShotMomentsSession struct {
+	Contract     *BridgedTopShotMoments // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts          // Call options to use throughout this session
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// BridgedTopShotMomentsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BridgedTopShotMomentsCallerSession struct {
+	Contract *BridgedTopShotMomentsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                // Call options to use throughout this session
+}
+
+// BridgedTopShotMomentsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BridgedTopShotMomentsTransactorSession struct {
+	Contract     *BridgedTopShotMomentsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                // Transaction auth options to use throughout this session
+}
+
+// BridgedTopShotMomentsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BridgedTopShotMomentsRaw struct {
+	Contract *BridgedTopShotMoments // Generic contract binding to access the raw methods on
+}
+
+// BridgedTopShotMomentsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BridgedTopShotMomentsCallerRaw struct {
+	Contract *BridgedTopShotMomentsCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BridgedTopShotMomentsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BridgedTopShotMomentsTransactorRaw struct {
+	Contract *BridgedTopShotMomentsTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBridgedTopShotMoments creates a new instance of BridgedTopShotMoments, bound to a specific deployed contract.
+func NewBridgedTopShotMoments(address common.Address, backend bind.ContractBackend) (*BridgedTopShotMoments, error) {
+	contract, err := bindBridgedTopShotMoments(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMoments{BridgedTopShotMomentsCaller: BridgedTopShotMomentsCaller{contract: contract}, BridgedTopShotMomentsTransactor: BridgedTopShotMomentsTransactor{contract: contract}, BridgedTopShotMomentsFilterer: BridgedTopShotMomentsFilterer{contract: contract}}, nil
+}
+
+// NewBridgedTopShotMomentsCaller creates a new read-only instance of BridgedTopShotMoments, bound to a specific deployed contract.
+func NewBridgedTopShotMomentsCaller(address common.Address, caller bind.ContractCaller) (*BridgedTopShotMomentsCaller, error) {
+	contract, err := bindBridgedTopShotMoments(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsCaller{contract: contract}, nil
+}
+
+// NewBridgedTopShotMomentsTransactor creates a new write-only instance of BridgedTopShotMoments, bound to a specific deployed contract.
+func NewBridgedTopShotMomentsTransactor(address common.Address, transactor bind.ContractTransactor) (*BridgedTopShotMomentsTransactor, error) {
+	contract, err := bindBridgedTopShotMoments(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsTransactor{contract: contract}, nil
+}
+
+// NewBridgedTopShotMomentsFilterer creates a new log filterer instance of BridgedTopShotMoments, bound to a specific deployed contract.
+func NewBridgedTopShotMomentsFilterer(address common.Address, filterer bind.ContractFilterer) (*BridgedTopShotMomentsFilterer, error) {
+	contract, err := bindBridgedTopShotMoments(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsFilterer{contract: contract}, nil
+}
+
+// bindBridgedTopShotMoments binds a generic wrapper to an already deployed contract.
+func bindBridgedTopShotMoments(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BridgedTopShotMomentsMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BridgedTopShotMoments *BridgedTopShotMomentsRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BridgedTopShotMoments.Contract.BridgedTopShotMomentsCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BridgedTopShotMoments *BridgedTopShotMomentsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.BridgedTopShotMomentsTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BridgedTopShotMoments *BridgedTopShotMomentsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.BridgedTopShotMomentsTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BridgedTopShotMoments.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.contract.Transact(opts, method, params...)
+}
+
+// AllowsBridging is a free data retrieval call binding the contract method 0x031c04f9.
+//
+// Solidity: function allowsBridging() view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) AllowsBridging(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "allowsBridging")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AllowsBridging is a free data retrieval call binding the contract method 0x031c04f9.
+//
+// Solidity: function allowsBridging() view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) AllowsBridging() (bool, error) {
+	return _BridgedTopShotMoments.Contract.AllowsBridging(&_BridgedTopShotMoments.CallOpts)
+}
+
+// AllowsBridging is a free data retrieval call binding the contract method 0x031c04f9.
+//
+// Solidity: function allowsBridging() view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) AllowsBridging() (bool, error) {
+	return _BridgedTopShotMoments.Contract.AllowsBridging(&_BridgedTopShotMoments.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.BalanceOf(&_BridgedTopShotMoments.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.BalanceOf(&_BridgedTopShotMoments.CallOpts, owner)
+}
+
+// CadenceNFTAddress is a free data retrieval call binding the contract method 0x66bd8dc0.
+//
+// Solidity: function cadenceNFTAddress() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) CadenceNFTAddress(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "cadenceNFTAddress")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// CadenceNFTAddress is a free data retrieval call binding the contract method 0x66bd8dc0.
+//
+// Solidity: function cadenceNFTAddress() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) CadenceNFTAddress() (string, error) {
+	return _BridgedTopShotMoments.Contract.CadenceNFTAddress(&_BridgedTopShotMoments.CallOpts)
+}
+
+// CadenceNFTAddress is a free data retrieval call binding the contract method 0x66bd8dc0.
+//
+// Solidity: function cadenceNFTAddress() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) CadenceNFTAddress() (string, error) {
+	return _BridgedTopShotMoments.Contract.CadenceNFTAddress(&_BridgedTopShotMoments.CallOpts)
+}
+
+// CadenceNFTIdentifier is a free data retrieval call binding the contract method 0xbef43b9d.
+//
+// Solidity: function cadenceNFTIdentifier() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) CadenceNFTIdentifier(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "cadenceNFTIdentifier")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// CadenceNFTIdentifier is a free data retrieval call binding the contract method 0xbef43b9d.
+//
+// Solidity: function cadenceNFTIdentifier() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) CadenceNFTIdentifier() (string, error) {
+	return _BridgedTopShotMoments.Contract.CadenceNFTIdentifier(&_BridgedTopShotMoments.CallOpts)
+}
+
+// CadenceNFTIdentifier is a free data retrieval call binding the contract method 0xbef43b9d.
+//
+// Solidity: function cadenceNFTIdentifier() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) CadenceNFTIdentifier() (string, error) {
+	return _BridgedTopShotMoments.Contract.CadenceNFTIdentifier(&_BridgedTopShotMoments.CallOpts)
+}
+
+// ContractMetadata is a free data retrieval call binding the contract method 0xa76b4d56.
+//
+// Solidity: function contractMetadata() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) ContractMetadata(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "contractMetadata")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// ContractMetadata is a free data retrieval call binding the contract method 0xa76b4d56.
+//
+// Solidity: function contractMetadata() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) ContractMetadata() (string, error) {
+	return _BridgedTopShotMoments.Contract.ContractMetadata(&_BridgedTopShotMoments.CallOpts)
+}
+
+// ContractMetadata is a free data retrieval call binding the contract method 0xa76b4d56.
+//
+// Solidity: function contractMetadata() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) ContractMetadata() (string, error) {
+	return _BridgedTopShotMoments.Contract.ContractMetadata(&_BridgedTopShotMoments.CallOpts)
+}
+
+// ContractURI is a free data retrieval call binding the contract method 0xe8a3d485.
+//
+// Solidity: function contractURI() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) ContractURI(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "contractURI")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// ContractURI is a free data retrieval call binding the contract method 0xe8a3d485.
+//
+// Solidity: function contractURI() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) ContractURI() (string, error) {
+	return _BridgedTopShotMoments.Contract.ContractURI(&_BridgedTopShotMoments.CallOpts)
+}
+
+// ContractURI is a free data retrieval call binding the contract method 0xe8a3d485.
+//
+// Solidity: function contractURI() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) ContractURI() (string, error) {
+	return _BridgedTopShotMoments.Contract.ContractURI(&_BridgedTopShotMoments.CallOpts)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 _id) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) Exists(opts *bind.CallOpts, _id *big.Int) (bool, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "exists", _id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 _id) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) Exists(_id *big.Int) (bool, error) {
+	return _BridgedTopShotMoments.Contract.Exists(&_BridgedTopShotMoments.CallOpts, _id)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 _id) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) Exists(_id *big.Int) (bool, error) {
+	return _BridgedTopShotMoments.Contract.Exists(&_BridgedTopShotMoments.CallOpts, _id)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.GetApproved(&_BridgedTopShotMoments.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.GetApproved(&_BridgedTopShotMoments.CallOpts, tokenId)
+}
+
+// GetCadenceAddress is a free data retrieval call binding the contract method 0x1a622896.
+//
+// Solidity: function getCadenceAddress() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) GetCadenceAddress(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "getCadenceAddress")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetCadenceAddress is a free data retrieval call binding the contract method 0x1a622896.
+//
+// Solidity: function getCadenceAddress() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) GetCadenceAddress() (string, error) {
+	return _BridgedTopShotMoments.Contract.GetCadenceAddress(&_BridgedTopShotMoments.CallOpts)
+}
+
+// GetCadenceAddress is a free data retrieval call binding the contract method 0x1a622896.
+//
+// Solidity: function getCadenceAddress() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) GetCadenceAddress() (string, error) {
+	return _BridgedTopShotMoments.Contract.GetCadenceAddress(&_BridgedTopShotMoments.CallOpts)
+}
+
+// GetCadenceIdentifier is a free data retrieval call binding the contract method 0x97d9a159.
+//
+// Solidity: function getCadenceIdentifier() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) GetCadenceIdentifier(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "getCadenceIdentifier")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetCadenceIdentifier is a free data retrieval call binding the contract method 0x97d9a159.
+//
+// Solidity: function getCadenceIdentifier() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) GetCadenceIdentifier() (string, error) {
+	return _BridgedTopShotMoments.Contract.GetCadenceIdentifier(&_BridgedTopShotMoments.CallOpts)
+}
+
+// GetCadenceIdentifier is a free data retrieval call binding the contract method 0x97d9a159.
+//
+// Solidity: function getCadenceIdentifier() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) GetCadenceIdentifier() (string, error) {
+	return _BridgedTopShotMoments.Contract.GetCadenceIdentifier(&_BridgedTopShotMoments.CallOpts)
+}
+
+// GetTransferValidationFunction is a free data retrieval call binding the contract method 0x0d705df6.
+//
+// Solidity: function getTransferValidationFunction() pure returns(bytes4 functionSignature, bool isViewFunction)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) GetTransferValidationFunction(opts *bind.CallOpts) (struct {
+	FunctionSignature [4]byte
+	IsViewFunction    bool
+}, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "getTransferValidationFunction")
+
+	outstruct := new(struct {
+		FunctionSignature [4]byte
+		IsViewFunction    bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.FunctionSignature = *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+	outstruct.IsViewFunction = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetTransferValidationFunction is a free data retrieval call binding the contract method 0x0d705df6.
+//
+// Solidity: function getTransferValidationFunction() pure returns(bytes4 functionSignature, bool isViewFunction)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) GetTransferValidationFunction() (struct {
+	FunctionSignature [4]byte
+	IsViewFunction    bool
+}, error) {
+	return _BridgedTopShotMoments.Contract.GetTransferValidationFunction(&_BridgedTopShotMoments.CallOpts)
+}
+
+// GetTransferValidationFunction is a free data retrieval call binding the contract method 0x0d705df6.
+//
+// Solidity: function getTransferValidationFunction() pure returns(bytes4 functionSignature, bool isViewFunction)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) GetTransferValidationFunction() (struct {
+	FunctionSignature [4]byte
+	IsViewFunction    bool
+}, error) {
+	return _BridgedTopShotMoments.Contract.GetTransferValidationFunction(&_BridgedTopShotMoments.CallOpts)
+}
+
+// GetTransferValidator is a free data retrieval call binding the contract method 0x098144d4.
+//
+// Solidity: function getTransferValidator() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) GetTransferValidator(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "getTransferValidator")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetTransferValidator is a free data retrieval call binding the contract method 0x098144d4.
+//
+// Solidity: function getTransferValidator() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) GetTransferValidator() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.GetTransferValidator(&_BridgedTopShotMoments.CallOpts)
+}
+
+// GetTransferValidator is a free data retrieval call binding the contract method 0x098144d4.
+//
+// Solidity: function getTransferValidator() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) GetTransferValidator() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.GetTransferValidator(&_BridgedTopShotMoments.CallOpts)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _BridgedTopShotMoments.Contract.IsApprovedForAll(&_BridgedTopShotMoments.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _BridgedTopShotMoments.Contract.IsApprovedForAll(&_BridgedTopShotMoments.CallOpts, owner, operator)
+}
+
+// IsEscrowed is a free data retrieval call binding the contract method 0xa4d320cc.
+//
+// Solidity: function isEscrowed(uint256 _id) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) IsEscrowed(opts *bind.CallOpts, _id *big.Int) (bool, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "isEscrowed", _id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsEscrowed is a free data retrieval call binding the contract method 0xa4d320cc.
+//
+// Solidity: function isEscrowed(uint256 _id) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) IsEscrowed(_id *big.Int) (bool, error) {
+	return _BridgedTopShotMoments.Contract.IsEscrowed(&_BridgedTopShotMoments.CallOpts, _id)
+}
+
+// IsEscrowed is a free data retrieval call binding the contract method 0xa4d320cc.
+//
+// Solidity: function isEscrowed(uint256 _id) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) IsEscrowed(_id *big.Int) (bool, error) {
+	return _BridgedTopShotMoments.Contract.IsEscrowed(&_BridgedTopShotMoments.CallOpts, _id)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) Name() (string, error) {
+	return _BridgedTopShotMoments.Contract.Name(&_BridgedTopShotMoments.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) Name() (string, error) {
+	return _BridgedTopShotMoments.Contract.Name(&_BridgedTopShotMoments.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) Owner() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.Owner(&_BridgedTopShotMoments.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) Owner() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.Owner(&_BridgedTopShotMoments.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.OwnerOf(&_BridgedTopShotMoments.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.OwnerOf(&_BridgedTopShotMoments.CallOpts, tokenId)
+}
+
+// RoyaltyAddress is a free data retrieval call binding the contract method 0xad2f852a.
+//
+// Solidity: function royaltyAddress() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) RoyaltyAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "royaltyAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// RoyaltyAddress is a free data retrieval call binding the contract method 0xad2f852a.
+//
+// Solidity: function royaltyAddress() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) RoyaltyAddress() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.RoyaltyAddress(&_BridgedTopShotMoments.CallOpts)
+}
+
+// RoyaltyAddress is a free data retrieval call binding the contract method 0xad2f852a.
+//
+// Solidity: function royaltyAddress() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) RoyaltyAddress() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.RoyaltyAddress(&_BridgedTopShotMoments.CallOpts)
+}
+
+// RoyaltyBasisPoints is a free data retrieval call binding the contract method 0x42260b5d.
+//
+// Solidity: function royaltyBasisPoints() view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) RoyaltyBasisPoints(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "royaltyBasisPoints")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RoyaltyBasisPoints is a free data retrieval call binding the contract method 0x42260b5d.
+//
+// Solidity: function royaltyBasisPoints() view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) RoyaltyBasisPoints() (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.RoyaltyBasisPoints(&_BridgedTopShotMoments.CallOpts)
+}
+
+// RoyaltyBasisPoints is a free data retrieval call binding the contract method 0x42260b5d.
+//
+// Solidity: function royaltyBasisPoints() view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) RoyaltyBasisPoints() (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.RoyaltyBasisPoints(&_BridgedTopShotMoments.CallOpts)
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 , uint256 _salePrice) view returns(address receiver, uint256 royaltyAmount)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) RoyaltyInfo(opts *bind.CallOpts, arg0 *big.Int, _salePrice *big.Int) (struct {
+	Receiver      common.Address
+	RoyaltyAmount *big.Int
+}, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "royaltyInfo", arg0, _salePrice)
+
+	outstruct := new(struct {
+		Receiver      common.Address
+		RoyaltyAmount *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Receiver = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.RoyaltyAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 , uint256 _salePrice) view returns(address receiver, uint256 royaltyAmount)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) RoyaltyInfo(arg0 *big.Int, _salePrice *big.Int) (struct {
+	Receiver      common.Address
+	RoyaltyAmount *big.Int
+}, error) {
+	return _BridgedTopShotMoments.Contract.RoyaltyInfo(&_BridgedTopShotMoments.CallOpts, arg0, _salePrice)
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 , uint256 _salePrice) view returns(address receiver, uint256 royaltyAmount)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) RoyaltyInfo(arg0 *big.Int, _salePrice *big.Int) (struct {
+	Receiver      common.Address
+	RoyaltyAmount *big.Int
+}, error) {
+	return _BridgedTopShotMoments.Contract.RoyaltyInfo(&_BridgedTopShotMoments.CallOpts, arg0, _salePrice)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _BridgedTopShotMoments.Contract.SupportsInterface(&_BridgedTopShotMoments.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _BridgedTopShotMoments.Contract.SupportsInterface(&_BridgedTopShotMoments.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) Symbol() (string, error) {
+	return _BridgedTopShotMoments.Contract.Symbol(&_BridgedTopShotMoments.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) Symbol() (string, error) {
+	return _BridgedTopShotMoments.Contract.Symbol(&_BridgedTopShotMoments.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.TokenByIndex(&_BridgedTopShotMoments.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.TokenByIndex(&_BridgedTopShotMoments.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.TokenOfOwnerByIndex(&_BridgedTopShotMoments.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.TokenOfOwnerByIndex(&_BridgedTopShotMoments.CallOpts, owner, index)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _BridgedTopShotMoments.Contract.TokenURI(&_BridgedTopShotMoments.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _BridgedTopShotMoments.Contract.TokenURI(&_BridgedTopShotMoments.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) TotalSupply() (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.TotalSupply(&_BridgedTopShotMoments.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) TotalSupply() (*big.Int, error) {
+	return _BridgedTopShotMoments.Contract.TotalSupply(&_BridgedTopShotMoments.CallOpts)
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) Underlying(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "underlying")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) Underlying() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.Underlying(&_BridgedTopShotMoments.CallOpts)
+}
+
+// Underlying is a free data retrieval call binding the contract method 0x6f307dc3.
+//
+// Solidity: function underlying() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) Underlying() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.Underlying(&_BridgedTopShotMoments.CallOpts)
+}
+
+// VmBridgeAddress is a free data retrieval call binding the contract method 0xb7f9a9ec.
+//
+// Solidity: function vmBridgeAddress() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCaller) VmBridgeAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BridgedTopShotMoments.contract.Call(opts, &out, "vmBridgeAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VmBridgeAddress is a free data retrieval call binding the contract method 0xb7f9a9ec.
+//
+// Solidity: function vmBridgeAddress() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) VmBridgeAddress() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.VmBridgeAddress(&_BridgedTopShotMoments.CallOpts)
+}
+
+// VmBridgeAddress is a free data retrieval call binding the contract method 0xb7f9a9ec.
+//
+// Solidity: function vmBridgeAddress() view returns(address)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsCallerSession) VmBridgeAddress() (common.Address, error) {
+	return _BridgedTopShotMoments.Contract.VmBridgeAddress(&_BridgedTopShotMoments.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.Approve(&_BridgedTopShotMoments.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.Approve(&_BridgedTopShotMoments.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.Burn(&_BridgedTopShotMoments.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.Burn(&_BridgedTopShotMoments.TransactOpts, tokenId)
+}
+
+// DepositFor is a paid mutator transaction binding the contract method 0xcace6eb2.
+//
+// Solidity: function depositFor(address account, uint256[] tokenIds) returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) DepositFor(opts *bind.TransactOpts, account common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "depositFor", account, tokenIds)
+}
+
+// DepositFor is a paid mutator transaction binding the contract method 0xcace6eb2.
+//
+// Solidity: function depositFor(address account, uint256[] tokenIds) returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) DepositFor(account common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.DepositFor(&_BridgedTopShotMoments.TransactOpts, account, tokenIds)
+}
+
+// DepositFor is a paid mutator transaction binding the contract method 0xcace6eb2.
+//
+// Solidity: function depositFor(address account, uint256[] tokenIds) returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) DepositFor(account common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.DepositFor(&_BridgedTopShotMoments.TransactOpts, account, tokenIds)
+}
+
+// FulfillToEVM is a paid mutator transaction binding the contract method 0xc5e623c5.
+//
+// Solidity: function fulfillToEVM(address _to, uint256 _id, bytes _data) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) FulfillToEVM(opts *bind.TransactOpts, _to common.Address, _id *big.Int, _data []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "fulfillToEVM", _to, _id, _data)
+}
+
+// FulfillToEVM is a paid mutator transaction binding the contract method 0xc5e623c5.
+//
+// Solidity: function fulfillToEVM(address _to, uint256 _id, bytes _data) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) FulfillToEVM(_to common.Address, _id *big.Int, _data []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.FulfillToEVM(&_BridgedTopShotMoments.TransactOpts, _to, _id, _data)
+}
+
+// FulfillToEVM is a paid mutator transaction binding the contract method 0xc5e623c5.
+//
+// Solidity: function fulfillToEVM(address _to, uint256 _id, bytes _data) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) FulfillToEVM(_to common.Address, _id *big.Int, _data []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.FulfillToEVM(&_BridgedTopShotMoments.TransactOpts, _to, _id, _data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8725ee48.
+//
+// Solidity: function initialize(address owner, address underlyingNftContractAddress, address vmBridgeAddress, string name_, string symbol_, string baseTokenURI_, string _cadenceNFTAddress, string _cadenceNFTIdentifier, string _contractMetadata) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) Initialize(opts *bind.TransactOpts, owner common.Address, underlyingNftContractAddress common.Address, vmBridgeAddress common.Address, name_ string, symbol_ string, baseTokenURI_ string, _cadenceNFTAddress string, _cadenceNFTIdentifier string, _contractMetadata string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "initialize", owner, underlyingNftContractAddress, vmBridgeAddress, name_, symbol_, baseTokenURI_, _cadenceNFTAddress, _cadenceNFTIdentifier, _contractMetadata)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8725ee48.
+//
+// Solidity: function initialize(address owner, address underlyingNftContractAddress, address vmBridgeAddress, string name_, string symbol_, string baseTokenURI_, string _cadenceNFTAddress, string _cadenceNFTIdentifier, string _contractMetadata) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) Initialize(owner common.Address, underlyingNftContractAddress common.Address, vmBridgeAddress common.Address, name_ string, symbol_ string, baseTokenURI_ string, _cadenceNFTAddress string, _cadenceNFTIdentifier string, _contractMetadata string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.Initialize(&_BridgedTopShotMoments.TransactOpts, owner, underlyingNftContractAddress, vmBridgeAddress, name_, symbol_, baseTokenURI_, _cadenceNFTAddress, _cadenceNFTIdentifier, _contractMetadata)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8725ee48.
+//
+// Solidity: function initialize(address owner, address underlyingNftContractAddress, address vmBridgeAddress, string name_, string symbol_, string baseTokenURI_, string _cadenceNFTAddress, string _cadenceNFTIdentifier, string _contractMetadata) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) Initialize(owner common.Address, underlyingNftContractAddress common.Address, vmBridgeAddress common.Address, name_ string, symbol_ string, baseTokenURI_ string, _cadenceNFTAddress string, _cadenceNFTIdentifier string, _contractMetadata string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.Initialize(&_BridgedTopShotMoments.TransactOpts, owner, underlyingNftContractAddress, vmBridgeAddress, name_, symbol_, baseTokenURI_, _cadenceNFTAddress, _cadenceNFTIdentifier, _contractMetadata)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address from, uint256 tokenId, bytes ) returns(bytes4)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) OnERC721Received(opts *bind.TransactOpts, arg0 common.Address, from common.Address, tokenId *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "onERC721Received", arg0, from, tokenId, arg3)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address from, uint256 tokenId, bytes ) returns(bytes4)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) OnERC721Received(arg0 common.Address, from common.Address, tokenId *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.OnERC721Received(&_BridgedTopShotMoments.TransactOpts, arg0, from, tokenId, arg3)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address from, uint256 tokenId, bytes ) returns(bytes4)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) OnERC721Received(arg0 common.Address, from common.Address, tokenId *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.OnERC721Received(&_BridgedTopShotMoments.TransactOpts, arg0, from, tokenId, arg3)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) RenounceOwnership() (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.RenounceOwnership(&_BridgedTopShotMoments.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.RenounceOwnership(&_BridgedTopShotMoments.TransactOpts)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SafeTransferFrom(&_BridgedTopShotMoments.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SafeTransferFrom(&_BridgedTopShotMoments.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SafeTransferFrom0(&_BridgedTopShotMoments.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SafeTransferFrom0(&_BridgedTopShotMoments.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetApprovalForAll(&_BridgedTopShotMoments.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetApprovalForAll(&_BridgedTopShotMoments.TransactOpts, operator, approved)
+}
+
+// SetBaseTokenURI is a paid mutator transaction binding the contract method 0x30176e13.
+//
+// Solidity: function setBaseTokenURI(string newBaseTokenURI) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SetBaseTokenURI(opts *bind.TransactOpts, newBaseTokenURI string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "setBaseTokenURI", newBaseTokenURI)
+}
+
+// SetBaseTokenURI is a paid mutator transaction binding the contract method 0x30176e13.
+//
+// Solidity: function setBaseTokenURI(string newBaseTokenURI) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SetBaseTokenURI(newBaseTokenURI string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetBaseTokenURI(&_BridgedTopShotMoments.TransactOpts, newBaseTokenURI)
+}
+
+// SetBaseTokenURI is a paid mutator transaction binding the contract method 0x30176e13.
+//
+// Solidity: function setBaseTokenURI(string newBaseTokenURI) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SetBaseTokenURI(newBaseTokenURI string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetBaseTokenURI(&_BridgedTopShotMoments.TransactOpts, newBaseTokenURI)
+}
+
+// SetBridgePermissions is a paid mutator transaction binding the contract method 0x153f520b.
+//
+// Solidity: function setBridgePermissions(bool permissions) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SetBridgePermissions(opts *bind.TransactOpts, permissions bool) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "setBridgePermissions", permissions)
+}
+
+// SetBridgePermissions is a paid mutator transaction binding the contract method 0x153f520b.
+//
+// Solidity: function setBridgePermissions(bool permissions) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SetBridgePermissions(permissions bool) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetBridgePermissions(&_BridgedTopShotMoments.TransactOpts, permissions)
+}
+
+// SetBridgePermissions is a paid mutator transaction binding the contract method 0x153f520b.
+//
+// Solidity: function setBridgePermissions(bool permissions) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SetBridgePermissions(permissions bool) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetBridgePermissions(&_BridgedTopShotMoments.TransactOpts, permissions)
+}
+
+// SetContractURI is a paid mutator transaction binding the contract method 0x938e3d7b.
+//
+// Solidity: function setContractURI(string newMetadata) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SetContractURI(opts *bind.TransactOpts, newMetadata string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "setContractURI", newMetadata)
+}
+
+// SetContractURI is a paid mutator transaction binding the contract method 0x938e3d7b.
+//
+// Solidity: function setContractURI(string newMetadata) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SetContractURI(newMetadata string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetContractURI(&_BridgedTopShotMoments.TransactOpts, newMetadata)
+}
+
+// SetContractURI is a paid mutator transaction binding the contract method 0x938e3d7b.
+//
+// Solidity: function setContractURI(string newMetadata) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SetContractURI(newMetadata string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetContractURI(&_BridgedTopShotMoments.TransactOpts, newMetadata)
+}
+
+// SetRoyaltyInfo is a paid mutator transaction binding the contract method 0x44dae42c.
+//
+// Solidity: function setRoyaltyInfo((address,uint96) newInfo) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SetRoyaltyInfo(opts *bind.TransactOpts, newInfo BridgedTopShotMomentsRoyaltyInfo) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "setRoyaltyInfo", newInfo)
+}
+
+// SetRoyaltyInfo is a paid mutator transaction binding the contract method 0x44dae42c.
+//
+// Solidity: function setRoyaltyInfo((address,uint96) newInfo) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SetRoyaltyInfo(newInfo BridgedTopShotMomentsRoyaltyInfo) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetRoyaltyInfo(&_BridgedTopShotMoments.TransactOpts, newInfo)
+}
+
+// SetRoyaltyInfo is a paid mutator transaction binding the contract method 0x44dae42c.
+//
+// Solidity: function setRoyaltyInfo((address,uint96) newInfo) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SetRoyaltyInfo(newInfo BridgedTopShotMomentsRoyaltyInfo) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetRoyaltyInfo(&_BridgedTopShotMoments.TransactOpts, newInfo)
+}
+
+// SetSymbol is a paid mutator transaction binding the contract method 0xb84c8246.
+//
+// Solidity: function setSymbol(string newSymbol) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SetSymbol(opts *bind.TransactOpts, newSymbol string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "setSymbol", newSymbol)
+}
+
+// SetSymbol is a paid mutator transaction binding the contract method 0xb84c8246.
+//
+// Solidity: function setSymbol(string newSymbol) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SetSymbol(newSymbol string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetSymbol(&_BridgedTopShotMoments.TransactOpts, newSymbol)
+}
+
+// SetSymbol is a paid mutator transaction binding the contract method 0xb84c8246.
+//
+// Solidity: function setSymbol(string newSymbol) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SetSymbol(newSymbol string) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetSymbol(&_BridgedTopShotMoments.TransactOpts, newSymbol)
+}
+
+// SetTransferValidator is a paid mutator transaction binding the contract method 0xa9fc664e.
+//
+// Solidity: function setTransferValidator(address newValidator) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) SetTransferValidator(opts *bind.TransactOpts, newValidator common.Address) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "setTransferValidator", newValidator)
+}
+
+// SetTransferValidator is a paid mutator transaction binding the contract method 0xa9fc664e.
+//
+// Solidity: function setTransferValidator(address newValidator) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) SetTransferValidator(newValidator common.Address) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetTransferValidator(&_BridgedTopShotMoments.TransactOpts, newValidator)
+}
+
+// SetTransferValidator is a paid mutator transaction binding the contract method 0xa9fc664e.
+//
+// Solidity: function setTransferValidator(address newValidator) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) SetTransferValidator(newValidator common.Address) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.SetTransferValidator(&_BridgedTopShotMoments.TransactOpts, newValidator)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.TransferFrom(&_BridgedTopShotMoments.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.TransferFrom(&_BridgedTopShotMoments.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.TransferOwnership(&_BridgedTopShotMoments.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.TransferOwnership(&_BridgedTopShotMoments.TransactOpts, newOwner)
+}
+
+// WithdrawTo is a paid mutator transaction binding the contract method 0x7c1b126c.
+//
+// Solidity: function withdrawTo(address account, uint256[] tokenIds) returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactor) WithdrawTo(opts *bind.TransactOpts, account common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.contract.Transact(opts, "withdrawTo", account, tokenIds)
+}
+
+// WithdrawTo is a paid mutator transaction binding the contract method 0x7c1b126c.
+//
+// Solidity: function withdrawTo(address account, uint256[] tokenIds) returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsSession) WithdrawTo(account common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.WithdrawTo(&_BridgedTopShotMoments.TransactOpts, account, tokenIds)
+}
+
+// WithdrawTo is a paid mutator transaction binding the contract method 0x7c1b126c.
+//
+// Solidity: function withdrawTo(address account, uint256[] tokenIds) returns(bool)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsTransactorSession) WithdrawTo(account common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _BridgedTopShotMoments.Contract.WithdrawTo(&_BridgedTopShotMoments.TransactOpts, account, tokenIds)
+}
+
+// BridgedTopShotMomentsApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsApprovalIterator struct {
+	Event *BridgedTopShotMomentsApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsApproval represents a Approval event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*BridgedTopShotMomentsApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsApprovalIterator{contract: _BridgedTopShotMoments.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsApproval)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseApproval(log types.Log) (*BridgedTopShotMomentsApproval, error) {
+	event := new(BridgedTopShotMomentsApproval)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsApprovalForAllIterator struct {
+	Event *BridgedTopShotMomentsApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsApprovalForAll represents a ApprovalForAll event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*BridgedTopShotMomentsApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsApprovalForAllIterator{contract: _BridgedTopShotMoments.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsApprovalForAll)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseApprovalForAll(log types.Log) (*BridgedTopShotMomentsApprovalForAll, error) {
+	event := new(BridgedTopShotMomentsApprovalForAll)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsBatchMetadataUpdateIterator struct {
+	Event *BridgedTopShotMomentsBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*BridgedTopShotMomentsBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsBatchMetadataUpdateIterator{contract: _BridgedTopShotMoments.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsBatchMetadataUpdate)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseBatchMetadataUpdate(log types.Log) (*BridgedTopShotMomentsBatchMetadataUpdate, error) {
+	event := new(BridgedTopShotMomentsBatchMetadataUpdate)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsContractURIUpdatedIterator is returned from FilterContractURIUpdated and is used to iterate over the raw logs and unpacked data for ContractURIUpdated events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsContractURIUpdatedIterator struct {
+	Event *BridgedTopShotMomentsContractURIUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsContractURIUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsContractURIUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsContractURIUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsContractURIUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsContractURIUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsContractURIUpdated represents a ContractURIUpdated event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsContractURIUpdated struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractURIUpdated is a free log retrieval operation binding the contract event 0xa5d4097edda6d87cb9329af83fb3712ef77eeb13738ffe43cc35a4ce305ad962.
+//
+// Solidity: event ContractURIUpdated()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterContractURIUpdated(opts *bind.FilterOpts) (*BridgedTopShotMomentsContractURIUpdatedIterator, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "ContractURIUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsContractURIUpdatedIterator{contract: _BridgedTopShotMoments.contract, event: "ContractURIUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchContractURIUpdated is a free log subscription operation binding the contract event 0xa5d4097edda6d87cb9329af83fb3712ef77eeb13738ffe43cc35a4ce305ad962.
+//
+// Solidity: event ContractURIUpdated()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchContractURIUpdated(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsContractURIUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "ContractURIUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsContractURIUpdated)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "ContractURIUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractURIUpdated is a log parse operation binding the contract event 0xa5d4097edda6d87cb9329af83fb3712ef77eeb13738ffe43cc35a4ce305ad962.
+//
+// Solidity: event ContractURIUpdated()
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseContractURIUpdated(log types.Log) (*BridgedTopShotMomentsContractURIUpdated, error) {
+	event := new(BridgedTopShotMomentsContractURIUpdated)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "ContractURIUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsFulfilledToEVMIterator is returned from FilterFulfilledToEVM and is used to iterate over the raw logs and unpacked data for FulfilledToEVM events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsFulfilledToEVMIterator struct {
+	Event *BridgedTopShotMomentsFulfilledToEVM // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsFulfilledToEVMIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsFulfilledToEVM)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsFulfilledToEVM)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsFulfilledToEVMIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsFulfilledToEVMIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsFulfilledToEVM represents a FulfilledToEVM event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsFulfilledToEVM struct {
+	Recipient common.Address
+	TokenId   *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterFulfilledToEVM is a free log retrieval operation binding the contract event 0x58f05a03f1c161b4c2714f6423ba6ce3fec4587b9abd274cae7dc416fb56ba22.
+//
+// Solidity: event FulfilledToEVM(address indexed recipient, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterFulfilledToEVM(opts *bind.FilterOpts, recipient []common.Address, tokenId []*big.Int) (*BridgedTopShotMomentsFulfilledToEVMIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "FulfilledToEVM", recipientRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsFulfilledToEVMIterator{contract: _BridgedTopShotMoments.contract, event: "FulfilledToEVM", logs: logs, sub: sub}, nil
+}
+
+// WatchFulfilledToEVM is a free log subscription operation binding the contract event 0x58f05a03f1c161b4c2714f6423ba6ce3fec4587b9abd274cae7dc416fb56ba22.
+//
+// Solidity: event FulfilledToEVM(address indexed recipient, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchFulfilledToEVM(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsFulfilledToEVM, recipient []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "FulfilledToEVM", recipientRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsFulfilledToEVM)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "FulfilledToEVM", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFulfilledToEVM is a log parse operation binding the contract event 0x58f05a03f1c161b4c2714f6423ba6ce3fec4587b9abd274cae7dc416fb56ba22.
+//
+// Solidity: event FulfilledToEVM(address indexed recipient, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseFulfilledToEVM(log types.Log) (*BridgedTopShotMomentsFulfilledToEVM, error) {
+	event := new(BridgedTopShotMomentsFulfilledToEVM)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "FulfilledToEVM", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsInitializedIterator struct {
+	Event *BridgedTopShotMomentsInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsInitialized represents a Initialized event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterInitialized(opts *bind.FilterOpts) (*BridgedTopShotMomentsInitializedIterator, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsInitializedIterator{contract: _BridgedTopShotMoments.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsInitialized)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseInitialized(log types.Log) (*BridgedTopShotMomentsInitialized, error) {
+	event := new(BridgedTopShotMomentsInitialized)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsMetadataUpdateIterator struct {
+	Event *BridgedTopShotMomentsMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsMetadataUpdate represents a MetadataUpdate event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*BridgedTopShotMomentsMetadataUpdateIterator, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsMetadataUpdateIterator{contract: _BridgedTopShotMoments.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsMetadataUpdate)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseMetadataUpdate(log types.Log) (*BridgedTopShotMomentsMetadataUpdate, error) {
+	event := new(BridgedTopShotMomentsMetadataUpdate)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsOwnershipTransferredIterator struct {
+	Event *BridgedTopShotMomentsOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsOwnershipTransferred represents a OwnershipTransferred event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*BridgedTopShotMomentsOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsOwnershipTransferredIterator{contract: _BridgedTopShotMoments.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsOwnershipTransferred)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseOwnershipTransferred(log types.Log) (*BridgedTopShotMomentsOwnershipTransferred, error) {
+	event := new(BridgedTopShotMomentsOwnershipTransferred)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsPermissionsUpdatedIterator is returned from FilterPermissionsUpdated and is used to iterate over the raw logs and unpacked data for PermissionsUpdated events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsPermissionsUpdatedIterator struct {
+	Event *BridgedTopShotMomentsPermissionsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsPermissionsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsPermissionsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsPermissionsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsPermissionsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsPermissionsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsPermissionsUpdated represents a PermissionsUpdated event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsPermissionsUpdated struct {
+	NewPermissions bool
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermissionsUpdated is a free log retrieval operation binding the contract event 0x2926d469b6739d1e53cb5a939144e9e6cbfafb24d16b1ad3f7dee390e95771fc.
+//
+// Solidity: event PermissionsUpdated(bool newPermissions)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterPermissionsUpdated(opts *bind.FilterOpts) (*BridgedTopShotMomentsPermissionsUpdatedIterator, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "PermissionsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsPermissionsUpdatedIterator{contract: _BridgedTopShotMoments.contract, event: "PermissionsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchPermissionsUpdated is a free log subscription operation binding the contract event 0x2926d469b6739d1e53cb5a939144e9e6cbfafb24d16b1ad3f7dee390e95771fc.
+//
+// Solidity: event PermissionsUpdated(bool newPermissions)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchPermissionsUpdated(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsPermissionsUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "PermissionsUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsPermissionsUpdated)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "PermissionsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermissionsUpdated is a log parse operation binding the contract event 0x2926d469b6739d1e53cb5a939144e9e6cbfafb24d16b1ad3f7dee390e95771fc.
+//
+// Solidity: event PermissionsUpdated(bool newPermissions)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParsePermissionsUpdated(log types.Log) (*BridgedTopShotMomentsPermissionsUpdated, error) {
+	event := new(BridgedTopShotMomentsPermissionsUpdated)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "PermissionsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsRoyaltyInfoUpdatedIterator is returned from FilterRoyaltyInfoUpdated and is used to iterate over the raw logs and unpacked data for RoyaltyInfoUpdated events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsRoyaltyInfoUpdatedIterator struct {
+	Event *BridgedTopShotMomentsRoyaltyInfoUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsRoyaltyInfoUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsRoyaltyInfoUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsRoyaltyInfoUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsRoyaltyInfoUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsRoyaltyInfoUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsRoyaltyInfoUpdated represents a RoyaltyInfoUpdated event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsRoyaltyInfoUpdated struct {
+	Receiver common.Address
+	Bps      *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoyaltyInfoUpdated is a free log retrieval operation binding the contract event 0xf21fccf4d64d86d532c4e4eb86c007b6ad57a460c27d724188625e755ec6cf6d.
+//
+// Solidity: event RoyaltyInfoUpdated(address receiver, uint256 bps)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterRoyaltyInfoUpdated(opts *bind.FilterOpts) (*BridgedTopShotMomentsRoyaltyInfoUpdatedIterator, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "RoyaltyInfoUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsRoyaltyInfoUpdatedIterator{contract: _BridgedTopShotMoments.contract, event: "RoyaltyInfoUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchRoyaltyInfoUpdated is a free log subscription operation binding the contract event 0xf21fccf4d64d86d532c4e4eb86c007b6ad57a460c27d724188625e755ec6cf6d.
+//
+// Solidity: event RoyaltyInfoUpdated(address receiver, uint256 bps)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchRoyaltyInfoUpdated(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsRoyaltyInfoUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "RoyaltyInfoUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsRoyaltyInfoUpdated)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "RoyaltyInfoUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoyaltyInfoUpdated is a log parse operation binding the contract event 0xf21fccf4d64d86d532c4e4eb86c007b6ad57a460c27d724188625e755ec6cf6d.
+//
+// Solidity: event RoyaltyInfoUpdated(address receiver, uint256 bps)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseRoyaltyInfoUpdated(log types.Log) (*BridgedTopShotMomentsRoyaltyInfoUpdated, error) {
+	event := new(BridgedTopShotMomentsRoyaltyInfoUpdated)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "RoyaltyInfoUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsTransferIterator struct {
+	Event *BridgedTopShotMomentsTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsTransfer represents a Transfer event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*BridgedTopShotMomentsTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsTransferIterator{contract: _BridgedTopShotMoments.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsTransfer)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseTransfer(log types.Log) (*BridgedTopShotMomentsTransfer, error) {
+	event := new(BridgedTopShotMomentsTransfer)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BridgedTopShotMomentsTransferValidatorUpdatedIterator is returned from FilterTransferValidatorUpdated and is used to iterate over the raw logs and unpacked data for TransferValidatorUpdated events raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsTransferValidatorUpdatedIterator struct {
+	Event *BridgedTopShotMomentsTransferValidatorUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BridgedTopShotMomentsTransferValidatorUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BridgedTopShotMomentsTransferValidatorUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BridgedTopShotMomentsTransferValidatorUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BridgedTopShotMomentsTransferValidatorUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BridgedTopShotMomentsTransferValidatorUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BridgedTopShotMomentsTransferValidatorUpdated represents a TransferValidatorUpdated event raised by the BridgedTopShotMoments contract.
+type BridgedTopShotMomentsTransferValidatorUpdated struct {
+	OldValidator common.Address
+	NewValidator common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferValidatorUpdated is a free log retrieval operation binding the contract event 0xcc5dc080ff977b3c3a211fa63ab74f90f658f5ba9d3236e92c8f59570f442aac.
+//
+// Solidity: event TransferValidatorUpdated(address oldValidator, address newValidator)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) FilterTransferValidatorUpdated(opts *bind.FilterOpts) (*BridgedTopShotMomentsTransferValidatorUpdatedIterator, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.FilterLogs(opts, "TransferValidatorUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &BridgedTopShotMomentsTransferValidatorUpdatedIterator{contract: _BridgedTopShotMoments.contract, event: "TransferValidatorUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferValidatorUpdated is a free log subscription operation binding the contract event 0xcc5dc080ff977b3c3a211fa63ab74f90f658f5ba9d3236e92c8f59570f442aac.
+//
+// Solidity: event TransferValidatorUpdated(address oldValidator, address newValidator)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) WatchTransferValidatorUpdated(opts *bind.WatchOpts, sink chan<- *BridgedTopShotMomentsTransferValidatorUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _BridgedTopShotMoments.contract.WatchLogs(opts, "TransferValidatorUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BridgedTopShotMomentsTransferValidatorUpdated)
+				if err := _BridgedTopShotMoments.contract.UnpackLog(event, "TransferValidatorUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferValidatorUpdated is a log parse operation binding the contract event 0xcc5dc080ff977b3c3a211fa63ab74f90f658f5ba9d3236e92c8f59570f442aac.
+//
+// Solidity: event TransferValidatorUpdated(address oldValidator, address newValidator)
+func (_BridgedTopShotMoments *BridgedTopShotMomentsFilterer) ParseTransferValidatorUpdated(log types.Log) (*BridgedTopShotMomentsTransferValidatorUpdated, error) {
+	event := new(BridgedTopShotMomentsTransferValidatorUpdated)
+	if err := _BridgedTopShotMoments.contract.UnpackLog(event, "TransferValidatorUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,81 @@
+// Command gen generates the Go bindings of the contracts from their ABIs,
+// first copying the ABIs from the forge artifacts when they are built
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// contracts are the contracts bindings are generated for
+var contracts = []string{"BridgedTopShotMoments", "ERC1967Proxy", "UUPSUpgradeable"}
+
+func main() {
+	artifacts := flag.String("artifacts", "../out", "forge artifacts directory")
+	abiDir := flag.String("abi", "abi", "directory of the ABIs the bindings are generated from")
+	out := flag.String("out", ".", "directory the bindings are written to")
+	pkg := flag.String("pkg", "bindings", "package of the bindings")
+	flag.Parse()
+
+	for _, contract := range contracts {
+		abiPath := filepath.Join(*abiDir, contract+".json")
+
+		refreshed, err := copyABI(filepath.Join(*artifacts, contract+".sol", contract+".json"), abiPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !refreshed {
+			log.Printf("No artifact for %s, generating its bindings from %s", contract, abiPath)
+		}
+
+		contractABI, err := os.ReadFile(abiPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		code, err := bind.Bind([]string{contract}, []string{string(contractABI)}, []string{""}, nil, *pkg, bind.LangGo, nil, nil)
+		if err != nil {
+			log.Fatalf("failed to generate the bindings of %s: %v", contract, err)
+		}
+
+		path := filepath.Join(*out, strings.ToLower(contract)+".go")
+		if err := os.WriteFile(path, []byte(code), 0o644); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Generated %s", path)
+	}
+}
+
+// copyABI writes the ABI of a forge artifact, returning false if the artifact was not built
+func copyABI(artifactPath, abiPath string) (bool, error) {
+	artifact, err := os.ReadFile(artifactPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var parsed struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	if err := json.Unmarshal(artifact, &parsed); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", artifactPath, err)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, parsed.ABI, "", "  "); err != nil {
+		return false, fmt.Errorf("invalid ABI in %s: %w", artifactPath, err)
+	}
+	indented.WriteByte('\n')
+	return true, os.WriteFile(abiPath, indented.Bytes(), 0o644)
+}
@@ -0,0 +1,322 @@
+// Package bulk runs the bridge, wrap, unwrap and transfer transactions of
+// cadence/transactions over many moments, in batches recorded in a journal
+// so that a large migration can be resumed.
+package bulk
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+
+	"bridged-topshot-evm-deploy/deploy"
+)
+
+// Runner runs an operation over moments from the signer's account
+type Runner struct {
+	Network string
+	Flow    deploy.FlowExecutor
+	// Signer is the account signing the transactions, which owns the moments and the COA
+	Signer string
+
+	// NFTIdentifier is the type identifier of TopShot moments, e.g. A.0b2a3299cc857e29.TopShot.NFT
+	NFTIdentifier string
+	// UnderlyingAddr is the ERC721 the bridge deployed for moments
+	UnderlyingAddr string
+	// WrapperAddr is the BridgedTopShotMoments proxy wrapping the underlying ERC721
+	WrapperAddr string
+	// Recipient is the EVM address that receives the moments of ToEVM and
+	// Transfer, the signer's COA when empty for ToEVM
+	Recipient string
+
+	// BatchSize caps the moments of a batch, the operation's default when zero.
+	// A batch exceeding the computation limit is split in two and run again.
+	BatchSize int
+	// MaxBridgeFee caps the bridge fee in FLOW a batch of ToEVM or FromEVM
+	// may withdraw from the signer, unlimited when zero
+	MaxBridgeFee float64
+
+	// JournalPath is the file progress is recorded in
+	JournalPath string
+	// DryRun prints the batches instead of running them, and leaves the journal untouched
+	DryRun bool
+	Out    io.Writer
+	Log    *log.Logger
+}
+
+// Run runs the operation over the moments, resuming the journal of an
+// earlier run, and returns the journal. It stops at the first batch that
+// fails for another reason than the computation limit.
+func (r *Runner) Run(op Operation, ids []uint64) (*Journal, error) {
+	if err := r.validate(op, ids); err != nil {
+		return nil, err
+	}
+	journal, err := LoadJournal(r.JournalPath, r.Network, op, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if !journal.Planned() {
+		if err := r.plan(journal); err != nil {
+			return nil, err
+		}
+		if err := r.save(journal); err != nil {
+			return nil, err
+		}
+	} else {
+		r.Log.Printf("Resuming %s: %d moments done, %d to go", r.JournalPath, journal.Count(Done), len(ids)-len(journal.Skipped)-journal.Count(Done))
+	}
+
+	for i := 0; i < len(journal.Batches); i++ {
+		batch := journal.Batches[i]
+		if batch.Status == Done {
+			continue
+		}
+
+		batchOp := journal.operation(batch)
+		if r.DryRun {
+			r.printBatch(batchOp, i, batch)
+			continue
+		}
+
+		result, err := r.Flow.Tx(batchOp.transaction(), r.Signer, r.args(batchOp, batch.IDs)...)
+		if err != nil && exceedsComputationLimit(err) && len(batch.IDs) > 1 {
+			r.Log.Printf("Batch of %d moments exceeds the computation limit, splitting it", len(batch.IDs))
+			journal.split(i)
+			i--
+			if err := r.save(journal); err != nil {
+				return journal, err
+			}
+			continue
+		}
+		if err != nil {
+			batch.Status, batch.Error = Failed, err.Error()
+			if saveErr := r.save(journal); saveErr != nil {
+				return journal, saveErr
+			}
+			return journal, fmt.Errorf("%s of moments %v failed: %w", batchOp, batch.IDs, err)
+		}
+
+		batch.Status, batch.TxID, batch.Error = Done, result.ID(), ""
+		if err := r.save(journal); err != nil {
+			return journal, err
+		}
+		r.Log.Printf("%s of %d moments done in %s (%d/%d)", batchOp, len(batch.IDs), batch.TxID, journal.Count(Done), len(ids)-len(journal.Skipped))
+	}
+	return journal, nil
+}
+
+func (r *Runner) validate(op Operation, ids []uint64) error {
+	if len(ids) == 0 {
+		return errors.New("no moments given")
+	}
+	if r.JournalPath == "" {
+		return errors.New("no journal path given")
+	}
+	switch {
+	case op.bridges() && r.NFTIdentifier == "":
+		return fmt.Errorf("%s needs the NFT identifier", op)
+	case op == Transfer && r.Recipient == "":
+		return fmt.Errorf("%s needs a recipient", op)
+	case !op.bridges() && r.WrapperAddr == "":
+		return fmt.Errorf("%s needs the wrapper address", op)
+	case op.checksWrapped() && r.UnderlyingAddr == "":
+		return fmt.Errorf("%s needs the underlying ERC721 address", op)
+	}
+	return nil
+}
+
+// plan skips the moments already wrapped or unwrapped, or for a toggle
+// picks the operation of each moment, and splits the moments into batches
+// under the batch size and the bridge fee limit
+func (r *Runner) plan(journal *Journal) error {
+	size, err := r.batchSize(journal.Operation)
+	if err != nil {
+		return err
+	}
+	if !journal.Operation.checksWrapped() {
+		journal.addBatches("", journal.IDs, size)
+		r.Log.Printf("Planned %d batches of up to %d moments", len(journal.Batches), size)
+		return nil
+	}
+
+	unwrapped, wrapped, err := r.partitionWrapped(journal.IDs)
+	if err != nil {
+		return err
+	}
+	switch journal.Operation {
+	case Wrap:
+		journal.addBatches("", unwrapped, size)
+		journal.Skipped = wrapped
+	case Unwrap:
+		journal.addBatches("", wrapped, size)
+		journal.Skipped = unwrapped
+	case Toggle:
+		journal.addBatches(Wrap, unwrapped, size)
+		journal.addBatches(Unwrap, wrapped, size)
+		r.Log.Printf("Wrapping %d moments and unwrapping %d", len(unwrapped), len(wrapped))
+	}
+	if len(journal.Skipped) > 0 {
+		r.Log.Printf("Skipping %d moments already in place: %v", len(journal.Skipped), journal.Skipped)
+	}
+	r.Log.Printf("Planned %d batches of up to %d moments", len(journal.Batches), size)
+	return nil
+}
+
+// partitionWrapped runs is_erc721_wrapped for every moment, returning the
+// moments held unwrapped and the ones held wrapped
+func (r *Runner) partitionWrapped(ids []uint64) (unwrapped []uint64, wrapped []uint64, err error) {
+	account := r.Flow.Address(r.Signer)
+	for _, id := range ids {
+		result, err := r.Flow.Script(isWrappedScript,
+			deploy.Arg{Name: "flowAccountWithCoa", Value: account},
+			deploy.Arg{Name: "nftID", Value: id},
+			deploy.Arg{Name: "underlying", Value: r.UnderlyingAddr},
+			deploy.Arg{Name: "wrapper", Value: r.WrapperAddr},
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check whether moment %d is wrapped: %w", id, err)
+		}
+		isWrapped, ok := result.(bool)
+		if !ok {
+			return nil, nil, fmt.Errorf("unexpected %s result %v for moment %d", isWrappedScript, result, id)
+		}
+
+		if isWrapped {
+			wrapped = append(wrapped, id)
+		} else {
+			unwrapped = append(unwrapped, id)
+		}
+	}
+	return unwrapped, wrapped, nil
+}
+
+// batchSize is the most moments a batch holds, lowered for the operations
+// paying the bridge fee so that the fee stays under MaxBridgeFee
+func (r *Runner) batchSize(op Operation) (int, error) {
+	size := r.BatchSize
+	if size <= 0 {
+		size = op.defaultBatchSize()
+	}
+	if !op.bridges() || r.MaxBridgeFee <= 0 {
+		return size, nil
+	}
+
+	// The fee is a storage fee plus a base fee per moment
+	fixed, err := r.bridgeFee(0)
+	if err != nil {
+		return 0, err
+	}
+	perMoment, err := r.bridgeFee(1)
+	if err != nil {
+		return 0, err
+	}
+	perMoment -= fixed
+	limit := toUFix64(r.MaxBridgeFee)
+	if limit < fixed+perMoment {
+		return 0, fmt.Errorf("a max bridge fee of %.8f FLOW does not cover a single moment, which costs %.8f FLOW", r.MaxBridgeFee, fromUFix64(fixed+perMoment))
+	}
+	if perMoment > 0 {
+		size = min(size, int((limit-fixed)/perMoment))
+	}
+	return size, nil
+}
+
+// bridgeFee is the fee in UFix64 units the bridge transactions allow the bridge to withdraw for n moments
+func (r *Runner) bridgeFee(n int) (uint64, error) {
+	result, err := r.Flow.Script(bridgeFeeScript, deploy.Arg{Name: "nftCount", Value: n})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the bridge fee: %w", err)
+	}
+	switch fee := result.(type) {
+	case float64:
+		return toUFix64(fee), nil
+	case string:
+		value, err := strconv.ParseFloat(fee, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unexpected bridge fee %q: %w", fee, err)
+		}
+		return toUFix64(value), nil
+	}
+	return 0, fmt.Errorf("unexpected %s result %v", bridgeFeeScript, result)
+}
+
+func (r *Runner) args(op Operation, ids []uint64) []deploy.Arg {
+	switch op {
+	case ToEVM:
+		var recipient any
+		if r.Recipient != "" {
+			recipient = r.Recipient
+		}
+		return []deploy.Arg{{Name: "nftIdentifier", Value: r.NFTIdentifier}, {Name: "nftIDs", Value: ids}, {Name: "recipientEvmAddressIfnotCoa", Value: recipient}}
+	case FromEVM:
+		return []deploy.Arg{{Name: "nftIdentifier", Value: r.NFTIdentifier}, {Name: "nftIDs", Value: ids}}
+	case Transfer:
+		return []deploy.Arg{{Name: "erc721Address", Value: r.WrapperAddr}, {Name: "toEVMAddress", Value: r.Recipient}, {Name: "nftIDs", Value: ids}}
+	default:
+		return []deploy.Arg{{Name: "wrapperERC721Address", Value: r.WrapperAddr}, {Name: "nftIDs", Value: ids}}
+	}
+}
+
+func (r *Runner) printBatch(op Operation, i int, batch *Batch) {
+	fmt.Fprintf(r.Out, "--- batch %d: transaction %s, signed by %s\n", i+1, op.transaction(), r.Signer)
+	for _, arg := range r.args(op, batch.IDs) {
+		fmt.Fprintf(r.Out, "%s: %v\n", arg.Name, arg.Value)
+	}
+	fmt.Fprintln(r.Out)
+}
+
+func (r *Runner) save(journal *Journal) error {
+	if r.DryRun {
+		return nil
+	}
+	return journal.Save(r.JournalPath)
+}
+
+// exceedsComputationLimit reports whether a transaction failed on the
+// computation limit, error code 1110 of the Flow execution errors
+func exceedsComputationLimit(err error) bool {
+	message := err.Error()
+	return strings.Contains(message, "computation exceeds limit") ||
+		strings.Contains(message, "insufficient computation") ||
+		strings.Contains(message, "Error Code: 1110")
+}
+
+// ReadIDs reads moment IDs separated by whitespace or commas
+func ReadIDs(r io.Reader) ([]uint64, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	var ids []uint64
+	seen := map[uint64]bool{}
+	for scanner.Scan() {
+		for _, field := range strings.Split(scanner.Text(), ",") {
+			if field == "" {
+				continue
+			}
+			id, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid moment ID %q", field)
+			}
+			if seen[id] {
+				return nil, fmt.Errorf("moment %d is listed twice", id)
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, scanner.Err()
+}
+
+// toUFix64 converts FLOW to the fixed point units of UFix64
+func toUFix64(flow float64) uint64 {
+	return uint64(math.Round(flow * 1e8))
+}
+
+func fromUFix64(units uint64) float64 {
+	return float64(units) / 1e8
+}
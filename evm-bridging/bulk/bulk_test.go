@@ -0,0 +1,311 @@
+package bulk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"bridged-topshot-evm-deploy/deploy"
+)
+
+// fakeFlow runs transactions of up to maxIDs moments, failing the
+// transaction numbered failAt, and answers the scripts of a bulk run
+type fakeFlow struct {
+	txs     []string
+	batches [][]uint64
+	args    [][]deploy.Arg
+	scripts int
+
+	maxIDs  int
+	failAt  int
+	wrapped map[uint64]bool
+	// fees are the bridge fees by number of moments
+	fees map[int]float64
+}
+
+func (f *fakeFlow) Tx(name string, signer string, args ...deploy.Arg) (deploy.TxResult, error) {
+	var ids []uint64
+	for _, arg := range args {
+		if arg.Name == "nftIDs" {
+			ids = arg.Value.([]uint64)
+		}
+	}
+	if f.maxIDs > 0 && len(ids) > f.maxIDs {
+		return nil, errors.New("[Error Code: 1110] computation exceeds limit (9999)")
+	}
+	if len(f.txs)+1 == f.failAt {
+		f.failAt = 0
+		return nil, errors.New("transaction reverted")
+	}
+	f.txs = append(f.txs, name)
+	f.batches = append(f.batches, ids)
+	f.args = append(f.args, args)
+	return txResult(fmt.Sprintf("tx%d", len(f.txs))), nil
+}
+
+func (f *fakeFlow) Script(name string, args ...deploy.Arg) (any, error) {
+	f.scripts++
+	switch name {
+	case isWrappedScript:
+		return f.wrapped[args[1].Value.(uint64)], nil
+	case bridgeFeeScript:
+		return f.fees[args[0].Value.(int)], nil
+	}
+	return nil, fmt.Errorf("unexpected script %s", name)
+}
+
+func (f *fakeFlow) Address(account string) string {
+	return "f8d6e0586b0a20c7"
+}
+
+type txResult string
+
+func (r txResult) ID() string {
+	return string(r)
+}
+
+func (r txResult) Events(name string) []map[string]any {
+	return nil
+}
+
+func newTestRunner(t *testing.T) (*Runner, *fakeFlow) {
+	t.Helper()
+	flow := &fakeFlow{}
+	return &Runner{
+		Network:        "testnet",
+		Flow:           flow,
+		Signer:         "topshot-signer",
+		NFTIdentifier:  "A.877931736ee77cff.TopShot.NFT",
+		UnderlyingAddr: "0xb3627e6f7f1cc981217f789d7737b1f3a93ec519",
+		WrapperAddr:    "0x0000000000000000000000000000000000000102",
+		JournalPath:    filepath.Join(t.TempDir(), "testnet-bulk.json"),
+		Out:            io.Discard,
+		Log:            log.New(io.Discard, "", 0),
+	}, flow
+}
+
+func ids(n int) []uint64 {
+	ids := make([]uint64, n)
+	for i := range ids {
+		ids[i] = uint64(i + 1)
+	}
+	return ids
+}
+
+func TestRunResumesFromJournal(t *testing.T) {
+	r, flow := newTestRunner(t)
+	r.BatchSize = 4
+	flow.failAt = 2
+
+	journal, err := r.Run(ToEVM, ids(10))
+	if err == nil {
+		t.Fatal("expected the failed batch to stop the run")
+	}
+	if len(journal.Batches) != 3 || journal.Count(Done) != 4 || journal.Count(Failed) != 4 {
+		t.Fatalf("unexpected journal after the failure %+v", journal)
+	}
+	if journal.Batches[1].Error != "transaction reverted" {
+		t.Errorf("expected the error to be recorded, got %q", journal.Batches[1].Error)
+	}
+
+	// a new run reads the journal and only runs the batches not done
+	r.Flow = flow
+	journal, err = r.Run(ToEVM, ids(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := [][]uint64{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10}}; !reflect.DeepEqual(flow.batches, expected) {
+		t.Errorf("expected batches %v, got %v", expected, flow.batches)
+	}
+	if journal.Count(Done) != 10 || journal.Batches[1].TxID != "tx2" || journal.Batches[1].Error != "" {
+		t.Errorf("unexpected journal %+v", journal.Batches[1])
+	}
+
+	// the recipient of to-evm is optional
+	if recipient := flow.args[0][2].Value; recipient != nil {
+		t.Errorf("expected no recipient, got %v", recipient)
+	}
+
+	if _, err := r.Run(ToEVM, ids(9)); err == nil {
+		t.Error("expected a journal of other moments to be rejected")
+	}
+	if _, err := r.Run(FromEVM, ids(10)); err == nil {
+		t.Error("expected a journal of another operation to be rejected")
+	}
+}
+
+func TestWrapSkipsWrappedMoments(t *testing.T) {
+	r, flow := newTestRunner(t)
+	flow.wrapped = map[uint64]bool{2: true, 4: true}
+
+	journal, err := r.Run(Wrap, ids(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(journal.Skipped, []uint64{2, 4}) || !reflect.DeepEqual(flow.batches, [][]uint64{{1, 3, 5}}) {
+		t.Errorf("expected 2 and 4 to be skipped, got %v and %v", journal.Skipped, flow.batches)
+	}
+	if flow.txs[0] != wrapTx || flow.args[0][0].Value != r.WrapperAddr {
+		t.Errorf("unexpected transaction %s %v", flow.txs[0], flow.args[0])
+	}
+
+	r, flow = newTestRunner(t)
+	flow.wrapped = map[uint64]bool{2: true, 4: true}
+	if _, err := r.Run(Unwrap, ids(5)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(flow.batches, [][]uint64{{2, 4}}) || flow.txs[0] != unwrapTx {
+		t.Errorf("expected 2 and 4 to be unwrapped, got %v %v", flow.txs, flow.batches)
+	}
+
+	// a run where every moment is in place is done, and is not planned again
+	r, flow = newTestRunner(t)
+	flow.wrapped = map[uint64]bool{1: true}
+	if _, err := r.Run(Wrap, ids(1)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Run(Wrap, ids(1)); err != nil {
+		t.Fatal(err)
+	}
+	if len(flow.txs) != 0 || flow.scripts != 1 {
+		t.Errorf("expected a single check and no transaction, got %d and %v", flow.scripts, flow.txs)
+	}
+}
+
+func TestToggleWrapsOrUnwrapsEachMoment(t *testing.T) {
+	r, flow := newTestRunner(t)
+	flow.wrapped = map[uint64]bool{2: true, 4: true, 5: true}
+	flow.maxIDs = 2
+	flow.failAt = 3
+
+	if _, err := r.Run(Toggle, ids(6)); err == nil {
+		t.Fatal("expected the failed batch to stop the run")
+	}
+	// the wrap batch was split, then the unwrap batch is resumed with its operation
+	journal, err := r.Run(Toggle, ids(6))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{wrapTx, wrapTx, unwrapTx, unwrapTx}; !reflect.DeepEqual(flow.txs, expected) {
+		t.Errorf("expected transactions %v, got %v", expected, flow.txs)
+	}
+	if expected := [][]uint64{{1}, {3, 6}, {2}, {4, 5}}; !reflect.DeepEqual(flow.batches, expected) {
+		t.Errorf("expected batches %v, got %v", expected, flow.batches)
+	}
+	if len(journal.Skipped) != 0 || journal.Count(Done) != 6 || journal.Batches[3].Operation != Unwrap {
+		t.Errorf("unexpected journal %+v", journal)
+	}
+	if flow.scripts != 6 {
+		t.Errorf("expected every moment to be checked once, got %d checks", flow.scripts)
+	}
+}
+
+func TestComputationLimitSplitsBatches(t *testing.T) {
+	r, flow := newTestRunner(t)
+	flow.maxIDs = 3
+
+	journal, err := r.Run(Transfer, ids(8))
+	if err == nil {
+		t.Fatal("expected a transfer without recipient to be rejected")
+	}
+
+	r.Recipient = "0x000000000000000000000002958e48adcfd5d1c7"
+	journal, err = r.Run(Transfer, ids(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := [][]uint64{{1, 2}, {3, 4}, {5, 6}, {7, 8}}; !reflect.DeepEqual(flow.batches, expected) {
+		t.Errorf("expected batches %v, got %v", expected, flow.batches)
+	}
+	if len(journal.Batches) != 4 || journal.Count(Done) != 8 {
+		t.Errorf("expected the split batches to be journaled, got %+v", journal.Batches)
+	}
+	if flow.args[0][1].Value != r.Recipient {
+		t.Errorf("unexpected transfer arguments %v", flow.args[0])
+	}
+}
+
+func TestBridgeFeeLimitsBatchSize(t *testing.T) {
+	r, flow := newTestRunner(t)
+	flow.fees = map[int]float64{0: 0.004, 1: 0.005}
+	r.MaxBridgeFee = 0.0265
+
+	if _, err := r.Run(FromEVM, ids(7)); err != nil {
+		t.Fatal(err)
+	}
+	// the fee allows batches of 22 moments, more than the default size
+	if expected := [][]uint64{{1, 2, 3, 4, 5, 6, 7}}; !reflect.DeepEqual(flow.batches, expected) {
+		t.Errorf("expected batches %v, got %v", expected, flow.batches)
+	}
+
+	r, flow = newTestRunner(t)
+	flow.fees = map[int]float64{0: 0.004, 1: 0.005}
+	r.MaxBridgeFee = 0.0065
+	if _, err := r.Run(ToEVM, ids(7)); err != nil {
+		t.Fatal(err)
+	}
+	if expected := [][]uint64{{1, 2}, {3, 4}, {5, 6}, {7}}; !reflect.DeepEqual(flow.batches, expected) {
+		t.Errorf("expected batches %v, got %v", expected, flow.batches)
+	}
+
+	r, flow = newTestRunner(t)
+	flow.fees = map[int]float64{0: 0.004, 1: 0.005}
+	r.MaxBridgeFee = 0.0049
+	if _, err := r.Run(ToEVM, ids(7)); err == nil || !strings.Contains(err.Error(), "does not cover a single moment") {
+		t.Errorf("expected a fee limit under one moment to be rejected, got %v", err)
+	}
+}
+
+func TestDryRunSubmitsNothing(t *testing.T) {
+	r, flow := newTestRunner(t)
+	out := &bytes.Buffer{}
+	r.DryRun, r.Out, r.BatchSize = true, out, 2
+
+	if _, err := r.Run(FromEVM, ids(3)); err != nil {
+		t.Fatal(err)
+	}
+	if len(flow.txs) != 0 {
+		t.Fatalf("dry run submitted %v", flow.txs)
+	}
+	if printed := out.String(); !strings.Contains(printed, "--- batch 2: transaction "+bridgeFromEVMTx) || !strings.Contains(printed, "nftIDs: [3]") {
+		t.Errorf("unexpected dry run output:\n%s", printed)
+	}
+
+	// the journal was not written
+	journal, err := LoadJournal(r.JournalPath, "testnet", FromEVM, ids(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if journal.Planned() {
+		t.Errorf("dry run wrote the journal %+v", journal)
+	}
+}
+
+func TestReadIDs(t *testing.T) {
+	read, err := ReadIDs(strings.NewReader("1, 2,3\n4\t5\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(read, ids(5)) {
+		t.Errorf("unexpected IDs %v", read)
+	}
+
+	for _, input := range []string{"1 x", "1 -2", "1 2 1"} {
+		if _, err := ReadIDs(strings.NewReader(input)); err == nil {
+			t.Errorf("expected %q to be rejected", input)
+		}
+	}
+
+	if _, err := ParseOperation("Wrap"); err != nil {
+		t.Error(err)
+	}
+	if _, err := ParseOperation("burn"); err == nil {
+		t.Error("expected an unknown operation to be rejected")
+	}
+}
@@ -0,0 +1,118 @@
+package bulk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+
+	"bridged-topshot-evm-deploy/deploy"
+)
+
+// Batch statuses
+const (
+	Pending = "pending"
+	Done    = "done"
+	Failed  = "failed"
+)
+
+// Batch is a set of moments moved by one transaction
+type Batch struct {
+	// Operation is the operation of the batch when it differs from that of
+	// the journal, wrap or unwrap for a toggle
+	Operation Operation `json:"operation,omitempty"`
+	IDs       []uint64  `json:"ids"`
+	Status    string    `json:"status"`
+	TxID      string    `json:"txId,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Journal records the progress of a bulk run. It is saved after each
+// batch, so that a run started again with the same journal skips the
+// batches that are done and retries the others.
+type Journal struct {
+	Network   string    `json:"network"`
+	Operation Operation `json:"operation"`
+	// IDs are the moments the run was given, in order
+	IDs []uint64 `json:"ids"`
+	// Skipped are the moments already in the state the operation moves them to
+	Skipped []uint64 `json:"skipped,omitempty"`
+	// Batches are empty until the run is planned
+	Batches []*Batch `json:"batches,omitempty"`
+}
+
+// LoadJournal reads the journal of a run, which is new when the file does
+// not exist. A journal of another network, operation or list of moments
+// is rejected rather than resumed.
+func LoadJournal(path, network string, op Operation, ids []uint64) (*Journal, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Journal{Network: network, Operation: op, IDs: ids}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	journal := &Journal{}
+	if err := json.Unmarshal(data, journal); err != nil {
+		return nil, fmt.Errorf("invalid journal %s: %w", path, err)
+	}
+	if journal.Network != network || journal.Operation != op {
+		return nil, fmt.Errorf("%s is the journal of %s on %s, not %s on %s", path, journal.Operation, journal.Network, op, network)
+	}
+	if !slices.Equal(journal.IDs, ids) {
+		return nil, fmt.Errorf("%s was started with other moments, use another journal", path)
+	}
+	return journal, nil
+}
+
+// Save writes the journal, replacing the file only once it is fully written
+func (j *Journal) Save(path string) error {
+	return deploy.WriteJSON(path, j)
+}
+
+// Planned reports whether the moments were split into batches
+func (j *Journal) Planned() bool {
+	return len(j.Batches) > 0 || len(j.Skipped) == len(j.IDs)
+}
+
+// Count returns the number of moments in batches with the status
+func (j *Journal) Count(status string) int {
+	count := 0
+	for _, batch := range j.Batches {
+		if batch.Status == status {
+			count += len(batch.IDs)
+		}
+	}
+	return count
+}
+
+// operation returns the operation the batch runs
+func (j *Journal) operation(batch *Batch) Operation {
+	if batch.Operation != "" {
+		return batch.Operation
+	}
+	return j.Operation
+}
+
+// addBatches appends batches of up to size moments running the operation,
+// that of the journal when empty
+func (j *Journal) addBatches(op Operation, ids []uint64, size int) {
+	for start := 0; start < len(ids); start += size {
+		end := min(start+size, len(ids))
+		j.Batches = append(j.Batches, &Batch{Operation: op, IDs: ids[start:end], Status: Pending})
+	}
+}
+
+// split replaces the batch at index i by its two halves
+func (j *Journal) split(i int) {
+	ids := j.Batches[i].IDs
+	half := len(ids) / 2
+	op := j.Batches[i].Operation
+	j.Batches = slices.Replace(j.Batches, i, i+1,
+		&Batch{Operation: op, IDs: ids[:half], Status: Pending},
+		&Batch{Operation: op, IDs: ids[half:], Status: Pending},
+	)
+}
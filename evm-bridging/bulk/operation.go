@@ -0,0 +1,88 @@
+package bulk
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Operation is what a bulk run does with the moments
+type Operation string
+
+const (
+	// ToEVM bridges moments from Cadence to the signer's COA or a recipient, wrapping them
+	ToEVM Operation = "to-evm"
+	// FromEVM bridges moments from the signer's COA back to Cadence, unwrapping them
+	FromEVM Operation = "from-evm"
+	// Wrap wraps the underlying ERC721s held by the signer's COA
+	Wrap Operation = "wrap"
+	// Unwrap unwraps the wrapped ERC721s held by the signer's COA
+	Unwrap Operation = "unwrap"
+	// Toggle wraps the underlying ERC721s held by the signer's COA and
+	// unwraps the wrapped ones, as is_erc721_wrapped finds each moment
+	Toggle Operation = "toggle"
+	// Transfer transfers the wrapped ERC721s held by the signer's COA to a recipient
+	Transfer Operation = "transfer"
+)
+
+// Operations are the operations a bulk run supports
+var Operations = []Operation{ToEVM, FromEVM, Wrap, Unwrap, Toggle, Transfer}
+
+const (
+	bridgeToEVMTx     = "bridge_nfts_to_evm"
+	bridgeFromEVMTx   = "bridge_nfts_from_evm"
+	wrapTx            = "utils/wrap_nfts"
+	unwrapTx          = "utils/unwrap_nfts"
+	transferTx        = "transfer_erc721s_to_evm_address"
+	isWrappedScript   = "is_erc721_wrapped"
+	bridgeFeeScript   = "get_bridge_fee"
+	defaultBridgeSize = 20
+	defaultEVMSize    = 50
+)
+
+// ParseOperation returns the operation with the name
+func ParseOperation(name string) (Operation, error) {
+	op := Operation(strings.ToLower(name))
+	if !slices.Contains(Operations, op) {
+		return "", fmt.Errorf("unknown operation %q, expected one of %v", name, Operations)
+	}
+	return op, nil
+}
+
+// transaction is the Cadence transaction run for each batch of the operation
+func (op Operation) transaction() string {
+	switch op {
+	case ToEVM:
+		return bridgeToEVMTx
+	case FromEVM:
+		return bridgeFromEVMTx
+	case Wrap:
+		return wrapTx
+	case Unwrap:
+		return unwrapTx
+	default:
+		return transferTx
+	}
+}
+
+// checksWrapped reports whether the operation runs is_erc721_wrapped for
+// every moment before planning its batches
+func (op Operation) checksWrapped() bool {
+	return op == Wrap || op == Unwrap || op == Toggle
+}
+
+// bridges reports whether the operation moves moments across the VMs and
+// so pays the bridge fee
+func (op Operation) bridges() bool {
+	return op == ToEVM || op == FromEVM
+}
+
+// defaultBatchSize is the number of moments a batch of the operation holds
+// unless a smaller limit applies. Bridging costs far more computation per
+// moment than calls made from the COA.
+func (op Operation) defaultBatchSize() int {
+	if op.bridges() {
+		return defaultBridgeSize
+	}
+	return defaultEVMSize
+}
@@ -0,0 +1,214 @@
+// Package client calls the admin functions of a BridgedTopShotMoments
+// contract from the Cadence-owned account (COA) that owns it, and reads
+// its state, encoding the calls with the generated bindings
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"bridged-topshot-evm-deploy/bindings"
+	"bridged-topshot-evm-deploy/deploy"
+)
+
+// Caller calls contracts from the COA and simulates calls, as a deploy.Deployer does
+type Caller interface {
+	// CallContract calls a contract through EVM.call and returns the ID of the Flow transaction
+	CallContract(contractAddr string, data []byte) (string, error)
+	// DryCall simulates a call and returns the returned data, or nil when there is no network
+	DryCall(from, contractAddr string, data []byte) ([]byte, error)
+}
+
+var _ Caller = (*deploy.Deployer)(nil)
+
+// Client calls a BridgedTopShotMoments contract, usually the proxy. All
+// addresses are 0x prefixed, and returned in lower case.
+type Client struct {
+	caller       Caller
+	coaAddr      string
+	contractAddr string
+	abi          *abi.ABI
+}
+
+// New returns a client calling the contract from the COA
+func New(caller Caller, coaAddr, contractAddr string) (*Client, error) {
+	for _, addr := range []string{coaAddr, contractAddr} {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid EVM address %q", addr)
+		}
+	}
+
+	contractABI, err := bindings.BridgedTopShotMomentsMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+	return &Client{caller: caller, coaAddr: coaAddr, contractAddr: contractAddr, abi: contractABI}, nil
+}
+
+// SetSymbol changes the symbol of the collection
+func (c *Client) SetSymbol(symbol string) (string, error) {
+	return c.call("setSymbol", symbol)
+}
+
+// SetContractURI changes the contract level metadata, emitting ContractURIUpdated
+func (c *Client) SetContractURI(metadata string) (string, error) {
+	return c.call("setContractURI", metadata)
+}
+
+// SetContractMetadata validates the collection metadata and sets it as the contractURI
+func (c *Client) SetContractMetadata(metadata deploy.CollectionMetadata) (string, error) {
+	if err := metadata.Validate(); err != nil {
+		return "", fmt.Errorf("invalid collection metadata: %w", err)
+	}
+	return c.SetContractURI(metadata.DataURI())
+}
+
+// SetBaseTokenURI changes the prefix of the token URIs, emitting BatchMetadataUpdate
+func (c *Client) SetBaseTokenURI(baseTokenURI string) (string, error) {
+	return c.call("setBaseTokenURI", baseTokenURI)
+}
+
+// SetBridgePermissions allows or forbids bridging the moments with the VM bridge
+func (c *Client) SetBridgePermissions(allowed bool) (string, error) {
+	return c.call("setBridgePermissions", allowed)
+}
+
+// SetRoyaltyInfo changes the recipient and basis points of the royalties
+func (c *Client) SetRoyaltyInfo(recipient string, basisPoints uint64) (string, error) {
+	if basisPoints > deploy.MaxRoyaltyBasisPoints {
+		return "", fmt.Errorf("royalty basis points must be at most %d, got %d", deploy.MaxRoyaltyBasisPoints, basisPoints)
+	}
+	recipientAddr, err := parseAddress(recipient)
+	if err != nil {
+		return "", err
+	}
+	if recipientAddr == (common.Address{}) {
+		return "", fmt.Errorf("the royalty recipient cannot be the zero address")
+	}
+
+	return c.call("setRoyaltyInfo", bindings.BridgedTopShotMomentsRoyaltyInfo{
+		RoyaltyAddress: recipientAddr,
+		RoyaltyBps:     new(big.Int).SetUint64(basisPoints),
+	})
+}
+
+// SetTransferValidator changes the ERC721C transfer validator, which the zero address disables
+func (c *Client) SetTransferValidator(validator string) (string, error) {
+	validatorAddr, err := parseAddress(validator)
+	if err != nil {
+		return "", err
+	}
+	return c.call("setTransferValidator", validatorAddr)
+}
+
+// RoyaltyInfo returns the recipient and the amount of the royalty on a sale (ERC-2981)
+func (c *Client) RoyaltyInfo(tokenID uint64, salePrice *big.Int) (string, *big.Int, error) {
+	values, err := c.read("royaltyInfo", new(big.Int).SetUint64(tokenID), salePrice)
+	if err != nil {
+		return "", nil, err
+	}
+	return formatAddress(values[0].(common.Address)), values[1].(*big.Int), nil
+}
+
+// TokenURI returns the metadata URI of a moment
+func (c *Client) TokenURI(tokenID uint64) (string, error) {
+	return readOne[string](c, "tokenURI", new(big.Int).SetUint64(tokenID))
+}
+
+// GetCadenceIdentifier returns the type identifier of the Cadence NFT the moments are bridged from
+func (c *Client) GetCadenceIdentifier() (string, error) {
+	return readOne[string](c, "getCadenceIdentifier")
+}
+
+// ContractURI returns the contract level metadata
+func (c *Client) ContractURI() (string, error) {
+	return readOne[string](c, "contractURI")
+}
+
+// ContractMetadata decodes the contract level metadata, which can be
+// diffed with the metadata about to be set
+func (c *Client) ContractMetadata() (*deploy.CollectionMetadata, error) {
+	uri, err := c.ContractURI()
+	if err != nil {
+		return nil, err
+	}
+	return deploy.ParseContractURI(uri)
+}
+
+// Symbol returns the symbol of the collection
+func (c *Client) Symbol() (string, error) {
+	return readOne[string](c, "symbol")
+}
+
+// AllowsBridging reports whether the moments may be bridged with the VM bridge
+func (c *Client) AllowsBridging() (bool, error) {
+	return readOne[bool](c, "allowsBridging")
+}
+
+// GetTransferValidator returns the ERC721C transfer validator
+func (c *Client) GetTransferValidator() (string, error) {
+	validator, err := readOne[common.Address](c, "getTransferValidator")
+	if err != nil {
+		return "", err
+	}
+	return formatAddress(validator), nil
+}
+
+// call simulates a call first, so that a revert is reported with its
+// reason before a transaction is sent
+func (c *Client) call(method string, args ...any) (string, error) {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+
+	if _, err := c.caller.DryCall(c.coaAddr, c.contractAddr, data); err != nil {
+		return "", fmt.Errorf("%s on %s would fail: %w", method, c.contractAddr, err)
+	}
+	return c.caller.CallContract(c.contractAddr, data)
+}
+
+func (c *Client) read(method string, args ...any) ([]any, error) {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+
+	returned, err := c.caller.DryCall(c.coaAddr, c.contractAddr, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s on %s: %w", method, c.contractAddr, err)
+	}
+	if returned == nil {
+		return nil, fmt.Errorf("%s on %s was not called, there is no network to read from", method, c.contractAddr)
+	}
+
+	values, err := c.abi.Unpack(method, returned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s: %w", method, err)
+	}
+	return values, nil
+}
+
+func readOne[T any](c *Client, method string, args ...any) (T, error) {
+	var value T
+	values, err := c.read(method, args...)
+	if err != nil {
+		return value, err
+	}
+	return *abi.ConvertType(values[0], new(T)).(*T), nil
+}
+
+func parseAddress(addr string) (common.Address, error) {
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, fmt.Errorf("invalid EVM address %q", addr)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+func formatAddress(addr common.Address) string {
+	return strings.ToLower(addr.Hex())
+}
@@ -0,0 +1,242 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"bridged-topshot-evm-deploy/bindings"
+	"bridged-topshot-evm-deploy/deploy"
+)
+
+const (
+	coaAddr      = "0x000000000000000000000002d1e4c5a3b5f8d4a5"
+	contractAddr = "0x84c6a2e6765e88427c41bb38c82a78b570e24709"
+	recipient    = "0xfaf0022c0f7e6c5bd9dd9d5c1f5e8a7b9d8c6e4a"
+)
+
+// fakeCaller records the calls and returns the outputs set by method
+type fakeCaller struct {
+	calls    [][]byte
+	dryCalls [][]byte
+	returned map[string][]any
+	revert   error
+}
+
+func (c *fakeCaller) CallContract(addr string, data []byte) (string, error) {
+	c.calls = append(c.calls, data)
+	return "tx1", nil
+}
+
+func (c *fakeCaller) DryCall(from, addr string, data []byte) ([]byte, error) {
+	c.dryCalls = append(c.dryCalls, data)
+	if from != coaAddr || addr != contractAddr {
+		return nil, errors.New("unexpected addresses")
+	}
+	if c.revert != nil {
+		return nil, c.revert
+	}
+	if c.returned == nil {
+		return []byte{}, nil
+	}
+
+	parsed, _ := bindings.BridgedTopShotMomentsMetaData.GetAbi()
+	method, err := parsed.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(c.returned[method.Name]...)
+}
+
+func newTestClient(t *testing.T) (*Client, *fakeCaller) {
+	t.Helper()
+	caller := &fakeCaller{}
+	client, err := New(caller, coaAddr, contractAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, caller
+}
+
+func selector(signature string) []byte {
+	return crypto.Keccak256([]byte(signature))[:4]
+}
+
+func TestAdminCalls(t *testing.T) {
+	for signature, send := range map[string]func(*Client) (string, error){
+		"setSymbol(string)":      func(c *Client) (string, error) { return c.SetSymbol("TS") },
+		"setContractURI(string)": func(c *Client) (string, error) { return c.SetContractURI("data:application/json;utf8,{}") },
+		"setContractURI(string) metadata": func(c *Client) (string, error) {
+			return c.SetContractMetadata(deploy.CollectionMetadata{Name: "NBA Top Shot"})
+		},
+		"setBaseTokenURI(string)":          func(c *Client) (string, error) { return c.SetBaseTokenURI("https://example.com/") },
+		"setBridgePermissions(bool)":       func(c *Client) (string, error) { return c.SetBridgePermissions(true) },
+		"setRoyaltyInfo((address,uint96))": func(c *Client) (string, error) { return c.SetRoyaltyInfo(recipient, 500) },
+		"setTransferValidator(address)":    func(c *Client) (string, error) { return c.SetTransferValidator(recipient) },
+	} {
+		t.Run(signature, func(t *testing.T) {
+			client, caller := newTestClient(t)
+			txID, err := send(client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if txID != "tx1" || len(caller.calls) != 1 || len(caller.dryCalls) != 1 {
+				t.Fatalf("expected one simulated call, got %v and %v", caller.calls, caller.dryCalls)
+			}
+			if !bytes.Equal(caller.calls[0][:4], selector(strings.Fields(signature)[0])) || !bytes.Equal(caller.calls[0], caller.dryCalls[0]) {
+				t.Errorf("unexpected calldata %x", caller.calls[0])
+			}
+		})
+	}
+}
+
+func TestSetRoyaltyInfo(t *testing.T) {
+	client, caller := newTestClient(t)
+	if _, err := client.SetRoyaltyInfo(recipient, 500); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, _ := bindings.BridgedTopShotMomentsMetaData.GetAbi()
+	values, err := parsed.Methods["setRoyaltyInfo"].Inputs.Unpack(caller.calls[0][4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := values[0].(struct {
+		RoyaltyAddress common.Address `json:"royaltyAddress"`
+		RoyaltyBps     *big.Int       `json:"royaltyBps"`
+	})
+	if info.RoyaltyAddress != common.HexToAddress(recipient) || info.RoyaltyBps.Uint64() != 500 {
+		t.Errorf("unexpected royalty info %+v", info)
+	}
+
+	if _, err := client.SetRoyaltyInfo(recipient, 10001); err == nil {
+		t.Error("expected more than 100% of royalties to be rejected")
+	}
+	if _, err := client.SetRoyaltyInfo("0x0000000000000000000000000000000000000000", 500); err == nil {
+		t.Error("expected the zero address to be rejected")
+	}
+	if _, err := client.SetTransferValidator("0x1234"); err == nil {
+		t.Error("expected an invalid address to be rejected")
+	}
+	if len(caller.calls) != 1 {
+		t.Errorf("rejected calls were sent: %v", caller.calls)
+	}
+}
+
+func TestRevertedCallIsNotSent(t *testing.T) {
+	client, caller := newTestClient(t)
+	caller.revert = errors.New("error code: 306, error message: execution reverted")
+
+	if _, err := client.SetSymbol("TS"); err == nil || !strings.Contains(err.Error(), "setSymbol on "+contractAddr+" would fail") {
+		t.Errorf("expected the revert to be reported, got %v", err)
+	}
+	if len(caller.calls) != 0 {
+		t.Errorf("a reverting call was sent: %v", caller.calls)
+	}
+}
+
+func TestReads(t *testing.T) {
+	client, caller := newTestClient(t)
+	caller.returned = map[string][]any{
+		"royaltyInfo":          {common.HexToAddress(recipient), big.NewInt(25)},
+		"tokenURI":             {"https://example.com/1"},
+		"getCadenceIdentifier": {"A.0b2a3299cc857e29.TopShot.NFT"},
+		"contractURI":          {"data:application/json;utf8,{}"},
+		"symbol":               {"TOPSHOT"},
+		"allowsBridging":       {true},
+		"getTransferValidator": {common.HexToAddress(recipient)},
+	}
+
+	receiver, amount, err := client.RoyaltyInfo(1, big.NewInt(500))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receiver != recipient || amount.Int64() != 25 {
+		t.Errorf("unexpected royalty %s %s", receiver, amount)
+	}
+	if !bytes.Equal(caller.dryCalls[0][:4], selector("royaltyInfo(uint256,uint256)")) {
+		t.Errorf("unexpected calldata %x", caller.dryCalls[0])
+	}
+
+	for expected, read := range map[string]func() (string, error){
+		"https://example.com/1":          func() (string, error) { return client.TokenURI(1) },
+		"A.0b2a3299cc857e29.TopShot.NFT": client.GetCadenceIdentifier,
+		"data:application/json;utf8,{}":  client.ContractURI,
+		"TOPSHOT":                        client.Symbol,
+		recipient:                        client.GetTransferValidator,
+	} {
+		if value, err := read(); err != nil || value != expected {
+			t.Errorf("expected %q, got %q and %v", expected, value, err)
+		}
+	}
+	if allowed, err := client.AllowsBridging(); err != nil || !allowed {
+		t.Errorf("expected bridging to be allowed, got %v and %v", allowed, err)
+	}
+	if len(caller.calls) != 0 {
+		t.Errorf("reads sent transactions: %v", caller.calls)
+	}
+}
+
+func TestContractMetadata(t *testing.T) {
+	client, caller := newTestClient(t)
+	caller.returned = map[string][]any{
+		"contractURI": {`data:application/json;utf8,{\"name\":\"NBA Top Shot\",\"external_link\":\"https://nbatopshot.com\"}`},
+	}
+
+	current, err := client.ContractMetadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := *current
+	next.Description = "NBA Top Shot on Flow EVM"
+	if changes := current.Diff(next); len(changes) != 1 || changes[0] != `description: "" -> "NBA Top Shot on Flow EVM"` {
+		t.Errorf("unexpected changes %q", changes)
+	}
+
+	if _, err := client.SetContractMetadata(next); err != nil {
+		t.Fatal(err)
+	}
+	parsed, _ := bindings.BridgedTopShotMomentsMetaData.GetAbi()
+	values, err := parsed.Methods["setContractURI"].Inputs.Unpack(caller.calls[0][4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0] != next.DataURI() {
+		t.Errorf("unexpected contractURI %v", values[0])
+	}
+
+	if _, err := client.SetContractMetadata(deploy.CollectionMetadata{Description: "unnamed"}); err == nil {
+		t.Error("expected invalid metadata to be rejected")
+	}
+	if len(caller.calls) != 1 {
+		t.Errorf("rejected metadata was sent: %v", caller.calls)
+	}
+}
+
+// noNetwork simulates nothing, as a deployer in a dry run
+type noNetwork struct{ fakeCaller }
+
+func (n *noNetwork) DryCall(from, addr string, data []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func TestReadWithoutNetwork(t *testing.T) {
+	client, err := New(&noNetwork{}, coaAddr, contractAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ContractURI(); err == nil || !strings.Contains(err.Error(), "no network") {
+		t.Errorf("expected the read to be reported as not done, got %v", err)
+	}
+}
+
+func TestNewRejectsInvalidAddresses(t *testing.T) {
+	if _, err := New(&fakeCaller{}, "0x1234", contractAddr); err == nil {
+		t.Error("expected an invalid COA address to be rejected")
+	}
+}
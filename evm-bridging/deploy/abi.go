@@ -0,0 +1,193 @@
+package deploy
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"bridged-topshot-evm-deploy/bindings"
+)
+
+// InitializeParams are the arguments of BridgedTopShotMoments.initialize
+type InitializeParams struct {
+	Owner                        string
+	UnderlyingNftContractAddress string
+	VmBridgeAddress              string
+	Name                         string
+	Symbol                       string
+	BaseTokenURI                 string
+	CadenceNFTAddress            string
+	CadenceNFTIdentifier         string
+	ContractMetadata             string
+}
+
+// GenerateEncodedInitializeFunctionCall ABI encodes the initialize call the proxy makes on construction
+func GenerateEncodedInitializeFunctionCall(params InitializeParams) ([]byte, error) {
+	parsedABI, err := bindings.BridgedTopShotMomentsMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	addresses := make([]common.Address, 3)
+	for i, addr := range []string{params.Owner, params.UnderlyingNftContractAddress, params.VmBridgeAddress} {
+		if addresses[i], err = parseAddress(addr); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := parsedABI.Pack(
+		"initialize",
+		addresses[0],
+		addresses[1],
+		addresses[2],
+		params.Name,
+		params.Symbol,
+		params.BaseTokenURI,
+		params.CadenceNFTAddress,
+		params.CadenceNFTIdentifier,
+		params.ContractMetadata,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack ABI: %w", err)
+	}
+	return data, nil
+}
+
+// GenerateProxyEncodedConstructorData ABI encodes the ERC1967Proxy constructor arguments
+func GenerateProxyEncodedConstructorData(implementationAddr string, initializeFunctionCall []byte) ([]byte, error) {
+	parsedABI, err := bindings.ERC1967ProxyMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	implementation, err := parseAddress(implementationAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := parsedABI.Pack("", implementation, initializeFunctionCall)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack proxy ABI: %w", err)
+	}
+	return data, nil
+}
+
+// ReadBytecode reads the creation bytecode of a contract from the forge artifacts under out/
+func ReadBytecode(dir, contractName string) ([]byte, error) {
+	path := filepath.Join(dir, "out", contractName+".sol", contractName+".json")
+	artifact, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact of %s, run 'forge build' first: %w", contractName, err)
+	}
+
+	var parsed struct {
+		Bytecode struct {
+			Object string `json:"object"`
+		} `json:"bytecode"`
+	}
+	if err := json.Unmarshal(artifact, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	bytecode, err := hex.DecodeString(strings.TrimPrefix(parsed.Bytecode.Object, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode in %s: %w", path, err)
+	}
+	if len(bytecode) == 0 {
+		return nil, fmt.Errorf("no bytecode in %s", path)
+	}
+	return bytecode, nil
+}
+
+// ReadDeployedBytecode reads the runtime bytecode of a contract from the forge
+// artifacts under out/, with the ranges its immutable variables fill on deployment
+func ReadDeployedBytecode(dir, contractName string) ([]byte, []ImmutableReference, error) {
+	path := filepath.Join(dir, "out", contractName+".sol", contractName+".json")
+	artifact, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read artifact of %s, run 'forge build' first: %w", contractName, err)
+	}
+
+	var parsed struct {
+		DeployedBytecode struct {
+			Object              string                          `json:"object"`
+			ImmutableReferences map[string][]ImmutableReference `json:"immutableReferences"`
+		} `json:"deployedBytecode"`
+	}
+	if err := json.Unmarshal(artifact, &parsed); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	bytecode, err := hex.DecodeString(strings.TrimPrefix(parsed.DeployedBytecode.Object, "0x"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid deployed bytecode in %s: %w", path, err)
+	}
+	if len(bytecode) == 0 {
+		return nil, nil, fmt.Errorf("no deployed bytecode in %s", path)
+	}
+
+	var immutables []ImmutableReference
+	for _, references := range parsed.DeployedBytecode.ImmutableReferences {
+		for _, reference := range references {
+			if reference.Start < 0 || reference.Length < 0 || reference.Start+reference.Length > len(bytecode) {
+				return nil, nil, fmt.Errorf("immutable reference %+v out of the deployed bytecode in %s", reference, path)
+			}
+			immutables = append(immutables, reference)
+		}
+	}
+	return bytecode, immutables, nil
+}
+
+// ImmutableReference is a range of the runtime bytecode filled with an immutable variable on deployment
+type ImmutableReference struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// ReadABI reads the ABI of a contract from the forge artifacts under out/
+func ReadABI(dir, contractName string) (abi.ABI, error) {
+	path := filepath.Join(dir, "out", contractName+".sol", contractName+".json")
+	artifact, err := os.ReadFile(path)
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("failed to read artifact of %s, run 'forge build' first: %w", contractName, err)
+	}
+
+	var parsed struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	if err := json.Unmarshal(artifact, &parsed); err != nil {
+		return abi.ABI{}, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if parsed.ABI == nil {
+		return abi.ABI{}, fmt.Errorf("no ABI in %s", path)
+	}
+
+	contractABI, err := abi.JSON(bytes.NewReader(parsed.ABI))
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("invalid ABI in %s: %w", path, err)
+	}
+	return contractABI, nil
+}
+
+// parseAddress parses an EVM address given with or without the 0x prefix
+func parseAddress(addr string) (common.Address, error) {
+	addr = ensureHexPrefix(addr)
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, fmt.Errorf("invalid EVM address %q", addr)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+func ensureHexPrefix(addr string) string {
+	if !strings.HasPrefix(addr, "0x") {
+		return "0x" + addr
+	}
+	return addr
+}
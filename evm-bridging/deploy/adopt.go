@@ -0,0 +1,104 @@
+package deploy
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Adopt records in deployments/<network>.json a proxy deployed before the
+// state file existed, with the implementation it points to and the storage
+// layout of that implementation, so that its first upgrade checks the layout.
+// The implementation must run the BridgedTopShotMoments built from the
+// current checkout, which is the source the layout is taken from.
+func (d *Deployer) Adopt(proxyAddr string) (*Deployment, error) {
+	d.describe()
+
+	proxy, err := parseAddress(proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	proxyAddr = proxy.Hex()
+
+	deployment, err := LoadDeployment(d.StatePath, d.Network)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ProxyAddr != "" {
+		return nil, fmt.Errorf("%s already records the proxy %s", d.StatePath, deployment.ProxyAddr)
+	}
+	if d.EVM == nil {
+		return nil, fmt.Errorf("no rpcUrl configured for %s, the proxy cannot be read", d.Network)
+	}
+
+	coaHex, err := d.lookupCOA()
+	if err != nil {
+		return nil, err
+	}
+	if coaHex == "" {
+		return nil, fmt.Errorf("%s has no COA, the proxy cannot be upgraded from it", d.TopshotAccountName)
+	}
+
+	value, err := d.EVM.StorageAt(proxyAddr, ImplementationSlot)
+	if err != nil {
+		return nil, err
+	}
+	implementation := common.BytesToAddress(value[12:])
+	if implementation == (common.Address{}) {
+		return nil, fmt.Errorf("the implementation slot of %s is empty, it is not an ERC-1967 proxy", proxyAddr)
+	}
+	implementationAddr := implementation.Hex()
+
+	if err := d.forge().Build(); err != nil {
+		return nil, err
+	}
+	if err := d.checkDeployedCode(implementationAddr, "BridgedTopShotMoments"); err != nil {
+		return nil, err
+	}
+
+	bytecode, err := ReadBytecode(d.Dir, "BridgedTopShotMoments")
+	if err != nil {
+		return nil, err
+	}
+	layout, err := ReadStorageLayout(d.Dir, "BridgedTopShotMoments")
+	if err != nil {
+		return nil, err
+	}
+
+	deployment.CoaAddr = "0x" + coaHex
+	deployment.ProxyAddr = proxyAddr
+	deployment.ImplementationAddr = implementationAddr
+	deployment.ImplementationCodeHash = codeHash(bytecode)
+	deployment.StorageLayout = layout
+	if err := d.save(deployment); err != nil {
+		return nil, err
+	}
+
+	d.Log.Printf("Proxy %s adopted, pointing to %s%s", proxyAddr, implementationAddr, separatorString())
+	return deployment, nil
+}
+
+// checkDeployedCode checks that a contract runs the runtime bytecode of the
+// named forge artifact, leaving out the immutable variables it was deployed with
+func (d *Deployer) checkDeployedCode(contractAddr, contractName string) error {
+	expected, immutables, err := ReadDeployedBytecode(d.Dir, contractName)
+	if err != nil {
+		return err
+	}
+	code, err := d.EVM.CodeAt(contractAddr)
+	if err != nil {
+		return err
+	}
+
+	if len(code) == len(expected) {
+		code = bytes.Clone(code)
+		for _, immutable := range immutables {
+			copy(code[immutable.Start:immutable.Start+immutable.Length], expected[immutable.Start:immutable.Start+immutable.Length])
+		}
+		if bytes.Equal(code, expected) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s does not run the %s built from this checkout, check out the commit it was deployed from", contractAddr, contractName)
+}
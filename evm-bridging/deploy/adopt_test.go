@@ -0,0 +1,121 @@
+package deploy
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const adoptedProxy = "0x00000000000000000000000000000000000000b1"
+
+var adoptedImplementation = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+
+// newAdoptTest builds a BridgedTopShotMoments whose runtime code holds an
+// immutable address, and points a proxy to an implementation running it
+func newAdoptTest(t *testing.T) (*Deployer, *fakeFlow, *fakeEVM) {
+	t.Helper()
+	d, flow, _ := newTestDeployer(t, "testnet")
+	flow.coa = "00000000000000000000000200000000000000aa"
+
+	runtime := "6080604052" + strings.Repeat("00", 32) + "fe"
+	artifact, err := json.Marshal(map[string]any{
+		"abi":      []any{},
+		"bytecode": map[string]string{"object": "0x6080604052" + runtime},
+		"deployedBytecode": map[string]any{
+			"object":              "0x" + runtime,
+			"immutableReferences": map[string][]ImmutableReference{"42": {{Start: 5, Length: 32}}},
+		},
+		"storageLayout": testLayout(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(d.Dir, "out", "BridgedTopShotMoments.sol", "BridgedTopShotMoments.json"), artifact)
+
+	code, _ := hex.DecodeString(runtime)
+	copy(code[5+12:], adoptedImplementation.Bytes())
+	evm := &fakeEVM{implementation: adoptedImplementation.Hex(), code: map[common.Address][]byte{adoptedImplementation: code}}
+	d.EVM = evm
+	return d, flow, evm
+}
+
+func TestAdopt(t *testing.T) {
+	d, flow, _ := newAdoptTest(t)
+
+	deployment, err := d.Adopt(strings.TrimPrefix(adoptedProxy, "0x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(flow.txs) != 0 {
+		t.Errorf("adopting a proxy ran %v", flow.txs)
+	}
+
+	saved, err := LoadDeployment(d.StatePath, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, adopted := range []*Deployment{deployment, saved} {
+		if adopted.ProxyAddr != common.HexToAddress(adoptedProxy).Hex() || adopted.ImplementationAddr != adoptedImplementation.Hex() ||
+			adopted.CoaAddr != "0x00000000000000000000000200000000000000aa" || adopted.ImplementationCodeHash == "" {
+			t.Errorf("unexpected deployment %+v", adopted)
+		}
+		if len(adopted.StorageLayout.Storage) != 4 {
+			t.Errorf("expected the storage layout of the implementation, got %+v", adopted.StorageLayout)
+		}
+	}
+
+	if _, err := d.Adopt(adoptedProxy); err == nil || !strings.Contains(err.Error(), "already records") {
+		t.Errorf("expected a second proxy to be rejected, got %v", err)
+	}
+
+	// the first upgrade of the adopted proxy checks the recorded layout
+	layout := testLayout()
+	layout.Storage[1].Label = "metadata"
+	writeArtifact(t, d.Dir, "BridgedTopShotMoments", "0x60806040526002", layout, "upgradeToAndCall", "proxiableUUID")
+	if _, err := d.Upgrade(nil); err == nil || !strings.Contains(err.Error(), "renamed to metadata") {
+		t.Errorf("expected an incompatible layout to be rejected, got %v", err)
+	}
+}
+
+func TestAdoptChecksImplementation(t *testing.T) {
+	d, _, evm := newAdoptTest(t)
+	evm.code[adoptedImplementation] = append(evm.code[adoptedImplementation], 0x00)
+
+	if _, err := d.Adopt(adoptedProxy); err == nil || !strings.Contains(err.Error(), "check out the commit it was deployed from") {
+		t.Errorf("expected other code to be rejected, got %v", err)
+	}
+
+	evm.implementation = ""
+	if _, err := d.Adopt(adoptedProxy); err == nil || !strings.Contains(err.Error(), "not an ERC-1967 proxy") {
+		t.Errorf("expected an empty implementation slot to be rejected, got %v", err)
+	}
+
+	saved, err := LoadDeployment(d.StatePath, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.ProxyAddr != "" {
+		t.Errorf("a rejected proxy was recorded: %+v", saved)
+	}
+}
+
+func TestAdoptErrors(t *testing.T) {
+	d, flow, _ := newAdoptTest(t)
+	if _, err := d.Adopt("not an address"); err == nil || !strings.Contains(err.Error(), "invalid EVM address") {
+		t.Errorf("expected an invalid address to be rejected, got %v", err)
+	}
+
+	flow.coa = nil
+	if _, err := d.Adopt(adoptedProxy); err == nil || !strings.Contains(err.Error(), "has no COA") {
+		t.Errorf("expected a missing COA to be rejected, got %v", err)
+	}
+
+	d.EVM = nil
+	if _, err := d.Adopt(adoptedProxy); err == nil || !strings.Contains(err.Error(), "no rpcUrl") {
+		t.Errorf("expected a missing rpcUrl to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,275 @@
+package deploy
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Config holds the addresses, endpoints and collection settings a deployment uses on a network
+type Config struct {
+	TopShotFlowAddr                 string `json:"topShotFlowAddr"`
+	BridgeDeployedTopshotERC721Addr string `json:"bridgeDeployedTopshotERC721Addr"`
+	FlowEvmBridgeCoaAddr            string `json:"flowEvmBridgeCoaAddr"`
+	TransferValidatorAddr           string `json:"transferValidatorAddr"`
+	RoyaltyRecipientAddr            string `json:"royaltyRecipientAddr"`
+
+	// RpcUrl, VerifierUrl and VerifierProvider are only needed on networks
+	// where the contracts are verified, which is every network with a VerifierUrl
+	RpcUrl           string `json:"rpcUrl,omitempty"`
+	VerifierUrl      string `json:"verifierUrl,omitempty"`
+	VerifierProvider string `json:"verifierProvider,omitempty"`
+
+	// RoyaltyBasisPoints is the royalty rate set on the collection, 500 being 5%
+	RoyaltyBasisPoints uint64 `json:"royaltyBasisPoints"`
+	// GasLimit is the EVM gas limit of contract deployments
+	GasLimit uint64 `json:"gasLimit"`
+
+	CollectionName   string `json:"collectionName"`
+	CollectionSymbol string `json:"collectionSymbol"`
+	BaseTokenURI     string `json:"baseTokenURI"`
+	// ContractMetadata is the collection metadata encoded as the contractURI,
+	// given as an object or as a data URI
+	ContractMetadata CollectionMetadata `json:"contractMetadata"`
+}
+
+// MaxRoyaltyBasisPoints is a royalty of 100%
+const MaxRoyaltyBasisPoints = 10000
+
+// verifierProviders are the verifiers 'forge verify-contract' supports
+var verifierProviders = []string{"etherscan", "sourcify", "blockscout", "oklink", "custom"}
+
+// ConfigFile is the deployment configuration of every network. The
+// settings of a network default to the ones in Defaults.
+type ConfigFile struct {
+	Defaults Config            `json:"defaults"`
+	Networks map[string]Config `json:"networks"`
+}
+
+//go:embed networks.json
+var defaultConfigFile []byte
+
+// DefaultConfigFile returns the configuration of the emulator, testnet and mainnet shipped in networks.json
+func DefaultConfigFile() *ConfigFile {
+	configs, err := ParseConfigFile(defaultConfigFile)
+	if err != nil {
+		panic(fmt.Sprintf("invalid networks.json: %s", err))
+	}
+	return configs
+}
+
+// LoadConfigFile reads and validates a configuration file
+func LoadConfigFile(path string) (*ConfigFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := ParseConfigFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return configs, nil
+}
+
+// ParseConfigFile parses a configuration file, rejecting unknown fields,
+// and validates every network
+func ParseConfigFile(data []byte) (*ConfigFile, error) {
+	var raw struct {
+		Defaults json.RawMessage            `json:"defaults"`
+		Networks map[string]json.RawMessage `json:"networks"`
+	}
+	if err := decodeStrict(data, &raw); err != nil {
+		return nil, err
+	}
+	if len(raw.Networks) == 0 {
+		return nil, fmt.Errorf("no networks configured")
+	}
+
+	configs := &ConfigFile{Networks: map[string]Config{}}
+	if raw.Defaults != nil {
+		if err := decodeStrict(raw.Defaults, &configs.Defaults); err != nil {
+			return nil, fmt.Errorf("defaults: %w", err)
+		}
+	}
+
+	var errs []error
+	for _, network := range sortedKeys(raw.Networks) {
+		// decoding over the defaults only replaces the fields the network sets
+		config := configs.Defaults
+		if err := decodeStrict(raw.Networks[network], &config); err != nil {
+			errs = append(errs, fmt.Errorf("networks.%s: %w", network, err))
+			continue
+		}
+		if err := config.Validate(); err != nil {
+			errs = append(errs, prefixErrors("networks."+network, err))
+			continue
+		}
+		configs.Networks[network] = config
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return configs, nil
+}
+
+// Network returns the configuration of a network
+func (f *ConfigFile) Network(network string) (Config, error) {
+	config, ok := f.Networks[network]
+	if !ok {
+		return Config{}, fmt.Errorf("unknown network %q, expected one of %v", network, f.NetworkNames())
+	}
+	return config, nil
+}
+
+// NetworkNames returns the configured networks in alphabetical order
+func (f *ConfigFile) NetworkNames() []string {
+	return sortedKeys(f.Networks)
+}
+
+// ConfigFor returns the default configuration of the network
+func ConfigFor(network string) (Config, error) {
+	return DefaultConfigFile().Network(network)
+}
+
+// Validate checks every field of the configuration and reports all the invalid ones
+func (c Config) Validate() error {
+	var errs []error
+	check := func(field string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", field, err))
+		}
+	}
+
+	check("topShotFlowAddr", validateFlowAddress(c.TopShotFlowAddr))
+	check("bridgeDeployedTopshotERC721Addr", validateEvmAddress(c.BridgeDeployedTopshotERC721Addr))
+	check("flowEvmBridgeCoaAddr", validateEvmAddress(c.FlowEvmBridgeCoaAddr))
+	check("transferValidatorAddr", validateEvmAddress(c.TransferValidatorAddr))
+	check("royaltyRecipientAddr", validateEvmAddress(c.RoyaltyRecipientAddr))
+
+	if c.VerifierUrl != "" || c.VerifierProvider != "" || c.RpcUrl != "" {
+		check("rpcUrl", validateURL(c.RpcUrl))
+	}
+	if c.VerifierUrl != "" || c.VerifierProvider != "" {
+		check("verifierUrl", validateURL(c.VerifierUrl))
+		if !slices.Contains(verifierProviders, c.VerifierProvider) {
+			check("verifierProvider", fmt.Errorf("%q is not one of %v", c.VerifierProvider, verifierProviders))
+		}
+	}
+
+	if c.RoyaltyBasisPoints > MaxRoyaltyBasisPoints {
+		check("royaltyBasisPoints", fmt.Errorf("%d is more than %d", c.RoyaltyBasisPoints, MaxRoyaltyBasisPoints))
+	}
+	if c.GasLimit == 0 {
+		check("gasLimit", fmt.Errorf("missing"))
+	}
+
+	if c.CollectionName == "" {
+		check("collectionName", fmt.Errorf("missing"))
+	}
+	if c.CollectionSymbol == "" {
+		check("collectionSymbol", fmt.Errorf("missing"))
+	}
+	check("baseTokenURI", validateURL(c.BaseTokenURI))
+	if err := c.ContractMetadata.Validate(); err != nil {
+		errs = append(errs, prefixErrors("contractMetadata", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Verified reports whether the contracts are verified on the network's block explorer
+func (c Config) Verified() bool {
+	return c.VerifierUrl != ""
+}
+
+// CadenceNFTIdentifier is the type identifier of TopShot moments on the network
+func (c Config) CadenceNFTIdentifier() string {
+	return fmt.Sprintf("A.%s.TopShot.NFT", c.TopShotFlowAddr)
+}
+
+// validateFlowAddress accepts 8 byte hex addresses without the 0x prefix, the way Cadence type identifiers spell them
+func validateFlowAddress(addr string) error {
+	if addr == "" {
+		return fmt.Errorf("missing")
+	}
+	if strings.HasPrefix(addr, "0x") {
+		return fmt.Errorf("%q must not have the 0x prefix", addr)
+	}
+	if len(addr) != 16 {
+		return fmt.Errorf("%q is not 16 hex characters long", addr)
+	}
+	if _, err := hex.DecodeString(addr); err != nil {
+		return fmt.Errorf("%q is not hex", addr)
+	}
+	return nil
+}
+
+// validateEvmAddress accepts 0x prefixed addresses, which must match their
+// EIP-55 checksum when they mix upper and lower case
+func validateEvmAddress(addr string) error {
+	if addr == "" {
+		return fmt.Errorf("missing")
+	}
+	if !strings.HasPrefix(addr, "0x") || !common.IsHexAddress(addr) {
+		return fmt.Errorf("%q is not a 0x prefixed 20 byte hex address", addr)
+	}
+
+	digits := addr[2:]
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) {
+		if checksummed := common.HexToAddress(addr).Hex(); checksummed != addr {
+			return fmt.Errorf("%q does not match its EIP-55 checksum %s", addr, checksummed)
+		}
+	}
+	return nil
+}
+
+func validateURL(rawURL string) error {
+	if rawURL == "" {
+		return fmt.Errorf("missing")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q is not an http(s) URL", rawURL)
+	}
+	return nil
+}
+
+func decodeStrict(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// prefixErrors prefixes each of the joined errors with the path of the network
+func prefixErrors(prefix string, err error) error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return fmt.Errorf("%s.%w", prefix, err)
+	}
+
+	var errs []error
+	for _, err := range joined.Unwrap() {
+		errs = append(errs, fmt.Errorf("%s.%w", prefix, err))
+	}
+	return errors.Join(errs...)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,186 @@
+package deploy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDefaultConfigFile(t *testing.T) {
+	configs := DefaultConfigFile()
+
+	if names := strings.Join(configs.NetworkNames(), ","); names != "emulator,mainnet,testnet" {
+		t.Fatalf("unexpected networks %s", names)
+	}
+
+	mainnet, err := configs.Network("mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the collection settings come from the defaults
+	if mainnet.RoyaltyBasisPoints != 500 || mainnet.GasLimit != 15000000 || mainnet.CollectionSymbol != "TOPSHOT" {
+		t.Errorf("defaults not applied: %+v", mainnet)
+	}
+	if !strings.HasPrefix(mainnet.ContractMetadata.DataURI(), `data:application/json;utf8,{"name":"NBA Top Shot","description":"NBA Top Shot is`) {
+		t.Errorf("unexpected contract metadata %s", mainnet.ContractMetadata.DataURI())
+	}
+	if mainnet.CadenceNFTIdentifier() != "A.0b2a3299cc857e29.TopShot.NFT" {
+		t.Errorf("unexpected identifier %s", mainnet.CadenceNFTIdentifier())
+	}
+
+	emulator, _ := configs.Network("emulator")
+	if emulator.Verified() || !mainnet.Verified() {
+		t.Error("only the public networks should be verified")
+	}
+}
+
+func TestExtraNetwork(t *testing.T) {
+	configs, err := ParseConfigFile([]byte(`{
+		"defaults": {
+			"royaltyBasisPoints": 500,
+			"gasLimit": 15000000,
+			"collectionName": "NBA Top Shot",
+			"collectionSymbol": "TOPSHOT",
+			"baseTokenURI": "https://example.com/moment/",
+			"contractMetadata": "data:application/json;utf8,{\\\"name\\\":\\\"NBA Top Shot\\\"}"
+		},
+		"networks": {
+			"previewnet-fork": {
+				"topShotFlowAddr": "877931736ee77cff",
+				"flowEvmBridgeCoaAddr": "0x0000000000000000000000023f946ffbc8829bfd",
+				"bridgeDeployedTopshotERC721Addr": "0xB3627E6f7F1cC981217f789D7737B1f3a93EC519",
+				"transferValidatorAddr": "0x721c002b0059009a671d00ad1700c9748146cd1b",
+				"royaltyRecipientAddr": "0x000000000000000000000002958e48adcfd5d1c7",
+				"royaltyBasisPoints": 250,
+				"gasLimit": 30000000
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fork, err := configs.Network("previewnet-fork")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fork.RoyaltyBasisPoints != 250 || fork.GasLimit != 30000000 || fork.CollectionName != "NBA Top Shot" {
+		t.Errorf("overrides not applied: %+v", fork)
+	}
+	// a contractURI escaped the way earlier configurations were still reads
+	if fork.ContractMetadata.DataURI() != `data:application/json;utf8,{"name":"NBA Top Shot"}` {
+		t.Errorf("unexpected contract metadata %s", fork.ContractMetadata.DataURI())
+	}
+	if _, err := configs.Network("testnet"); err == nil {
+		t.Error("expected the networks of the file to replace the default ones")
+	}
+}
+
+func TestConfigValidation(t *testing.T) {
+	valid, err := ConfigFor("testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		edit  func(*Config)
+		field string
+	}{
+		"bad checksum": {
+			edit:  func(c *Config) { c.BridgeDeployedTopshotERC721Addr = "0xb3627E6f7F1cC981217f789D7737B1f3a93EC519" },
+			field: "bridgeDeployedTopshotERC721Addr",
+		},
+		"short EVM address": {
+			edit:  func(c *Config) { c.RoyaltyRecipientAddr = "0x1234" },
+			field: "royaltyRecipientAddr",
+		},
+		"EVM address without 0x": {
+			edit:  func(c *Config) { c.TransferValidatorAddr = "721c002b0059009a671d00ad1700c9748146cd1b" },
+			field: "transferValidatorAddr",
+		},
+		"short Flow address": {
+			edit:  func(c *Config) { c.TopShotFlowAddr = "877931736ee77c" },
+			field: "topShotFlowAddr",
+		},
+		"Flow address with 0x": {
+			edit:  func(c *Config) { c.TopShotFlowAddr = "0x877931736ee77cff" },
+			field: "topShotFlowAddr",
+		},
+		"basis points over 100%": {
+			edit:  func(c *Config) { c.RoyaltyBasisPoints = 10001 },
+			field: "royaltyBasisPoints",
+		},
+		"unknown verifier": {
+			edit:  func(c *Config) { c.VerifierProvider = "flowscan" },
+			field: "verifierProvider",
+		},
+		"verifier without RPC": {
+			edit:  func(c *Config) { c.RpcUrl = "" },
+			field: "rpcUrl",
+		},
+		"no gas limit": {
+			edit:  func(c *Config) { c.GasLimit = 0 },
+			field: "gasLimit",
+		},
+		"relative base token URI": {
+			edit:  func(c *Config) { c.BaseTokenURI = "/moment/" },
+			field: "baseTokenURI",
+		},
+		"unnamed collection": {
+			edit:  func(c *Config) { c.ContractMetadata.Name = "" },
+			field: "contractMetadata.name",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := valid
+			tc.edit(&config)
+
+			err := config.Validate()
+			if err == nil || !strings.HasPrefix(err.Error(), tc.field+": ") {
+				t.Errorf("expected a %s error, got %v", tc.field, err)
+			}
+		})
+	}
+
+	valid.RoyaltyBasisPoints = MaxRoyaltyBasisPoints
+	if err := valid.Validate(); err != nil {
+		t.Errorf("a 100%% royalty should be valid: %v", err)
+	}
+}
+
+func TestParseConfigFileErrors(t *testing.T) {
+	_, err := ParseConfigFile([]byte(`{
+		"networks": {
+			"a": {"topShotFlowAddr": "877931736ee77cff", "royaltyBasisPoints": 20000},
+			"b": {"topShotFlowAddr": "877931736ee77cff", "rpc": "https://example.com"}
+		}
+	}`))
+	if err == nil {
+		t.Fatal("expected the file to be rejected")
+	}
+	for _, expected := range []string{
+		"networks.a.royaltyBasisPoints: 20000 is more than 10000",
+		"networks.a.collectionName: missing",
+		`networks.b: json: unknown field "rpc"`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %q in:\n%s", expected, err)
+		}
+	}
+
+	if _, err := ParseConfigFile([]byte(`{"networks": {}}`)); err == nil {
+		t.Error("expected a file without networks to be rejected")
+	}
+	if _, err := ParseConfigFile([]byte(`{"defaults": {}, "network": {}}`)); err == nil {
+		t.Error("expected an unknown top level field to be rejected")
+	}
+
+	path := filepath.Join(t.TempDir(), "networks.json")
+	if err := os.WriteFile(path, []byte(`{"networks": {"a": {}}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfigFile(path); err == nil || !strings.Contains(err.Error(), path) {
+		t.Errorf("expected the error to name the file, got %v", err)
+	}
+}
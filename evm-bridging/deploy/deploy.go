@@ -0,0 +1,417 @@
+// Package deploy deploys BridgedTopShotMoments behind an ERC1967Proxy on
+// Flow EVM through the Topshot account's COA, and sets up its royalties.
+//
+// The Cadence side runs through a FlowExecutor and compilation and
+// verification through Forge, so the deployment can be driven by any Flow
+// client. In dry-run mode every Cadence transaction, encoded calldata and
+// target address is printed and nothing is submitted:
+//
+//	d, err := deploy.New(dir, "testnet", nil)
+//	d.Flow, d.Forge, d.DryRun = flow, deploy.ForgeCLI{Dir: dir, Log: log.Default()}, true
+//	deployment, err := d.Setup()
+package deploy
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	deployContractTx = "admin/deploy/deploy_contract"
+	createCoaTx      = "admin/deploy/create_coa"
+	royaltyTx        = "admin/set_up_royalty_management"
+	testsTx          = "tests/test_uint_array_encoding"
+	coaScript        = "get_evm_address_string"
+)
+
+// Deployer deploys the contracts on one network
+type Deployer struct {
+	Dir                string
+	Network            string
+	Config             Config
+	TopshotAccountName string
+
+	Flow  FlowExecutor
+	Forge Forge
+	// EVM reads the state of Flow EVM when the network has an rpcUrl
+	EVM EVMReader
+
+	// StatePath is the file the completed steps of a setup are recorded in
+	StatePath string
+	// Force deploys a new implementation and proxy even when a proxy was
+	// already deployed, replacing it in the state
+	Force bool
+
+	// DryRun prints the transactions, calldata and verifications to Out
+	// instead of submitting them, and leaves the state file untouched
+	DryRun bool
+	Out    io.Writer
+	Log    *log.Logger
+
+	dryRunFlow *dryRunFlow
+}
+
+// New returns a deployer for a network of the configuration, or of the
+// default one when configs is nil, with the contracts and Cadence files
+// in dir. Flow and Forge must be set before deploying.
+func New(dir, network string, configs *ConfigFile) (*Deployer, error) {
+	if configs == nil {
+		configs = DefaultConfigFile()
+	}
+	config, err := configs.Network(network)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Deployer{
+		Dir:                dir,
+		Network:            network,
+		Config:             config,
+		TopshotAccountName: "topshot-signer",
+		StatePath:          filepath.Join(dir, "deployments", network+".json"),
+		Out:                os.Stdout,
+		Log:                log.Default(),
+	}
+	if config.RpcUrl != "" {
+		d.EVM = RPCReader{Url: config.RpcUrl}
+	}
+	return d, nil
+}
+
+// Setup deploys the implementation and the proxy, verifies them on networks
+// with a verifier and sets up royalty management. The steps recorded in
+// the state file by previous runs are skipped.
+func (d *Deployer) Setup() (*Deployment, error) {
+	d.describe()
+
+	deployment, err := LoadDeployment(d.StatePath, d.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	coaAddr, err := d.RetrieveOrCreateCOA()
+	if err != nil {
+		return nil, err
+	}
+	if deployment.CoaAddr != "" && deployment.CoaAddr != coaAddr && !d.Force {
+		return nil, fmt.Errorf("the COA is %s but %s was deployed from %s, pass --force to deploy from the new COA", coaAddr, d.StatePath, deployment.CoaAddr)
+	}
+	deployment.CoaAddr = coaAddr
+	if err := d.save(deployment); err != nil {
+		return nil, err
+	}
+
+	if err := d.forge().Build(); err != nil {
+		return nil, err
+	}
+
+	implementation, err := ReadBytecode(d.Dir, "BridgedTopShotMoments")
+	if err != nil {
+		return nil, err
+	}
+	implementationCodeHash := codeHash(implementation)
+	layout, err := ReadStorageLayout(d.Dir, "BridgedTopShotMoments")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case d.Force:
+		deployment.reset()
+	case deployment.ImplementationAddr != "" && deployment.ImplementationCodeHash != implementationCodeHash:
+		if deployment.ProxyAddr != "" {
+			return nil, fmt.Errorf("BridgedTopShotMoments changed since proxy %s was deployed, refusing to deploy a second proxy without --force", deployment.ProxyAddr)
+		}
+		d.Log.Printf("BridgedTopShotMoments changed since %s was deployed, deploying it again", deployment.ImplementationAddr)
+		deployment.reset()
+	}
+
+	// Deploy implementation contract
+	if deployment.ImplementationAddr == "" {
+		if deployment.ImplementationAddr, err = d.DeployContract("BridgedTopShotMoments", nil); err != nil {
+			return nil, err
+		}
+		deployment.ImplementationCodeHash = implementationCodeHash
+		deployment.StorageLayout = layout
+		if err := d.save(deployment); err != nil {
+			return nil, err
+		}
+	} else {
+		d.Log.Printf("Using BridgedTopShotMoments deployed at %s", deployment.ImplementationAddr)
+	}
+
+	// Deploy proxy contract
+	if deployment.ProxyAddr == "" {
+		initializeFunctionCall, err := GenerateEncodedInitializeFunctionCall(d.InitializeParams(coaAddr))
+		if err != nil {
+			return nil, err
+		}
+		d.printCalldata("initialize", initializeFunctionCall)
+
+		constructorData, err := GenerateProxyEncodedConstructorData(deployment.ImplementationAddr, initializeFunctionCall)
+		if err != nil {
+			return nil, err
+		}
+		d.printCalldata("ERC1967Proxy constructor", constructorData)
+
+		if deployment.ProxyAddr, err = d.DeployContract("ERC1967Proxy", constructorData); err != nil {
+			return nil, err
+		}
+		if err := d.save(deployment); err != nil {
+			return nil, err
+		}
+	} else {
+		d.Log.Printf("Using ERC1967Proxy deployed at %s", deployment.ProxyAddr)
+	}
+
+	if d.Config.Verified() {
+		for _, addr := range []string{deployment.ImplementationAddr, deployment.ProxyAddr} {
+			if deployment.IsVerified(addr) {
+				continue
+			}
+			if err := d.VerifyContract(addr); err != nil {
+				return nil, err
+			}
+			deployment.Verified = append(deployment.Verified, addr)
+			if err := d.save(deployment); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if deployment.RoyaltyTxID == "" {
+		if deployment.RoyaltyTxID, err = d.SetRoyaltyManagement(deployment.ProxyAddr); err != nil {
+			return nil, err
+		}
+		if err := d.save(deployment); err != nil {
+			return nil, err
+		}
+	} else {
+		d.Log.Printf("Royalty management already set up by transaction %s", deployment.RoyaltyTxID)
+	}
+
+	d.Log.Printf("\n\nSETUP COMPLETE!")
+	return deployment, nil
+}
+
+// save records the completed steps, except in a dry run
+func (d *Deployer) save(deployment *Deployment) error {
+	if d.DryRun {
+		return nil
+	}
+	return deployment.Save(d.StatePath)
+}
+
+// Tests deploys TestContract on the emulator and runs the test transaction against it
+func (d *Deployer) Tests() error {
+	if d.Network != "emulator" {
+		return fmt.Errorf("test script can only be run on the emulator network")
+	}
+
+	if _, err := d.RetrieveOrCreateCOA(); err != nil {
+		return err
+	}
+
+	testContractAddr, err := d.DeployContract("TestContract", nil)
+	if err != nil {
+		return err
+	}
+
+	d.Log.Printf("\t...running test uint array encoding tx")
+	if _, err := d.flow().Tx(testsTx, d.TopshotAccountName,
+		Arg{"evmContractAddress", strings.TrimPrefix(testContractAddr, "0x")},
+	); err != nil {
+		return err
+	}
+	d.Log.Printf("Tx executed%s", separatorString())
+	return nil
+}
+
+// InitializeParams are the arguments the proxy initializes the implementation with
+func (d *Deployer) InitializeParams(coaAddr string) InitializeParams {
+	return InitializeParams{
+		Owner:                        coaAddr,
+		UnderlyingNftContractAddress: d.Config.BridgeDeployedTopshotERC721Addr,
+		VmBridgeAddress:              d.Config.FlowEvmBridgeCoaAddr,
+		Name:                         d.Config.CollectionName,
+		Symbol:                       d.Config.CollectionSymbol,
+		BaseTokenURI:                 d.Config.BaseTokenURI,
+		CadenceNFTAddress:            d.Config.TopShotFlowAddr,
+		CadenceNFTIdentifier:         d.Config.CadenceNFTIdentifier(),
+		ContractMetadata:             d.Config.ContractMetadata.DataURI(),
+	}
+}
+
+// RetrieveOrCreateCOA returns the EVM address of the Topshot account's COA, creating the COA if needed
+func (d *Deployer) RetrieveOrCreateCOA() (string, error) {
+	d.Log.Printf("\t...retrieving COA")
+	coaHex, err := d.lookupCOA()
+	if err != nil {
+		return "", err
+	}
+	if coaHex != "" {
+		d.Log.Printf("Using existing COA with EVM address: %s", coaHex)
+		return "0x" + coaHex, nil
+	}
+
+	d.Log.Printf("\t...creating new COA")
+	if _, err := d.flow().Tx(createCoaTx, d.TopshotAccountName, Arg{"amount", 1.0}); err != nil {
+		return "", err
+	}
+
+	if coaHex, err = d.lookupCOA(); err != nil {
+		return "", err
+	}
+	if coaHex == "" {
+		if !d.DryRun {
+			return "", fmt.Errorf("no COA found after creating one")
+		}
+		// the COA was only printed, use a placeholder owner in the calldata
+		coaHex = strings.TrimPrefix(DryRunAddress(0), "0x")
+	}
+
+	d.Log.Printf("Created new COA with EVM address: %s%s", coaHex, separatorString())
+	return "0x" + coaHex, nil
+}
+
+func (d *Deployer) lookupCOA() (string, error) {
+	result, err := d.flow().Script(coaScript, Arg{"flowAddress", d.flow().Address(d.TopshotAccountName)})
+	if err != nil {
+		return "", err
+	}
+	if result == nil {
+		return "", nil
+	}
+
+	coaHex, ok := result.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected COA address %v", result)
+	}
+	return strings.TrimPrefix(coaHex, "0x"), nil
+}
+
+// DeployContract deploys a compiled contract, followed by its encoded
+// constructor arguments if any, from the COA and returns its address
+func (d *Deployer) DeployContract(name string, constructorData []byte) (string, error) {
+	d.Log.Printf("\t...deploying %s contract", name)
+
+	bytecode, err := ReadBytecode(d.Dir, name)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := d.flow().Tx(deployContractTx, d.TopshotAccountName,
+		Arg{"bytecode", fmt.Sprintf("%x%x", bytecode, constructorData)},
+		Arg{"gasLimit", d.Config.GasLimit},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	address, err := contractAddressFromEVMEvent(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to deploy %s: %w", name, err)
+	}
+	d.Log.Printf("%s contract deployed to address: %s%s", name, address, separatorString())
+	return address, nil
+}
+
+// VerifyContract verifies a deployed contract on the network's block explorer
+func (d *Deployer) VerifyContract(contractAddr string) error {
+	return d.forge().Verify(d.Config.RpcUrl, d.Config.VerifierProvider, d.Config.VerifierUrl, contractAddr)
+}
+
+// SetRoyaltyManagement sets the transfer validator and the royalty info of
+// the proxy and returns the ID of the transaction
+func (d *Deployer) SetRoyaltyManagement(proxyAddr string) (string, error) {
+	if proxyAddr == "" {
+		return "", fmt.Errorf("proxy contract not deployed")
+	}
+
+	d.Log.Printf("\t...setting up royalty management")
+	result, err := d.flow().Tx(royaltyTx, d.TopshotAccountName,
+		Arg{"erc721C", proxyAddr},
+		Arg{"validator", d.Config.TransferValidatorAddr},
+		Arg{"royaltyRecipient", d.Config.RoyaltyRecipientAddr},
+		Arg{"royaltyBasisPoints", d.Config.RoyaltyBasisPoints},
+	)
+	if err != nil {
+		return "", err
+	}
+	d.Log.Printf("Royalty management set up%s", separatorString())
+	return result.ID(), nil
+}
+
+// flow returns the Flow executor, wrapped so that it only prints transactions in a dry run
+func (d *Deployer) flow() FlowExecutor {
+	if !d.DryRun {
+		return d.Flow
+	}
+	if d.dryRunFlow == nil {
+		d.dryRunFlow = &dryRunFlow{
+			flow:            d.Flow,
+			transactionsDir: filepath.Join(d.Dir, "cadence", "transactions"),
+			out:             d.Out,
+		}
+	}
+	return d.dryRunFlow
+}
+
+func (d *Deployer) forge() Forge {
+	if d.DryRun {
+		return dryRunForge{forge: d.Forge, out: d.Out}
+	}
+	return d.Forge
+}
+
+// describe prints the addresses a dry run targets
+func (d *Deployer) describe() {
+	if !d.DryRun {
+		return
+	}
+
+	fmt.Fprintf(d.Out, "--- dry run on %s\n", d.Network)
+	for _, target := range [][2]string{
+		{"signer", d.TopshotAccountName},
+		{"TopShot Flow address", d.Config.TopShotFlowAddr},
+		{"bridge COA", d.Config.FlowEvmBridgeCoaAddr},
+		{"bridge-deployed TopShot ERC721", d.Config.BridgeDeployedTopshotERC721Addr},
+		{"transfer validator", d.Config.TransferValidatorAddr},
+		{"royalty recipient", d.Config.RoyaltyRecipientAddr},
+		{"RPC", d.Config.RpcUrl},
+		{"verifier", d.Config.VerifierUrl},
+	} {
+		fmt.Fprintf(d.Out, "%s: %s\n", target[0], target[1])
+	}
+	fmt.Fprintln(d.Out)
+}
+
+func (d *Deployer) printCalldata(name string, data []byte) {
+	if d.DryRun {
+		fmt.Fprintf(d.Out, "--- calldata %s\n0x%x\n\n", name, data)
+		return
+	}
+	d.Log.Printf("Encoded %s: 0x%x", name, data)
+}
+
+// contractAddressFromEVMEvent extracts the deployed contract address from the TransactionExecuted event
+func contractAddressFromEVMEvent(result TxResult) (string, error) {
+	events := result.Events("TransactionExecuted")
+	if len(events) == 0 {
+		return "", fmt.Errorf("no TransactionExecuted event")
+	}
+
+	contractAddr, ok := events[0]["contractAddress"].(string)
+	if !ok || contractAddr == "" {
+		return "", fmt.Errorf("contract address not found in event")
+	}
+	return ensureHexPrefix(strings.TrimPrefix(strings.ToLower(contractAddr), "0x")), nil
+}
+
+func separatorString() string {
+	return "\n--------------------------------\n"
+}
@@ -0,0 +1,315 @@
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bridged-topshot-evm-deploy/bindings"
+)
+
+// fakeFlow records the transactions it is given and reports sequential
+// contract addresses for deployments
+type fakeFlow struct {
+	coa      any
+	txs      []string
+	args     [][]Arg
+	deployed int
+	failTx   string
+
+	dryCall  any
+	dryCalls [][]Arg
+}
+
+func (f *fakeFlow) Tx(name string, signer string, args ...Arg) (TxResult, error) {
+	if name == f.failTx {
+		return nil, errors.New("transaction reverted")
+	}
+	f.txs = append(f.txs, name)
+	f.args = append(f.args, args)
+
+	result := fakeResult{dryRunResult{}, fmt.Sprintf("tx%d", len(f.txs))}
+	switch name {
+	case createCoaTx:
+		f.coa = "00000000000000000000000200000000000000aa"
+	case deployContractTx:
+		f.deployed++
+		result.dryRunResult["A.f8d6e0586b0a20c7.EVM.TransactionExecuted"] = []map[string]any{
+			{"contractAddress": "0x" + strings.ToUpper(strings.TrimPrefix(DryRunAddress(100+f.deployed), "0x"))},
+		}
+	}
+	return result, nil
+}
+
+type fakeResult struct {
+	dryRunResult
+	id string
+}
+
+func (r fakeResult) ID() string {
+	return r.id
+}
+
+func (f *fakeFlow) Script(name string, args ...Arg) (any, error) {
+	if name == dryCallScript {
+		f.dryCalls = append(f.dryCalls, args)
+		return f.dryCall, nil
+	}
+	return f.coa, nil
+}
+
+func (f *fakeFlow) Address(account string) string {
+	return "f8d6e0586b0a20c7"
+}
+
+type fakeForge struct {
+	built    int
+	verified []string
+}
+
+func (f *fakeForge) Build() error {
+	f.built++
+	return nil
+}
+
+func (f *fakeForge) Verify(rpcUrl, verifierProvider, verifierUrl, contractAddr string) error {
+	f.verified = append(f.verified, contractAddr)
+	return nil
+}
+
+// newTestDeployer lays out forge artifacts and the Cadence transactions in a temporary directory
+func newTestDeployer(t *testing.T, network string) (*Deployer, *fakeFlow, *fakeForge) {
+	t.Helper()
+	dir := t.TempDir()
+
+	for _, name := range []string{"BridgedTopShotMoments", "ERC1967Proxy", "TestContract"} {
+		writeArtifact(t, dir, name, "0x6080604052", testLayout())
+	}
+	for _, tx := range []string{deployContractTx, createCoaTx, royaltyTx, testsTx, callContractTx} {
+		writeFile(t, filepath.Join(dir, "cadence", "transactions", tx+".cdc"), []byte("transaction { /* "+tx+" */ }\n"))
+	}
+
+	d, err := New(dir, network, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flow, forge := &fakeFlow{}, &fakeForge{}
+	d.Flow, d.Forge = flow, forge
+	d.Out = io.Discard
+	d.Log = log.New(io.Discard, "", 0)
+	return d, flow, forge
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetup(t *testing.T) {
+	d, flow, forge := newTestDeployer(t, "testnet")
+
+	deployment, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{createCoaTx, deployContractTx, deployContractTx, royaltyTx}
+	if strings.Join(flow.txs, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected transactions %v, got %v", expected, flow.txs)
+	}
+	if deployment.CoaAddr != "0x00000000000000000000000200000000000000aa" {
+		t.Errorf("unexpected COA %s", deployment.CoaAddr)
+	}
+	if deployment.ImplementationAddr != DryRunAddress(101) || deployment.ProxyAddr != DryRunAddress(102) {
+		t.Errorf("unexpected deployment %+v", deployment)
+	}
+	if forge.built != 1 || len(forge.verified) != 2 {
+		t.Errorf("expected one build and two verifications, got %d and %v", forge.built, forge.verified)
+	}
+
+	// the proxy is deployed with its constructor arguments appended to the bytecode
+	proxyBytecode := flow.args[2][0].Value.(string)
+	if !strings.HasPrefix(proxyBytecode, "6080604052") || len(proxyBytecode) <= len("6080604052") {
+		t.Errorf("proxy deployed without constructor data: %s", proxyBytecode)
+	}
+	if royalty := flow.args[3]; royalty[0].Value != deployment.ProxyAddr || royalty[3].Value != uint64(500) {
+		t.Errorf("unexpected royalty arguments %v", royalty)
+	}
+}
+
+func TestSetupDoesNotVerifyOnEmulator(t *testing.T) {
+	d, _, forge := newTestDeployer(t, "emulator")
+
+	if _, err := d.Setup(); err != nil {
+		t.Fatal(err)
+	}
+	if len(forge.verified) != 0 {
+		t.Errorf("expected no verification on the emulator, got %v", forge.verified)
+	}
+}
+
+func TestDryRunSubmitsNothing(t *testing.T) {
+	d, flow, forge := newTestDeployer(t, "mainnet")
+	out := &bytes.Buffer{}
+	d.DryRun, d.Out = true, out
+
+	deployment, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(flow.txs) != 0 || len(forge.verified) != 0 {
+		t.Fatalf("dry run submitted %v and verified %v", flow.txs, forge.verified)
+	}
+	if forge.built != 1 {
+		t.Errorf("a dry run should still build the contracts, built %d times", forge.built)
+	}
+	if deployment.CoaAddr != DryRunAddress(0) || deployment.ProxyAddr != DryRunAddress(2) {
+		t.Errorf("unexpected dry run deployment %+v", deployment)
+	}
+
+	printed := out.String()
+	for _, expected := range []string{
+		"--- dry run on mainnet",
+		d.Config.TransferValidatorAddr,
+		"/* " + createCoaTx + " */",
+		"/* " + royaltyTx + " */",
+		"--- calldata initialize",
+		"--- calldata ERC1967Proxy constructor",
+		"--- forge verify-contract --rpc-url https://mainnet.evm.nodes.onflow.org",
+		"erc721C: " + DryRunAddress(2),
+	} {
+		if !strings.Contains(printed, expected) {
+			t.Errorf("dry run output does not contain %q:\n%s", expected, printed)
+		}
+	}
+	if n := strings.Count(printed, "/* "+deployContractTx+" */"); n != 2 {
+		t.Errorf("expected two deployments printed, got %d", n)
+	}
+}
+
+func TestDryRunUsesExistingCOA(t *testing.T) {
+	d, flow, _ := newTestDeployer(t, "testnet")
+	flow.coa = "00000000000000000000000200000000000000bb"
+	d.DryRun = true
+
+	coa, err := d.RetrieveOrCreateCOA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coa != "0x00000000000000000000000200000000000000bb" {
+		t.Errorf("expected the existing COA, got %s", coa)
+	}
+}
+
+func TestTests(t *testing.T) {
+	d, flow, _ := newTestDeployer(t, "emulator")
+
+	if err := d.Tests(); err != nil {
+		t.Fatal(err)
+	}
+	if last := flow.args[len(flow.args)-1]; last[0].Value != strings.TrimPrefix(DryRunAddress(101), "0x") {
+		t.Errorf("unexpected test contract address %v", last[0].Value)
+	}
+
+	d, _, _ = newTestDeployer(t, "testnet")
+	if err := d.Tests(); err == nil {
+		t.Error("expected the tests to be rejected outside the emulator")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := New(t.TempDir(), "previewnet", nil); err == nil {
+		t.Error("expected an unknown network to be rejected")
+	}
+
+	d, flow, _ := newTestDeployer(t, "emulator")
+	flow.failTx = royaltyTx
+	if _, err := d.Setup(); err == nil {
+		t.Error("expected a failed transaction to fail the setup")
+	}
+
+	d, _, _ = newTestDeployer(t, "emulator")
+	if _, err := d.DeployContract("Missing", nil); err == nil {
+		t.Error("expected a missing artifact to be reported")
+	}
+	if _, err := d.SetRoyaltyManagement(""); err == nil {
+		t.Error("expected royalties without a proxy to be rejected")
+	}
+	if _, err := contractAddressFromEVMEvent(dryRunResult{}); err == nil {
+		t.Error("expected a missing event to be reported")
+	}
+}
+
+func TestEncodedInitializeFunctionCall(t *testing.T) {
+	params := InitializeParams{
+		Owner:                        "00000000000000000000000200000000000000aa",
+		UnderlyingNftContractAddress: "0x1234567890abcdef1234567890abcdef12345678",
+		VmBridgeAddress:              "0x1234567890abcdef1234567890abcdef12345678",
+		Name:                         "NBA Top Shot",
+		Symbol:                       "TOPSHOT",
+		BaseTokenURI:                 "https://example.com/moment/",
+		CadenceNFTAddress:            "877931736ee77cff",
+		CadenceNFTIdentifier:         "A.877931736ee77cff.TopShot.NFT",
+		ContractMetadata:             `data:application/json;utf8,{"name":"NBA Top Shot"}`,
+	}
+	data, err := GenerateEncodedInitializeFunctionCall(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := bindings.BridgedTopShotMomentsMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	method, err := parsed.MethodById(data[:4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[3] != params.Name || values[7] != params.CadenceNFTIdentifier || values[8] != params.ContractMetadata {
+		t.Errorf("unexpected decoded arguments %v", values)
+	}
+
+	params.Owner = "0x1234"
+	if _, err := GenerateEncodedInitializeFunctionCall(params); err == nil {
+		t.Error("expected an invalid owner to be rejected")
+	}
+	if _, err := GenerateProxyEncodedConstructorData("not an address", data); err == nil {
+		t.Error("expected an invalid implementation to be rejected")
+	}
+}
+
+// writeArtifact writes a forge artifact with the ABI functions named, all without arguments
+func writeArtifact(t *testing.T, dir, name, bytecode string, layout *StorageLayout, functions ...string) {
+	t.Helper()
+	functionABI := []map[string]any{}
+	for _, function := range functions {
+		functionABI = append(functionABI, map[string]any{"type": "function", "name": function, "inputs": []any{}, "outputs": []any{}})
+	}
+
+	artifact, err := json.Marshal(map[string]any{
+		"abi":           functionABI,
+		"bytecode":      map[string]string{"object": bytecode},
+		"storageLayout": layout,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(dir, "out", name+".sol", name+".json"), artifact)
+}
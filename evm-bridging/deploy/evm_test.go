@@ -0,0 +1,285 @@
+package deploy_test
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"bridged-topshot-evm-deploy/bindings"
+	"bridged-topshot-evm-deploy/client"
+	"bridged-topshot-evm-deploy/deploy"
+	"bridged-topshot-evm-deploy/evmtest"
+)
+
+// These tests run the contracts forge built under ../out on a simulated
+// chain, and are skipped until 'forge build' is run
+
+var (
+	owner      = evmtest.Account("owner")
+	holder     = evmtest.Account("holder")
+	buyer      = evmtest.Account("buyer")
+	vmBridge   = evmtest.Account("vmBridge")
+	underlying = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	recipient  = common.HexToAddress("0x00000000000000000000000000000000000000d4")
+)
+
+var initializeParams = deploy.InitializeParams{
+	Owner:                        owner.Hex(),
+	UnderlyingNftContractAddress: underlying.Hex(),
+	VmBridgeAddress:              vmBridge.Hex(),
+	Name:                         "NBA Top Shot",
+	Symbol:                       "TOPSHOT",
+	BaseTokenURI:                 "https://api.nbatopshot.com/metadata/",
+	CadenceNFTAddress:            "0b2a3299cc857e29",
+	CadenceNFTIdentifier:         "A.0b2a3299cc857e29.TopShot.NFT",
+	ContractMetadata:             `data:application/json;utf8,{"name":"NBA Top Shot"}`,
+}
+
+// moments is a proxied BridgedTopShotMoments, deployed with the encoding helpers of the deploy package
+type moments struct {
+	t              *testing.T
+	evm            *evmtest.EVM
+	abi            *abi.ABI
+	implementation common.Address
+	proxy          common.Address
+}
+
+func deployMoments(t *testing.T) *moments {
+	t.Helper()
+	evm := evmtest.New(t, "..")
+
+	implementation, err := evm.Deploy(owner, "BridgedTopShotMoments", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	initializeCall, err := deploy.GenerateEncodedInitializeFunctionCall(initializeParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	constructorData, err := deploy.GenerateProxyEncodedConstructorData(implementation.Hex(), initializeCall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy, err := evm.Deploy(owner, "ERC1967Proxy", constructorData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contractABI, err := bindings.BridgedTopShotMomentsMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &moments{t: t, evm: evm, abi: contractABI, implementation: implementation, proxy: proxy}
+}
+
+// send calls the proxy, returning the revert if any
+func (m *moments) send(from common.Address, method string, args ...any) error {
+	m.t.Helper()
+	data, err := m.abi.Pack(method, args...)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	_, err = m.evm.Transact(from, m.proxy, data)
+	return err
+}
+
+// read calls a view function of the proxy
+func (m *moments) read(method string, args ...any) []any {
+	m.t.Helper()
+	data, err := m.abi.Pack(method, args...)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	returned, err := m.evm.DryCall(owner, m.proxy, data)
+	if err != nil {
+		m.t.Fatalf("%s: %v", method, err)
+	}
+	values, err := m.abi.Unpack(method, returned)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return values
+}
+
+// reverted returns the name of the custom error a call reverted with
+func (m *moments) reverted(err error) string {
+	m.t.Helper()
+	var revert *evmtest.RevertError
+	if !errors.As(err, &revert) {
+		m.t.Fatalf("expected a revert, got %v", err)
+	}
+	return revert.Name(m.abi)
+}
+
+func TestEVMInitialize(t *testing.T) {
+	m := deployMoments(t)
+
+	for method, expected := range map[string]any{
+		"name":                 initializeParams.Name,
+		"symbol":               initializeParams.Symbol,
+		"getCadenceAddress":    initializeParams.CadenceNFTAddress,
+		"getCadenceIdentifier": initializeParams.CadenceNFTIdentifier,
+		"contractURI":          initializeParams.ContractMetadata,
+		"owner":                owner,
+		"underlying":           underlying,
+		"vmBridgeAddress":      vmBridge,
+		"getTransferValidator": common.Address{},
+	} {
+		if value := m.read(method)[0]; value != expected {
+			t.Errorf("%s: expected %v, got %v", method, expected, value)
+		}
+	}
+
+	slot, _ := m.evm.StorageAt(m.proxy.Hex(), deploy.ImplementationSlot)
+	if common.BytesToAddress(slot[12:]) != m.implementation {
+		t.Errorf("the proxy points to %s instead of %s", slot, m.implementation)
+	}
+
+	initializeCall, _ := deploy.GenerateEncodedInitializeFunctionCall(initializeParams)
+	if _, err := m.evm.Transact(owner, m.proxy, initializeCall); err == nil {
+		t.Error("expected the proxy not to be initialized twice")
+	}
+}
+
+func TestEVMInitializeRejectsZeroUnderlying(t *testing.T) {
+	m := deployMoments(t)
+
+	params := initializeParams
+	params.UnderlyingNftContractAddress = common.Address{}.Hex()
+	initializeCall, err := deploy.GenerateEncodedInitializeFunctionCall(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	constructorData, err := deploy.GenerateProxyEncodedConstructorData(m.implementation.Hex(), initializeCall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.evm.Deploy(owner, "ERC1967Proxy", constructorData); err == nil {
+		t.Error("expected a proxy without underlying contract to fail to deploy")
+	}
+}
+
+func TestEVMRoyalties(t *testing.T) {
+	m := deployMoments(t)
+	admin, err := client.New(m.evm.COA(owner), owner.Hex(), m.proxy.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txHash, err := admin.SetRoyaltyInfo(recipient.Hex(), 500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := m.evm.Receipt(txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.ContainsFunc(receipt.Logs, func(log *types.Log) bool { return log.Topics[0] == m.abi.Events["RoyaltyInfoUpdated"].ID }) {
+		t.Errorf("expected RoyaltyInfoUpdated to be emitted, got %+v", receipt.Logs)
+	}
+	receiver, amount, err := admin.RoyaltyInfo(1, big.NewInt(20000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if common.HexToAddress(receiver) != recipient || amount.Int64() != 1000 {
+		t.Errorf("expected 1000 to %s, got %s to %s", recipient, amount, receiver)
+	}
+
+	info := bindings.BridgedTopShotMomentsRoyaltyInfo{RoyaltyAddress: recipient, RoyaltyBps: big.NewInt(10001)}
+	if name := m.reverted(m.send(owner, "setRoyaltyInfo", info)); name != "InvalidRoyaltyBasisPoints" {
+		t.Errorf("expected InvalidRoyaltyBasisPoints, got %q", name)
+	}
+	info = bindings.BridgedTopShotMomentsRoyaltyInfo{RoyaltyAddress: common.Address{}, RoyaltyBps: big.NewInt(500)}
+	if name := m.reverted(m.send(owner, "setRoyaltyInfo", info)); name != "RoyaltyAddressCannotBeZeroAddress" {
+		t.Errorf("expected RoyaltyAddressCannotBeZeroAddress, got %q", name)
+	}
+	info.RoyaltyAddress = holder
+	if name := m.reverted(m.send(holder, "setRoyaltyInfo", info)); name != "OwnableUnauthorizedAccount" {
+		t.Errorf("expected OwnableUnauthorizedAccount, got %q", name)
+	}
+	if bps := m.read("royaltyBasisPoints")[0].(*big.Int); bps.Int64() != 500 {
+		t.Errorf("rejected royalty info was set: %s", bps)
+	}
+}
+
+func TestEVMTransferValidator(t *testing.T) {
+	m := deployMoments(t)
+	blocking, err := m.evm.DeployCode(owner, common.FromHex("0x60006000fd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	allowing, err := m.evm.DeployCode(owner, []byte{0x00})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.send(owner, "setTransferValidator", blocking); err != nil {
+		t.Fatal(err)
+	}
+	if name := m.reverted(m.send(owner, "setTransferValidator", blocking)); name != "SameTransferValidator" {
+		t.Errorf("expected SameTransferValidator, got %q", name)
+	}
+
+	// minting is not a transfer, so the validator is not called
+	if err := m.send(vmBridge, "fulfillToEVM", holder, big.NewInt(1), []byte{}); err != nil {
+		t.Fatal(err)
+	}
+	if uri := m.read("tokenURI", big.NewInt(1))[0]; uri != initializeParams.BaseTokenURI+"1" {
+		t.Errorf("unexpected token URI %v", uri)
+	}
+
+	if err := m.send(holder, "transferFrom", holder, buyer, big.NewInt(1)); err == nil {
+		t.Fatal("expected the validator to block the transfer")
+	}
+
+	if err := m.send(owner, "setTransferValidator", allowing); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.send(holder, "transferFrom", holder, buyer, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	if tokenOwner := m.read("ownerOf", big.NewInt(1))[0]; tokenOwner != buyer {
+		t.Errorf("expected the moment to belong to %s, got %v", buyer, tokenOwner)
+	}
+
+	if err := m.send(owner, "setBaseTokenURI", "ipfs://moments/"); err != nil {
+		t.Fatal(err)
+	}
+	if uri := m.read("tokenURI", big.NewInt(1))[0]; uri != "ipfs://moments/1" {
+		t.Errorf("unexpected token URI %v", uri)
+	}
+}
+
+func TestEVMArrayEncoding(t *testing.T) {
+	evm := evmtest.New(t, "..")
+	testContract, err := evm.Deploy(owner, "TestContract", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testABI, err := deploy.ReadABI("..", "TestContract")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := []*big.Int{big.NewInt(1), big.NewInt(2), new(big.Int).Lsh(big.NewInt(1), 255)}
+	data, err := testABI.Pack("testArrayEncoding", values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	returned, err := evm.DryCall(owner, testContract, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := testABI.Unpack("testArrayEncoding", returned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.EqualFunc(decoded[0].([]*big.Int), values, func(a, b *big.Int) bool { return a.Cmp(b) == 0 }) {
+		t.Errorf("expected %v, got %v", values, decoded[0])
+	}
+}
@@ -0,0 +1,188 @@
+package deploy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Arg is a named argument of a Cadence transaction or script
+type Arg struct {
+	Name  string
+	Value any
+}
+
+// TxResult is an executed Cadence transaction
+type TxResult interface {
+	ID() string
+	// Events returns the fields of the emitted events whose type ends with the name,
+	// e.g. TransactionExecuted for A.f8d6e0586b0a20c7.EVM.TransactionExecuted
+	Events(name string) []map[string]any
+}
+
+// FlowExecutor runs the Cadence side of a deployment. Transactions and
+// scripts are named by their path, without the .cdc extension, under
+// cadence/transactions and cadence/scripts.
+type FlowExecutor interface {
+	Tx(name string, signer string, args ...Arg) (TxResult, error)
+	Script(name string, args ...Arg) (any, error)
+	// Address returns the Flow address of a named account, without 0x
+	Address(account string) string
+}
+
+// Forge compiles and verifies the Solidity contracts
+type Forge interface {
+	// Build cleans and recompiles the contracts into out/
+	Build() error
+	Verify(rpcUrl, verifierProvider, verifierUrl, contractAddr string) error
+}
+
+// ForgeCLI runs the forge command line in the project directory
+type ForgeCLI struct {
+	Dir string
+	Log *log.Logger
+}
+
+// Build runs 'forge clean' and 'forge build'
+func (f ForgeCLI) Build() error {
+	if _, err := f.run("clean"); err != nil {
+		return err
+	}
+
+	out, err := f.run("build")
+	if err != nil {
+		return err
+	}
+	f.Log.Println("Output:\n", out)
+	return nil
+}
+
+// Verify runs 'forge verify-contract'
+func (f ForgeCLI) Verify(rpcUrl, verifierProvider, verifierUrl, contractAddr string) error {
+	_, err := f.run(verifyArgs(rpcUrl, verifierProvider, verifierUrl, contractAddr)...)
+	return err
+}
+
+func (f ForgeCLI) run(args ...string) (string, error) {
+	cmd := exec.Command("forge", args...)
+	cmd.Dir = f.Dir
+	f.Log.Println("Executing command:", cmd.String())
+
+	var out, stderr bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to run 'forge %s': %w; %s", args[0], err, stderr.String())
+	}
+	return out.String(), nil
+}
+
+func verifyArgs(rpcUrl, verifierProvider, verifierUrl, contractAddr string) []string {
+	return []string{
+		"verify-contract",
+		"--rpc-url", rpcUrl,
+		"--verifier", verifierProvider,
+		"--verifier-url", verifierUrl,
+		contractAddr,
+	}
+}
+
+// dryRunFlow prints the transactions it is given instead of submitting them.
+// Scripts only read state, so they still run against the network when a
+// Flow executor is available.
+type dryRunFlow struct {
+	flow FlowExecutor
+	// transactionsDir is the folder the transaction code is read from
+	transactionsDir string
+	out             io.Writer
+	deployed        int
+}
+
+func (d *dryRunFlow) Tx(name string, signer string, args ...Arg) (TxResult, error) {
+	code, err := os.ReadFile(filepath.Join(d.transactionsDir, name+".cdc"))
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(d.out, "--- transaction %s, signed by %s\n%s\n", name, signer, strings.TrimSpace(string(code)))
+	fmt.Fprintln(d.out, "--- arguments")
+	for _, arg := range args {
+		fmt.Fprintf(d.out, "%s: %v\n", arg.Name, arg.Value)
+	}
+	fmt.Fprintln(d.out)
+
+	result := dryRunResult{}
+	if name == deployContractTx {
+		d.deployed++
+		result["TransactionExecuted"] = []map[string]any{{"contractAddress": DryRunAddress(d.deployed)}}
+	}
+	return result, nil
+}
+
+func (d *dryRunFlow) Script(name string, args ...Arg) (any, error) {
+	if d.flow == nil {
+		return nil, nil
+	}
+	return d.flow.Script(name, args...)
+}
+
+func (d *dryRunFlow) Address(account string) string {
+	if d.flow == nil {
+		return ""
+	}
+	return d.flow.Address(account)
+}
+
+// dryRunResult holds the events a dry run pretends a transaction emitted
+type dryRunResult map[string][]map[string]any
+
+// ID is the ID of a transaction that was not submitted
+func (r dryRunResult) ID() string {
+	return "dry-run"
+}
+
+func (r dryRunResult) Events(name string) []map[string]any {
+	names := make([]string, 0, len(r))
+	for eventType := range r {
+		names = append(names, eventType)
+	}
+	sort.Strings(names)
+
+	var events []map[string]any
+	for _, eventType := range names {
+		if strings.HasSuffix(eventType, name) {
+			events = append(events, r[eventType]...)
+		}
+	}
+	return events
+}
+
+// dryRunForge still compiles the contracts, since the calldata printed by a
+// dry run is built from the artifacts, but only prints verifications
+type dryRunForge struct {
+	forge Forge
+	out   io.Writer
+}
+
+func (d dryRunForge) Build() error {
+	if d.forge == nil {
+		return nil
+	}
+	return d.forge.Build()
+}
+
+func (d dryRunForge) Verify(rpcUrl, verifierProvider, verifierUrl, contractAddr string) error {
+	fmt.Fprintf(d.out, "--- forge %s\n\n", strings.Join(verifyArgs(rpcUrl, verifierProvider, verifierUrl, contractAddr), " "))
+	return nil
+}
+
+// DryRunAddress is the address a dry run reports for the nth deployed contract
+func DryRunAddress(n int) string {
+	return fmt.Sprintf("0x%040x", n)
+}
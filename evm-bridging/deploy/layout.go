@@ -0,0 +1,213 @@
+package deploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// StorageLayout is the storage layout solc reports for a contract, which
+// forge writes to the artifacts since foundry.toml asks for it
+type StorageLayout struct {
+	Storage []StorageEntry         `json:"storage"`
+	Types   map[string]StorageType `json:"types"`
+}
+
+// StorageEntry is a state variable, or a member of a struct
+type StorageEntry struct {
+	Contract string `json:"contract,omitempty"`
+	Label    string `json:"label"`
+	Offset   uint64 `json:"offset"`
+	Slot     string `json:"slot"`
+	Type     string `json:"type"`
+}
+
+// StorageType describes the encoding of a type of the layout
+type StorageType struct {
+	Encoding      string         `json:"encoding"`
+	Label         string         `json:"label"`
+	NumberOfBytes string         `json:"numberOfBytes"`
+	Base          string         `json:"base,omitempty"`
+	Key           string         `json:"key,omitempty"`
+	Value         string         `json:"value,omitempty"`
+	Members       []StorageEntry `json:"members,omitempty"`
+}
+
+// ReadStorageLayout reads the storage layout of a contract from the forge artifacts under out/
+func ReadStorageLayout(dir, contractName string) (*StorageLayout, error) {
+	path := filepath.Join(dir, "out", contractName+".sol", contractName+".json")
+	artifact, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact of %s, run 'forge build' first: %w", contractName, err)
+	}
+
+	var parsed struct {
+		StorageLayout *StorageLayout `json:"storageLayout"`
+	}
+	if err := json.Unmarshal(artifact, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if parsed.StorageLayout == nil {
+		return nil, fmt.Errorf("no storage layout in %s, add storageLayout to extra_output in foundry.toml", path)
+	}
+	return parsed.StorageLayout, nil
+}
+
+// CompareStorageLayouts lists the changes of the next layout that would
+// corrupt the storage written with the previous one: variables removed,
+// renamed, retyped or moved, and new variables overlapping old ones.
+// Appending variables, and taking slots from the end of a __gap array
+// that shrinks by as many slots, are safe.
+func CompareStorageLayouts(previous, next *StorageLayout) ([]string, error) {
+	prevEntries, err := previous.locate()
+	if err != nil {
+		return nil, fmt.Errorf("previous layout: %w", err)
+	}
+	nextEntries, err := next.locate()
+	if err != nil {
+		return nil, fmt.Errorf("next layout: %w", err)
+	}
+
+	var problems []string
+	matched := map[int]bool{}
+	// the byte ranges new variables may use: after the previous variables and in shrunk gaps
+	var free []byteRange
+	var end uint64
+
+	for _, prev := range prevEntries {
+		end = max(end, prev.end)
+
+		i, found := findEntry(nextEntries, prev.start)
+		if prev.Label == "__gap" {
+			shrunk, ok := findShrunkGap(nextEntries, prev, previous, next)
+			switch {
+			case found && nextEntries[i].Label == "__gap" && previous.describe(prev.Type) == next.describe(nextEntries[i].Type):
+				matched[i] = true
+			case ok:
+				matched[shrunk] = true
+				free = append(free, byteRange{prev.start, nextEntries[shrunk].start})
+			default:
+				problems = append(problems, fmt.Sprintf("%s.__gap at slot %s was removed or did not shrink from its start", prev.Contract, prev.Slot))
+			}
+			continue
+		}
+
+		if !found {
+			problems = append(problems, fmt.Sprintf("%s.%s at slot %s offset %d was removed or moved", prev.Contract, prev.Label, prev.Slot, prev.Offset))
+			continue
+		}
+		matched[i] = true
+
+		entry := nextEntries[i]
+		if entry.Label != prev.Label {
+			problems = append(problems, fmt.Sprintf("%s.%s at slot %s was renamed to %s", prev.Contract, prev.Label, prev.Slot, entry.Label))
+		}
+		if prevType, nextType := previous.describe(prev.Type), next.describe(entry.Type); prevType != nextType {
+			problems = append(problems, fmt.Sprintf("%s.%s changed type from %s to %s", prev.Contract, prev.Label, prevType, nextType))
+		}
+	}
+	free = append(free, byteRange{end, ^uint64(0)})
+
+	for i, entry := range nextEntries {
+		if matched[i] {
+			continue
+		}
+		if !entry.within(free) {
+			problems = append(problems, fmt.Sprintf("%s.%s at slot %s offset %d overlaps the previous layout", entry.Contract, entry.Label, entry.Slot, entry.Offset))
+		}
+	}
+	return problems, nil
+}
+
+// locatedEntry is a variable with the range of bytes it occupies in storage
+type locatedEntry struct {
+	StorageEntry
+	byteRange
+}
+
+type byteRange struct {
+	start, end uint64
+}
+
+func (r byteRange) within(ranges []byteRange) bool {
+	for _, free := range ranges {
+		if r.start >= free.start && r.end <= free.end {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *StorageLayout) locate() ([]locatedEntry, error) {
+	entries := make([]locatedEntry, len(l.Storage))
+	for i, entry := range l.Storage {
+		slot, err := strconv.ParseUint(entry.Slot, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s has slot %q: %w", entry.Label, entry.Slot, err)
+		}
+		size, err := strconv.ParseUint(l.Types[entry.Type].NumberOfBytes, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s has type %q of unknown size", entry.Label, entry.Type)
+		}
+		start := slot*32 + entry.Offset
+		entries[i] = locatedEntry{entry, byteRange{start, start + size}}
+	}
+	return entries, nil
+}
+
+func findEntry(entries []locatedEntry, start uint64) (int, bool) {
+	for i, entry := range entries {
+		if entry.start == start {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+var gapArrayType = regexp.MustCompile(`^t_array\((.+)\)(\d+)_storage$`)
+
+// findShrunkGap finds the __gap of the same contract that ends where the
+// previous one did, with the same element type
+func findShrunkGap(entries []locatedEntry, prev locatedEntry, previous, next *StorageLayout) (int, bool) {
+	prevType := gapArrayType.FindStringSubmatch(prev.Type)
+	if prevType == nil {
+		return 0, false
+	}
+
+	for i, entry := range entries {
+		nextType := gapArrayType.FindStringSubmatch(entry.Type)
+		if entry.Label == "__gap" && entry.Contract == prev.Contract && entry.end == prev.end && entry.start > prev.start &&
+			nextType != nil && previous.describe(prevType[1]) == next.describe(nextType[1]) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// describe spells out a type with its members, so that types compare
+// equal across layouts whatever IDs solc gave them
+func (l *StorageLayout) describe(typeID string) string {
+	t, ok := l.Types[typeID]
+	if !ok {
+		return typeID
+	}
+
+	description := fmt.Sprintf("%s(%s,%s)", t.Label, t.Encoding, t.NumberOfBytes)
+	for _, inner := range []string{t.Base, t.Key, t.Value} {
+		if inner != "" {
+			description += "[" + l.describe(inner) + "]"
+		}
+	}
+	if len(t.Members) > 0 {
+		members := make([]string, len(t.Members))
+		for i, member := range t.Members {
+			members[i] = fmt.Sprintf("%s@%s+%d:%s", member.Label, member.Slot, member.Offset, l.describe(member.Type))
+		}
+		description += "{" + strings.Join(members, ",") + "}"
+	}
+	return description
+}
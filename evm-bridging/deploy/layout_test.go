@@ -0,0 +1,150 @@
+package deploy
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// testLayout is a layout with strings, a struct and a gap, as solc reports them
+func testLayout() *StorageLayout {
+	return &StorageLayout{
+		Storage: []StorageEntry{
+			{Contract: "src/BridgedTopShotMoments.sol:BridgedTopShotMoments", Label: "cadenceNFTAddress", Slot: "0", Type: "t_string_storage"},
+			{Contract: "src/BridgedTopShotMoments.sol:BridgedTopShotMoments", Label: "contractMetadata", Slot: "1", Type: "t_string_storage"},
+			{Contract: "src/BridgedTopShotMoments.sol:BridgedTopShotMoments", Label: "_royaltyInfo", Slot: "2", Type: "t_struct(RoyaltyInfo)1_storage"},
+			{Contract: "src/BridgedTopShotMoments.sol:BridgedTopShotMoments", Label: "__gap", Slot: "3", Type: "t_array(t_uint256)10_storage"},
+		},
+		Types: map[string]StorageType{
+			"t_address":        {Encoding: "inplace", Label: "address", NumberOfBytes: "20"},
+			"t_bool":           {Encoding: "inplace", Label: "bool", NumberOfBytes: "1"},
+			"t_uint96":         {Encoding: "inplace", Label: "uint96", NumberOfBytes: "12"},
+			"t_uint256":        {Encoding: "inplace", Label: "uint256", NumberOfBytes: "32"},
+			"t_string_storage": {Encoding: "bytes", Label: "string", NumberOfBytes: "32"},
+			"t_struct(RoyaltyInfo)1_storage": {
+				Encoding: "inplace", Label: "struct ERC721TransferValidator.RoyaltyInfo", NumberOfBytes: "32",
+				Members: []StorageEntry{
+					{Label: "royaltyAddress", Slot: "0", Type: "t_address"},
+					{Label: "royaltyBps", Offset: 20, Slot: "0", Type: "t_uint96"},
+				},
+			},
+			"t_array(t_uint256)10_storage": {Encoding: "inplace", Label: "uint256[10]", NumberOfBytes: "320", Base: "t_uint256"},
+			"t_array(t_uint256)9_storage":  {Encoding: "inplace", Label: "uint256[9]", NumberOfBytes: "288", Base: "t_uint256"},
+		},
+	}
+}
+
+func TestCompareStorageLayouts(t *testing.T) {
+	for name, tc := range map[string]struct {
+		edit     func(*StorageLayout)
+		problems []string
+	}{
+		"unchanged": {
+			edit: func(l *StorageLayout) {},
+		},
+		"appended": {
+			edit: func(l *StorageLayout) {
+				l.Storage = append(l.Storage, StorageEntry{Contract: "C", Label: "paused", Slot: "13", Type: "t_bool"})
+			},
+		},
+		"taken from the gap": {
+			edit: func(l *StorageLayout) {
+				l.Storage[3] = StorageEntry{Contract: l.Storage[3].Contract, Label: "__gap", Slot: "4", Type: "t_array(t_uint256)9_storage"}
+				l.Storage = append(l.Storage, StorageEntry{Contract: "C", Label: "paused", Slot: "3", Type: "t_bool"})
+			},
+		},
+		"gap shrunk without taking slots": {
+			edit: func(l *StorageLayout) {
+				l.Storage[3].Type = "t_array(t_uint256)9_storage"
+			},
+			problems: []string{"__gap at slot 3 was removed or did not shrink from its start"},
+		},
+		"renamed": {
+			edit: func(l *StorageLayout) {
+				l.Storage[1].Label = "metadata"
+			},
+			problems: []string{"contractMetadata at slot 1 was renamed to metadata"},
+		},
+		"retyped": {
+			edit: func(l *StorageLayout) {
+				l.Storage[0].Type = "t_uint256"
+			},
+			problems: []string{"cadenceNFTAddress changed type from string(bytes,32) to uint256(inplace,32)"},
+		},
+		"struct member changed": {
+			edit: func(l *StorageLayout) {
+				royaltyInfo := l.Types["t_struct(RoyaltyInfo)1_storage"]
+				royaltyInfo.Members = []StorageEntry{royaltyInfo.Members[1], royaltyInfo.Members[0]}
+				l.Types["t_struct(RoyaltyInfo)1_storage"] = royaltyInfo
+			},
+			problems: []string{"_royaltyInfo changed type"},
+		},
+		"inserted": {
+			edit: func(l *StorageLayout) {
+				l.Storage = []StorageEntry{
+					l.Storage[0],
+					{Contract: "C", Label: "paused", Slot: "1", Type: "t_bool"},
+					{Contract: l.Storage[1].Contract, Label: "contractMetadata", Slot: "2", Type: "t_string_storage"},
+					{Contract: l.Storage[2].Contract, Label: "_royaltyInfo", Slot: "3", Type: "t_struct(RoyaltyInfo)1_storage"},
+					{Contract: l.Storage[3].Contract, Label: "__gap", Slot: "4", Type: "t_array(t_uint256)10_storage"},
+				}
+			},
+			problems: []string{
+				"contractMetadata at slot 1 was renamed to paused",
+				"contractMetadata changed type from string(bytes,32) to bool(inplace,1)",
+				"_royaltyInfo at slot 2 was renamed to contractMetadata",
+				"__gap at slot 3 was removed",
+			},
+		},
+		"removed": {
+			edit: func(l *StorageLayout) {
+				l.Storage = append(l.Storage[:1], l.Storage[2:]...)
+			},
+			problems: []string{"contractMetadata at slot 1 offset 0 was removed or moved"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			next := testLayout()
+			tc.edit(next)
+
+			problems, err := CompareStorageLayouts(testLayout(), next)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(problems) < len(tc.problems) || (len(tc.problems) == 0 && len(problems) > 0) {
+				t.Fatalf("expected %v, got %v", tc.problems, problems)
+			}
+			for _, expected := range tc.problems {
+				if !strings.Contains(strings.Join(problems, "\n"), expected) {
+					t.Errorf("expected %q in %v", expected, problems)
+				}
+			}
+		})
+	}
+}
+
+func TestReadStorageLayout(t *testing.T) {
+	dir := t.TempDir()
+	writeArtifact(t, dir, "BridgedTopShotMoments", "0x6080604052", testLayout())
+
+	layout, err := ReadStorageLayout(dir, "BridgedTopShotMoments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := json.Marshal(testLayout())
+	read, _ := json.Marshal(layout)
+	if string(read) != string(expected) {
+		t.Errorf("expected %s, got %s", expected, read)
+	}
+
+	writeArtifact(t, dir, "ERC1967Proxy", "0x6080604052", nil)
+	if _, err := ReadStorageLayout(dir, "ERC1967Proxy"); err == nil || !strings.Contains(err.Error(), "extra_output") {
+		t.Errorf("expected a missing layout to be reported, got %v", err)
+	}
+
+	broken := testLayout()
+	broken.Storage[0].Slot = "0x00"
+	if _, err := CompareStorageLayouts(testLayout(), broken); err == nil {
+		t.Error("expected an invalid slot to be reported")
+	}
+}
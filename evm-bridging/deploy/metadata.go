@@ -0,0 +1,164 @@
+package deploy
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CollectionMetadata is the collection level metadata BridgedTopShotMoments
+// returns as its contractURI, in the format of OpenSea
+type CollectionMetadata struct {
+	Name          string `json:"name"`
+	Description   string `json:"description,omitempty"`
+	Image         string `json:"image,omitempty"`
+	ExternalLink  string `json:"external_link,omitempty"`
+	BannerImage   string `json:"banner_image,omitempty"`
+	FeaturedImage string `json:"featured_image,omitempty"`
+	// SellerFeeBasisPoints and FeeRecipient are the royalty marketplaces
+	// reading the metadata collect, set together or not at all
+	SellerFeeBasisPoints uint64 `json:"seller_fee_basis_points,omitempty"`
+	FeeRecipient         string `json:"fee_recipient,omitempty"`
+}
+
+const (
+	jsonDataURIPrefix   = "data:application/json;utf8,"
+	base64DataURIPrefix = "data:application/json;base64,"
+)
+
+// Validate checks every field of the metadata and reports all the invalid ones
+func (m CollectionMetadata) Validate() error {
+	var errs []error
+	check := func(field string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", field, err))
+		}
+	}
+
+	if m.Name == "" {
+		check("name", fmt.Errorf("missing"))
+	}
+	for _, uri := range []struct{ field, value string }{
+		{"image", m.Image},
+		{"external_link", m.ExternalLink},
+		{"banner_image", m.BannerImage},
+		{"featured_image", m.FeaturedImage},
+	} {
+		if uri.value != "" {
+			check(uri.field, validateMetadataURI(uri.value))
+		}
+	}
+
+	if m.SellerFeeBasisPoints > MaxRoyaltyBasisPoints {
+		check("seller_fee_basis_points", fmt.Errorf("%d is more than %d", m.SellerFeeBasisPoints, MaxRoyaltyBasisPoints))
+	}
+	switch {
+	case m.FeeRecipient != "":
+		check("fee_recipient", validateEvmAddress(m.FeeRecipient))
+	case m.SellerFeeBasisPoints > 0:
+		check("fee_recipient", fmt.Errorf("missing while seller_fee_basis_points is set"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// DataURI encodes the metadata as the JSON data URI contractURI returns
+func (m CollectionMetadata) DataURI() string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	// a struct of strings and integers always encodes
+	_ = encoder.Encode(m)
+	return jsonDataURIPrefix + strings.TrimSuffix(buf.String(), "\n")
+}
+
+// ParseContractURI decodes a JSON data URI, as plain or percent-encoded
+// UTF-8 or as base64, into the metadata. It also reads the URIs of earlier
+// deployments, whose JSON had its quotes escaped with backslashes.
+func ParseContractURI(uri string) (*CollectionMetadata, error) {
+	var payload []byte
+	switch {
+	case strings.HasPrefix(uri, base64DataURIPrefix):
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, base64DataURIPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 contractURI: %w", err)
+		}
+		payload = decoded
+	case strings.HasPrefix(uri, jsonDataURIPrefix):
+		payload = []byte(strings.TrimPrefix(uri, jsonDataURIPrefix))
+		if !json.Valid(payload) {
+			payload = []byte(strings.ReplaceAll(string(payload), `\"`, `"`))
+		}
+		if !json.Valid(payload) {
+			if unescaped, err := url.PathUnescape(string(payload)); err == nil {
+				payload = []byte(unescaped)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("contractURI %.40q is not a JSON data URI", uri)
+	}
+
+	metadata := &CollectionMetadata{}
+	if err := decodeStrict(payload, metadata); err != nil {
+		return nil, fmt.Errorf("invalid contractURI metadata: %w", err)
+	}
+	return metadata, nil
+}
+
+// Diff lists the fields that change from the metadata to next, as
+// `field: "old" -> "new"`, in the order of the fields
+func (m CollectionMetadata) Diff(next CollectionMetadata) []string {
+	var changes []string
+	current, updated := reflect.ValueOf(m), reflect.ValueOf(next)
+	for i := 0; i < current.NumField(); i++ {
+		if from, to := current.Field(i).Interface(), updated.Field(i).Interface(); from != to {
+			name := strings.Split(current.Type().Field(i).Tag.Get("json"), ",")[0]
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", name, formatField(from), formatField(to)))
+		}
+	}
+	return changes
+}
+
+// formatField quotes strings, so that an empty field reads as ""
+func formatField(value any) string {
+	if s, ok := value.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(value)
+}
+
+// UnmarshalJSON reads the metadata from a JSON object or from a data URI string
+func (m *CollectionMetadata) UnmarshalJSON(data []byte) error {
+	var uri string
+	if err := json.Unmarshal(data, &uri); err == nil {
+		metadata, err := ParseContractURI(uri)
+		if err != nil {
+			return err
+		}
+		*m = *metadata
+		return nil
+	}
+
+	// the alias has no UnmarshalJSON to recurse into
+	type collectionMetadata CollectionMetadata
+	var metadata collectionMetadata
+	if err := decodeStrict(data, &metadata); err != nil {
+		return err
+	}
+	*m = CollectionMetadata(metadata)
+	return nil
+}
+
+// validateMetadataURI accepts the http(s), ipfs and data URIs marketplaces load
+func validateMetadataURI(uri string) error {
+	if strings.HasPrefix(uri, "ipfs://") || strings.HasPrefix(uri, "data:") {
+		return nil
+	}
+	return validateURL(uri)
+}
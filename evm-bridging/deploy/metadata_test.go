@@ -0,0 +1,120 @@
+package deploy
+
+import (
+	"encoding/base64"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testCollectionMetadata() CollectionMetadata {
+	return CollectionMetadata{
+		Name:                 `NBA Top Shot "Bridged"`,
+		Description:          "Plays & players <of> the NBA and WNBA's greatest",
+		Image:                "https://assets.nbatopshot.com/open_sea/favicon.svg",
+		ExternalLink:         "https://nbatopshot.com",
+		BannerImage:          "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		SellerFeeBasisPoints: 500,
+		FeeRecipient:         "0x000000000000000000000002958e48adcfd5d1c7",
+	}
+}
+
+func TestCollectionMetadataDataURI(t *testing.T) {
+	metadata := testCollectionMetadata()
+	if err := metadata.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	uri := metadata.DataURI()
+	expected := `data:application/json;utf8,{"name":"NBA Top Shot \"Bridged\"","description":"Plays & players <of> the NBA and WNBA's greatest",` +
+		`"image":"https://assets.nbatopshot.com/open_sea/favicon.svg","external_link":"https://nbatopshot.com",` +
+		`"banner_image":"ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",` +
+		`"seller_fee_basis_points":500,"fee_recipient":"0x000000000000000000000002958e48adcfd5d1c7"}`
+	if uri != expected {
+		t.Errorf("unexpected data URI\n%s\nexpected\n%s", uri, expected)
+	}
+
+	payload := strings.TrimPrefix(uri, jsonDataURIPrefix)
+	for _, encoded := range []string{
+		uri,
+		base64DataURIPrefix + base64.StdEncoding.EncodeToString([]byte(payload)),
+		jsonDataURIPrefix + url.PathEscape(payload),
+	} {
+		decoded, err := ParseContractURI(encoded)
+		if err != nil {
+			t.Fatalf("%s: %v", encoded, err)
+		}
+		if *decoded != metadata {
+			t.Errorf("%s decoded to %+v", encoded, decoded)
+		}
+	}
+}
+
+func TestParseContractURI(t *testing.T) {
+	// the contractURI of the first deployments escaped its quotes
+	legacy := `data:application/json;utf8,{\"name\":\"NBA Top Shot\",\"image\": \"https://assets.nbatopshot.com/open_sea/favicon.svg\"}`
+	decoded, err := ParseContractURI(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Name != "NBA Top Shot" || decoded.Image != "https://assets.nbatopshot.com/open_sea/favicon.svg" {
+		t.Errorf("unexpected metadata %+v", decoded)
+	}
+
+	for _, uri := range []string{
+		"https://nbatopshot.com/contract.json",
+		`data:application/json;utf8,{"name":"NBA Top Shot"`,
+		`data:application/json;utf8,{"name":"NBA Top Shot","symbol":"TOPSHOT"}`,
+		"data:application/json;base64,not base64",
+	} {
+		if _, err := ParseContractURI(uri); err == nil {
+			t.Errorf("expected %s to be rejected", uri)
+		}
+	}
+}
+
+func TestCollectionMetadataValidation(t *testing.T) {
+	metadata := CollectionMetadata{
+		Image:                "/favicon.svg",
+		SellerFeeBasisPoints: 10001,
+	}
+	err := metadata.Validate()
+	if err == nil {
+		t.Fatal("expected the metadata to be rejected")
+	}
+	for _, expected := range []string{
+		"name: missing",
+		`image: "/favicon.svg" is not an http(s) URL`,
+		"seller_fee_basis_points: 10001 is more than 10000",
+		"fee_recipient: missing while seller_fee_basis_points is set",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %q in:\n%s", expected, err)
+		}
+	}
+
+	metadata = testCollectionMetadata()
+	metadata.FeeRecipient = "0x1234"
+	if err := metadata.Validate(); err == nil || !strings.HasPrefix(err.Error(), "fee_recipient: ") {
+		t.Errorf("expected an invalid fee recipient to be rejected, got %v", err)
+	}
+}
+
+func TestCollectionMetadataDiff(t *testing.T) {
+	current := testCollectionMetadata()
+	next := current
+	next.Description = "NBA Top Shot on Flow EVM"
+	next.SellerFeeBasisPoints = 250
+
+	expected := []string{
+		`description: "Plays & players <of> the NBA and WNBA's greatest" -> "NBA Top Shot on Flow EVM"`,
+		"seller_fee_basis_points: 500 -> 250",
+	}
+	if changes := current.Diff(next); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("unexpected changes %q", changes)
+	}
+	if changes := current.Diff(current); len(changes) != 0 {
+		t.Errorf("expected no changes, got %q", changes)
+	}
+}
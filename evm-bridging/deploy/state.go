@@ -0,0 +1,112 @@
+package deploy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// Deployment records the completed steps of a setup on a network. It is
+// saved after each step, so that a setup run again skips what is done
+// and resumes where a failed run stopped. All addresses are 0x prefixed.
+type Deployment struct {
+	Network string `json:"network"`
+	CoaAddr string `json:"coaAddr,omitempty"`
+
+	ImplementationAddr string `json:"implementationAddr,omitempty"`
+	// ImplementationCodeHash is the sha256 of the creation bytecode the implementation was deployed from
+	ImplementationCodeHash string `json:"implementationCodeHash,omitempty"`
+	ProxyAddr              string `json:"proxyAddr,omitempty"`
+	// StorageLayout is the storage layout of the implementation, which upgrades must keep compatible
+	StorageLayout *StorageLayout `json:"storageLayout,omitempty"`
+
+	// PendingImplementationAddr is an implementation deployed by an upgrade that did not complete
+	PendingImplementationAddr     string `json:"pendingImplementationAddr,omitempty"`
+	PendingImplementationCodeHash string `json:"pendingImplementationCodeHash,omitempty"`
+	// Upgrades are the upgrades of the proxy, oldest first
+	Upgrades []Upgrade `json:"upgrades,omitempty"`
+
+	// Verified are the addresses of the contracts verified on the block explorer
+	Verified []string `json:"verified,omitempty"`
+	// RoyaltyTxID is the Flow transaction that set up royalty management on the proxy
+	RoyaltyTxID string `json:"royaltyTxId,omitempty"`
+
+	// ReplacedProxies are the proxies deployed before a forced setup, oldest first
+	ReplacedProxies []string `json:"replacedProxies,omitempty"`
+}
+
+// LoadDeployment reads the state of the network's deployment, which is
+// empty when the file does not exist
+func LoadDeployment(path, network string) (*Deployment, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Deployment{Network: network}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := &Deployment{}
+	if err := decodeStrict(data, deployment); err != nil {
+		return nil, fmt.Errorf("invalid deployment state %s: %w", path, err)
+	}
+	if deployment.Network != network {
+		return nil, fmt.Errorf("%s is the deployment state of %q, not %q", path, deployment.Network, network)
+	}
+	return deployment, nil
+}
+
+// Save writes the state, replacing the file only once it is fully written
+func (d *Deployment) Save(path string) error {
+	return WriteJSON(path, d)
+}
+
+// WriteJSON writes v as indented JSON, to a temporary file renamed over
+// the file only once it is fully written
+func WriteJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// IsVerified reports whether the contract at the address was verified
+func (d *Deployment) IsVerified(addr string) bool {
+	return slices.Contains(d.Verified, addr)
+}
+
+// reset forgets the deployed contracts and the steps done on them, keeping the COA
+func (d *Deployment) reset() {
+	if d.ProxyAddr != "" {
+		d.ReplacedProxies = append(d.ReplacedProxies, d.ProxyAddr)
+	}
+	d.ImplementationAddr = ""
+	d.ImplementationCodeHash = ""
+	d.ProxyAddr = ""
+	d.StorageLayout = nil
+	d.PendingImplementationAddr = ""
+	d.PendingImplementationCodeHash = ""
+	d.Upgrades = nil
+	d.Verified = nil
+	d.RoyaltyTxID = ""
+}
+
+func codeHash(bytecode []byte) string {
+	hash := sha256.Sum256(bytecode)
+	return hex.EncodeToString(hash[:])
+}
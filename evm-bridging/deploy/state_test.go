@@ -0,0 +1,172 @@
+package deploy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetupResumesAfterFailure(t *testing.T) {
+	d, flow, forge := newTestDeployer(t, "testnet")
+	flow.failTx = royaltyTx
+
+	if _, err := d.Setup(); err == nil {
+		t.Fatal("expected the royalty setup to fail")
+	}
+	saved, err := LoadDeployment(d.StatePath, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.ProxyAddr != DryRunAddress(102) || len(saved.Verified) != 2 || saved.RoyaltyTxID != "" {
+		t.Fatalf("unexpected state after the failure %+v", saved)
+	}
+
+	flow.failTx, flow.txs, forge.verified = "", nil, nil
+	deployment, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(flow.txs, ",") != royaltyTx || len(forge.verified) != 0 {
+		t.Errorf("expected only the royalty setup to run again, got %v and verified %v", flow.txs, forge.verified)
+	}
+	if deployment.ProxyAddr != saved.ProxyAddr || deployment.RoyaltyTxID != "tx1" {
+		t.Errorf("unexpected deployment %+v", deployment)
+	}
+}
+
+func TestSetupSkipsCompletedSteps(t *testing.T) {
+	d, flow, forge := newTestDeployer(t, "testnet")
+
+	first, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flow.txs, forge.verified = nil, nil
+	second, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(flow.txs) != 0 || len(forge.verified) != 0 {
+		t.Errorf("a completed setup ran %v and verified %v again", flow.txs, forge.verified)
+	}
+	if first.ProxyAddr != second.ProxyAddr || first.RoyaltyTxID != second.RoyaltyTxID {
+		t.Errorf("expected %+v, got %+v", first, second)
+	}
+}
+
+func TestSetupRefusesSecondProxy(t *testing.T) {
+	d, flow, _ := newTestDeployer(t, "emulator")
+	first, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the implementation changes after the proxy was deployed
+	writeArtifact(t, d.Dir, "BridgedTopShotMoments", "0x60806040526001", testLayout())
+
+	flow.txs = nil
+	if _, err := d.Setup(); err == nil || !strings.Contains(err.Error(), "refusing to deploy a second proxy") {
+		t.Fatalf("expected a second proxy to be refused, got %v", err)
+	}
+	if len(flow.txs) != 0 {
+		t.Errorf("a refused setup ran %v", flow.txs)
+	}
+
+	d.Force = true
+	forced, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if forced.ProxyAddr == first.ProxyAddr || forced.ImplementationCodeHash == first.ImplementationCodeHash {
+		t.Errorf("expected a new implementation and proxy, got %+v", forced)
+	}
+	if len(forced.ReplacedProxies) != 1 || forced.ReplacedProxies[0] != first.ProxyAddr {
+		t.Errorf("expected %s to be recorded as replaced, got %v", first.ProxyAddr, forced.ReplacedProxies)
+	}
+}
+
+func TestSetupRedeploysChangedImplementationWithoutProxy(t *testing.T) {
+	d, flow, _ := newTestDeployer(t, "emulator")
+	flow.coa = "00000000000000000000000200000000000000aa"
+
+	stale := &Deployment{
+		Network:                "emulator",
+		CoaAddr:                "0x00000000000000000000000200000000000000aa",
+		ImplementationAddr:     "0x00000000000000000000000000000000000000ff",
+		ImplementationCodeHash: "stale",
+	}
+	if err := stale.Save(d.StatePath); err != nil {
+		t.Fatal(err)
+	}
+
+	deployment, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deployment.ImplementationAddr == stale.ImplementationAddr {
+		t.Error("expected the changed implementation to be deployed again")
+	}
+	if n := strings.Count(strings.Join(flow.txs, ","), deployContractTx); n != 2 {
+		t.Errorf("expected the implementation and the proxy to be deployed, got %v", flow.txs)
+	}
+}
+
+func TestSetupRejectsAnotherCOA(t *testing.T) {
+	d, flow, _ := newTestDeployer(t, "emulator")
+	if _, err := d.Setup(); err != nil {
+		t.Fatal(err)
+	}
+
+	flow.coa = "00000000000000000000000200000000000000cc"
+	if _, err := d.Setup(); err == nil {
+		t.Error("expected a deployment from another COA to be rejected")
+	}
+}
+
+func TestDryRunLeavesStateUntouched(t *testing.T) {
+	d, _, _ := newTestDeployer(t, "testnet")
+	d.DryRun = true
+
+	if _, err := d.Setup(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(d.StatePath); !os.IsNotExist(err) {
+		t.Errorf("a dry run wrote %s", d.StatePath)
+	}
+}
+
+func TestLoadDeployment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deployments", "testnet.json")
+
+	empty, err := LoadDeployment(path, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty.Network != "testnet" || empty.ProxyAddr != "" {
+		t.Errorf("expected an empty deployment, got %+v", empty)
+	}
+
+	deployment := &Deployment{Network: "testnet", ProxyAddr: DryRunAddress(2), Verified: []string{DryRunAddress(2)}}
+	if err := deployment.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadDeployment(path, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.IsVerified(DryRunAddress(2)) || loaded.IsVerified(DryRunAddress(1)) {
+		t.Errorf("unexpected verification status %v", loaded.Verified)
+	}
+
+	if _, err := LoadDeployment(path, "mainnet"); err == nil {
+		t.Error("expected the state of another network to be rejected")
+	}
+	if err := os.WriteFile(path, []byte(`{"network": "testnet", "proxy": "0x01"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadDeployment(path, "testnet"); err == nil {
+		t.Error("expected unknown fields to be rejected")
+	}
+}
@@ -0,0 +1,302 @@
+package deploy
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"bridged-topshot-evm-deploy/bindings"
+)
+
+const (
+	callContractTx = "admin/call_evm_contract"
+	dryCallScript  = "evm_dry_call"
+)
+
+// ImplementationSlot is the ERC-1967 slot holding the implementation of a proxy
+var ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076c4b6ab8e5b2fa1c28f7a7c3b")
+
+// Upgrade is an upgrade of the proxy to a new implementation
+type Upgrade struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// TxID is the Flow transaction that called upgradeToAndCall
+	TxID string `json:"txId"`
+	// SlotChecked is set once the implementation slot of the proxy was read back over RPC
+	SlotChecked bool `json:"slotChecked"`
+}
+
+// EVMReader reads the state of Flow EVM
+type EVMReader interface {
+	StorageAt(contractAddr string, slot common.Hash) (common.Hash, error)
+	CodeAt(contractAddr string) ([]byte, error)
+}
+
+// RPCReader reads Flow EVM state over its JSON-RPC API
+type RPCReader struct {
+	Url string
+}
+
+// StorageAt returns the value of a storage slot of a contract at the latest block
+func (r RPCReader) StorageAt(contractAddr string, slot common.Hash) (common.Hash, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, r.Url)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to connect to %s: %w", r.Url, err)
+	}
+	defer client.Close()
+
+	value, err := client.StorageAt(ctx, common.HexToAddress(contractAddr), slot, nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to read slot %s of %s: %w", slot, contractAddr, err)
+	}
+	return common.BytesToHash(value), nil
+}
+
+// CodeAt returns the runtime code of a contract at the latest block
+func (r RPCReader) CodeAt(contractAddr string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, r.Url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", r.Url, err)
+	}
+	defer client.Close()
+
+	code, err := client.CodeAt(ctx, common.HexToAddress(contractAddr), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the code of %s: %w", contractAddr, err)
+	}
+	return code, nil
+}
+
+// Upgrade deploys the current BridgedTopShotMoments and upgrades the proxy
+// to it through the COA, calling reinitializer on it if not empty. The new
+// storage layout must be compatible with the one of the implementation in
+// use, unless forced when no layout was recorded for it.
+func (d *Deployer) Upgrade(reinitializer []byte) (*Deployment, error) {
+	d.describe()
+
+	deployment, err := LoadDeployment(d.StatePath, d.Network)
+	if err != nil {
+		return nil, err
+	}
+	if deployment.ProxyAddr == "" {
+		return nil, fmt.Errorf("no proxy recorded in %s, run the setup first", d.StatePath)
+	}
+
+	coaHex, err := d.lookupCOA()
+	if err != nil {
+		return nil, err
+	}
+	if coaHex != "" && "0x"+coaHex != deployment.CoaAddr {
+		return nil, fmt.Errorf("the COA is 0x%s but the proxy was deployed from %s", coaHex, deployment.CoaAddr)
+	}
+
+	if err := d.forge().Build(); err != nil {
+		return nil, err
+	}
+
+	contractABI, err := ReadABI(d.Dir, "BridgedTopShotMoments")
+	if err != nil {
+		return nil, err
+	}
+	for _, method := range []string{"upgradeToAndCall", "proxiableUUID"} {
+		if _, ok := contractABI.Methods[method]; !ok {
+			return nil, fmt.Errorf("BridgedTopShotMoments has no %s, it must inherit UUPSUpgradeable to be upgraded", method)
+		}
+	}
+
+	bytecode, err := ReadBytecode(d.Dir, "BridgedTopShotMoments")
+	if err != nil {
+		return nil, err
+	}
+	implementationCodeHash := codeHash(bytecode)
+	if implementationCodeHash == deployment.ImplementationCodeHash {
+		return nil, fmt.Errorf("BridgedTopShotMoments has not changed since %s was deployed", deployment.ImplementationAddr)
+	}
+
+	layout, err := ReadStorageLayout(d.Dir, "BridgedTopShotMoments")
+	if err != nil {
+		return nil, err
+	}
+	if err := d.checkStorageLayout(deployment, layout); err != nil {
+		return nil, err
+	}
+
+	// Deploy the new implementation, unless a failed upgrade already did
+	deployedNow := false
+	if deployment.PendingImplementationAddr == "" || deployment.PendingImplementationCodeHash != implementationCodeHash {
+		if deployment.PendingImplementationAddr, err = d.DeployContract("BridgedTopShotMoments", nil); err != nil {
+			return nil, err
+		}
+		deployment.PendingImplementationCodeHash = implementationCodeHash
+		deployedNow = true
+		if err := d.save(deployment); err != nil {
+			return nil, err
+		}
+	} else {
+		d.Log.Printf("Using BridgedTopShotMoments deployed at %s", deployment.PendingImplementationAddr)
+	}
+	newImplementation := deployment.PendingImplementationAddr
+
+	upgradeCall, err := GenerateUpgradeToAndCall(newImplementation, reinitializer)
+	if err != nil {
+		return nil, err
+	}
+	d.printCalldata("upgradeToAndCall", upgradeCall)
+
+	// Simulate the upgrade first, which fails if the implementation in use is not UUPS,
+	// the COA is not the owner or the reinitializer reverts. A dry run only pretends
+	// to deploy the new implementation, so there is nothing to upgrade to yet.
+	if d.DryRun && deployedNow {
+		fmt.Fprintf(d.Out, "--- the upgrade is not simulated, %s is not deployed\n\n", newImplementation)
+	} else if _, err := d.DryCall(deployment.CoaAddr, deployment.ProxyAddr, upgradeCall); err != nil {
+		return nil, fmt.Errorf("the upgrade of %s would fail: %w", deployment.ProxyAddr, err)
+	}
+
+	d.Log.Printf("\t...upgrading %s to %s", deployment.ProxyAddr, newImplementation)
+	txID, err := d.CallContract(deployment.ProxyAddr, upgradeCall)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the upgrade as soon as it is submitted, so that a failed check
+	// of the implementation slot does not leave the state file behind the proxy
+	deployment.Upgrades = append(deployment.Upgrades, Upgrade{From: deployment.ImplementationAddr, To: newImplementation, TxID: txID})
+	deployment.ImplementationAddr = newImplementation
+	deployment.ImplementationCodeHash = implementationCodeHash
+	deployment.StorageLayout = layout
+	deployment.PendingImplementationAddr = ""
+	deployment.PendingImplementationCodeHash = ""
+	if err := d.save(deployment); err != nil {
+		return nil, err
+	}
+
+	if !d.DryRun {
+		if d.EVM == nil {
+			d.Log.Printf("No rpcUrl configured for %s, the implementation slot of the proxy is not checked", d.Network)
+		} else {
+			if err := d.checkImplementationSlot(deployment.ProxyAddr, newImplementation); err != nil {
+				return nil, fmt.Errorf("the upgrade was submitted in %s but %w", txID, err)
+			}
+			deployment.Upgrades[len(deployment.Upgrades)-1].SlotChecked = true
+			if err := d.save(deployment); err != nil {
+				return nil, err
+			}
+		}
+	}
+	d.Log.Printf("Proxy upgraded to %s%s", newImplementation, separatorString())
+
+	if d.Config.Verified() && !deployment.IsVerified(newImplementation) {
+		if err := d.VerifyContract(newImplementation); err != nil {
+			return nil, err
+		}
+		deployment.Verified = append(deployment.Verified, newImplementation)
+		if err := d.save(deployment); err != nil {
+			return nil, err
+		}
+	}
+
+	d.Log.Printf("\n\nUPGRADE COMPLETE!")
+	return deployment, nil
+}
+
+// GenerateUpgradeToAndCall ABI encodes the UUPS upgradeToAndCall call
+func GenerateUpgradeToAndCall(newImplementation string, data []byte) ([]byte, error) {
+	parsedABI, err := bindings.UUPSUpgradeableMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	implementation, err := parseAddress(newImplementation)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		data = []byte{}
+	}
+
+	call, err := parsedABI.Pack("upgradeToAndCall", implementation, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack upgrade ABI: %w", err)
+	}
+	return call, nil
+}
+
+// CallContract calls a contract from the COA and returns the ID of the transaction
+func (d *Deployer) CallContract(contractAddr string, data []byte) (string, error) {
+	result, err := d.flow().Tx(callContractTx, d.TopshotAccountName,
+		Arg{"to", contractAddr},
+		Arg{"data", hex.EncodeToString(data)},
+		Arg{"gasLimit", d.Config.GasLimit},
+	)
+	if err != nil {
+		return "", err
+	}
+	return result.ID(), nil
+}
+
+// DryCall simulates a call to a contract and returns the returned data. It
+// returns nil without error when there is no Flow network to simulate on.
+func (d *Deployer) DryCall(from, contractAddr string, data []byte) ([]byte, error) {
+	result, err := d.flow().Script(dryCallScript,
+		Arg{"from", from},
+		Arg{"to", contractAddr},
+		Arg{"data", hex.EncodeToString(data)},
+		Arg{"gasLimit", d.Config.GasLimit},
+	)
+	if err != nil || result == nil {
+		return nil, err
+	}
+
+	fields, ok := result.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected dry call result %v", result)
+	}
+	if reason, failed := fields["error"]; failed {
+		return nil, fmt.Errorf("%v", reason)
+	}
+	returned, _ := fields["data"].(string)
+	return hex.DecodeString(returned)
+}
+
+func (d *Deployer) checkStorageLayout(deployment *Deployment, layout *StorageLayout) error {
+	if deployment.StorageLayout == nil {
+		if !d.Force {
+			return fmt.Errorf("no storage layout recorded for %s, pass --force to upgrade without checking it", deployment.ImplementationAddr)
+		}
+		d.Log.Printf("No storage layout recorded for %s, upgrading without checking it", deployment.ImplementationAddr)
+		return nil
+	}
+
+	problems, err := CompareStorageLayouts(deployment.StorageLayout, layout)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("the storage layout of BridgedTopShotMoments is incompatible with the one of %s:\n\t%s",
+			deployment.ImplementationAddr, strings.Join(problems, "\n\t"))
+	}
+	return nil
+}
+
+func (d *Deployer) checkImplementationSlot(proxyAddr, implementationAddr string) error {
+	value, err := d.EVM.StorageAt(proxyAddr, ImplementationSlot)
+	if err != nil {
+		return err
+	}
+
+	if current := common.BytesToAddress(value[12:]); current != common.HexToAddress(implementationAddr) {
+		return fmt.Errorf("the implementation slot of %s holds %s instead of %s", proxyAddr, current.Hex(), implementationAddr)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package deploy
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// fakeEVM holds the implementation the proxy points to and the code of contracts
+type fakeEVM struct {
+	implementation string
+	code           map[common.Address][]byte
+}
+
+func (e *fakeEVM) StorageAt(contractAddr string, slot common.Hash) (common.Hash, error) {
+	if slot != ImplementationSlot {
+		return common.Hash{}, nil
+	}
+	return common.BytesToHash(common.HexToAddress(e.implementation).Bytes()), nil
+}
+
+func (e *fakeEVM) CodeAt(contractAddr string) ([]byte, error) {
+	return e.code[common.HexToAddress(contractAddr)], nil
+}
+
+// newUpgradeTest sets up a deployment and builds a new, upgradeable, implementation
+func newUpgradeTest(t *testing.T, network string) (*Deployer, *fakeFlow, *fakeForge, *Deployment) {
+	t.Helper()
+	d, flow, forge := newTestDeployer(t, network)
+
+	deployment, err := d.Setup()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	layout := testLayout()
+	layout.Storage = append(layout.Storage, StorageEntry{Contract: "C", Label: "paused", Slot: "13", Type: "t_bool"})
+	writeArtifact(t, d.Dir, "BridgedTopShotMoments", "0x60806040526002", layout, "upgradeToAndCall", "proxiableUUID")
+
+	flow.txs, flow.args, forge.verified = nil, nil, nil
+	return d, flow, forge, deployment
+}
+
+func TestUpgrade(t *testing.T) {
+	d, flow, forge, setup := newUpgradeTest(t, "testnet")
+	evm := &fakeEVM{implementation: DryRunAddress(103)}
+	d.EVM = evm
+
+	deployment, err := d.Upgrade([]byte{0xab})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(flow.txs, ",") != deployContractTx+","+callContractTx {
+		t.Fatalf("unexpected transactions %v", flow.txs)
+	}
+	expectedCall, err := GenerateUpgradeToAndCall(DryRunAddress(103), []byte{0xab})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call := flow.args[1]; call[0].Value != setup.ProxyAddr || call[1].Value != hex.EncodeToString(expectedCall) {
+		t.Errorf("unexpected call %v", call)
+	}
+	if len(flow.dryCalls) != 1 || flow.dryCalls[0][0].Value != setup.CoaAddr {
+		t.Errorf("expected the upgrade to be simulated from the COA, got %v", flow.dryCalls)
+	}
+
+	upgrade := Upgrade{From: setup.ImplementationAddr, To: DryRunAddress(103), TxID: "tx2", SlotChecked: true}
+	if len(deployment.Upgrades) != 1 || deployment.Upgrades[0] != upgrade {
+		t.Errorf("expected %+v, got %+v", upgrade, deployment.Upgrades)
+	}
+	if deployment.ImplementationAddr != DryRunAddress(103) || deployment.PendingImplementationAddr != "" || len(deployment.StorageLayout.Storage) != 5 {
+		t.Errorf("unexpected deployment %+v", deployment)
+	}
+	if len(forge.verified) != 1 || forge.verified[0] != DryRunAddress(103) {
+		t.Errorf("expected the new implementation to be verified, got %v", forge.verified)
+	}
+
+	saved, err := LoadDeployment(d.StatePath, "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.ImplementationAddr != DryRunAddress(103) || len(saved.Upgrades) != 1 {
+		t.Errorf("upgrade not saved: %+v", saved)
+	}
+
+	// the proxy is already on this implementation
+	if _, err := d.Upgrade(nil); err == nil || !strings.Contains(err.Error(), "has not changed") {
+		t.Errorf("expected an unchanged implementation to be rejected, got %v", err)
+	}
+}
+
+func TestUpgradeRequiresUUPS(t *testing.T) {
+	d, flow, _, _ := newUpgradeTest(t, "emulator")
+	writeArtifact(t, d.Dir, "BridgedTopShotMoments", "0x60806040526002", testLayout())
+
+	if _, err := d.Upgrade(nil); err == nil || !strings.Contains(err.Error(), "UUPSUpgradeable") {
+		t.Errorf("expected a non UUPS implementation to be rejected, got %v", err)
+	}
+	if len(flow.txs) != 0 {
+		t.Errorf("a rejected upgrade ran %v", flow.txs)
+	}
+}
+
+func TestUpgradeChecksStorageLayout(t *testing.T) {
+	d, flow, _, _ := newUpgradeTest(t, "emulator")
+	layout := testLayout()
+	layout.Storage[1].Label = "metadata"
+	writeArtifact(t, d.Dir, "BridgedTopShotMoments", "0x60806040526002", layout, "upgradeToAndCall", "proxiableUUID")
+
+	if _, err := d.Upgrade(nil); err == nil || !strings.Contains(err.Error(), "renamed to metadata") {
+		t.Errorf("expected an incompatible layout to be rejected, got %v", err)
+	}
+	if len(flow.txs) != 0 {
+		t.Errorf("a rejected upgrade ran %v", flow.txs)
+	}
+}
+
+func TestUpgradeWithoutRecordedLayout(t *testing.T) {
+	d, _, _, setup := newUpgradeTest(t, "emulator")
+	setup.StorageLayout = nil
+	if err := setup.Save(d.StatePath); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := d.Upgrade(nil); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("expected the upgrade to require --force, got %v", err)
+	}
+	d.Force = true
+	if _, err := d.Upgrade(nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpgradeResumesAfterFailedSimulation(t *testing.T) {
+	d, flow, _, _ := newUpgradeTest(t, "emulator")
+	flow.dryCall = map[string]any{"error": "error code: 306, error message: execution reverted"}
+
+	if _, err := d.Upgrade(nil); err == nil || !strings.Contains(err.Error(), "would fail: error code: 306") {
+		t.Fatalf("expected the simulation to fail, got %v", err)
+	}
+	saved, err := LoadDeployment(d.StatePath, "emulator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.PendingImplementationAddr != DryRunAddress(103) || len(saved.Upgrades) != 0 {
+		t.Fatalf("unexpected state after the failure %+v", saved)
+	}
+
+	flow.dryCall, flow.txs = map[string]any{"data": ""}, nil
+	deployment, err := d.Upgrade(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(flow.txs, ",") != callContractTx || deployment.ImplementationAddr != DryRunAddress(103) {
+		t.Errorf("expected the pending implementation to be reused, got %v and %+v", flow.txs, deployment)
+	}
+	if deployment.Upgrades[0].SlotChecked {
+		t.Error("the slot cannot be checked without an rpcUrl")
+	}
+}
+
+func TestUpgradeChecksImplementationSlot(t *testing.T) {
+	d, _, _, setup := newUpgradeTest(t, "emulator")
+	d.EVM = &fakeEVM{implementation: setup.ImplementationAddr}
+
+	if _, err := d.Upgrade(nil); err == nil || !strings.Contains(err.Error(), "implementation slot") {
+		t.Fatalf("expected an unchanged slot to be reported, got %v", err)
+	}
+	saved, err := LoadDeployment(d.StatePath, "emulator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Upgrades) != 1 || saved.ImplementationAddr == setup.ImplementationAddr {
+		t.Fatalf("a submitted upgrade should be recorded, got %+v", saved)
+	}
+	if upgrade := saved.Upgrades[0]; upgrade.TxID == "" || upgrade.SlotChecked {
+		t.Errorf("expected the upgrade to be recorded with its transaction and an unchecked slot, got %+v", upgrade)
+	}
+	if saved.PendingImplementationAddr != "" {
+		t.Errorf("the pending implementation %s should be cleared", saved.PendingImplementationAddr)
+	}
+}
+
+func TestUpgradeDryRun(t *testing.T) {
+	d, flow, _, _ := newUpgradeTest(t, "emulator")
+	out := &bytes.Buffer{}
+	d.DryRun, d.Out = true, out
+
+	if _, err := d.Upgrade(nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(flow.txs) != 0 || len(flow.dryCalls) != 0 {
+		t.Errorf("a dry run ran %v and simulated %v", flow.txs, flow.dryCalls)
+	}
+	for _, expected := range []string{"--- calldata upgradeToAndCall\n0x4f1ef286", "/* " + callContractTx + " */", "is not deployed"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected %q in:\n%s", expected, out)
+		}
+	}
+}
+
+func TestUpgradeWithoutProxy(t *testing.T) {
+	d, _, _ := newTestDeployer(t, "emulator")
+	if _, err := d.Upgrade(nil); err == nil || !strings.Contains(err.Error(), "run the setup first") {
+		t.Errorf("expected the upgrade to require a setup, got %v", err)
+	}
+}
@@ -0,0 +1,287 @@
+// Package evmtest deploys and calls the contracts built by forge on a
+// simulated chain, so that tests exercise them without Flow or a network.
+// Every change is a signed transaction mined in its own block, with the
+// hash, receipt, logs, gas and nonce a network would give it.
+package evmtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"bridged-topshot-evm-deploy/deploy"
+)
+
+// gasLimit is the gas of every transaction, enough to deploy BridgedTopShotMoments
+const gasLimit = 10_000_000
+
+var (
+	// keys are the keys of the accounts returned by Account, by address
+	keys sync.Map
+
+	faucet = Account("faucet")
+	// funding is what an account is sent before its first transaction
+	funding = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+)
+
+// Account returns the address of the account of a name, whose key is
+// derived from the name so that every test and EVM share it. The account
+// is funded by an EVM before it sends its first transaction.
+func Account(name string) common.Address {
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte("evmtest " + name)))
+	if err != nil {
+		panic(err)
+	}
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	keys.Store(addr, key)
+	return addr
+}
+
+// EVM is a simulated chain whose state persists across transactions
+type EVM struct {
+	// Dir is the directory holding the forge artifacts under out/
+	Dir     string
+	backend *simulated.Backend
+	client  simulated.Client
+	signer  types.Signer
+	funded  map[common.Address]bool
+}
+
+// RevertError is a call or transaction that reverted, with the data it reverted with
+type RevertError struct {
+	Data []byte
+	// Receipt is the receipt of the failed transaction, nil for a dry call
+	Receipt *types.Receipt
+}
+
+func (e *RevertError) Error() string {
+	if reason, err := abi.UnpackRevert(e.Data); err == nil {
+		return "execution reverted: " + reason
+	}
+	return "execution reverted: 0x" + hex.EncodeToString(e.Data)
+}
+
+// Name returns the name of the custom error of the contract the call reverted with, if any
+func (e *RevertError) Name(contractABI *abi.ABI) string {
+	if len(e.Data) < 4 {
+		return ""
+	}
+	customError, err := contractABI.ErrorByID([4]byte(e.Data[:4]))
+	if err != nil {
+		return ""
+	}
+	return customError.Name
+}
+
+// New returns an empty EVM deploying the contracts built under dir/out. The
+// test is skipped when forge did not build them, and fails on CI, which
+// builds them before running the tests.
+func New(t testing.TB, dir string) *EVM {
+	t.Helper()
+	if _, err := os.Stat(filepath.Join(dir, "out")); err != nil {
+		if os.Getenv("CI") != "" {
+			t.Fatalf("no forge artifacts under %s, CI must run 'forge build' before the tests", filepath.Join(dir, "out"))
+		}
+		t.Skipf("no forge artifacts under %s, run 'forge build' first", filepath.Join(dir, "out"))
+	}
+	return NewEmpty(t, dir)
+}
+
+// NewEmpty returns an empty EVM, whether or not the contracts under dir/out are built
+func NewEmpty(t testing.TB, dir string) *EVM {
+	t.Helper()
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		faucet: {Balance: new(big.Int).Lsh(big.NewInt(1), 128)},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	client := backend.Client()
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &EVM{
+		Dir:     dir,
+		backend: backend,
+		client:  client,
+		signer:  types.LatestSignerForChainID(chainID),
+		funded:  map[common.Address]bool{faucet: true},
+	}
+}
+
+// Deploy deploys a contract from its forge artifact, with the ABI encoded constructor arguments
+func (e *EVM) Deploy(from common.Address, contractName string, constructorData []byte) (common.Address, error) {
+	bytecode, err := deploy.ReadBytecode(e.Dir, contractName)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return e.Create(from, append(bytecode, constructorData...))
+}
+
+// Create deploys a contract from its creation code
+func (e *EVM) Create(from common.Address, creationCode []byte) (common.Address, error) {
+	receipt, err := e.send(from, nil, creationCode, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy from %s: %w", from, err)
+	}
+	return receipt.ContractAddress, nil
+}
+
+// DeployCode deploys runtime code as is, such as a stub of a contract the tests do not build
+func (e *EVM) DeployCode(from common.Address, runtimeCode []byte) (common.Address, error) {
+	// copy the code following the 12 bytes of this prefix to memory and return it
+	size := byte(len(runtimeCode))
+	if int(size) != len(runtimeCode) {
+		return common.Address{}, fmt.Errorf("runtime code of %d bytes is too long", len(runtimeCode))
+	}
+	prefix := []byte{0x60, size, 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, size, 0x60, 0x00, 0xf3}
+	return e.Create(from, append(prefix, runtimeCode...))
+}
+
+// Transact sends a transaction calling a contract and returns its receipt
+// once mined. A revert is returned as a *RevertError holding the receipt.
+func (e *EVM) Transact(from, to common.Address, data []byte) (*types.Receipt, error) {
+	return e.send(from, &to, data, nil)
+}
+
+// DryCall calls a contract at the latest block, without sending a transaction
+func (e *EVM) DryCall(from, to common.Address, data []byte) ([]byte, error) {
+	returned, err := e.client.CallContract(context.Background(), ethereum.CallMsg{From: from, To: &to, Data: data}, nil)
+	return returned, revertError(err)
+}
+
+// StorageAt returns the value of a storage slot of a contract at the latest block
+func (e *EVM) StorageAt(contractAddr string, slot common.Hash) (common.Hash, error) {
+	value, err := e.client.StorageAt(context.Background(), common.HexToAddress(contractAddr), slot, nil)
+	return common.BytesToHash(value), err
+}
+
+// CodeAt returns the runtime code of a contract at the latest block
+func (e *EVM) CodeAt(contractAddr string) ([]byte, error) {
+	return e.client.CodeAt(context.Background(), common.HexToAddress(contractAddr), nil)
+}
+
+// Receipt returns the receipt of a mined transaction
+func (e *EVM) Receipt(txHash string) (*types.Receipt, error) {
+	return e.client.TransactionReceipt(context.Background(), common.HexToHash(txHash))
+}
+
+// COA calls contracts from an account the way the Cadence-owned account
+// of the deployer does, so it can stand for a deployer in tests
+func (e *EVM) COA(addr common.Address) *COA {
+	return &COA{evm: e, Addr: addr}
+}
+
+// COA is an account calling contracts with the string addresses the deployer uses
+type COA struct {
+	evm  *EVM
+	Addr common.Address
+}
+
+// CallContract sends a transaction calling a contract from the account and returns its hash
+func (c *COA) CallContract(contractAddr string, data []byte) (string, error) {
+	receipt, err := c.evm.Transact(c.Addr, common.HexToAddress(contractAddr), data)
+	if err != nil {
+		return "", err
+	}
+	return receipt.TxHash.Hex(), nil
+}
+
+// DryCall simulates a call to a contract from an account
+func (c *COA) DryCall(from, contractAddr string, data []byte) ([]byte, error) {
+	return c.evm.DryCall(common.HexToAddress(from), common.HexToAddress(contractAddr), data)
+}
+
+// send signs and sends a transaction, funding the sender first, and mines it
+func (e *EVM) send(from common.Address, to *common.Address, data []byte, value *big.Int) (*types.Receipt, error) {
+	if !e.funded[from] {
+		e.funded[from] = true
+		if _, err := e.send(faucet, &from, nil, funding); err != nil {
+			return nil, fmt.Errorf("failed to fund %s: %w", from, err)
+		}
+	}
+
+	key, ok := keys.Load(from)
+	if !ok {
+		return nil, fmt.Errorf("no key for %s, which must be an evmtest.Account", from)
+	}
+
+	ctx := context.Background()
+	nonce, err := e.client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	head, err := e.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	tip := big.NewInt(params.GWei)
+	tx, err := types.SignNewTx(key.(*ecdsa.PrivateKey), e.signer, &types.DynamicFeeTx{
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2))),
+		Gas:       gasLimit,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := e.client.SendTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+	e.backend.Commit()
+
+	receipt, err := e.client.TransactionReceipt(ctx, tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return receipt, nil
+	}
+
+	// Replay the transaction on the state it ran on for the data it reverted with
+	call := ethereum.CallMsg{From: from, To: to, Gas: gasLimit, Value: value, Data: data}
+	_, err = e.client.CallContract(ctx, call, new(big.Int).Sub(receipt.BlockNumber, common.Big1))
+	var revert *RevertError
+	if !errors.As(revertError(err), &revert) {
+		revert = &RevertError{}
+	}
+	revert.Receipt = receipt
+	return nil, revert
+}
+
+// revertError returns the revert of a call as a *RevertError
+func revertError(err error) error {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+	data, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err
+	}
+	returned, decodeErr := hexutil.Decode(data)
+	if decodeErr != nil {
+		return err
+	}
+	return &RevertError{Data: returned}
+}
@@ -0,0 +1,96 @@
+package evmtest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var sender = Account("sender")
+
+// counterCode stores the word it is called with, if any, and returns the word stored
+var counterCode = common.FromHex("0x3615600b57600035600055" + "5b60005460005260206000f3")
+
+// revertCode reverts with the custom error Nope()
+func revertCode() []byte {
+	return append(append([]byte{0x63}, crypto.Keccak256([]byte("Nope()"))[:4]...), common.FromHex("0x60e01b60005260046000fd")...)
+}
+
+func TestTransactionsKeepState(t *testing.T) {
+	evm := NewEmpty(t, t.TempDir())
+	counter, err := evm.DeployCode(sender, counterCode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receipt, err := evm.Transact(sender, counter, common.LeftPadBytes([]byte{7}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful || receipt.GasUsed == 0 {
+		t.Errorf("unexpected receipt %+v", receipt)
+	}
+	mined, err := evm.Receipt(receipt.TxHash.Hex())
+	if err != nil || mined.BlockNumber.Cmp(receipt.BlockNumber) != 0 {
+		t.Errorf("expected the transaction to be mined, got %v and %v", mined, err)
+	}
+
+	if returned, err := evm.DryCall(sender, counter, common.LeftPadBytes([]byte{9}, 32)); err != nil || returned[31] != 9 {
+		t.Fatalf("expected the dry call to see its own change, got %x and %v", returned, err)
+	}
+	if returned, err := evm.DryCall(sender, counter, nil); err != nil || returned[31] != 7 {
+		t.Errorf("expected the dry call to be discarded, got %x and %v", returned, err)
+	}
+
+	value, _ := evm.StorageAt(counter.Hex(), common.Hash{})
+	if value.Big().Int64() != 7 {
+		t.Errorf("unexpected slot value %s", value)
+	}
+}
+
+func TestRevert(t *testing.T) {
+	evm := NewEmpty(t, t.TempDir())
+	failing, err := evm.DeployCode(sender, revertCode())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	coa := evm.COA(sender)
+	_, err = coa.CallContract(failing.Hex(), nil)
+	var revert *RevertError
+	if !errors.As(err, &revert) {
+		t.Fatalf("expected a revert, got %v", err)
+	}
+	if revert.Receipt == nil || revert.Receipt.Status != types.ReceiptStatusFailed {
+		t.Errorf("expected the receipt of a failed transaction, got %+v", revert.Receipt)
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"Nope","inputs":[]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := revert.Name(&contractABI); name != "Nope" {
+		t.Errorf("expected the Nope error, got %q from %v", name, revert)
+	}
+
+	_, err = coa.DryCall(sender.Hex(), failing.Hex(), nil)
+	if !errors.As(err, &revert) || revert.Name(&contractABI) != "Nope" || revert.Receipt != nil {
+		t.Errorf("expected the dry call to revert with Nope, got %v", err)
+	}
+}
+
+func TestNewSkipsWithoutArtifacts(t *testing.T) {
+	t.Setenv("CI", "")
+	skipped := t.Run("no artifacts", func(t *testing.T) {
+		New(t, t.TempDir())
+		t.Error("expected the test to be skipped")
+	})
+	if !skipped {
+		t.Error("expected the test to be skipped")
+	}
+}
@@ -1,9 +1,8 @@
 package main
 
 import (
-	"bytes"
 	"encoding/hex"
-	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -13,8 +12,9 @@ import (
 	"strings"
 
 	. "github.com/bjartek/overflow/v2"
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/common"
+
+	"bridged-topshot-evm-deploy/bulk"
+	"bridged-topshot-evm-deploy/deploy"
 )
 
 /**
@@ -23,72 +23,47 @@ import (
 
 // Overflow prefixes signer names with the current network - e.g. "emulator-topshot-signer"
 // Ensure accounts in flow.json are named accordingly
-var networks = []string{"emulator", "testnet", "mainnet"}
-var scriptTypes = []string{"setup", "tests"}
-
-// Config by network
-type config struct {
-	topShotFlowAddr                 string
-	topshotCoaAddr                  string
-	bridgeDeployedTopshotERC721Addr string
-	flowEvmBridgeCoaAddr            string
-	transferValidatorAddr           string
-	royaltyRecipientAddr            string
-	proxyContractAddr               string
-	rpcUrl                          string
-	verifierUrl                     string
-	verifierProvider                string
-}
-
-const (
-	placeholderEvmAddress = "0x1234567890abcdef1234567890abcdef12345678"
-)
+var scriptTypes = []string{"setup", "tests", "upgrade", "adopt", "bulk"}
 
-// Deployment configuration by network
-var configByNetwork = map[string]config{
-	"emulator": {
-		topShotFlowAddr:                 "abcdef1234567890",
-		flowEvmBridgeCoaAddr:            placeholderEvmAddress,
-		bridgeDeployedTopshotERC721Addr: placeholderEvmAddress,
-		transferValidatorAddr:           "0x0000000000000000000000000000000000000000",
-		royaltyRecipientAddr:            placeholderEvmAddress,
-	},
-	"testnet": {
-		topShotFlowAddr:                 "877931736ee77cff",
-		flowEvmBridgeCoaAddr:            "0x0000000000000000000000023f946ffbc8829bfd",
-		bridgeDeployedTopshotERC721Addr: "0xB3627E6f7F1cC981217f789D7737B1f3a93EC519",
-		transferValidatorAddr:           "0x721C002B0059009a671D00aD1700c9748146cd1B", // CreatorTokenTransferValidator
-		royaltyRecipientAddr:            "0x000000000000000000000002958e48adcfd5d1c7", // owned by 0x078246e1386888f3
-		rpcUrl:                          "https://testnet.evm.nodes.onflow.org",
-		verifierUrl:                     "https://evm-testnet.flowscan.io/api",
-		verifierProvider:                "blockscout",
-	},
-	"mainnet": {
-		topShotFlowAddr:                 "0b2a3299cc857e29",
-		flowEvmBridgeCoaAddr:            "0x00000000000000000000000249250a5c27ecab3b",
-		bridgeDeployedTopshotERC721Addr: "0x50AB3a827aD268e9D5A24D340108FAD5C25dAD5f",
-		transferValidatorAddr:           "0x721C002B0059009a671D00aD1700c9748146cd1B", // CreatorTokenTransferValidator
-		royaltyRecipientAddr:            "0x000000000000000000000002b4c7594cb1b9e654", // owned by 0x065fb66f8d2d780a
-		rpcUrl:                          "https://mainnet.evm.nodes.onflow.org",
-		verifierUrl:                     "https://evm.flowscan.io/api",
-		verifierProvider:                "blockscout",
-	},
-}
+// To run, execute 'go run main.go [--dry-run] [--force] [--config <file>] [--reinitializer <calldata>] <script-type> <network-name>'
+// Adopting an existing proxy takes '--proxy <address>'
+// Bulk operations take '--op <operation> --ids <file>' and the other bulk flags
+func main() {
+	dryRun := flag.Bool("dry-run", false, "print the Cadence transactions, encoded calldata and target addresses without submitting them")
+	configPath := flag.String("config", "", "network configuration file, defaults to deploy/networks.json")
+	reinitializer := flag.String("reinitializer", "", "hex encoded calldata the upgrade calls on the new implementation, such as a reinitializer")
+	proxy := flag.String("proxy", "", "EVM address of the proxy deployed before deployments/<network>.json to adopt")
+	force := flag.Bool("force", false, "deploy a new implementation and proxy even if deployments/<network>.json records a proxy")
+	flowConfig := flag.String("flow-config", "cadence/transactions/admin/deploy/flow.json", "flow.json with the accounts and the contracts the Cadence code imports")
+	op := flag.String("op", "", fmt.Sprintf("bulk operation, one of %v", bulk.Operations))
+	idsPath := flag.String("ids", "", "file of the moment IDs of a bulk operation, separated by whitespace or commas, - for stdin")
+	journalPath := flag.String("journal", "", "journal of a bulk operation, defaults to deployments/<network>-<operation>.json")
+	recipient := flag.String("recipient", "", "EVM address receiving the moments of the to-evm and transfer bulk operations")
+	batchSize := flag.Int("batch-size", 0, "most moments moved by one transaction of a bulk operation")
+	maxBridgeFee := flag.Float64("max-bridge-fee", 0, "most FLOW a bulk to-evm or from-evm transaction may pay the bridge")
+	flag.Parse()
+
+	// Load and validate the network configuration
+	configs := deploy.DefaultConfigFile()
+	if *configPath != "" {
+		var err error
+		configs, err = deploy.LoadConfigFile(*configPath)
+		checkNoErr(err)
+	}
 
-// Provider struct
-type provider struct {
-	Dir                string
-	TopshotAccountName string
-	Network            string
-	Config             config
-	GasLimit           int
-	*OverflowState
-}
+	// Get network and script type from command line argument
+	scriptType, network, err := getSpecifiedNetworkAndScriptType(flag.Args(), configs.NetworkNames())
+	checkNoErr(err)
 
-// To run, execute 'go run main.go <script-type> <network-name>'
-func main() {
-	// Check prerequisites
-	for _, prerequisite := range []string{"forge", "cast", "flow"} {
+	// Check prerequisites, a dry run only compiles the contracts and bulk operations compile nothing
+	prerequisites := []string{}
+	if scriptType != "bulk" {
+		prerequisites = append(prerequisites, "forge")
+	}
+	if !*dryRun {
+		prerequisites = append(prerequisites, "flow")
+	}
+	for _, prerequisite := range prerequisites {
 		if _, err := exec.LookPath(prerequisite); err != nil {
 			log.Fatalf("Please install %s", prerequisite)
 		}
@@ -98,356 +73,150 @@ func main() {
 	dir, err := os.Getwd()
 	checkNoErr(err)
 
-	// Get network and script type from command line argument
-	scriptType, network := getSpecifiedNetworkAndScriptType()
-
-	// Initialize provider
-	p := provider{
-		Dir:                dir,
-		TopshotAccountName: "topshot-signer",
-		Network:            network,
-		Config:             configByNetwork[network],
-		GasLimit:           15000000,
-		OverflowState: Overflow(
-			WithNetwork(network),
-			WithFlowConfig("cadence/transactions/admin/deploy/flow.json"),
-			WithTransactionFolderName("cadence/transactions"),
-			WithScriptFolderName("cadence/scripts"),
-			WithGlobalPrintOptions(WithTransactionUrl()),
-			WithLogNone(),
-		),
-	}
-	log.Printf("Provider initialized%s", separatorString())
-
-	// Retrieve or create COA
-	p.retrieveOrCreateCOA()
+	d, err := deploy.New(dir, network, configs)
+	checkNoErr(err)
+	d.DryRun, d.Force = *dryRun, *force
+	d.Forge = deploy.ForgeCLI{Dir: dir, Log: d.Log}
+	d.Flow = overflowExecutor{Overflow(
+		WithNetwork(network),
+		WithFlowConfig(*flowConfig),
+		WithTransactionFolderName("cadence/transactions"),
+		WithScriptFolderName("cadence/scripts"),
+		WithGlobalPrintOptions(WithTransactionUrl()),
+		WithLogNone(),
+	)}
+	log.Printf("Provider initialized\n--------------------------------\n")
 
-	// Deploy test contract
 	switch scriptType {
 	case "setup":
-		p.setupProject()
+		_, err = d.Setup()
 	case "tests":
-		p.tests()
-	}
-}
-
-func (p *provider) setupProject() {
-	// Compile contracts
-	recompileContracts()
-
-	// Deploy implementation contract
-	implementationAddr := p.deployContract("BridgedTopShotMoments", "")
-
-	// Generate encoded initialize function call, ensure valid values are passed
-	encodedInitializeFunctionCall := generateEncodedInitializeFunctionCall(
-		p.Config.topshotCoaAddr,
-		p.Config.bridgeDeployedTopshotERC721Addr,
-		p.Config.flowEvmBridgeCoaAddr,
-		"NBA Top Shot",
-		"TOPSHOT",
-		"https://metadata-api.production.studio-platform.dapperlabs.com/v1/topshot/moment/",
-		p.Config.topShotFlowAddr,
-		fmt.Sprintf("A.%s.TopShot.NFT", p.Config.topShotFlowAddr),
-		`data:application/json;utf8,{\"name\":\"NBA Top Shot\",\"description\":\"NBA Top Shot is your chance to own, sell, and trade official digital collectibles of the NBA and WNBA's greatest plays and players.\",\"image\": \"https://assets.nbatopshot.com/open_sea/favicon.svg\",\"external_link\":\"https://nbatopshot.com\",\"banner_image\":\"https://assets.nbatopshot.com/open_sea/topshot_banner_1400_350.jpg\",\"featured_image\":\"https://assets.nbatopshot.com/open_sea/topshot_banner_600_400.jpg\"}`,
-	)
-
-	// Deploy proxy contract
-	proxyAddr := p.deployContract("ERC1967Proxy",
-		generateProxyEncodedConstructorData(
-			fmt.Sprintf("0x%s", implementationAddr),
-			fmt.Sprintf("0x%s", encodedInitializeFunctionCall),
-		),
-	)
-	p.Config.proxyContractAddr = fmt.Sprintf("0x%s", proxyAddr)
-
-	// Verify contracts
-	if p.Network == "testnet" || p.Network == "mainnet" {
-		p.verifyContract(implementationAddr)
-		p.verifyContract(proxyAddr)
-	}
-
-	// Set up royalty management
-	p.setRoyaltyManagement()
-
-	log.Printf("\n\nSETUP COMPLETE!")
-}
-
-func (p *provider) tests() {
-	if p.Network != "emulator" {
-		log.Fatal("Test script can only be run on the emulator network")
-	}
-	testContractAddr := p.deployContract("TestContract", "")
-
-	log.Printf("\t...running test uint array encoding tx")
-	result := p.OverflowState.Tx("tests/test_uint_array_encoding",
-		WithSigner(p.TopshotAccountName),
-		WithArg("evmContractAddress", testContractAddr),
-	)
-	checkNoErr(result.Err)
-	log.Printf("Tx executed%s", separatorString())
-}
-
-func (p *provider) deployContract(name, encodedConstructorData string) string {
-	log.Printf("\t...deploying %s contract", name)
-
-	// Get contract bytecode
-	bytecode := p.getContractBytecodeFromABIFile(name)
-
-	if encodedConstructorData != "" {
-		// Remove 0x prefix if present
-		encodedConstructorData = strings.TrimPrefix(encodedConstructorData, "0x")
-		// Ensure both parts are valid hex strings
-		if _, err := hex.DecodeString(bytecode); err != nil {
-			log.Fatalf("Invalid bytecode hex: %v", err)
+		err = d.Tests()
+	case "upgrade":
+		var data []byte
+		if data, err = hex.DecodeString(strings.TrimPrefix(*reinitializer, "0x")); err == nil {
+			_, err = d.Upgrade(data)
 		}
-		if _, err := hex.DecodeString(encodedConstructorData); err != nil {
-			log.Fatalf("Invalid constructor data hex: %v", err)
-		}
-		bytecode = bytecode + encodedConstructorData
+	case "adopt":
+		_, err = d.Adopt(*proxy)
+	case "bulk":
+		err = runBulk(d, *op, *idsPath, *journalPath, *recipient, *batchSize, *maxBridgeFee)
 	}
-
-	// Deploy contract and return address
-	result := p.OverflowState.Tx("admin/deploy/deploy_contract",
-		WithSigner(p.TopshotAccountName),
-		WithArg("bytecode", bytecode),
-		WithArg("gasLimit", p.GasLimit),
-	)
-	checkNoErr(result.Err)
-	address := getContractAddressFromEVMEvent(result)
-	log.Printf("%s contract deployed to address: %s%s", name, address, separatorString())
-	return address
+	checkNoErr(err)
 }
 
-// Read contract ABI file and return the bytecode object
-func (p *provider) getContractBytecodeFromABIFile(contractName string) string {
-	// Read ABI
-	abiFile, err := os.ReadFile(filepath.Join(p.Dir, fmt.Sprintf("out/%s.sol/%s.json", contractName, contractName)))
+// runBulk runs a bulk operation on the moments listed in a file, from the
+// TopShot signer and on the proxy recorded in deployments/<network>.json
+func runBulk(d *deploy.Deployer, opName, idsPath, journalPath, recipient string, batchSize int, maxBridgeFee float64) error {
+	op, err := bulk.ParseOperation(opName)
 	if err != nil {
-		log.Fatalf("Error reading file: %v", err)
+		return err
 	}
 
-	// Parse and return bytecode object without 0x prefix
-	var abi struct {
-		Bytecode struct {
-			Object string `json:"object"`
-		} `json:"bytecode"`
-	}
-	if err := json.Unmarshal(abiFile, &abi); err != nil {
-		log.Fatalf("Error parsing JSON: %v", err)
+	in := os.Stdin
+	if idsPath != "-" {
+		if in, err = os.Open(idsPath); err != nil {
+			return err
+		}
+		defer in.Close()
 	}
-	return abi.Bytecode.Object[2:]
-}
-
-func generateProxyEncodedConstructorData(implementationAddr, abiEncodedInitializeFunctionCall string) string {
-	implementationAddr = strings.TrimPrefix(implementationAddr, "0x")
-	abiEncodedInitializeFunctionCall = strings.TrimPrefix(abiEncodedInitializeFunctionCall, "0x")
-	initCallBytes, err := hex.DecodeString(abiEncodedInitializeFunctionCall)
+	ids, err := bulk.ReadIDs(in)
 	if err != nil {
-		log.Fatalf("Failed to decode init call hex: %v", err)
+		return err
 	}
-	const contractABI = `[{
-		"type": "constructor",
-		"inputs": [
-			{"name": "implementation", "type": "address"},
-			{"name": "_data", "type": "bytes"}
-		]
-	}]`
 
-	parsedABI, err := abi.JSON(strings.NewReader(contractABI))
+	deployment, err := deploy.LoadDeployment(d.StatePath, d.Network)
 	if err != nil {
-		log.Fatalf("Failed to parse ABI: %v", err)
+		return err
 	}
-
-	implementation := common.HexToAddress(implementationAddr)
-	data, err := parsedABI.Pack("", implementation, initCallBytes)
-	if err != nil {
-		log.Fatalf("Failed to pack proxy ABI: %v", err)
+	if journalPath == "" {
+		journalPath = filepath.Join(filepath.Dir(d.StatePath), fmt.Sprintf("%s-%s.json", d.Network, op))
 	}
 
-	// Convert to hex string
-	hexData := hex.EncodeToString(data)
-
-	// Debug log
-	log.Printf("Constructor data hex: %s", hexData)
-	return hexData
+	runner := &bulk.Runner{
+		Network:        d.Network,
+		Flow:           d.Flow,
+		Signer:         d.TopshotAccountName,
+		NFTIdentifier:  d.Config.CadenceNFTIdentifier(),
+		UnderlyingAddr: d.Config.BridgeDeployedTopshotERC721Addr,
+		WrapperAddr:    deployment.ProxyAddr,
+		Recipient:      recipient,
+		BatchSize:      batchSize,
+		MaxBridgeFee:   maxBridgeFee,
+		JournalPath:    journalPath,
+		DryRun:         d.DryRun,
+		Out:            d.Out,
+		Log:            d.Log,
+	}
+	journal, err := runner.Run(op, ids)
+	if journal != nil {
+		log.Printf("%s: %d moments done, %d skipped, %d failed", journalPath, journal.Count(bulk.Done), len(journal.Skipped), journal.Count(bulk.Failed))
+	}
+	return err
 }
 
-func (p *provider) verifyContract(contractAddr string) string {
-	//Run 'cast abi-encode'
-	cmd := exec.Command(
-		"forge",
-		"verify-contract",
-		"--rpc-url",
-		p.Config.rpcUrl,
-		"--verifier",
-		p.Config.verifierProvider,
-		"--verifier-url",
-		p.Config.verifierUrl,
-		contractAddr,
-	)
-	// Print command for logging
-	log.Println("Executing command:", cmd.String())
+// overflowExecutor runs the Cadence transactions and scripts with overflow
+type overflowExecutor struct {
+	*OverflowState
+}
 
-	// Get error output
-	var out, stderr bytes.Buffer
-	cmd.Stdout = &out
-	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
-		log.Fatalf("abiEncode: Failed to run 'cast abi-encode' and get output from command: %v; %s", err, stderr.String())
+func (o overflowExecutor) Tx(name string, signer string, args ...deploy.Arg) (deploy.TxResult, error) {
+	opts := []OverflowInteractionOption{WithSigner(signer)}
+	for _, arg := range args {
+		opts = append(opts, WithArg(arg.Name, arg.Value))
 	}
 
-	return out.String()[2:]
+	result := o.OverflowState.Tx(name, opts...)
+	if result.Err != nil {
+		return nil, result.Err
+	}
+	return overflowResult{result}, nil
 }
 
-// Generate ABI encoded initializer data for proxy contract
-func generateEncodedInitializeFunctionCall(
-	owner,
-	underlyingNftContractAddress,
-	vmBridgeAddress,
-	name,
-	symbol,
-	baseTokenURI,
-	cadenceNFTAddress,
-	cadenceNFTIdentifier,
-	contractMetadata string,
-) string {
-	const contractABI = `[{
-	"name": "initialize",
-	"type": "function",
-	"inputs": [
-		{"name": "owner", "type": "address"},
-		{"name": "underlyingNftContractAddress", "type": "address"},
-		{"name": "vmBridgeAddress", "type": "address"},
-		{"name": "name", "type": "string"},
-		{"name": "symbol", "type": "string"},
-		{"name": "baseTokenURI_", "type": "string"},
-		{"name": "_cadenceNFTAddress", "type": "string"},
-		{"name": "_cadenceNFTIdentifier", "type": "string"},
-		{"name": "_contractMetadata", "type": "string"}
-	]
-}]` // Print command for logging
-	parsedABI, err := abi.JSON(strings.NewReader(contractABI))
-	if err != nil {
-		log.Fatalf("Failed to parse ABI: %v", err)
+func (o overflowExecutor) Script(name string, args ...deploy.Arg) (any, error) {
+	opts := []OverflowInteractionOption{}
+	for _, arg := range args {
+		opts = append(opts, WithArg(arg.Name, arg.Value))
 	}
-	owner = ensureHexPrefix(owner)
-	underlyingNftContractAddress = ensureHexPrefix(underlyingNftContractAddress)
-	vmBridgeAddress = ensureHexPrefix(vmBridgeAddress)
-
-	ownerAddr := common.HexToAddress(owner)
-	nftContractAddr := common.HexToAddress(underlyingNftContractAddress)
-	bridgeAddr := common.HexToAddress(vmBridgeAddress)
+	return o.OverflowState.Script(name, opts...).GetAsInterface()
+}
 
-	// Run and return output without 0x prefix
-	data, err := parsedABI.Pack(
-		"initialize",
-		ownerAddr,
-		nftContractAddr,
-		bridgeAddr,
-		name,
-		symbol,
-		baseTokenURI,
-		cadenceNFTAddress,
-		cadenceNFTIdentifier,
-		contractMetadata,
-	)
-	if err != nil {
-		log.Fatalf("Failed to pack ABI: %v", err)
-	}
+func (o overflowExecutor) Address(account string) string {
+	return o.OverflowState.Address(account)
+}
 
-	log.Printf("Encoded ABI: 0x%x", data) // Output the hex-encoded transaction data
-	return hex.EncodeToString(data)
+type overflowResult struct {
+	*OverflowResult
 }
 
-// Retrieve or create a COA
-func (p *provider) retrieveOrCreateCOA() {
-	log.Printf("\t...retrieving COA")
-	topshotCOAHex, err := p.OverflowState.Script("get_evm_address_string",
-		WithArg("flowAddress", p.OverflowState.Address(p.TopshotAccountName)),
-	).GetAsInterface()
-	checkNoErr(err)
-	if topshotCOAHex != nil {
-		log.Printf("Using existing COA with EVM address: %s", topshotCOAHex)
-	} else {
-		log.Printf("\t...creating new COA")
-		createCoaResult := p.OverflowState.Tx("admin/deploy/create_coa",
-			WithSigner(p.TopshotAccountName),
-			WithArg("amount", 1.0),
-		)
-		checkNoErr(createCoaResult.Err)
-		topshotCOAHex, err = p.OverflowState.Script("get_evm_address_string",
-			WithArg("flowAddress", p.OverflowState.Address(p.TopshotAccountName)),
-		).GetAsInterface()
-		checkNoErr(err)
-		log.Printf("Created new COA with EVM address: %s%s", topshotCOAHex, separatorString())
-	}
-	p.Config.topshotCoaAddr = fmt.Sprintf("0x%s", topshotCOAHex.(string))
+func (r overflowResult) ID() string {
+	return r.Id.String()
 }
 
-// Set up royalty management
-func (p *provider) setRoyaltyManagement() {
-	if p.Config.proxyContractAddr == "" {
-		log.Fatal("Proxy contract not deployed")
+func (r overflowResult) Events(name string) []map[string]any {
+	var events []map[string]any
+	for _, event := range r.GetEventsWithName(name) {
+		events = append(events, event.Fields)
 	}
-
-	log.Printf("\t...setting up royalty management")
-	setRoyaltyManagementResult := p.OverflowState.Tx("admin/set_up_royalty_management",
-		WithSigner(p.TopshotAccountName),
-		WithArg("erc721C", p.Config.proxyContractAddr),
-		WithArg("validator", p.Config.transferValidatorAddr),
-		WithArg("royaltyRecipient", p.Config.royaltyRecipientAddr),
-		WithArg("royaltyBasisPoints", 500),
-	)
-	checkNoErr(setRoyaltyManagementResult.Err)
-	log.Printf("Royalty management set up%s", separatorString())
+	return events
 }
 
 // Parse script type and network argument from command line
-func getSpecifiedNetworkAndScriptType() (string, string) {
-	if len(os.Args) < 3 {
-		log.Fatal("Please provide a network and script type as an argument: ", networks)
+func getSpecifiedNetworkAndScriptType(args []string, networks []string) (string, string, error) {
+	if len(args) < 2 {
+		return "", "", fmt.Errorf("please provide a script type %v and a network %v as arguments", scriptTypes, networks)
 	}
-	scriptType := os.Args[1]
-	network := os.Args[2]
+	scriptType, network := args[0], args[1]
 
 	if !slices.Contains(scriptTypes, scriptType) {
-		log.Fatal("Please provide a valid script type as an argument: ", scriptTypes)
+		return "", "", fmt.Errorf("please provide a valid script type as an argument: %v", scriptTypes)
 	}
 
 	if !slices.Contains(networks, network) {
-		log.Fatal("Please provide a valid network as an argument: ", networks)
+		return "", "", fmt.Errorf("please provide a valid network as an argument: %v", networks)
 	}
 
-	return scriptType, network
-}
-
-// Extract deployed contract address from TransactionExecuted event
-func getContractAddressFromEVMEvent(res *OverflowResult) string {
-	evts := res.GetEventsWithName("TransactionExecuted")
-	contractAddr := evts[0].Fields["contractAddress"]
-	if contractAddr == nil {
-		log.Fatal("Contract address not found in event")
-	}
-	return strings.ToLower(strings.Split(contractAddr.(string), "x")[1])
-}
-
-// Compile contracts by running 'forge clean' and 'forge build'
-func recompileContracts() {
-	// Run 'forge clean'
-	cleanCmd := exec.Command("forge", "clean")
-	log.Println("Executing command:", cleanCmd.String())
-	if err := cleanCmd.Run(); err != nil {
-		log.Fatalf("Failed to run 'forge clean': %v", err)
-	}
-
-	// Run 'forge build'
-	buildCmd := exec.Command("forge", "build")
-	log.Println("Executing command:", buildCmd.String())
-	buildCmdOutput, err := buildCmd.Output()
-	if err != nil {
-		log.Fatalf("Failed to run 'forge build': %v", err)
-	}
-	log.Println("Output:\n", string(buildCmdOutput))
+	return scriptType, network, nil
 }
 
 func checkNoErr(err error) {
@@ -455,15 +224,3 @@ func checkNoErr(err error) {
 		log.Fatal(err)
 	}
 }
-
-func separatorString() string {
-	return "\n--------------------------------\n"
-}
-
-// NEW: Added helper function
-func ensureHexPrefix(addr string) string {
-	if !strings.HasPrefix(addr, "0x") {
-		return "0x" + addr
-	}
-	return addr
-}
@@ -35,6 +35,20 @@ const (
 	defaultEVMBaseURI                  = "${EVMBASEURI}"
 	fastBreakFile                      = "FastBreakV1.cdc"
 	crossVMMetadataViewsFile           = "imports/CrossVMMetadataViews.cdc"
+
+	// Standard and third party contracts vendored so the emulator can be bootstrapped offline
+	nonFungibleTokenFile                     = "imports/NonFungibleToken.cdc"
+	metadataViewsFile                        = "imports/MetadataViews.cdc"
+	viewResolverFile                         = "imports/ViewResolver.cdc"
+	fungibleTokenFile                        = "imports/FungibleToken.cdc"
+	burnerFile                               = "imports/Burner.cdc"
+	fungibleTokenMetadataViewsFile           = "imports/FungibleTokenMetadataViews.cdc"
+	fungibleTokenSwitchboardFile             = "imports/FungibleTokenSwitchboard.cdc"
+	dapperUtilityCoinFile                    = "imports/DapperUtilityCoin.cdc"
+	tokenForwardingFile                      = "imports/TokenForwarding.cdc"
+	packNFTFile                              = "imports/PackNFT.cdc"
+	defaultBurnerAddress                     = "BURNERADDRESS"
+	defaultFungibleTokenMetadataViewsAddress = "FUNGIBLETOKENMETADATAVIEWSADDRESS"
 )
 
 // GenerateTopShotContract returns a copy
@@ -159,3 +173,98 @@ func GenerateCrossVMMetadataViewsContract(evmAddr string, viewResolverAddr strin
 
 	return []byte(codeWithViewResolverAddr)
 }
+
+// GenerateNonFungibleTokenContract returns a copy
+// of the vendored NonFungibleToken standard with the import addresses updated
+func GenerateNonFungibleTokenContract(viewResolverAddr string) []byte {
+	code := assets.MustAssetString(nonFungibleTokenFile)
+	codeWithViewResolverAddr := strings.ReplaceAll(code, defaultViewResolverAddress, viewResolverAddr)
+
+	return []byte(codeWithViewResolverAddr)
+}
+
+// GenerateMetadataViewsContract returns a copy
+// of the vendored MetadataViews standard with the import addresses updated
+func GenerateMetadataViewsContract(ftAddr, nftAddr, viewResolverAddr string) []byte {
+	code := assets.MustAssetString(metadataViewsFile)
+	codeWithFTAddr := strings.ReplaceAll(code, defaultFungibleTokenAddress, ftAddr)
+	codeWithNFTAddr := strings.ReplaceAll(codeWithFTAddr, defaultNonFungibleTokenAddress, nftAddr)
+	codeWithViewResolverAddr := strings.ReplaceAll(codeWithNFTAddr, defaultViewResolverAddress, viewResolverAddr)
+
+	return []byte(codeWithViewResolverAddr)
+}
+
+// GenerateViewResolverContract returns a copy
+// of the vendored ViewResolver standard, which has no imports
+func GenerateViewResolverContract() []byte {
+	return []byte(assets.MustAssetString(viewResolverFile))
+}
+
+// GenerateFungibleTokenContract returns a copy
+// of the vendored FungibleToken standard with the import addresses updated
+func GenerateFungibleTokenContract(viewResolverAddr, burnerAddr string) []byte {
+	code := assets.MustAssetString(fungibleTokenFile)
+	codeWithViewResolverAddr := strings.ReplaceAll(code, defaultViewResolverAddress, viewResolverAddr)
+	codeWithBurnerAddr := strings.ReplaceAll(codeWithViewResolverAddr, defaultBurnerAddress, burnerAddr)
+
+	return []byte(codeWithBurnerAddr)
+}
+
+// GenerateBurnerContract returns a copy
+// of the vendored Burner contract, which has no imports
+func GenerateBurnerContract() []byte {
+	return []byte(assets.MustAssetString(burnerFile))
+}
+
+// GenerateFungibleTokenMetadataViewsContract returns a copy
+// of the vendored FungibleTokenMetadataViews standard with the import addresses updated
+func GenerateFungibleTokenMetadataViewsContract(ftAddr, metadataViewsAddr, viewResolverAddr string) []byte {
+	code := assets.MustAssetString(fungibleTokenMetadataViewsFile)
+	codeWithFTAddr := strings.ReplaceAll(code, defaultFungibleTokenAddress, ftAddr)
+	codeWithMetadataViewsAddr := strings.ReplaceAll(codeWithFTAddr, defaultMetadataviewsAddress, metadataViewsAddr)
+	codeWithViewResolverAddr := strings.ReplaceAll(codeWithMetadataViewsAddr, defaultViewResolverAddress, viewResolverAddr)
+
+	return []byte(codeWithViewResolverAddr)
+}
+
+// GenerateFungibleTokenSwitchboardContract returns a copy
+// of the vendored FungibleTokenSwitchboard contract with the import addresses updated
+func GenerateFungibleTokenSwitchboardContract(ftAddr string) []byte {
+	code := assets.MustAssetString(fungibleTokenSwitchboardFile)
+	codeWithFTAddr := strings.ReplaceAll(code, defaultFungibleTokenAddress, ftAddr)
+
+	return []byte(codeWithFTAddr)
+}
+
+// GenerateDapperUtilityCoinContract returns a copy
+// of the DapperUtilityCoin stand-in with the import addresses updated.
+// The stand-in is a standard fungible token with the production contract's name and paths.
+func GenerateDapperUtilityCoinContract(ftAddr, metadataViewsAddr, ftMetadataViewsAddr string) []byte {
+	code := assets.MustAssetString(dapperUtilityCoinFile)
+	codeWithFTAddr := strings.ReplaceAll(code, defaultFungibleTokenAddress, ftAddr)
+	// FUNGIBLETOKENMETADATAVIEWSADDRESS contains METADATAVIEWSADDRESS, so it has to be replaced first
+	codeWithFTMetadataViewsAddr := strings.ReplaceAll(codeWithFTAddr, defaultFungibleTokenMetadataViewsAddress, ftMetadataViewsAddr)
+	codeWithMetadataViewsAddr := strings.ReplaceAll(codeWithFTMetadataViewsAddr, defaultMetadataviewsAddress, metadataViewsAddr)
+
+	return []byte(codeWithMetadataViewsAddr)
+}
+
+// GenerateTokenForwardingContract returns a copy
+// of the DapperUtilityCoin TokenForwarding stand-in with the import addresses updated
+func GenerateTokenForwardingContract(ftAddr string) []byte {
+	code := assets.MustAssetString(tokenForwardingFile)
+	codeWithFTAddr := strings.ReplaceAll(code, defaultFungibleTokenAddress, ftAddr)
+
+	return []byte(codeWithFTAddr)
+}
+
+// GeneratePackNFTContract returns a copy
+// of the PackNFT stand-in with the import addresses updated
+func GeneratePackNFTContract(nftAddr, metadataViewsAddr, viewResolverAddr string) []byte {
+	code := assets.MustAssetString(packNFTFile)
+	codeWithNFTAddr := strings.ReplaceAll(code, defaultNonFungibleTokenAddress, nftAddr)
+	codeWithMetadataViewsAddr := strings.ReplaceAll(codeWithNFTAddr, defaultMetadataviewsAddress, metadataViewsAddr)
+	codeWithViewResolverAddr := strings.ReplaceAll(codeWithMetadataViewsAddr, defaultViewResolverAddress, viewResolverAddr)
+
+	return []byte(codeWithViewResolverAddr)
+}
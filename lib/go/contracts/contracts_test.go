@@ -54,3 +54,48 @@ func TestFastBreakContract(t *testing.T) {
 	assert.Contains(t, string(contract), addrA)
 	assert.Contains(t, string(contract), addrB)
 }
+
+func TestVendoredStandardContracts(t *testing.T) {
+	contract := contracts.GenerateNonFungibleTokenContract(addrA)
+	assert.Contains(t, string(contract), "import ViewResolver from 0x"+addrA)
+
+	contract = contracts.GenerateMetadataViewsContract(addrA, addrB, addrC)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.Contains(t, string(contract), "import NonFungibleToken from 0x"+addrB)
+	assert.Contains(t, string(contract), "import ViewResolver from 0x"+addrC)
+
+	contract = contracts.GenerateViewResolverContract()
+	assert.Contains(t, string(contract), "contract interface ViewResolver")
+
+	contract = contracts.GenerateFungibleTokenContract(addrA, addrB)
+	assert.Contains(t, string(contract), "import ViewResolver from 0x"+addrA)
+	assert.Contains(t, string(contract), "import Burner from 0x"+addrB)
+
+	contract = contracts.GenerateBurnerContract()
+	assert.Contains(t, string(contract), "contract Burner")
+
+	contract = contracts.GenerateFungibleTokenMetadataViewsContract(addrA, addrB, addrC)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.Contains(t, string(contract), "import MetadataViews from 0x"+addrB)
+
+	contract = contracts.GenerateFungibleTokenSwitchboardContract(addrA)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+}
+
+func TestDapperUtilityCoinContract(t *testing.T) {
+	contract := contracts.GenerateDapperUtilityCoinContract(addrA, addrB, addrC)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+	assert.Contains(t, string(contract), "import MetadataViews from 0x"+addrB)
+	assert.Contains(t, string(contract), "import FungibleTokenMetadataViews from 0x"+addrC)
+	assert.NotContains(t, string(contract), "ADDRESS")
+
+	contract = contracts.GenerateTokenForwardingContract(addrA)
+	assert.Contains(t, string(contract), "import FungibleToken from 0x"+addrA)
+}
+
+func TestPackNFTContract(t *testing.T) {
+	contract := contracts.GeneratePackNFTContract(addrA, addrB, addrC)
+	assert.Contains(t, string(contract), "import NonFungibleToken from 0x"+addrA)
+	assert.Contains(t, string(contract), "import MetadataViews from 0x"+addrB)
+	assert.Contains(t, string(contract), "import ViewResolver from 0x"+addrC)
+}
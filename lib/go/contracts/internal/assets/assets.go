@@ -8,7 +8,17 @@
 // ../../../contracts/TopShotMarketV3.cdc (15.791kB)
 // ../../../contracts/TopShotShardedCollection.cdc (7.984kB)
 // ../../../contracts/TopshotAdminReceiver.cdc (1.297kB)
+// ../../../contracts/imports/Burner.cdc (1.997kB)
 // ../../../contracts/imports/CrossVMMetadataViews.cdc (3.599kB)
+// ../../../contracts/imports/DapperUtilityCoin.cdc (10.077kB)
+// ../../../contracts/imports/FungibleToken.cdc (14.913kB)
+// ../../../contracts/imports/FungibleTokenMetadataViews.cdc (7.008kB)
+// ../../../contracts/imports/FungibleTokenSwitchboard.cdc (19.419kB)
+// ../../../contracts/imports/MetadataViews.cdc (30.544kB)
+// ../../../contracts/imports/NonFungibleToken.cdc (13.788kB)
+// ../../../contracts/imports/PackNFT.cdc (5.497kB)
+// ../../../contracts/imports/TokenForwarding.cdc (7.032kB)
+// ../../../contracts/imports/ViewResolver.cdc (2.71kB)
 
 package assets
 
@@ -238,6 +248,26 @@ func topshotadminreceiverCdc() (*asset, error) {
 	return a, nil
 }
 
+var _importsBurnerCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x41\x8b\xe3\x46\x13\xbd\xfb\x57\xbc\xbd\xd9\xc3\x8c\xbd\xdf\xd5\xec\x7c\xbb\xb3\xce\x2e\x09\x21\x09\x24\x03\x39\x84\xb0\xb4\x5a\x25\xab\x71\xab\xda\x74\x97\x46\x28\x8b\xff\x59\x6e\xf9\x63\xa1\x5a\x96\xac\x71\x66\x20\x87\x0c\x0c\x98\xee\x57\xf5\x5e\xbd\x7a\xad\xcd\x66\x83\x8f\x6d\x64\x8a\x70\x09\x06\x36\xb0\x44\x63\x05\x52\x1b\x81\x35\x8c\xca\x58\xe7\x9d\x18\x21\x48\x4d\x28\x29\x49\x6c\xad\xb8\xc0\x08\x15\x0c\xf7\x88\x94\x42\x1b\x2d\x21\x30\x2a\x1f\xba\xf5\x62\xb3\xd9\xe8\x3f\x76\xda\xcd\x15\xad\x84\x98\xf2\xc1\x1d\x1e\xda\x24\x8e\xf1\xbd\x77\x4c\xb8\x43\x2d\x72\x4c\xdb\xcd\x46\x3a\x27\x42\x71\x6d\x43\xb3\x31\x19\xf2\x45\x5b\x49\x7f\x2e\xfb\x86\xd8\xfd\x81\x4f\xa5\x63\xeb\x0e\xaf\xd4\x15\xbe\xa5\xe4\xf6\x7c\x2e\xf9\x68\x92\x38\xc3\xf8\xe1\xaf\x3f\xbd\xa7\xf8\x4a\x91\xb4\xb1\x08\x9e\x58\x16\xc6\x5a\x4a\x69\x69\xbc\x5f\x5d\x6c\x38\x7b\xf3\x75\x01\x00\xda\xf7\xd7\x9a\x18\xbb\x48\xc9\x12\x97\x01\xcb\x9d\x29\x89\x2d\xe1\x7f\xeb\xb7\x2b\xb5\x30\x92\x27\x93\xa8\xbc\x85\x6d\x93\x84\x66\x32\x2c\xc4\x84\xce\x79\x8f\x82\x10\xa9\x09\x4f\x54\xa2\x8a\xa1\x81\x35\x25\x59\x5a\x4f\x0c\x4a\x69\x0a\x4f\x79\x21\x0c\xc7\x42\xb1\x32\x96\xd0\x90\x61\x81\x04\x44\x3a\x7a\x3d\x90\xda\x25\xf8\x90\x04\x15\x19\x69\x23\xdd\xc2\x78\x1f\x3a\xc7\x7b\x5d\x4c\x60\x52\xb4\x29\x4b\x5d\xac\xf1\xbe\x30\xf6\x30\xd1\x34\x24\x75\x28\x15\x40\x9c\xda\x98\xb7\xdb\xa3\x0c\xe0\x20\x83\xe8\xd0\x23\x05\x85\x69\xbf\xae\x76\xb6\x56\x49\x7a\x3d\x29\x29\xe8\x76\x6a\x18\xe2\xc8\xe6\xc3\xde\x59\x14\x6a\x83\x66\x62\x1e\x99\xd4\xda\x1a\x26\x41\xdd\x3d\x68\x5f\xcd\x54\x6a\x8f\x47\xdf\xe7\x38\xe1\xf3\x23\x76\xc1\x7b\xca\xf0\xb1\xf7\xc4\xf1\xe3\x4f\x8f\x9f\xb6\x78\xac\x35\x6b\xbe\x47\x67\x7a\xe5\x4c\x44\x28\x88\xa9\x72\x32\x58\x9a\x8d\x99\x8c\x9b\xaa\x5d\xca\x0a\x7d\x67\xfa\x84\x36\x0d\x81\x2e\xda\xc8\xa3\x19\x8e\x87\xd2\x71\xfd\x6b\x3c\x0c\x36\x76\x75\x40\xe8\x58\x5f\xc8\x94\x76\x7d\x1c\xe7\x5e\xa4\x7a\x71\x73\xc3\x41\x6e\x6e\x26\x3a\x09\x93\x8f\xb3\xb2\x4c\xd0\x99\x3e\xc3\xe6\x99\x9b\x10\x97\x8d\x4f\x51\x18\xf2\x37\xab\x18\x15\xae\x50\xb5\x9c\x67\xd8\x9d\x17\xbc\x5c\x65\xec\x69\x31\xe9\xc8\x13\xe6\xd7\xbd\xf7\xa1\x30\x7e\x9c\x76\x58\x69\xce\xe4\xa4\x73\xfe\x9c\x9d\x68\xd5\xde\x3d\x11\x5f\xd2\xf9\x5d\x95\x5d\x3b\xc6\xf0\xe4\x4a\x2a\x67\xe8\xe6\xe8\xa9\x21\x96\x94\x01\x97\x14\x8f\xd3\x5c\x92\xe2\x64\x60\xd5\x4c\x4e\x3b\x18\xf5\x8f\xea\x0c\x97\x7a\xc7\x17\x6d\x95\x50\xec\x4c\x2c\xd3\xfa\x1f\xde\x8d\x26\x2c\xbf\x40\x82\x52\x6f\xf1\xe1\x81\xfb\x9f\xcf\xe2\xde\xaf\x66\x0e\xba\xea\x8c\xc1\xfd\x3d\xd8\xf9\xd9\x95\xfe\x8d\x74\x03\xe6\xd9\x55\x24\x99\x1f\x9d\xa6\x5f\x9e\x04\x11\xef\xee\xce\x45\x6f\x16\x73\x32\xbd\x4c\x7a\x19\x61\xd2\x7b\x7c\xf8\x3a\x5a\x73\xba\x62\x4e\xeb\x17\xf6\x78\x2d\x2b\x5d\xe8\x41\x3e\xd1\xc8\x60\x62\x9c\x71\xfc\x36\x1b\xfe\xf7\x2b\x9a\xae\x76\x9e\x14\xbf\xf6\xc4\x7b\xa9\xf1\x7f\xbc\xbd\x82\x8c\x33\x39\xa1\x46\xbb\x2a\x78\xf8\x66\x7d\x76\x31\xc9\x95\xb4\xac\x9d\x7c\x95\xe5\x2f\xdf\xdd\x69\xd5\x73\xc4\xe9\xc5\x51\x4c\x8c\xaf\x0c\x53\x3a\x2b\x73\xc7\xbe\x35\xa9\x56\xc7\x7e\xc9\xdf\x91\x2d\x66\xd3\x5d\x9b\xa8\xe5\x07\xea\x13\xee\x73\x97\xb5\xfe\x7e\x61\x7c\x3d\xfe\xf7\xf3\xe7\x4e\x83\x01\xcb\x03\xf5\xdb\xa1\xfc\x99\x23\xab\x37\xff\x89\x27\xca\x74\x6d\xca\xcb\xf9\x8c\x57\x39\x3c\x2d\x4e\x7f\x07\x00\x00\xff\xff\x5f\x32\xec\xc3\xcd\x07\x00\x00"
+
+func importsBurnerCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsBurnerCdc,
+		"imports/Burner.cdc",
+	)
+}
+
+func importsBurnerCdc() (*asset, error) {
+	bytes, err := importsBurnerCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/Burner.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x71, 0xaf, 0x18, 0xe2, 0x27, 0x98, 0x4c, 0xd4, 0x34, 0xa3, 0xad, 0x0, 0xbb, 0x2f, 0x36, 0x18, 0xb7, 0x64, 0x82, 0x84, 0x2b, 0xae, 0x92, 0xe, 0xe5, 0x56, 0x62, 0xc3, 0x7c, 0x8b, 0xf3, 0x31}}
+	return a, nil
+}
+
 var _importsCrossvmmetadataviewsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x5b\x6f\xdb\x38\x13\x7d\xf7\xaf\x98\xef\xa5\x48\x0a\x47\xfe\x8a\xbe\x14\xc6\x66\x8b\xb4\x75\x80\x00\x75\x5b\x24\x59\xf5\x71\x4d\x49\x23\x8b\xbb\x14\x29\x90\x23\x2b\x46\x91\xff\xbe\x18\x52\x57\x5f\x90\x16\xbb\x7e\x71\x62\xce\x9c\x39\x73\xe1\x19\xca\xb2\x32\x96\x20\x96\xd8\xdc\xa3\x33\x6a\x87\x16\x72\x6b\x4a\xf8\xff\x53\x7c\xb7\xfa\x7e\xbf\x7a\xf8\xfa\x39\x5e\xdd\xdf\x7c\xfa\x74\xbf\x7a\x78\x98\xb5\xe6\xab\x78\xdd\x59\xad\xe2\x75\x77\x38\x5b\x2c\x16\xf0\x58\x48\x07\xa9\xd1\x64\x45\x4a\x20\xcb\x4a\x61\x89\x9a\x1c\xec\x24\x36\x0e\x8c\x95\x5b\xa9\x85\x52\x7b\xa8\xac\xa9\x8c\xc3\x0c\xa4\x86\xdb\xcf\x77\xdf\xae\xde\xbe\x79\x07\xae\xae\x38\x82\xd4\x5b\xf8\x72\xfb\x08\xa9\x51\x0a\x53\x92\x46\x3b\x8f\xde\x48\x2a\xd8\xf1\x2f\x4c\xe9\x2a\xc3\x5c\x6a\xf6\xef\x82\x08\x6f\x08\x22\xb5\xc6\x39\x48\x0c\x15\xf0\x51\x64\xa8\x53\x84\x57\xcc\x39\x6a\x19\xa2\xcf\x17\x1c\xd9\x3a\x25\xc7\xf1\x69\xc2\xda\x15\xa6\x56\x19\x24\x38\x40\x07\x9a\x54\x20\x38\x51\x22\x34\x62\x0f\x54\x08\x6a\xb3\xf2\xb5\xd8\xac\x91\x44\x26\x48\x30\xb8\xdb\x80\xb0\x13\x7f\x8e\x3d\x13\x69\x8a\xce\x5d\x08\xa5\x2e\x87\x70\x1f\x99\x6e\xbc\x9e\xb8\xc3\x8f\xd9\x0c\x00\x80\x09\xdf\x68\x40\x5d\x97\x90\xa1\x36\xbe\x32\x02\xe2\x75\x04\xb7\xc6\x82\x36\xcd\x9c\x59\x59\xf4\xe1\x8c\x56\x7b\xa0\xc6\x40\xbc\x76\x60\x34\xdc\x2a\x3e\x4f\x6a\x0a\x09\x7a\x94\xb4\xcd\xad\x87\xc7\xa7\x4a\xe8\x6c\x48\x10\x77\xa8\x09\x0c\xa3\x7a\x1c\x06\x6e\xdb\x82\x19\xa3\xb2\x91\x46\x6a\x8c\xfd\x3b\xea\x50\xfc\xf7\x38\x3b\x1f\x2b\x5e\xc3\x12\xfe\xb8\xd3\xf4\x0e\x7e\x78\x8b\x43\xab\x54\x38\xec\x7a\x74\xde\x60\x15\xaf\xfd\xe1\xf3\x50\x13\xdf\x40\x1b\x26\x36\x03\x41\x43\x35\x5f\xf9\x9f\x6b\x9b\x22\x28\xdc\xa1\x82\xca\x48\xed\xcb\x46\xc6\x53\x17\xce\x99\x54\x0a\x4e\x86\xe7\x78\x3a\x3d\x7d\x42\xf0\xe5\xeb\xe3\x6a\x19\xc6\x99\x9b\x0c\x42\x19\x8d\x20\x1d\x68\x43\xe0\xea\x3c\x97\xa9\xe4\x42\x91\x81\x9d\x50\x32\x13\x84\x20\x74\x8f\x2e\x8d\xee\x06\x71\x32\x83\xff\xeb\x03\x7c\xe0\xf9\x64\x42\xdd\xf9\xe3\xbe\xc2\x45\x9f\xc7\x6b\xa1\xb3\xd7\xfe\x9c\x59\x1e\xce\xa6\x4f\x8a\x63\xa3\x48\x8b\xd0\xac\x79\xb8\x1d\x54\x0c\xad\x65\x4f\x6f\x89\x16\x12\xe4\x1a\xe4\x22\x95\x4a\x92\xcf\x3e\xd9\xc3\x5d\x3b\x7b\x91\x33\x6a\x74\x6b\xd9\x21\x17\x29\x42\x89\x54\x98\xcc\x85\x61\x2b\x8d\x1d\xa0\xa5\xce\x8d\x2d\xdb\x3c\x75\xe6\x9d\xf1\x89\xe6\xe0\x10\xfb\xeb\xbc\x84\x82\xa8\x72\xcb\xc5\x62\x2b\xa9\xa8\x93\x28\x35\xe5\xc2\xe8\x5c\x99\x66\x91\x2b\x59\xb9\x85\x74\xae\x46\xb7\x78\xfb\xe6\xdd\xd9\x49\x0a\xd7\x94\x73\xf9\xd6\xa6\x32\xcc\x52\x77\x9f\x47\x3d\x1d\x57\x13\x7a\x89\x08\x53\xdb\x67\xe8\xef\x2e\xf5\xbd\x95\x0e\x2c\x52\x6d\xd9\x94\xef\xf2\xc9\x51\x54\x48\x90\x06\x70\xc6\x5e\xfa\x08\x47\x4c\x7c\x44\xae\x74\xc7\xa3\x8f\x29\xb2\xcc\xa2\x73\x2f\x61\x7f\x6c\xed\x6f\x82\xf9\x12\x6e\x0e\xfc\x4e\xe4\x3c\x99\x90\x36\x4e\x97\x23\x1e\x33\x69\xa4\x52\x90\x58\x99\x6d\x11\xc8\x9c\x25\x84\xbb\xf2\x88\x0c\xab\x28\x4b\xfe\x09\x4e\xdf\x0b\xf4\x9a\xd1\xde\x31\x24\x2e\x6b\x1b\xfb\x0a\x8c\x65\xdf\x2b\x2d\x48\xee\x30\x82\x2f\xfe\x1b\xbc\x72\x95\x28\x7c\xc5\xd8\x91\x2f\xa4\x86\xa6\x90\xe9\x30\xc9\x5d\x80\x1e\x74\xb4\x44\x32\xe9\xc8\xca\xa4\x26\xcc\xa2\xb3\x89\x84\xa0\xf1\x7a\x09\xf1\x7a\xd6\x5b\x49\x2d\xe9\xa2\xff\x8f\x3f\x47\xdd\x9d\x9f\x3a\x3e\xd7\xa0\xa9\xf1\xcb\xc5\x9b\xda\x4f\x38\x76\x3f\x5e\x8e\x46\x9d\x3f\x0e\x55\x1e\x8d\x58\xc2\xf5\x98\xf3\x59\xd3\x03\x22\x83\xd7\xc1\xc1\x31\xc0\x71\x16\x70\x7d\x22\xb5\x63\xc7\x2e\x1d\xb8\xee\x33\xeb\x8d\x9e\xc7\x62\x3e\xee\x55\x5e\x6b\xd8\x22\x0d\x37\xfd\xe2\x4f\x7f\x3f\xbb\x97\xc9\x12\x5e\xfd\x18\xbf\x54\xa2\xee\x8f\xe7\xcb\xe5\x48\x1f\xde\x8f\xaa\x26\x73\x3f\x01\xfe\x96\x5f\x4f\xc0\xa2\x76\x7b\x30\xe0\x05\x97\xef\xb7\x01\xe1\xf7\x8b\xcb\xc3\xd2\x77\x40\x2d\x0a\x08\xf7\xfe\xb4\x24\x75\x9f\x20\x28\xb0\x9b\x1c\x3c\xcf\x8e\xff\x6a\x0d\xb5\x54\x2f\x2e\xb9\x83\xcd\x36\x3c\x08\xf4\x9e\xa5\xda\x3f\x1f\x78\x29\x24\x08\x15\xdf\x96\xec\xf4\xce\xeb\x03\x0c\x9a\x11\x94\x82\x64\x89\x60\xf2\xa0\x0d\x52\x6f\xa3\xb0\xff\x4c\xc5\x32\x2f\x54\xc8\xbc\xf1\x0b\x48\x28\x65\x1a\x2f\x3c\x4e\x66\x78\x10\xbd\x0f\x50\x57\x59\x58\x35\xc2\x85\xb7\x43\x5a\x5b\xcb\x5b\xb3\xd3\x24\xc7\xab\x28\x82\xbb\xdc\xc7\xef\xc4\x38\xac\xd8\xf6\xc9\x31\x07\x6d\x20\xd9\x13\xba\x56\xb8\x06\xf8\x36\x49\xa9\xc9\x78\x2a\x4d\x81\x7a\x20\xff\x13\x1b\xe5\x03\xc3\x76\x0f\xaf\x83\xbd\x72\xef\xfb\xe2\x3c\xb1\x10\xfe\x54\x65\x27\xd2\x6b\x34\x6c\xf2\x5a\xe5\x52\xa9\x47\xb3\x8a\xd7\x1b\x48\x85\x52\xf3\x50\xac\x56\xe2\x26\x31\x26\xde\x64\xda\x7a\x79\xe0\xbe\xa2\xa3\xde\x75\x0b\x9e\xdf\xc6\x91\x5f\x02\xa9\xb1\x16\x5d\x65\x74\xc6\xf0\x0f\x46\xc9\x4c\xd2\x7e\x12\x63\xe3\xc9\x6f\x80\x58\x30\x3c\x93\x90\xd3\xf0\x36\xb5\xb0\xb5\x28\xf8\x3b\x57\xf8\x24\x13\x7e\x21\xec\xf9\x79\x80\x5a\x24\xaa\xe5\x5d\x32\x3f\xce\x7d\xaa\xc9\x36\x91\x64\x85\xdd\x83\xe7\x9a\x20\x35\x88\x9a\xdf\x8d\xe7\xe5\xd8\xf3\xe9\xd5\xd0\xb7\xe0\x40\x95\x4f\x58\x9c\x94\xc2\xd0\x96\xeb\x80\xf8\x2b\xfa\x32\xe9\xfb\x2f\xaa\xcc\xc4\xf7\x5f\x68\xcd\x04\xe7\xe7\x15\xe7\xdc\xc8\xfe\x07\xba\xf3\x3c\xfb\x27\x00\x00\xff\xff\xc7\xe8\x44\x64\x0f\x0e\x00\x00"
 
 func importsCrossvmmetadataviewsCdcBytes() ([]byte, error) {
@@ -258,6 +288,186 @@ func importsCrossvmmetadataviewsCdc() (*asset, error) {
 	return a, nil
 }
 
+var _importsDapperutilitycoinCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x6d\x73\x13\x39\x12\xfe\x9e\x5f\xd1\x9b\xab\xba\xb3\x8b\xc4\x09\xbb\xc0\xed\xba\x08\xd9\x40\x92\x3b\xea\x60\x8f\x22\x06\x3e\x50\x14\x91\x67\xda\xb6\x2e\x1a\xc9\x25\x69\xec\x78\x53\xfc\xf7\xab\xd6\xcb\x78\x5e\x6d\x27\x70\x55\xe7\x0f\x60\x7b\xd4\x2f\xea\x7e\xfa\x51\xb7\x1c\x9e\xcd\x95\xb6\x70\x99\xcb\x29\x1f\x0b\x1c\xa9\x1b\x94\x30\xd1\x2a\x83\xe3\xdb\xcb\x0f\x7f\xfc\xe3\xf5\xcb\x37\x17\xa3\x7f\xff\xeb\xe2\x8f\xb3\xf3\xf3\xf7\x17\x57\x57\x7b\x41\xe0\x2d\x5a\x96\x32\xcb\x3e\x72\x5c\x9a\x28\xf0\xf6\x62\x74\x76\x7e\x36\x3a\xfb\xf8\xfa\xe2\xd3\x55\x4d\xa0\x62\xa1\x55\xba\x62\xae\x55\xd5\x1e\x4b\x12\x34\xa6\xc7\x84\xe8\x43\xa2\xa4\xd5\x2c\xb1\x70\xce\xe6\x73\xd4\x1f\x2c\x17\xdc\xae\x5e\x29\x2e\x87\xb5\xed\xdc\xed\xed\x01\x00\x1c\x1d\x1d\xc1\x68\x86\x80\x0b\x94\x16\xec\x8c\x59\xe0\x06\x30\xe3\xd6\x62\x0a\xcb\x19\x4a\x90\xb8\x04\x4b\x32\x06\x98\x46\xc8\xb8\xb4\x98\x3a\xe1\xb2\x65\xaf\xc0\xe9\x36\x6f\xdd\x92\x1e\xcb\x54\x2e\xed\x10\x3e\x5c\xf2\xdb\x67\x4f\x0e\xc0\xae\xe6\x38\x84\x2b\xab\xb9\x9c\xf6\x4b\xe6\x95\x65\x02\x4c\x3e\x9f\x8b\x15\xa8\x49\xd3\x75\x03\x5c\x02\xde\x72\x63\x51\x26\xd8\xb0\xbc\x60\x1a\x2c\xe9\xb8\x72\x2a\xa2\xbd\xb5\x81\x2b\xab\x34\x9b\x22\x30\x99\xc2\xbb\x7c\x2c\x78\x02\xef\x98\x9d\x99\x86\x26\x81\x16\x3e\xb2\x5c\xd8\x20\x41\xab\x86\x50\xfa\xd0\x2d\xe1\xf5\x7a\x81\xf5\xfb\xd6\xf5\xef\x31\x41\xbe\x40\x7d\x0f\x91\xb3\x34\xe3\xb2\xd3\xa9\x66\x44\x38\x2e\x61\x92\x4b\x98\xa2\x7d\x15\x10\xe1\x50\xd5\xd3\x68\x54\xae\x13\x1c\xb9\x54\xd0\xbf\xa7\xfd\x21\x7c\xa6\x37\x5f\xe0\xce\x29\xa2\x97\x46\x9b\x6b\x09\x9f\x8b\x2f\xe8\x45\x8b\x9e\x77\x23\x76\x70\x39\xa2\xff\x5f\xf4\xfa\x07\xf7\x14\x3b\xe7\x66\x2e\xd8\xea\x01\x92\x2e\xf4\xe7\xcc\xb2\x7b\xcb\x8e\xd6\x80\x79\xd1\xeb\x17\xa2\x5f\xdc\xbb\x6f\xcd\x90\x52\x34\x29\x78\x62\x81\xe5\x88\xb6\x05\xf4\xc0\xc5\x7f\xfd\x45\x7f\x08\x67\x72\x75\x65\x75\x9e\xd8\xd3\x52\x90\xcd\x92\xdb\x64\x56\x2c\x2e\x3d\xa1\x57\xc2\x0c\xee\x1e\xf2\x61\x45\xb6\x94\xc2\xad\xc2\xbd\x86\x24\xbd\x26\x36\x24\x65\x08\x06\xc5\x64\xb0\x7d\xeb\x92\x8b\xfa\xc6\x77\xcd\x7a\x1f\x98\xf9\x69\xb3\xa7\x61\xf1\xe9\x41\x87\xb7\x05\x10\xfe\x77\xfe\x96\xb1\xb6\x83\xc7\xc5\xf2\xd3\x86\xcb\xfd\x87\x24\x7a\x1d\xae\x66\xae\x89\x22\x32\x4c\x39\x83\x93\xea\x19\x34\x78\x4b\xdf\xb6\xa7\xd8\x05\x8e\x0b\x1c\xd6\x44\xfe\x39\x1a\xbd\xbb\xe4\x02\xbb\xa5\x72\x2d\x86\xb0\x3f\xb3\x76\x6e\x86\x47\x47\xcc\x18\xb4\x66\xb0\xc4\xb1\xe1\x16\x0f\x49\xa5\x19\x24\x2a\x3b\x7a\x3a\x79\xf6\xf3\x6f\x4f\x92\xe3\xe4\xef\xec\xd7\x24\x4d\x9f\x3d\xf9\x65\xfc\x38\xf9\xf5\xe7\xe3\xda\x03\xf6\xf4\x69\x32\x7e\x9c\xfc\xf6\xcb\xb3\xaf\x97\x42\x2d\xbf\x7e\x52\x3a\xcd\x98\xbe\x19\x98\xc5\x74\xbf\xd5\x87\x7e\x3b\x0a\x5c\x04\x7c\x36\xf7\x79\xc6\xa6\x78\x64\x16\xd3\x47\xb7\x99\x68\x6a\xe9\x77\x87\xd0\xb4\xc7\xd0\xf4\x3e\xbb\xc7\x5f\x9a\xa2\xbb\x54\x5a\xc8\x5e\x7b\x4c\x25\xcb\xc8\xe7\x8b\x5b\x96\xcd\x05\x16\x8a\xfc\x61\xba\xdf\xbe\x59\xb3\xca\xc6\x8a\xd2\x70\x71\x39\xea\x58\x92\xa2\x49\x34\x9f\x5b\xae\xe4\x10\xf6\x47\x33\x6e\x88\xc5\xbc\x6a\x77\x9e\xd3\x49\x9f\x1b\x4c\x81\x19\x60\x74\xc2\x7a\xfb\x56\xc1\x0c\xc5\x1c\x56\x2a\x87\x14\x17\x28\x94\x7b\xaf\x41\xe2\xad\x85\xcb\x11\xfc\x45\x49\xca\xd4\xa0\xc3\x2e\xde\x5a\xd4\x92\x89\x0f\xef\xdf\xd4\xb1\x75\xb1\x7e\xd4\x2b\x00\x14\xec\x1e\x4e\xec\x40\xc9\x09\x29\x56\x7a\xba\xdf\x91\x64\xa1\xa6\xca\x0c\x43\xaa\x3a\x42\xa3\x12\xce\x84\x19\xd6\x08\xb5\xfc\xda\xb7\x4b\x6a\x70\xf4\xfe\x4e\x0e\x86\xc5\x0e\xd4\xe4\xdf\xd7\xb1\x50\xc9\x4d\x32\x63\x5c\xee\x37\xe1\x00\xee\x00\xa9\x7f\xf3\xa0\x9a\x2f\x53\xce\x03\x19\x3e\x6a\x68\x47\x9e\x29\xb7\x14\x8e\x38\xeb\xed\x4f\x7b\x88\x75\x6c\x61\xd6\x82\xcd\xae\xa6\xab\x4a\xbd\x87\x75\x9b\xdb\xe4\xa2\xc9\x37\x5c\xde\x60\x5a\x22\xed\xbf\x36\xfa\x45\xaf\xb0\xd1\x12\xd4\x3d\xf8\x7e\x4d\x89\x46\x66\xf1\x22\x9b\xdb\x95\x5b\x78\x99\xcb\xc4\x97\x5b\x6f\x92\xcb\x5e\x7f\x08\xbf\xdf\x55\xd2\xe3\xf5\x7d\xdb\x80\xcc\x90\xd4\xe7\x87\x4d\x5f\xea\xd6\x7a\x0b\xfa\xb7\xe4\xff\xef\xdd\xfe\x77\xc0\xb4\xf9\xf5\x03\x70\x5a\x6d\xa5\x1e\x82\xd3\x92\x86\x76\x9c\x56\xda\xfb\xe6\x2e\x4b\x8f\x37\x6c\xe8\x5b\xbd\xbd\x95\x5c\x94\xdb\x3d\x1a\x15\x5c\xbc\xe2\xa7\xe2\xdb\x0b\x96\xcc\x88\x29\xb5\x2b\x18\x74\x6c\xc9\xa5\xb1\x4c\x26\x48\x13\x8b\x92\x62\x05\x76\x86\x5e\x9c\xa6\x15\x3b\x43\xae\x63\x79\x55\x06\xad\x49\xc0\x88\x09\xcb\x82\x0c\xcd\x26\x53\xb5\x40\x2d\x31\x85\xb1\xd7\x36\xd7\x7e\x66\x99\x2b\x63\x69\xb2\x4b\xb9\x13\x2c\xd4\xf1\x5a\x50\xfd\xb8\x66\x67\xb8\x72\x83\x5a\xc2\x84\xc0\x74\x50\xb1\x9e\xcc\x30\xb9\x31\x30\xa3\x10\x4a\x60\x16\x74\x2e\x2d\xcf\xd0\x89\xe2\x02\x35\xb0\xc2\x43\x3a\x1e\x6a\x3a\x0a\x5d\xef\x43\x2f\x45\x2b\xa4\xdf\xff\x18\x43\x3d\xa4\x71\x67\x34\x8c\xd2\x91\xa1\x26\xc5\x47\x37\x9b\xba\x29\x93\xdc\x2c\xd4\x91\xbb\x29\x4e\xb8\x74\xc2\x07\x60\x14\x3d\xd7\x48\x2e\x48\x05\x4b\xb6\x82\x89\x22\xdf\x32\x26\x78\xc2\x55\x6e\x7c\x3a\xac\x0a\x36\x7d\x14\xd7\xa1\x51\x79\x30\xcb\x25\x30\xae\x07\x70\x06\x66\x8e\x74\x2e\x80\x1b\x4e\x35\xc4\x6e\x10\x24\x62\x6a\x48\xd3\x78\xed\x83\x55\x6e\xcc\x2d\xd4\xad\x47\xe0\x6a\x28\xca\x13\x42\xa1\xd0\xb9\x52\x1b\xb7\x7d\x21\xc6\xa1\xbb\x9c\x11\x87\x5d\x18\x33\x11\xc1\x64\xe9\xa0\x5e\x14\x38\xac\x9b\xa1\x69\x37\xac\xae\x4e\xba\x5e\x29\x70\xc9\x2d\x67\x82\xff\x89\x2e\xe8\x51\x31\xa5\x3a\x3a\xe8\x42\x46\x09\xa6\xcc\x17\xb2\x24\xd8\xab\x69\xee\xd7\x98\xca\xd1\x75\x54\x79\x12\x95\x97\x2a\xac\xb2\xbd\x57\x0e\x3c\x1e\x95\xac\xa5\xf7\x18\xe7\x0e\xed\x0b\xce\x9c\xab\xd7\x2f\xe9\xb3\x1e\xd0\xd7\xbd\xfe\x35\xf1\xf4\x4c\xa5\xf5\x20\x44\x14\xf9\x91\x8c\xd6\x92\x99\x31\x4b\x6e\x7a\x75\x6f\xf9\xa4\xea\xf0\x0b\x38\x1e\x1c\xb7\x70\xef\x46\x42\x81\x93\x2d\xcf\x0f\x2b\x46\x2a\xca\xbf\x6d\x0a\xde\xf1\xe0\xb8\x2b\x70\xaf\x65\x2d\x5a\xe6\x20\xd4\x03\x15\x8a\x54\x1e\xcb\x13\x9e\xb8\x01\xc6\xb8\xda\x58\x7f\xe5\x2a\xe1\xa0\xa2\xf0\x4a\xc1\x12\x5d\xa9\x6a\x95\x5b\x4f\x0d\x0e\xf3\xf1\xa6\x20\x50\x92\x55\xd5\x4a\x0d\xea\xe3\xf3\x76\x40\x96\x2e\x1b\xfc\x25\x43\xdb\x8d\x42\x89\x76\x9b\xe1\xdc\x72\x4d\x21\xb9\xe8\xb7\x85\xaa\x63\x3e\x77\xc3\xde\x57\xe7\xd7\xe6\x01\x7c\xa3\x4f\xf7\x9e\x20\xe9\x5d\xab\x9b\x94\x80\x29\x5a\x82\x8b\xd2\x16\xd3\x8f\xf1\xd8\x36\xa0\x5c\x77\xce\x84\x58\x05\x47\x0c\x30\x10\xdc\x38\xf2\x72\x89\x74\x97\x66\x26\x52\x26\x37\x45\x1f\xe4\x76\x3f\xb7\xdb\x73\xd2\x62\x97\x32\x74\xe7\xbd\x7e\xa9\x94\xa8\xf7\x23\x34\x0b\x99\x28\xe5\x04\x6a\xcb\x4f\xe0\xae\x06\xed\xca\xea\xcf\x0e\xe9\x53\x74\xc6\x7a\xfd\x2f\x70\x02\x56\xe7\xd8\x16\xf7\xaa\xe0\xb6\x24\x17\xdb\xe2\xa6\xb9\xab\x9e\x2d\xdf\xb8\x90\xa3\xed\xa9\x8e\xce\xb5\xc6\xe5\xb3\x75\xb8\x3d\x3d\x85\x09\x13\xa6\x93\xd9\xce\xcc\x8d\x21\x7a\xa1\x62\xf1\xb7\x9c\xae\xb8\xc6\x08\x4b\x6e\x67\xa9\x66\xcb\x70\x5f\xbc\x8d\xcc\xd7\x1b\x3a\x5b\x30\x2e\x98\x3b\x2f\x3e\x05\x1d\xb5\x0b\xd4\x8d\xbb\x0a\x5e\x3c\x3f\x69\x67\xa3\x9a\xff\xd1\xcb\xf2\x97\x95\x05\xb1\x93\x0d\xc0\x63\x37\xbe\xe9\x09\x56\xfc\xbc\xc8\xf4\x34\xcf\x50\xda\x8a\x20\xf5\x2b\x51\x7b\x80\x6d\x10\x0a\xf1\x08\xe7\xe3\xa0\xd3\xf4\x6b\x1b\xce\x74\xaa\x05\x77\xf0\x62\x36\x57\x9a\xe9\x55\x68\x95\xe2\x25\xb5\x1b\x5d\x69\x58\x55\x22\xad\x68\xb0\xb3\x48\x9b\xc1\x01\x8d\x30\x46\x2e\xa7\x60\x35\x93\x66\x82\x5a\x63\x3a\x20\x43\xb1\xe8\x48\x42\xe2\xb2\xd4\x3e\x92\x9e\xd8\xce\x04\xb3\xaa\xd2\xd4\x38\xcd\xbe\x3d\xa2\x76\x85\x17\x08\x48\x71\xae\x0c\x8f\x57\xe4\x51\x17\x0a\x83\x4b\xa2\xf0\xf6\x8d\x07\x50\x54\x7b\x86\x88\x03\xcf\x6e\xcb\x4e\x54\x74\xf5\xfd\x9b\x0f\xef\xca\xc7\xc3\x90\xa5\x36\x68\x3d\x3f\x2c\xf7\x58\xeb\x16\xc1\x4b\x74\x52\x5e\x88\xc3\xfd\x20\x16\x62\xad\xc6\xff\xc1\xa4\x8e\x33\x87\x2d\x96\xa6\xa6\xa2\x86\x5b\x53\x34\x39\x21\x45\x95\x66\x0a\x41\x2d\x25\x6a\xb3\x03\xec\xb8\x01\x26\x84\x5a\x7a\x58\xa5\x68\xac\x56\xbe\x13\x37\x64\xde\xbb\x36\xc6\x84\xe5\x06\xd7\x48\xae\x16\x16\xb9\x5c\x42\x2c\x61\x13\x75\xf4\x24\xb4\x90\xae\xef\x73\xb2\x7f\x5b\xfb\x3e\x63\xd5\x7d\x8d\x11\x25\x81\xcd\xe4\x19\xa6\x6e\xeb\xae\x03\x98\x28\x8d\x6b\xa4\x39\x0f\x63\x7f\xde\x81\xa9\xe2\x78\x0c\x09\xe9\x51\x21\x76\x4d\xa6\xf5\x16\x8a\x8e\x02\x7f\x0e\x3d\x3f\xf4\x15\xcc\xcc\x4f\x9d\x80\xdb\x19\x6e\x8f\xbc\xd2\xd6\xa6\x29\xc6\xbd\xca\x9a\x35\x70\xd5\x67\xe1\x1d\x51\x56\x25\x16\x9f\x50\xaa\x2b\x60\x65\xd0\xfc\x89\x5a\x35\x48\x2d\x52\x05\x5f\x33\x01\x13\x82\x48\x25\x30\x02\xcd\x18\x6e\x28\xc9\x72\xe3\x99\xc1\xb3\x7f\xec\x9e\x1a\x1a\xdd\x2c\xe9\x34\x79\xdd\x05\xd3\xd4\xe7\x47\xfa\x42\xe9\xd4\xcf\x3b\x0e\xa1\xfe\x79\x55\x63\x92\x38\x9a\xf5\x83\x0c\xf3\xad\x63\xec\x16\x62\xee\x4d\x31\x60\xf8\x26\x9c\x4e\xbb\xdd\xc0\xd3\xb8\x7c\xd8\x9d\x77\xb6\xd0\xc8\xf1\xe0\xb8\x3e\xa3\x97\x06\x72\x3f\xad\x75\xce\x9f\x91\x29\x3c\x87\xb8\x3d\xb1\x34\xa3\xa1\xcf\x87\xc3\xcf\xa7\x54\x85\x71\xa6\xbb\xdf\x2c\x17\x86\xc5\xbb\x4a\xa8\x49\x8d\xff\xa1\x73\x47\xd8\x91\x80\x29\x19\x3e\x70\x34\x46\x49\xcc\x22\x98\x6c\xe9\x47\xd0\x83\x4e\xf0\x95\x25\xea\xf0\xdb\x29\x8d\x6b\xd7\x1f\x7c\x8c\x7c\xdf\xb4\xf4\xa8\xed\xa0\x29\xb3\xcc\x6e\x67\x0d\xbd\x30\xe3\x1d\x3f\x38\xfb\xff\xe3\x0f\xce\x9e\x6a\x8a\x76\x74\xc0\x53\x94\x96\x4f\x38\xea\x7e\x3b\x4e\xeb\xcc\x53\x83\x64\x2b\xf7\x94\xc1\xf9\x5d\x9c\xf3\x63\xf9\xe6\xc7\x72\xcd\x0f\xe0\x99\xb6\x6a\x6b\xe5\x97\xda\xe5\xe6\x6e\xf8\x2c\x52\x08\x5b\xb8\x26\xa4\xd3\xdd\x79\x94\xcf\x3c\x77\x4a\x55\xf1\xfc\xf8\xf8\x98\x46\xf5\xea\x92\xfa\x35\x39\x9c\xc0\x51\x88\xe0\x51\x5a\x77\xb3\x7a\x42\xb5\x5d\x79\x93\xf8\xdc\x7d\xda\x49\xba\x79\xd7\xbe\x49\x41\x5c\x5d\xd5\x51\xff\x93\x82\x8d\x3b\x70\x8b\xa1\x72\xcf\xf4\xca\x07\x78\x7d\x83\xe9\x30\x5d\xa7\x32\x87\x83\xf0\xb7\x23\x04\x43\xb6\x40\x42\x34\x97\x95\xbb\x51\xaf\x72\xaf\x8d\x0e\x3a\x12\x59\xcf\xd3\xba\x92\xbb\x59\xa1\xd2\x72\xec\x42\x0e\x2d\xdb\x65\xe0\xa3\x0c\x09\x9b\xb3\xb1\x8b\x4f\x2c\x4f\x57\x62\x69\x79\x46\xc1\xdb\xb9\x32\x58\x3e\x24\xfc\x3d\x57\x28\x92\x78\xc3\xe5\xef\x7e\xd1\x9e\xb9\x81\x3e\x8c\xc2\xf1\x99\x9d\x69\x95\x4f\x7d\x60\xaf\x63\x26\xaf\xc1\x1d\x4b\x13\x96\x94\xe3\x17\x9b\x44\xb8\x0e\x7b\xbc\x6e\x55\xf2\x32\x3e\x6c\xd3\x51\xc9\x41\x03\x06\xaf\xd8\x3c\x76\x72\x81\x06\x06\x45\x1c\x38\x9a\x41\xc8\xe9\x80\x1b\x93\x6f\xfa\x39\xa5\xb5\x84\xfa\x55\x7c\xb6\x1a\x70\xc1\x37\xb3\x5e\x9b\x67\x07\xc0\x6c\xfb\x0f\x4a\xfd\xca\xae\xe2\x05\xca\xff\xcd\x66\x4a\x0e\x95\xf6\xd0\xac\xf1\xfe\x5e\xbb\xd2\xe8\x28\x15\x57\x2f\x9c\x5e\x07\x60\xd5\x70\x1b\x25\x95\x14\x52\x60\x7c\xe3\xb4\x2e\x39\xdf\xfb\xf4\x3a\xb6\x52\xb3\xea\x84\xbd\xd5\x56\x7a\x89\xb4\xfb\x6d\xef\xbf\x01\x00\x00\xff\xff\x11\x3f\x08\x22\x5d\x27\x00\x00"
+
+func importsDapperutilitycoinCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsDapperutilitycoinCdc,
+		"imports/DapperUtilityCoin.cdc",
+	)
+}
+
+func importsDapperutilitycoinCdc() (*asset, error) {
+	bytes, err := importsDapperutilitycoinCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/DapperUtilityCoin.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x71, 0x85, 0x88, 0x89, 0x50, 0x42, 0x84, 0x7, 0x30, 0xb5, 0xa4, 0x1c, 0x51, 0x87, 0x8b, 0x72, 0xdc, 0x64, 0x27, 0x94, 0xcb, 0xf8, 0x1b, 0x62, 0xeb, 0x9a, 0xde, 0xf, 0xf9, 0xa5, 0x98, 0x23}}
+	return a, nil
+}
+
+var _importsFungibletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\x5f\x73\x1b\x39\x72\x7f\xe7\xa7\xe8\xf3\x3e\x58\xdc\xd0\xa4\xab\x92\x4a\xaa\x54\x77\xb6\xe5\xb5\x9c\x28\x97\x4b\x52\x92\x6c\x3f\xa4\x52\x47\x70\xa6\x49\xc2\x02\x81\x59\x00\x43\x9a\xeb\xd2\x37\xcb\x5b\xbe\xd8\x55\x37\x80\xf9\xc7\xe1\x3f\xd9\xbe\xd5\xc3\x2e\x4d\xa2\x1b\xfd\xbf\x7f\xdd\x33\x93\x9f\x7f\x1e\x0c\x7e\x82\xfb\x25\xc2\x7b\x65\x36\xf0\xbe\xd4\x0b\x39\x53\x08\xf7\xe6\x01\x35\x38\x2f\x74\x2e\x6c\x3e\x18\xfc\xf4\x13\x4c\xd3\x8f\xfc\xdb\x14\x32\xa3\xbd\x15\x99\x1f\x0c\x6e\xe6\x20\xa0\x74\x68\x1d\x6c\x84\xf6\x0e\xbc\x81\x1c\x0b\x65\xb6\x20\x40\xe3\x06\x3c\x73\x4b\x04\x23\xf0\x4b\x94\xb6\x66\xa0\x11\x73\x26\x92\xab\x42\xe1\x0a\xb5\xa7\x13\xd0\xba\x0f\xa4\xf6\x68\xe7\x22\x43\x10\x3a\x8f\x1c\x98\xaf\x63\xfa\x5d\xf2\x8a\xc0\x41\x8e\x73\xa9\x31\x07\xa9\xc1\x2f\xa5\xab\x6e\x1e\x0f\x06\x93\xc9\x04\x7e\xa1\x7f\xca\x59\xe9\x8d\x75\x70\x51\x28\x14\x0e\x41\xe4\xcc\x93\xcf\x2b\xe9\x3c\xc8\x39\x6c\x4d\x19\x68\xe9\x30\xfe\x61\x78\xc9\xe4\x2f\xe0\xdf\x8d\x5b\x96\x02\xfe\x4d\x68\x2d\x34\xbc\x80\xa5\xf7\x85\xbb\x9c\x4c\x16\xd2\x2f\xcb\xd9\x38\x33\xab\xc9\x67\x3e\xb2\xe4\x13\x91\xea\xad\x70\x5e\x0a\x0d\x7f\xf9\xff\xff\x53\x0a\x6d\x83\xce\x6f\xa4\xf7\x68\x99\xd0\x97\x76\x66\x14\x6a\x1f\xa9\xde\xa1\x47\xb8\x5b\x4a\xab\x70\xbb\x87\x24\x47\x8f\xff\xf2\x8f\xe9\x96\xcf\xc2\x7a\x84\x3f\x0b\xab\x1c\xea\x3d\x14\x2f\xbf\x84\x63\x0f\x91\xe8\xaa\x74\x5e\x6a\xf8\xb3\x92\x1a\xf7\x90\x08\x3e\xf2\xd7\xb9\x32\x1b\xbf\x8d\x64\xff\x2a\xcd\x5a\x68\x2d\xe1\x4e\xe8\x6c\x89\xbf\xed\x21\x5d\x48\xf3\x57\xa9\x33\xb3\xd0\xd2\x9b\x4a\x2d\x2d\x7f\x83\xeb\x5c\xea\x4c\x3e\xec\xa1\x9b\xa9\x12\x9d\x5c\xe8\xca\xea\x5a\x6f\xfb\xad\x9d\xdb\xad\x2d\x35\x5a\x3a\xc9\xa7\x6f\xb1\x30\x60\x71\x8e\x16\x75\x86\x97\x7d\x34\x46\x93\x2e\x13\xfa\xcf\x8b\xb9\x0f\x21\xff\x51\x94\xca\x4f\xc1\xa2\x33\xa5\xcd\x1a\x31\x35\x18\x5c\x8b\x6c\x09\xf3\x94\x2f\x21\xc2\xab\x73\x7e\x5b\x20\xec\x09\xeb\xfd\x4c\xc7\xe1\xd2\xff\xb6\x66\x2d\x73\xb4\xd3\x11\x4c\x6f\x31\x43\xb9\xe6\xcf\x14\xf6\xd3\xb7\x42\x09\x9d\x61\x1f\xb5\x1b\x0c\xee\x97\xe8\x3a\x81\x9f\x29\x61\x11\x0a\x8b\x2f\x32\xa3\x73\xe9\xa5\xd1\x8e\x59\x15\xc6\xf9\xe6\x77\x7e\x29\x3c\x71\xf5\x56\x66\x7e\x40\x82\xe2\x17\xcc\x4a\xfa\x11\xcc\x9c\x25\x9f\x97\x3a\x0b\x87\x39\x91\x10\x58\x93\xf1\x60\x70\xe3\x61\x21\xd7\xe8\x62\x11\xa0\x9f\xc4\x4c\x2a\xe9\xb7\xa4\xfe\x4a\x3c\x20\x64\xa5\xf3\x66\x55\x89\x1d\xef\xab\x2c\x43\x17\xb6\x45\xa7\x1a\x62\x60\x2d\xac\x34\x25\x9d\x96\x7a\xe1\x60\x23\xfd\x92\xd9\x87\xcc\x1f\x0f\xde\x1b\x0b\xf8\x45\x10\x9b\x11\x08\x98\x8b\x32\x43\x0f\x99\xd0\x30\xc3\x9a\x3b\xe6\x30\xdb\x52\xe6\xce\x8d\x5d\x49\xbd\x18\x70\x62\x23\x24\x4b\xb7\x5c\xf0\xf3\x64\x30\x90\xab\xc2\x58\x0f\x1f\x25\x6e\x6e\xd1\x19\xb5\x46\x0b\x73\x6b\x56\xf0\xf2\xcb\xc7\x9b\xeb\x4f\xb7\xd7\x77\xff\xf5\x1f\x1f\xaf\x6f\xaf\xde\xbd\xbb\xbd\xbe\xbb\x4b\xc7\xdf\x96\x56\xd7\x07\xdf\x7e\xb8\xfd\xcf\xfa\x08\x47\x61\xab\xa2\x55\xb1\xd9\x29\xba\x95\xd4\x22\xd8\xda\x2d\x4d\xa9\xf2\x4e\x10\xb5\x03\x8f\xd9\x34\x74\x10\x59\x86\xce\x5d\x08\xa5\x86\x55\xad\x6b\x14\xd0\x96\x18\x97\x6d\x2d\xbf\x0e\x06\x00\x00\x93\x09\x5c\x69\x40\xed\xa5\x8f\xb7\xce\x8d\x05\xa1\x94\xd9\x48\xbd\x60\x11\xc8\x17\xb9\x15\x1b\xa1\x38\x3e\xd8\x21\x41\x77\x11\x02\x83\x19\x35\x45\x69\xb2\xfb\x14\xa9\xd3\x75\x13\x6e\x42\xb8\x0e\x0a\x52\x68\x38\xc0\x15\xe5\x7e\x0e\x9b\x25\xea\x74\x01\x45\x73\xba\x59\x57\xb4\xe1\x5a\xbd\x0d\x17\x77\x62\xcb\xb5\xd2\xae\x4e\xe1\x1d\xf1\xd6\x4d\xc1\xf4\x05\x25\xf1\x25\xdc\x79\x2b\xf5\x62\xc4\xa7\x0f\xfd\x89\x95\x29\xb5\xbf\x84\x0f\xef\xe5\x97\x7f\xfe\xa7\xe3\xe7\x49\xe4\x4b\xb8\xca\x73\x8b\xce\xbd\x3e\xed\xfc\x87\x0f\x37\xef\x2e\xe1\xc3\x8d\xf6\xa7\xdc\x50\x99\xe9\x3c\xb2\x59\x28\x31\x57\x73\x8f\x36\xa9\x33\x7c\x8a\x9b\x72\x2c\x8c\x93\x9e\x7b\x73\x45\xfe\x7d\x9c\xf4\x2e\xb1\xfe\xc1\x4e\xf2\xe6\x1c\x17\x79\x73\x9e\xa5\x2b\x03\x7d\x57\x07\x5d\x1f\x70\xce\x12\x61\xa1\xcc\x4c\x28\x98\x86\x62\x35\x9e\x95\x56\x5f\x0c\xa7\xb0\x42\xbf\x34\x79\xc5\x84\x40\x92\x50\x8a\x08\xa9\xe4\x0a\xd0\x46\xbf\xf8\x0d\xad\x49\xb7\xef\x71\x0c\x73\xed\x78\xa5\x6b\xf5\x9d\x50\x6e\x08\xff\xb6\xc1\x9d\xea\x23\x34\xa2\xae\x2e\x60\xac\x5c\x11\x8a\xb7\x03\x51\xe1\x54\x98\x4b\x54\xb5\x12\x54\xb2\x2c\x16\x16\x1d\x55\x1e\xbd\x68\xc9\x9e\xb8\x37\x75\xd8\x6d\xaa\x49\x20\xf8\x5a\xb9\xa5\x49\xb0\x16\x36\x31\x4d\xea\xf1\xb9\xc7\x5a\xa3\xd4\x63\x4e\x51\x09\xa9\x41\x85\xe6\x88\x60\xf1\xd7\x52\xda\x98\x1c\xa4\x4a\xca\x67\x6a\x60\x89\x49\xb3\xec\x32\xe8\x4d\xf9\xc4\x55\x7a\x5b\xe0\x78\xe7\xde\x1b\x0f\xb9\x41\x07\xda\x54\x17\xb6\xef\x32\x1a\xa6\xb3\x84\x33\x96\x68\x71\x54\xd1\xce\x30\x13\x25\xf5\x69\x0f\x0a\x05\x75\x7c\x53\xc4\xb8\x2a\x8c\x73\x32\x36\x7d\x33\x87\xcc\xa2\x60\x21\x62\xe3\x8f\xde\xb2\xae\x16\x9d\x34\xce\x0d\xcb\xa1\x91\x6c\x2a\xac\x54\x5b\x08\x68\x9e\xf1\xbd\xd9\xe8\x64\xde\xf1\x39\x4e\xab\xfa\x7a\xec\x66\xe9\xca\xf7\x11\xc0\x10\xb8\x10\xee\x01\x44\x25\x16\xa1\x7b\x01\xae\xc0\x4c\xce\x65\x16\x23\xb6\xee\x6b\x2d\x2e\xd2\x81\x58\x0b\xa9\x44\xe8\xbf\x84\x34\xda\x1d\xa9\xf6\x30\xcf\x1a\xd4\xbd\x67\x48\xd8\x68\x5e\x2a\xbe\x7a\x6d\x64\x0e\x85\xd0\x32\x23\x0b\x71\x6a\x52\xb6\xf1\x3f\x52\x5f\x6c\x32\xaa\x92\x37\x1a\x83\x44\x28\xf5\x83\x36\x9d\x0b\xaf\xf2\x80\xe6\x84\x52\xdb\x11\xa9\xc4\x8e\xa9\x54\x74\x50\x94\xe1\x16\x8e\x97\x55\xa9\xbc\x2c\x14\xc2\x9a\x8a\x6e\x47\x47\x0f\x46\x47\x67\x30\x16\xcb\x96\x98\x3d\x80\x33\x2b\x4c\x68\x30\x50\x41\xa9\xbd\x54\xfc\x45\x8e\x4e\x5a\xcc\xa3\xf1\xba\x26\xb3\x28\xb2\x25\xe6\x23\x28\x8c\xa7\xf8\x24\x19\x61\x89\xaa\x48\x5a\x43\x81\x96\xf0\x59\xed\xed\xa6\xc7\x13\xa7\x37\x85\xb0\x62\x95\x1c\xc4\x48\xb3\xe3\x2b\x0e\x66\x74\xa1\xef\xb4\x6e\xeb\xb5\xed\x1b\x8b\xbe\xb4\x1a\xde\x1a\xa3\xe0\xd3\x12\xfd\x12\x2d\x18\xcb\x61\xc9\xc3\x5f\xe4\xdf\xf5\x7a\x2f\xb3\xfe\x22\x21\x71\x43\x80\x0d\xa4\xbb\x4a\x1c\xee\x4d\xc2\x19\x17\xed\xea\x38\xbc\x64\x49\xda\x71\x9b\xee\x02\x57\xce\x18\xcc\xb9\xc3\x89\x9f\x52\xa2\xc5\x84\x40\x7f\xd0\xd5\x25\x84\x56\xe3\x69\x8b\x2b\xb3\x66\x8b\x05\x5c\xbd\xcf\xfc\xf7\x8d\x31\xe0\xb9\x8b\x6a\x82\xc2\x35\x2a\xb2\xd0\x34\xea\x9d\x94\x1b\x4e\x5b\xd4\x77\x86\x26\x07\x63\x29\x1a\x29\x0f\x02\xb5\xf4\x23\x30\x1a\x61\xc3\x99\x82\x92\x3d\x90\xaa\x00\x98\xd9\x67\x24\xf4\xea\x1d\xaa\x79\x8b\x1b\x01\xd2\x0a\xa3\xe6\xf5\x74\x17\xb4\x9a\x26\x19\xa6\xdf\x14\x4c\x0d\x4f\xd7\xc6\xee\xb5\x6f\x0a\xa4\xfb\x34\x18\xd5\xd6\xad\xca\x43\x4f\x39\xe9\x86\x4c\x65\x3a\x0e\x99\xcd\xde\x28\x79\xf3\x95\x2f\x79\x6c\x74\x26\xfa\xa3\xb1\xae\xf3\x55\xb8\x08\xa6\x16\x5d\x1c\x3c\xe7\x3c\xa5\x99\xa8\x0b\x4b\xbd\x16\xaa\xc4\x1d\xb2\x40\x32\x4e\x65\xe7\x4f\x7f\x4a\xbd\xbc\x17\xa4\x3c\x6b\x0d\x16\xe3\x54\x85\xc7\x49\x89\x4b\xf8\x45\x68\xca\xab\xca\xa2\xc1\x1c\x7f\x78\xd6\xcb\x6e\x9c\x19\x9d\x09\x7f\xf1\xec\xbe\x51\xf9\x62\xf1\xe9\x5a\x14\x2e\x9e\x0d\xd3\xf9\xb6\xd0\x63\x6f\x02\x0e\xb9\x18\x0e\x0f\x5f\x33\xa4\x08\xe6\xae\xf8\x6b\x29\x54\xb2\x4f\x8c\x88\x56\x51\x69\x56\x7c\xba\xf9\x20\xdf\xc0\xa0\x29\x46\x7d\x63\x87\xf4\x71\xd0\xfe\xd4\x80\x11\x69\x11\xf0\xed\x30\x22\xa2\xce\x1e\x14\x21\x75\xd4\xf9\x04\x14\xf1\x09\x53\xef\x96\x3a\x53\x65\x8e\x20\xa0\xda\x26\x04\x31\xb8\x65\xb8\x56\xdf\x8a\xf8\xa1\xe2\xb2\x41\xde\x18\x92\x4d\x17\x72\x8d\xa7\xec\x0f\x82\x19\xc2\xfe\xa0\xe2\x43\x03\x7f\x6e\xd2\xa1\xfe\x65\xc1\x08\x94\x7c\x40\x70\x85\x92\x9c\xeb\x2b\x28\x0b\x2a\x8a\x15\x13\x87\x61\xb1\xb8\xe2\xdd\x83\x9c\x73\x39\xf1\x50\x28\x91\xa1\x3b\x0b\x7f\x24\x67\x75\xf1\x47\x34\x3d\x78\xf1\x80\x75\x11\xa6\xc2\x1c\x7f\xa1\x62\xb8\xc7\x0d\xad\xdd\xd2\xb1\x8a\x56\x55\xaa\xc6\xa8\x95\x19\xed\x85\xd4\xae\x61\x92\xb8\xa8\xa5\x9b\x0f\x95\x24\x56\x93\xaa\x51\x3c\x7b\x11\x66\xd7\x54\x81\x86\x6d\x25\x17\xe8\xef\xca\xa2\x30\xd6\x63\xce\x07\xee\xb7\x05\xba\x46\xeb\xc9\x25\xb7\x0f\x61\x19\x28\x46\x09\xe9\xcc\x4e\xbb\xda\xc4\x76\xcc\x12\x6f\x0b\xc6\x3d\x59\x69\xc9\x2d\x6a\x0b\x2e\xdd\x02\xb3\x6d\x68\xd5\xad\x24\xe9\x35\x4f\xac\x76\x5f\xef\x79\x40\xa1\x4e\xfb\x08\x57\x4d\x89\xc2\xdc\xa4\x73\x99\x09\x1f\x33\xa8\xbe\x67\x57\xca\xde\x3f\xde\x87\x27\x79\x29\x45\x2a\x0e\x23\xf2\x6f\x5c\xe8\xcc\x10\xa6\x5a\xaa\xe9\x88\x8f\xcc\x85\x72\xfd\x6e\xed\x85\x12\xfd\x46\xbe\x18\x5e\xb6\x54\x6b\x3b\xe6\x36\x7a\x60\xd3\x05\x39\xc8\xc9\xa7\x2b\x91\xe9\xc6\xa2\xb2\x2b\xf6\x9a\x15\xae\x02\x00\x8c\xb1\x25\x74\xa4\xe2\x01\x9f\x19\x45\x35\x3f\x97\xce\xa7\x26\xe3\x6d\x89\xc4\x34\xc7\x79\xb5\x20\x3a\x10\xc5\xcc\xe6\x3e\xf9\xde\x1b\xf8\xb5\x44\xbb\x05\x31\x33\xa5\x3f\x03\xc3\x61\x92\x34\xaa\x57\x79\xe3\x74\x73\x4b\xb7\x6b\xed\x38\xe4\xd2\xc7\x04\xda\x3a\x35\xbb\xde\x82\xc5\xa7\x0c\x04\x6f\x39\xe9\x84\x52\x60\x58\xca\xc6\xc6\xd3\x99\xce\x4e\x24\x2c\x00\x2b\x06\x0c\xc8\x97\x62\xcd\xa6\x88\xcb\x4c\xfa\x18\x97\x26\xe7\x14\x28\xa6\xb8\xac\x1c\x3b\xaa\x26\xa6\x51\x1a\x78\x47\xad\xb5\xe0\x38\x7d\x18\xc5\x25\xe7\x98\xfe\xc7\x50\xb8\x3b\x62\xd1\x04\x1e\xf4\x20\xa4\xda\xa9\xff\x86\x32\x63\xdd\xf2\xfd\xb1\x71\xba\xc5\xfd\x97\xb8\x93\xa0\x79\x48\x74\xb7\xef\xd2\xc1\x8c\xd7\x0f\xb0\x96\x22\xac\x93\xf6\x2f\x39\x12\xc7\x9b\xce\xb6\x35\x76\x12\x8a\xe2\x99\x31\x0f\x0f\x88\x3c\xa0\x18\xcb\x9b\x14\xfe\x9e\x37\x1d\xed\x32\xc0\xfa\xd6\x79\xdd\x5a\xba\x44\x85\x49\xbc\x1c\x9d\xb7\x66\x8b\x79\x0b\x92\xc2\x5f\x88\x6b\x77\xed\xbb\x91\x4a\x55\xcd\xb1\x2c\x72\xe1\xb1\xae\xdc\xcf\x29\x8a\xbc\x50\x1c\xcb\x6a\xdb\x96\x85\x1f\x74\x28\x02\xcd\xc1\x0d\x75\xb9\xe7\xe0\x99\x21\xea\x64\xa8\x30\x13\x04\xe8\x5f\xf5\x8c\xc0\x73\x7c\xd0\x4c\x42\x39\x03\xe9\xb9\x9b\x43\xdf\xf2\xb2\x37\x10\x56\x45\x38\x37\x36\x48\x4d\xad\xb5\xf3\x14\x61\x77\x54\x96\x9c\x1b\x85\x0d\xab\xa4\x60\x35\xb3\xd1\xd4\xed\x59\x38\x57\x88\xd5\x8a\xc7\x57\x42\x04\x61\xd5\x14\xbd\x31\xee\xc6\x53\x5a\x7b\x87\xc6\x45\xea\x52\xec\xcc\x44\xf6\x70\x31\xec\x42\x66\x8b\x3d\x88\x99\xdd\xdd\x5a\x67\xd1\x00\x32\x5e\x60\x48\xfe\xe1\x58\xe6\xd4\x99\xe7\x92\x92\x22\xa1\x73\x3e\x32\x4b\x19\x54\xef\xb9\xf8\xfb\xb2\x94\xf9\x3e\xbc\x07\xfb\xa1\x7b\x93\x27\x21\xf0\x97\xe3\x97\x27\xc1\xef\xf0\x70\xa6\xa9\x79\x85\xbf\x67\x5c\x8c\xc9\xe4\x8d\x41\xa5\x95\x2b\x63\x38\x03\x93\xaf\xa8\xc4\xcf\x30\xc4\x41\xf4\x7d\x5e\xda\xf4\xa0\xa0\x29\x42\x4c\xc1\xba\xd6\x71\xef\x60\x99\x30\xf8\x17\xf3\xf1\x5e\x54\xbc\x6b\x0e\xb2\x46\x03\x34\x9f\x00\x44\x76\xc6\xd9\xd8\x8c\x3a\x09\x48\xc1\x18\x91\x6d\xce\x4b\xb0\x6a\xf7\x15\xf7\x1e\xc2\x22\xe0\x97\x02\xb3\x4e\x23\x21\x0b\x70\xa5\xae\xba\x68\xbd\xcb\xa2\x38\x1a\x05\xdd\x31\x96\x72\x5d\xa7\x51\x5c\xd4\xd0\x50\xdb\x96\xa5\xc5\x9e\x20\x3c\xbb\xae\x2f\x8f\xbe\x07\x68\xe8\x84\xe0\x64\x02\x6f\x51\x99\x4d\xdc\x00\x91\x59\x1a\x8f\xd5\x12\x3c\x77\xa5\x8d\xc3\x87\x2d\xf5\x0b\x2f\x57\xb1\x69\xf3\x4c\xdf\xe5\xc7\xe6\x59\x60\x82\x05\xcd\xed\x74\x21\x18\x73\x57\x2d\x2b\x4b\x4d\x33\xcc\xf4\x3d\x11\x3e\x1d\x43\x8b\xbf\x9c\xef\x24\xaa\xbb\x2b\x67\x24\xcd\x85\x99\x87\x7e\xfd\xc7\x37\x5f\x7b\x38\x3d\xbe\xba\x18\x76\x6b\x03\xf0\x10\x1c\x30\x63\x9b\xed\x25\xa3\x99\x76\x6c\x3e\x02\x2a\xd7\x57\x4c\x2a\xf4\xc5\x1b\x8b\x55\xe1\xb7\x4d\xcc\x29\x5c\xdc\x9f\x85\x40\xe4\x51\x3c\x2e\xaf\xa3\x19\x36\x4b\x03\xb9\xd1\xcf\x7d\x1f\xe7\xfa\xc9\x60\xaf\x7d\x46\xe0\xca\x6c\x49\x97\xb4\x7f\xbe\xdb\x48\x9f\x2d\x67\x46\xd8\x7c\x3a\x82\x29\x7f\xf7\xde\xd8\x8d\xb0\x39\xda\x29\xa0\xcf\xc6\x7b\x4d\xf1\xb8\x77\x6c\x6d\xb7\xeb\x30\x01\xc6\x45\x64\x1b\x64\xee\xa2\xf7\x8f\xfb\x71\xc1\x79\x38\xac\xe3\x80\x28\x74\x72\x5f\x6f\x0a\xfc\x0f\x31\xf9\x5f\x78\xfd\xba\x03\xc4\x1f\x8f\x2e\xe2\xa6\xa1\xf6\x4f\x3b\x33\xd7\x73\xd7\x7a\xda\x00\xbd\x4b\x38\x8d\x9b\xee\xaa\x28\x31\x26\xbb\xec\xd2\x7f\xef\x65\x51\x6f\xe7\x6b\x55\xd8\x57\x67\xad\x7c\x42\xcf\x39\xba\xef\x39\xa5\xb9\x9c\xb2\x77\xd9\xbf\x62\x39\x61\xd3\xb3\xb0\x28\x3c\x0f\x97\x42\x77\x41\x6a\x3d\x3a\x1f\xdb\xee\x34\x8d\xd5\xbf\xe3\x39\xdc\xce\xf6\x34\xfd\xb8\xc3\xaa\xaa\x0d\x35\xfe\x6e\xfd\xf9\x3b\x3b\xa4\xa2\x89\x2d\xdc\x5b\x19\xbc\x62\xab\xca\x26\x75\x66\x56\x85\xf0\x0c\xca\xeb\xc1\x1e\xfe\x78\xc4\x88\x5d\x6d\x1b\xd8\xaa\x36\xe4\xab\x31\x1c\x61\xf3\xec\xc6\x07\x2c\x92\x24\xda\x49\x2e\x91\xfa\x92\x70\x6c\xce\xa3\x92\xed\xc5\x7c\x4d\xb9\x9e\x35\x36\x20\xe9\x6f\x32\x01\x02\x0b\x7c\x6b\x81\x99\x14\xaa\x6a\xd8\x30\x0d\xc0\x78\xca\x6b\x2f\x4c\x23\x2a\x15\xfd\x18\x7a\xf5\xe3\xb7\xfa\x81\x66\x87\x79\x04\xf6\x33\x5c\x48\xad\x79\x42\x69\xa3\xeb\xfa\xed\x9d\x1e\xea\xa3\x18\x33\x08\xd8\x0a\xee\x21\xbc\xf8\x21\x95\xa0\x3f\x8c\x5b\x91\xe7\x50\xe7\x68\xeb\x8a\x0a\x62\xee\xe3\x56\xa8\xf1\x12\xca\x53\x33\xf5\x58\xa9\x48\xe8\x96\x5b\x74\xdc\x0a\xd6\x55\x82\xa6\x15\x7e\x43\x29\xc9\xd6\x28\x4b\x3d\x56\x6c\x5e\x7c\xf4\xe6\xf8\xa2\x63\x2a\x85\x27\x96\xbe\xd6\x76\xb9\x7f\xac\xa9\xdf\x71\xe9\x15\x81\xfe\x42\x53\x3d\x90\x98\xfb\xdf\x53\x48\xdd\x27\xfc\x7f\xff\xb9\xb0\x45\x64\xeb\xf0\x94\xf7\x7a\x2c\xc2\xab\x16\x87\x49\x3a\x13\xd5\xfe\xc3\x9d\xf7\x5f\xa2\x2e\x87\x69\xda\xef\x56\x34\x3d\xb7\x43\xb2\x7f\x75\x7f\xe2\xce\x57\xe4\xb9\x03\xe9\x5d\x73\x6e\xde\xe9\x43\x3b\xb8\xe8\x9c\xfd\x6c\x1f\xc8\xed\x22\xdc\xc9\x04\xae\x9c\x43\xdb\xd8\x16\x50\x00\x59\xf4\xb1\x54\xc6\x40\xaf\xdf\xe6\xa9\xd6\x19\xa9\xa4\x76\xf9\x45\x3c\xdb\xd8\x0f\x56\x4b\xc5\x34\x13\x76\xb7\xce\xb0\x17\x8d\x90\x2e\x63\xe9\x6e\xb4\xf3\x64\x95\x4e\x45\x3e\xab\x0d\xc6\x4b\xab\x62\x54\xb9\xe6\xf4\x26\xd8\x6f\x91\xb8\x5e\x39\xd6\x4c\x58\x95\xde\x66\x72\xf8\xe2\x57\xb0\x14\x2e\xe2\x9f\x9d\x36\x56\x43\x95\x19\x92\x75\x6b\xa9\xf8\x39\xc2\xd3\x1b\xdc\x11\x99\xc6\x01\xdd\x07\xff\x6e\x4d\xd9\x7a\x33\x8f\x04\x69\x3c\xd6\x60\xaf\x9b\x18\x5b\xd6\x62\xe6\xa1\x10\x7e\x59\xbd\x47\x1a\x0a\x7c\x04\xc7\xf1\xd1\x89\xc8\x32\x2a\x1f\xee\x68\xd3\x0f\x55\x92\x43\xd7\x38\x8c\x8c\x97\x46\xe5\xb5\xad\x1a\xcf\x18\x9e\x84\xc6\xb8\x68\xd6\xef\x9c\x1d\x29\x9a\xb1\xec\xef\xf7\xf6\xf1\xd2\xd9\x64\x91\xfa\xef\x7e\x2a\x6f\xce\x2c\xa3\xe9\x45\xb5\x13\x8a\x68\xe7\x1d\xb5\xa6\x60\xbc\xcf\xfa\x01\xa5\x14\x4e\x05\x24\xff\xd0\x6b\xa7\x33\xe0\xc9\xd1\x82\x70\x02\x38\x49\x01\xdb\x0b\x4f\x12\xc3\xbf\x07\x36\x71\xe5\xea\x77\x00\x25\x75\xb9\xd9\xbd\xa3\xe5\x96\xb3\x1f\x80\xb7\xba\x28\xbf\x4e\x86\xd7\xab\xc2\x6f\x63\x03\x55\xca\x6c\x1c\xef\xe3\x4b\x17\x5e\x27\x09\x67\x5a\x63\x35\xd7\x05\x2a\x9e\x02\x76\x5e\x5e\x84\x03\xcf\x07\xaf\x22\x7d\xb4\x67\x5d\x70\x1b\x0c\x1b\x6d\x9a\x78\xef\x6d\xca\x5d\xd1\x2f\xce\x7e\x79\x63\xf7\x2d\x8c\xf3\x76\xc0\x5d\x09\x2e\x81\x3f\x47\x1d\xc3\x9b\x7a\x46\xc3\x5c\x48\x85\xf9\x49\x6d\x50\xe3\x46\x6d\xa3\x66\x79\xe4\xc3\xb1\xc8\x3b\xcd\xa6\xa9\x61\x56\xf2\x96\x77\xc7\x66\xa7\x8d\x84\x7d\x39\xf1\x94\x61\xf9\xf7\x34\x56\x13\x33\xd4\xa1\xf9\x2d\x33\xf1\x77\x81\x0b\xe1\xc1\x6d\x74\xa1\x3c\x2e\xd0\xd3\x91\xc2\xe9\x6f\xb9\xfc\x80\x34\x6f\xbe\xb4\xb2\x27\xbd\x1b\x3b\xa6\x13\x72\xfc\x68\x7e\xaf\xd3\x5e\xb1\x5a\x4a\xf6\x23\xf1\x46\xa6\xf7\x24\xfe\x89\x49\xdf\x89\xe1\x6f\x8e\xde\xef\x9d\xe6\x7d\x9d\xef\x78\x8e\xef\x9f\x61\xfb\x72\xbd\x36\xf8\x31\xf3\x7c\xa7\x14\x7f\x62\x7a\x3f\x29\xb5\x0f\xa7\x75\xcc\xe8\x3a\x6e\x37\xa2\xf9\x02\xec\x21\x29\x2a\xab\x9d\x78\x7d\x33\x8d\x53\xe2\x3e\xfe\x2d\x00\x00\xff\xff\x6d\x78\x62\xcc\x41\x3a\x00\x00"
+
+func importsFungibletokenCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsFungibletokenCdc,
+		"imports/FungibleToken.cdc",
+	)
+}
+
+func importsFungibletokenCdc() (*asset, error) {
+	bytes, err := importsFungibletokenCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/FungibleToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x48, 0x5b, 0x57, 0xd1, 0x32, 0x43, 0x9e, 0x1e, 0x88, 0x6f, 0xa8, 0xb6, 0xc9, 0xea, 0x5f, 0x89, 0xc3, 0x4b, 0x8c, 0x38, 0x57, 0xa9, 0x6d, 0x4b, 0x6f, 0x50, 0x63, 0xab, 0x8d, 0xdc, 0xc2, 0xa1}}
+	return a, nil
+}
+
+var _importsFungibletokenmetadataviewsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x5f\x6f\xdb\x38\x12\x7f\xf7\xa7\x98\xf3\xc3\x6e\x02\xa4\x72\x5b\x1c\xee\x16\x46\xbd\xdd\x1c\x62\xdf\x15\x97\x6e\x8a\xc4\xcd\x3e\x5e\x69\x69\x64\x13\xa1\x48\x1d\x49\xd9\x31\x82\x7c\xf7\xc3\x90\xd4\x5f\x4b\x8e\xb3\x7b\xc5\xea\xa1\x75\xc4\xe1\xcc\x6f\xfe\xf2\x47\xf1\x2c\x57\xda\xc2\xa2\x90\x6b\xbe\x12\xb8\x54\x0f\x28\x21\xd5\x2a\x83\xb7\x8f\x8b\xaf\xbf\xfe\xf3\xd3\x3f\xae\xe7\xcb\x9b\x7f\xcf\x7f\xbd\xbc\xba\xba\x9d\xdf\xdd\x8d\xc2\x86\xcf\x68\x59\xc2\x2c\xbb\xe7\xb8\x33\xe5\x86\xcf\xf3\xe5\xe5\xd5\xe5\xf2\xf2\xfe\xd3\xfc\xb7\xbb\xce\x06\x12\xbc\x45\xa3\xc4\x16\x75\x29\x4f\x72\xb7\xf3\xbb\x9b\xeb\xfb\xf9\x6d\x29\x3e\x9a\x4c\x26\xb0\xdc\x70\x03\xb1\x92\x56\xb3\xd8\x02\xcf\x72\x81\x19\x4a\x6b\xc0\x6e\x10\xb2\x60\x1a\x8c\x65\x32\x61\x3a\x81\x5c\xab\x5c\x19\x4c\xdc\x5e\x2e\x61\x71\xfd\xe9\xcb\x9b\x77\x6f\x7f\xfa\x7b\xe4\xde\xb8\x7f\x6e\x31\x9d\xc2\xc6\xda\xdc\x4c\x27\x93\x35\xb7\x9b\x62\x15\xc5\x2a\x9b\x28\x99\x0a\xb5\x9b\xa4\x82\xe7\x66\xb2\x12\x6a\x35\xc9\x18\x97\x13\x96\xe7\x82\xc7\xcc\x72\x25\x27\xef\xdf\xbe\x7f\xff\xf6\xa7\x77\xef\xde\xa4\x21\x4a\x6f\x2c\x85\xc9\xbc\x29\x91\x44\x59\x52\x1b\xba\xb3\xba\x88\xad\x01\x26\x13\xd0\x68\x54\xa1\x63\x34\x10\x33\x59\xfb\x01\x4a\x22\x28\x0d\x99\xd2\xe8\xf6\x54\x2e\xd9\x7d\x8e\xe6\x02\x62\x26\x04\x26\xb0\xa5\xe0\x46\x30\x67\xf1\xc6\xfd\x76\xcb\xa0\x31\xd7\x68\x28\x1c\x6e\x2f\x83\x84\xa7\x29\x6a\xd2\xfb\xc0\x65\x02\x2a\xad\xf4\x39\xff\x47\x2c\x8e\xd1\x98\x33\x26\xc4\x79\x1d\xd4\x56\xc6\xdb\xd9\x7c\x1a\x8d\x00\x00\x48\xf9\x62\x49\xaf\x60\xa7\x59\x6e\x60\xb1\xbc\xe2\x26\x17\x6c\xef\x7c\x5b\x2c\xef\x59\x21\xec\x15\xb3\xec\xc2\xbd\xe0\x06\x0a\x83\x09\x58\x05\x6b\xbe\x45\x60\x10\x2b\xf2\xd8\x22\x54\xfa\x72\x1e\xdb\x42\x23\x61\x64\x15\x04\x70\x18\x22\xf8\xac\x8c\xed\xbc\xac\xf0\x1a\x30\x1b\x55\x88\xa4\x56\x55\x47\xd3\x52\xb5\x50\x7c\xa2\x72\xd1\xfd\xdf\x74\xdb\xb8\xa4\x94\xee\x3c\xb9\xf5\xae\x8c\x40\x0b\xa9\x0d\x2e\x4e\x6b\x6f\x3f\x3a\xc9\x23\x5b\xaa\x38\x4c\x9b\x41\xf9\x58\xed\x70\xa9\xe3\x92\xdb\xb3\xea\x15\x3d\xbd\xb6\x2e\x3a\x22\x2f\xe9\x3e\x6f\x38\x43\x8f\x41\x91\x46\x95\x66\x98\xd5\x56\xfa\xc4\x2a\x85\x4e\xb0\xfa\xab\x12\x7d\x1e\xf9\x7f\xab\xa0\xff\x0b\x45\x8e\xda\xa5\x18\x2d\xa5\x70\xd9\x13\x78\x12\xfc\x25\x67\x9a\x65\x6e\xb1\xec\xfa\x29\x5c\x82\x46\x57\xa9\x31\x92\x0a\x6a\x66\x5d\x8e\x84\xb2\x55\x6a\x0d\x1a\x6d\xa1\x25\x5c\x96\x59\xf3\x39\x1c\x4c\x71\x5a\x48\x02\xe5\x85\xcf\xda\x86\x7f\x78\x6a\x8e\x9f\xa8\xfc\xf1\x7c\x3e\x3d\x2c\x09\xca\x69\xc6\xf6\x2b\x0c\x2b\xb3\x96\x13\x51\x00\xec\x8c\x2c\xf7\x39\x7e\xf0\x62\x3f\x9f\x9d\x9f\x57\x2a\x78\x5a\x56\x86\x57\xd0\x54\xd7\x4e\x57\xf0\x31\x48\x32\xf3\x97\x80\xa7\x93\x81\x86\x68\xf0\x6f\xa8\x92\x5c\x62\x5d\x18\xc2\xab\x56\x24\xce\x8f\x94\x57\xbd\xb3\x7a\xd9\xde\x5b\xd7\x5c\xb7\x2a\x1c\x78\xab\x00\x1f\x69\x0c\xbb\xbc\x72\x99\x2a\x9d\xb9\xf9\x09\x12\x31\xf1\x73\xc1\x6c\xd4\x2e\x66\x4e\x84\xd3\x3c\x89\xea\x76\xf6\x23\x9f\x49\x58\xa1\x1f\x23\xab\x3d\x34\x86\xb0\xa9\xc7\x8a\x04\xb5\x45\xed\x9a\x8a\xc6\x4e\xa5\x61\xad\x59\xbe\xe1\xb1\xa1\xe1\x42\x10\x16\xcb\x13\xe6\x41\xd9\x28\x75\x5a\x3c\x18\x84\x24\xac\x48\x96\x21\xa4\x4a\x7b\xcc\x6e\xf2\x47\x4d\xe1\xd6\xc6\xf9\x23\xa3\xb1\x34\x85\xf1\x42\xa8\xdd\xb8\x57\xae\x3b\x40\xc8\xc0\x94\x8e\x0d\x2e\xd7\xa3\x03\x18\x6c\xb5\xd2\xb8\xe5\xcc\x62\x02\x66\x9f\xad\x94\xf8\x3d\x60\xae\x6f\x7e\x1b\x0f\x02\xf0\x6a\xfb\x21\x5c\x42\x82\x26\xd6\x3c\x77\x99\xa4\xb0\xe6\x5a\x6d\x79\x82\xa6\x95\x08\x17\xf2\xd7\x20\x22\xd7\x08\x95\xdf\x41\x67\x07\xe9\x96\xcc\x52\x8a\xe3\x42\xd3\x90\xd8\x57\x99\x14\x6a\x07\x12\xed\x4e\xe9\x87\x68\xd8\x8f\x06\xd2\x7e\x67\xe6\x8f\x16\xb5\x64\x02\x04\x97\x0f\x54\x50\x0c\xbe\xde\x5e\xd3\x0f\xe7\x04\x1d\xc7\xad\xc2\x65\x2b\x55\x58\x87\xa0\x3c\xf9\xbb\x0e\x76\x21\x60\xb0\xf0\xf5\xf6\x7a\xda\xe6\x48\xd1\xbc\x5e\x6a\xa3\xba\xa9\xc9\x00\x6c\x51\x1b\x57\xed\xc1\xf3\xb6\x5d\x10\x6a\xad\x86\x8d\xd3\xaa\xe9\x9a\xfd\x8c\x09\x67\xa6\x6d\xf1\x4e\xc5\x3c\x44\xc1\xf5\x95\x46\x62\x18\x87\xf6\x7e\x34\x60\xbc\xe8\x46\x65\x98\xb3\x35\x9a\x56\x6e\xe1\x8b\x32\xc6\x89\x3f\xe0\xde\xd0\x98\xa3\xee\x1d\x73\x69\x2c\x5b\x6b\x96\x8d\x2f\x60\x6c\x77\xdc\x5a\xd4\xf4\x33\xe1\x26\x56\x3a\x19\x5f\x00\xda\x78\xd8\x0d\x6f\xd2\x4c\xe1\xc9\xe7\xf0\x48\x20\x9f\x47\x2f\x1d\xb2\xcd\xe6\x6a\x0f\xbf\x76\xd5\xb7\xd7\x7a\x2a\xa9\x2d\x70\x5a\x9e\xdb\x7b\x8e\xa4\xa7\x83\xec\x35\x01\x28\x37\xf5\x12\x01\x37\xbb\x66\x2e\x08\x87\x8b\x61\x9a\xcc\x42\x24\x0e\x05\x9a\x9d\x3f\x6b\xc6\xe4\x50\xb4\x11\x0f\x98\x35\xa3\x73\x28\xea\xc2\x00\x33\x1f\x8e\x1e\x54\xde\x79\x82\xe5\x7f\x9d\x4a\x46\xea\x59\xce\x25\x30\xd8\xb1\x3d\xd8\x0d\xb3\xb0\xe3\x42\x94\x87\xa7\x27\xd8\x09\x28\xe7\x06\x13\xd5\x01\x01\xdf\x83\xb8\xc8\xca\x4e\x03\xdc\xa9\x2c\xa6\x3c\xbe\xff\x03\xaf\xa0\x32\x15\x63\x7d\xea\x72\x11\x47\x41\xc2\xf2\x89\xb4\x26\x48\x13\xb3\xe9\xd4\x56\xd0\x99\xb4\xd4\x1d\x58\x60\xe6\x63\xef\x01\x5b\x3e\x21\x4c\x0d\x2d\x2d\x91\xe7\x61\x0e\x24\xb9\xf8\x7d\x14\xc4\x58\x1a\xb2\xee\xb2\x22\x2d\xba\x7b\xd0\x8e\xdb\x4d\x20\xb2\x44\x7b\xa2\x57\x11\x12\x83\xb6\xc8\x1b\xbb\xbd\x36\xba\x8e\xa2\xae\x4b\x8a\xac\xb2\xb5\xb7\x9b\x17\x2b\xc1\x63\x88\x59\xce\x56\x5c\x70\xcb\xcb\x91\x7a\xfc\xd6\x52\xf1\xf4\x36\x4f\xf9\xc2\xec\x86\xca\xbd\xb4\xb0\xdb\xa0\xae\xc8\x55\x80\xc4\x0d\x68\x8c\x55\x96\xa1\x0c\x2c\x6c\x85\x3e\x10\xc9\x91\x19\xec\x15\x92\x7e\x1a\x80\xd5\x1f\xed\x73\xe4\x8b\x77\x26\x27\x14\xbb\x0d\x8f\x37\x90\x15\xc6\x92\x7e\x3a\x5a\xbc\xb1\x46\x42\x82\xef\x1a\x63\xe4\xd4\x39\x55\x10\xf6\xc3\x40\x4a\x61\x8f\xc4\x1b\xfc\xc3\x40\x56\x4c\x30\x6a\xe5\xf2\x8a\xee\xfa\x78\x30\x33\x7d\xb0\xca\x0b\xf6\x71\x58\xd4\x48\x7e\x08\x85\xcb\x6b\x0d\x88\x79\x5e\xf1\xad\xe9\xdf\xb7\xc8\x17\x1d\x37\xc0\x08\x97\xd5\x3c\x26\xda\x17\x6e\xfd\xff\x2d\x38\x9d\x06\xd0\x32\xe1\x94\xb4\xee\xf2\xd1\x6d\x50\xf9\xcd\x17\x79\xca\x62\x7c\x39\xbe\xd7\x0e\x16\x01\x9e\x3a\xd8\x7f\x8e\x23\xbd\xa3\xad\xe1\xc7\x09\x19\x39\xee\xc8\xa2\x90\x71\x60\xb2\xcc\x02\x13\x42\xed\x0c\xc4\x1a\xfd\x94\x50\x29\x91\x5a\xcc\x72\xbb\xaf\xfb\xc7\x49\x92\x33\xd2\xba\x0e\x6a\xa3\x56\x61\xa6\x04\xf2\x94\x1c\x41\xe8\xcc\xe0\x9c\xb4\xbb\x7e\x9e\xd2\x86\xb3\xf3\x29\xfc\xf2\xd4\x4e\xa0\x5b\x7d\x99\xda\x0c\xf5\xe8\x45\xe7\x86\xd9\xdf\x40\x6d\xa9\xa1\x7a\xee\xd7\xd5\x8d\x71\xbf\xae\xe3\x52\xdd\x68\x94\x99\x79\x29\x2a\xe5\xfe\xee\x99\x94\x6b\xec\x3d\x63\xba\x88\x23\x6e\xee\x8a\x15\x55\xe2\x99\x4a\x3d\xb0\x0f\x3f\x3c\xf5\x77\xd0\x33\x9d\x7d\xd3\x03\xa5\xf4\x8c\x4b\x99\x72\x6e\xb8\xda\xfe\x30\x8e\x62\x25\x63\x66\xcf\xfa\x0c\x27\x28\x2d\x4f\x79\xf3\x2a\xdd\x7c\xca\xad\xe3\x9f\xab\xe9\xc5\xc0\x78\xac\x54\x9a\x1f\xc6\xe7\xa5\xc8\x8b\xa8\x5f\x61\x2c\x1a\x1f\x4a\x1c\xa6\xf0\x94\xb0\xf9\x0c\x1d\x89\x59\x49\x63\xab\xa9\xdc\x8e\x59\x9f\xd5\xef\x1c\xb3\x0a\xf2\x1f\x08\xd8\x73\x0f\x89\xad\xbb\x91\x88\x6c\xe3\xfc\x3c\x10\x6d\xf6\x27\xcc\x5a\xed\x7a\x28\xdc\x6c\x53\xa2\x5c\x8d\x3f\x87\x35\xd7\xe1\x6c\xe8\xaf\x5f\x0e\x5b\x69\x6d\x3c\x7c\x79\xb8\xb1\xdb\xd3\x30\x1b\x6c\xf3\xd3\x59\x7d\xcd\x7d\x5e\xe6\xf5\x37\x5d\x5e\xff\x5d\xbe\x47\x36\x58\x7d\x0d\xee\xe4\xaf\x93\xd5\xc7\xb5\x57\x31\xfb\xfa\xd3\xef\x21\xb7\xdf\x9e\xf8\x99\xb2\x54\x31\xcc\xe8\xb7\x41\x4d\xe0\xee\x7d\xb4\xb3\x7c\x42\x34\xb6\xff\x5f\xce\x6e\x95\x65\x02\x4c\x91\xe7\xa2\xfa\xfa\xe3\x50\xfc\x18\xbe\x2d\x0d\x71\xe4\x25\x6d\xbc\xf3\xfb\x86\x3f\xef\x7b\xc5\x53\xf8\xba\xe0\x8f\x7f\xfb\x6b\xdf\x09\x6b\x6b\x3d\xa5\x58\xef\xcd\x3a\x40\x9c\x41\x63\xc3\x41\x4d\x3f\x8f\xe0\x7f\x01\x00\x00\xff\xff\x1f\xb4\xdd\xa1\x60\x1b\x00\x00"
+
+func importsFungibletokenmetadataviewsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsFungibletokenmetadataviewsCdc,
+		"imports/FungibleTokenMetadataViews.cdc",
+	)
+}
+
+func importsFungibletokenmetadataviewsCdc() (*asset, error) {
+	bytes, err := importsFungibletokenmetadataviewsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/FungibleTokenMetadataViews.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1, 0xc5, 0xbf, 0xe5, 0xce, 0x82, 0xd, 0xcb, 0x1c, 0xdf, 0x18, 0x7e, 0x3e, 0x8d, 0x5f, 0x24, 0x7d, 0x97, 0xac, 0xac, 0x54, 0xb5, 0xf, 0x46, 0x6a, 0x4c, 0xec, 0xa9, 0x8a, 0x93, 0xc5, 0xca}}
+	return a, nil
+}
+
+var _importsFungibletokenswitchboardCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x4f\x73\xdc\xb6\x92\xbf\xeb\x53\xb4\x7d\xd8\xcc\x54\xe9\x8d\x72\xd8\xda\x83\xca\xb2\x63\xc7\x51\xca\xb5\xbb\x79\x29\x5b\x79\x39\xa4\x52\x25\x0c\xd9\xd4\x60\xc5\x01\x58\x00\x38\xe3\x79\x2e\x7d\xf7\xad\x06\x40\x12\x20\x41\x0e\x47\x52\x9c\xdd\x57\xe1\xc1\xd6\xcc\x90\x8d\xee\x46\xff\xf9\x75\x03\x20\xdf\x56\x52\x19\xb8\xae\xc5\x1d\x5f\x97\x78\x23\xef\x51\x40\xa1\xe4\x16\xbe\xfd\x7c\xfd\xcb\x4f\x3f\x7e\x78\xf7\x5f\x3f\xdc\xfc\xfd\x3f\x7f\xf8\xe9\xed\xfb\xf7\x1f\x7f\xf8\xf4\xe9\xec\xec\xe2\xe2\x02\x6e\x36\x08\x99\x14\x46\xb1\xcc\x80\xd9\x30\x03\xac\x2c\xe5\x5e\x03\x13\xc0\xb2\x4c\xd6\xc2\x80\x91\xa0\x30\x43\xbe\x43\xa8\xd8\x61\x8b\xc2\x68\xe0\x02\xb6\x75\x69\x78\x55\x22\x14\x7e\x48\x4b\xd0\xd0\xb8\x1a\x6a\xcd\xc5\x1d\x30\xa0\xff\x4a\x84\xdb\x2f\x11\x5f\xab\x8f\x8e\x9e\x7a\xb8\x85\x8c\x55\x6c\xcd\x4b\x6e\x0e\x2b\xcf\x11\xd7\xc1\x97\xa0\x37\xb2\x2e\x73\xe0\x39\xb2\xb2\x3c\xc0\x1a\x41\x1b\xa9\x30\x07\x46\x0c\x23\xd8\x87\x6e\x23\xf2\x9f\xf6\xdc\x64\x9b\xb5\x64\x2a\x6f\x47\xfa\xb9\x5e\x97\x3c\xfb\x99\x99\x0d\x5c\xc1\x45\x65\x3f\x5d\xfc\x88\x02\x15\xcf\xae\x6f\x9a\xbb\x6e\x2d\xb5\x75\x6d\x80\x1b\xc8\x98\x08\x87\x13\x87\xfd\x06\x15\x3a\x2e\xcf\x58\x96\xa1\xd6\x0b\x56\x96\xcb\x4e\x81\x63\x5c\xc0\x97\x33\x80\x33\x00\x80\x8b\x0b\xf8\x64\xa4\x62\x77\x08\x4c\xe4\xe0\xb8\x02\x62\x4b\xdb\xdf\x43\xb2\x25\x9a\xe6\x66\xba\xe1\x32\xfc\x90\xbc\xb9\x93\xf1\x32\xf8\x3b\x79\xeb\x50\x2d\xd1\x23\x83\x67\x50\x18\x6e\x4a\xa4\xc9\x87\xbf\xef\x05\xaa\x33\x2f\x8e\x33\x21\xdc\xd1\x0f\xd6\x7e\xb8\x06\xdc\x72\x63\x30\x87\xfd\x06\x05\x30\x10\xb8\x87\x1d\xab\x4b\x13\x4e\x2b\xd7\xc0\xf2\x1c\x73\xb2\x2e\xd6\xd2\xd2\x81\xce\x14\x6a\x59\xab\x0c\x57\xed\xaf\x43\xae\xec\xb0\xff\x20\xda\xdf\xb7\xa4\xdf\x12\xd9\x85\x39\x54\x78\x09\x37\x87\x0a\xcf\x43\xaa\x96\xf7\x4b\x78\x9b\xe7\x0a\xb5\x7e\x73\xee\x68\x1e\xbb\x3a\xbe\x7b\xcf\x2f\x4f\x50\x43\x4a\x05\x0a\xb7\x72\x87\xb9\xf3\x53\x06\xcf\xaa\x87\x8f\x8e\x76\xa4\x89\xa7\xab\xe2\xd9\xd4\x91\x63\x25\xb5\xf7\x32\x21\x0d\x79\x5a\x26\xb7\x55\x89\x06\xf3\xa3\xa2\xfe\x24\xcd\xf7\xcd\xcd\xef\x1d\xa1\x48\x4e\xb6\xa5\xc8\x75\x09\xbf\x5c\xf3\xcf\xff\xf1\xef\x33\x45\x1b\xd7\x4d\x4f\x2e\x2e\x0c\xaa\x82\x65\xe8\x64\x43\x51\x48\x95\xa1\xb6\xe1\x68\x8b\x66\x23\x9d\x55\x53\x20\xa5\xb0\x21\x05\xd2\xe7\x6c\x83\xd9\x3d\x48\x41\xb7\xb5\xe4\xd8\x8e\xf1\x92\xad\x4b\xec\x94\xca\x51\x83\x2c\x28\x76\x26\x8c\xc0\x46\x0d\x56\x6a\x09\xf8\xb9\x92\xda\x0f\xda\x92\x6b\x94\xea\xb8\xd0\x34\x6c\xf3\x55\x51\x8b\x5c\xd3\xf0\xdc\x4c\xa8\xb7\x1d\xa7\x93\x31\x88\x63\x3e\x5c\x7d\x69\xd5\x19\x3e\xba\xe3\xb8\xa7\x51\xe0\x0e\x8d\x35\x45\x9a\x0a\xfd\x2b\x37\x1b\xaf\xc6\xc5\xf2\x12\xbe\xdc\xd8\x59\xf2\xdf\x3c\x1c\x25\xf4\xa9\xae\x28\xad\x61\xde\x51\x0c\xc8\xbc\x93\xb2\x3c\x42\x83\xeb\x21\x89\xc0\x54\x96\x8e\x48\x92\x06\x3d\xee\xb5\xb7\x20\x07\xbd\x84\xef\x7a\x69\xcc\x52\x7c\x58\x8e\x3e\xad\x59\x81\xef\xe7\x50\x18\xfb\xe1\xcd\xb4\x70\x44\xff\x9d\x54\x4a\xee\xdf\x1d\x86\x82\xfd\xdb\x58\xd2\x75\x54\x1f\x62\xa3\x6e\x67\xde\xda\xb4\x4d\x7a\xde\xa2\xfb\x79\xde\xe5\xf8\x06\x13\xa8\xce\xfa\x42\x13\x3e\x77\xf6\x4f\x28\x80\x88\x48\x72\x28\xeb\x15\x79\x6e\x6d\xd8\x45\x3e\xfa\x6d\xeb\x6c\xba\xf5\x93\x81\x31\x33\x71\xe8\x8f\xcd\xb6\xd2\x13\xee\x1c\x88\x64\xd7\x73\x4c\x3b\x30\xe8\x4b\x48\xab\xe8\x7c\xca\xea\xdb\x39\xa1\x61\xde\xf3\xcc\x70\x29\x98\x3a\xc0\x46\x96\x79\x23\xef\x98\xae\x62\x15\x45\x94\xb8\xc8\xf1\x33\xe6\xb0\x3e\xa4\x28\xb8\xec\x41\x32\xae\xa2\xa7\xfa\x06\xd2\x60\x91\x25\xec\x98\x6a\xc7\xfd\x3e\x18\xb6\xf5\x9e\x2e\x55\xbc\x1a\x35\x95\xd7\xde\x4a\x9a\xe1\xde\xe6\xb9\xf6\x29\xfd\xa8\x88\x07\x9a\x4d\x12\x25\x0c\x64\x11\xb5\x38\xb5\x0d\x44\xa2\x0f\xdf\x55\x4c\xb1\x6d\x40\xf4\xd2\x61\xd6\x68\x10\x17\x0b\x81\x41\x86\xca\x30\x2e\x3a\x48\x1a\x92\x0a\x15\x19\x44\x45\x3b\x7f\x60\x36\x4a\xd6\x77\x9b\x29\xa4\x4a\x8e\x11\x11\xdc\xf3\xb2\xa4\xbc\xd5\x02\x99\x9e\xb0\xd3\x33\x65\x73\x8c\x8b\x13\x2c\xcf\x7f\xc2\xbd\x75\xf9\x45\x28\xe9\xac\x19\x5a\x06\xf1\xd8\x8d\x05\x2e\x26\x00\x03\x85\x05\x2a\x14\x19\x36\xdc\x39\xe9\x2b\x49\xe1\xdd\xb2\xec\xad\x2d\xd0\xe7\x1e\xa1\x4f\x6f\xcf\x5c\x19\x60\xa3\x02\x70\xa1\x79\x8e\x7d\x61\xa3\x67\x08\x62\xda\xa1\x3e\x62\x01\x57\x21\xc6\x5f\x5b\xd6\x16\xcb\x61\x4a\x7e\xf3\x06\x2a\x26\x78\xb6\x78\x39\x0a\xe6\xc3\xbf\x03\x9d\x91\xa2\x84\xc5\x10\x4e\xee\x48\x6a\x27\xb1\x05\x58\x1d\x1b\x2f\xe0\xe5\x04\x24\x58\x65\x52\x64\xcc\x2c\x5e\xfe\x37\xbb\x47\xd0\xb5\xf2\x41\xb1\xa7\xa8\x8a\x8a\x09\xab\x4a\x9b\x68\x99\x03\x60\xee\xd6\x0d\xd3\xb0\x46\x14\x50\x29\x59\xa1\x2a\x0f\xc0\x05\x37\x9c\x95\xfc\x9f\x98\xaf\xe0\xe5\x72\x79\xd6\x57\xf2\xf7\x16\x1f\xf0\x82\x86\x21\x2d\x93\x9f\x55\x0a\x77\x5c\xd6\x51\x41\x54\x48\x05\x86\x8a\x24\x6b\xcf\x11\x15\x5e\xc0\x42\x63\x59\xac\x52\x7e\xff\x5b\x33\x21\xab\x3b\x74\x69\x70\xf9\x3b\x5c\x5d\x81\xe0\x65\xdf\x84\x3c\x47\xb5\xc6\xc0\x68\x02\xad\x1e\x2a\x04\xa6\xe1\x1e\x1d\x37\x64\x16\x4d\xe0\x4b\xd1\x09\x98\xa7\x50\x6f\x36\x3d\xb6\xe9\x3a\x91\xed\x80\x66\x6a\x44\xc2\x9a\x96\x9d\x10\x82\x8a\x9c\x67\xcc\xd8\xac\x46\x75\xae\x0d\x62\x01\x6b\xed\x94\xa5\x08\x5a\x17\x1f\xfc\x60\x87\x99\x28\x3f\x86\xac\x9f\xcf\x06\xd9\x8d\x5e\x06\xa0\xd4\x6a\xca\xe6\xd3\x37\xe4\x04\x84\xa3\x4e\xc0\xee\xed\x35\x00\xf1\x81\x93\x7a\xb2\xcb\x88\xea\x03\x60\xa9\x31\x6d\x29\x1f\x1a\xab\xdd\x33\x0d\xac\x54\xc8\xf2\x03\x85\xe3\xbe\xd5\x92\x13\x91\xd5\x9e\x3b\x57\x1f\x90\x7a\x6a\x00\x20\x74\x41\xd3\xfa\x8f\x5e\xad\x35\xe6\xef\xad\xa7\xdf\xb4\x3e\xd7\x32\xef\xac\x9e\x5b\xbc\x1e\xc2\x81\xc0\x01\xc9\x0f\x5e\xbd\x5c\x4e\x92\x1e\xda\xc0\x8a\xe7\x54\x4e\x17\x1c\xd5\xb2\x65\xe0\xf5\x8a\x62\x42\xa4\xef\xb3\xee\xaf\x64\x0e\xae\xb7\x6b\x54\x54\x2a\xcc\xca\xc6\x54\x56\xac\x0f\xae\x31\x13\xa7\xc5\x0d\xda\x50\xa6\xc1\xf6\x37\xe8\xf3\x01\x98\x6a\x1a\x1f\x71\x12\x4b\x5c\xa9\x74\x6d\xe9\xb9\x4c\xdd\x23\x0d\xae\xf5\x12\xf3\x35\x36\x9a\xa7\xe6\xcd\xd1\xd1\xf3\x1f\x48\xee\x0e\x58\xfa\x0f\x21\xd1\xc7\x24\x5f\xfd\xee\xf0\x33\x33\x9b\x85\x67\xff\xb7\xae\x23\xf2\xfb\x79\xc7\x85\x2f\x5e\x12\x79\xf7\x47\x74\x51\xa7\xe9\x9c\xcd\x95\x7a\x90\x3b\x9d\x54\x57\x54\x01\xbd\x75\xb4\x16\x49\x9f\xbc\xb8\x80\x6b\xa9\x00\x59\xb6\xb1\x8a\x3e\xa7\x27\x5c\x66\x66\x3b\xcc\xfb\x91\xd7\xe7\x6f\x33\x48\xf0\x5c\x0c\xd1\xcb\x37\x7a\xc4\x8a\xf2\x16\xf2\x46\x64\xc8\x2d\x6c\x4a\xe4\xc2\x4f\xfa\x30\x54\x90\x6c\x01\x4f\x57\x4e\xd0\x55\x34\x6f\x77\x68\x26\xd0\x8e\x9d\x9b\xa1\xc7\x3d\x11\xf4\xa4\xe8\xed\xf1\x74\xdc\x03\x61\x38\xf4\xe3\x52\x48\x74\xc0\x04\x73\xd0\xb5\xb5\xbf\xa2\x2e\xcb\xc3\x6a\xb5\x1a\x3c\xcc\x8b\x39\xd8\x69\xa8\x58\x3f\xf0\x6a\xb5\xa2\x79\x0e\x41\x84\x90\x49\x14\xe1\x30\x6b\x1c\x95\x93\x54\xe7\x61\x8a\x17\xf3\x40\x45\xc0\xea\x2f\xa7\x83\x8b\x29\x72\x13\x73\xd9\x5c\xa7\x4a\x31\x87\x26\x41\x03\x91\xcf\xc6\x1b\xf3\x24\xe8\xa0\x48\x1a\x76\x34\xd7\x73\xc3\x8f\x79\x58\x63\x92\xc4\x00\x55\x1c\x7d\x2a\x9d\x3d\x1f\x06\xdf\x3e\x9c\x96\x1c\x9f\xb7\x40\x05\x5d\x61\xc6\x8b\x03\x19\xe2\x7e\xc3\xb3\x0d\xdc\x92\x42\x6f\x29\xf1\xdc\xa6\x5b\x37\xb7\xcd\xfa\x41\x44\xd0\xd7\x9d\x2e\x0c\x71\xb3\xb2\x6e\xc0\x6d\x90\xe1\x22\x2b\xeb\x9c\xc2\x0c\x1c\x64\xad\x22\xa6\x5e\xee\x15\xab\x2a\x54\x2f\x7b\xdc\x39\x89\x34\x85\x95\x0d\x39\x0d\x83\xdb\xef\x2c\x13\xd7\x52\xed\x99\xca\xb9\xb8\x5b\xf9\x3f\x51\xdd\xae\xe0\x83\xeb\xb7\xda\x06\xe2\x3a\xae\x8e\x6b\xed\x98\x92\x3b\x54\x7b\xc5\x8d\xf3\x4e\xe7\x8d\xc6\xb0\x6c\xe3\x7b\xf5\x6d\x8d\x1d\x96\x8c\xdc\x6c\x64\x6d\x62\x51\x37\x6c\x67\xfd\x56\x76\xbd\x1e\x16\x45\xff\x82\x2b\x6d\xa2\x34\xfd\xaf\x59\xf9\xa7\xa4\xf2\x8d\xba\x46\xc3\xb2\xe8\x5b\xab\x57\x96\xb5\xa0\xc8\x68\x06\xbc\x74\x0a\x39\x07\xc5\x28\xee\xd3\x3d\x0e\xb6\x7a\x2b\xb5\xd5\xaf\xb1\x4d\xbe\x26\xcc\xb6\x19\x89\x7e\x8b\xe8\x69\xc6\xf3\x54\xe8\x9b\x0f\xa3\x7e\x75\xc6\x7a\x7a\x2b\xe3\x31\x75\xcc\xc8\x15\x74\x42\x87\x38\x2d\x2c\xb5\x7b\x2b\x32\x7b\xa9\xee\x43\x84\x6c\x85\xd5\x1a\x95\x81\xc5\x80\xb9\x20\x37\xdb\xee\x7e\x2a\xc0\x6e\x51\x6b\x76\x87\x97\x49\xc9\x4e\xae\x74\xbc\x6a\x27\x3b\x1e\x2c\xec\x79\x58\xf0\xa9\xe4\x8e\xe7\x11\x1c\x3c\x5a\x10\xfd\x51\xad\x8f\x5e\xb5\x34\x80\xb3\x0d\x2c\xb0\x6e\x61\x7d\x05\x0d\xaa\x10\x10\x4c\x20\xb7\xf1\x0c\x4f\xe4\x26\x52\xfa\xb3\xb6\x0c\x92\xed\x82\xf1\x5c\x3d\x98\x07\x67\xba\xf4\xef\xd0\x9a\x1e\x99\xa2\x67\x14\xfb\xe7\x23\xf3\x92\xca\xaf\xff\x44\x25\x41\x2a\xd8\x12\x2c\x9e\x5d\x7d\xfa\x30\x19\x67\x89\xe4\x22\xd7\x48\xb6\xd5\x53\xe9\x56\xf7\x08\xa7\x62\xe7\xff\x93\x84\xab\xa3\x8c\x3b\xc8\xb7\xa4\xcb\xb9\x19\x97\x92\x63\xf4\xe0\x30\xe9\xf6\x6d\x05\xbe\x4a\x4d\x6f\xe5\xec\x32\x60\x5b\xcf\xf7\xd3\xa0\x8c\x27\x51\x8a\xae\xd8\xfd\x73\x7b\x05\x3e\x12\x4f\xb6\x0c\xbc\x90\xbf\x91\xf5\xfe\xfe\x2c\xc9\xed\xaf\x26\xc4\xf1\x26\x04\x3f\xff\xab\x0f\xf1\x27\xf7\x21\x1e\x51\xdc\x1f\xab\xb6\xa7\x73\xbb\xfe\x8d\xff\x3e\xa3\x64\x3f\xb1\x5c\x3f\xb1\x26\x3f\x21\xc7\x37\x57\x97\xeb\x49\x82\xf1\x2a\xf9\x89\xa5\xf9\x69\x65\xf9\xd0\xac\x67\x16\xdf\x6e\xcb\x11\x65\xc5\x19\xb5\x77\x0b\x4f\x53\x46\xfc\x9c\xab\xc3\x03\x6e\xfc\x5a\xfa\x20\x23\x47\xbb\xb1\x1e\xbb\x96\xeb\x88\xfc\xdf\x5f\xcb\xf5\x10\x61\x72\x16\x60\xd6\x52\xee\xc0\x5a\x9a\x95\x5c\x38\x7d\x25\xe7\x8f\xae\x6f\xdc\xf5\x15\xaa\x9c\xe1\xfa\xee\x68\x4c\x94\x50\x70\xb7\x2c\xda\x33\x5c\x37\x45\xf3\x2a\x9c\x95\xbb\x79\x71\x8f\x87\x54\xe3\x6f\x90\xb1\x7f\x78\xce\x72\xc7\x3b\xce\xd1\x82\xa7\xd9\x92\x38\x52\xf2\xcc\xe9\x57\xfe\x19\x05\x50\xf3\x57\x14\x02\x6e\xd8\x7d\x2a\xd2\xb9\xd9\xb5\x3b\x9c\x64\x4d\xaa\x74\xb5\x86\x37\xd3\x0a\x55\xf7\x40\xa2\x45\x95\x8c\x93\x52\x35\x00\x98\x52\x25\x37\xc7\xe3\xa1\xdb\x6b\x46\x91\xb0\x43\xce\x49\x3e\x8f\x84\xd8\x47\xed\x86\x1b\x47\xa4\xa9\xe0\x2f\x05\xea\xde\x8e\xee\xa9\x48\xd4\xca\xd3\xb3\x2c\xb8\x9a\x00\x08\x34\x58\xd0\xd9\x7f\x72\xbc\xf2\x4c\xb4\x41\xaa\x69\x29\x5a\x9e\x66\x46\x9e\x78\x72\xdc\x6c\x50\x65\xe2\x97\x94\x9b\xfb\x62\xd6\xc3\x95\xe3\x39\x83\xbc\x76\x0b\x40\x26\xd8\x25\x67\x1b\x9e\x5c\xb7\xfb\xde\xc0\x1d\x14\x08\x33\x1d\x8c\xac\x68\x27\x24\x50\x98\xf1\x8a\x53\x00\x11\x88\x6e\xbf\x69\x17\x06\x69\x32\xb9\x6a\xeb\x10\x8b\xfd\x83\x12\xdb\x48\xbb\x63\xce\xef\x06\x74\xe7\x0b\x4c\x42\x2b\x76\xcf\x5b\x2a\xa0\xfa\xcc\x18\xf7\x35\xbd\xaf\xe5\xa8\xb9\x6a\x48\x4c\xe5\xb3\x31\x83\x7a\xbe\xec\xd6\xb7\x96\xa9\x94\x36\xcb\x74\x5a\xdf\x69\x5a\xc7\xb1\xd6\x5c\x92\x7a\x3e\x1b\x5a\xc1\x57\xcb\x92\x6d\x02\x88\x03\xcd\xab\xbf\xd1\xff\xa3\x2d\xa9\xa3\x91\xd8\x28\xdf\x7e\xb2\x21\x79\x10\x91\x23\x62\x73\x80\x6b\x1c\x90\x7d\xd7\x22\xef\xef\xe7\x64\x3b\xc9\xed\x7e\x50\x6b\x2d\xf7\x36\x76\x87\xf5\x58\xdf\x35\xc7\x9b\x32\xa9\x10\xbf\x6b\x36\x33\xc7\xab\x08\x96\x19\xd3\x78\x0e\x4d\x01\xe1\x3d\xed\xfb\x28\xe9\xa5\xa8\xa9\x7c\xa2\xd0\xd4\x4a\x9c\x92\x4a\xce\xdb\xde\x54\xb8\x62\xeb\x55\x9b\xeb\x46\x07\x4d\xcc\xa4\xaa\xb4\x2b\x46\xcf\xc1\x16\x85\xbc\x2c\xed\xa1\x1a\xc6\x45\xa4\xe1\x88\x9c\x27\x34\x70\x7d\x8a\x44\x6d\xf0\x20\xf2\xa4\xe5\x42\xd6\xe2\x08\x86\x7f\xc6\x5d\xdb\xc3\x14\x78\xa3\x2c\xa2\x6b\x1a\x23\x1e\x0a\x0c\x8e\x84\x1c\x45\xe3\x5d\x51\x1f\x45\x38\x32\xa6\x4a\xa1\xa6\x48\xec\x4e\x18\x44\x11\xbd\x57\xe0\xfb\xe2\xfe\x39\x72\x69\x7a\x3b\xd8\xaf\x08\xc6\x09\xbc\x3e\x52\xbd\xb4\x02\x4f\xf7\x40\x3e\x14\x54\xca\xd8\x96\xee\x34\xc1\x79\xbd\x8c\xa3\x41\x7f\xbc\xb3\xf1\x6b\x67\xba\xad\x59\x92\xca\xed\xaa\xd9\x30\xdb\x34\xd7\x64\x64\x5b\x91\xc7\xe4\x8a\xed\x17\xcd\xb9\x15\xfb\xed\x9a\x95\x4c\x64\xb8\x9c\x5f\x8e\x7b\x1e\x79\xd1\xad\x72\x32\x5e\xfa\x7d\x1e\x5a\x6e\xc9\x5b\x98\x96\x83\x4d\xa3\xe1\x70\xf0\x1a\xbe\x5d\x7d\x9b\x50\x80\x05\xf4\xa9\x83\x37\x49\x81\x1d\xa2\x8f\xad\x25\xdd\x6d\x48\xc9\x9c\xbe\xf3\x91\xd8\x7f\xa8\x3f\x1f\xd5\x9c\xf6\x27\x74\x99\xa3\x36\x4a\x7a\xb7\x3c\x4b\x50\x10\xbc\x1c\xcb\x4a\x76\x8d\x51\x27\x33\xa6\xe1\xcd\x5a\xba\x0d\xdb\x5c\xc3\x8e\x95\x3c\x6a\x7e\x3c\x36\xe4\xef\xe3\x8e\xff\xa1\xc3\x65\x6b\x74\x27\x90\x70\x62\x1c\x2f\x15\x83\xb5\x94\x25\x32\x01\x5b\x66\x57\x43\x07\xe8\x5d\xaa\x86\x79\xe6\x99\xa7\xc0\x2d\xa4\x49\x06\xd5\xf6\xbc\x8a\x65\xa0\xe9\x76\xa4\x8e\xac\xbc\x93\xb2\xec\x99\x1e\x2f\x8e\x2f\xeb\xd9\xfd\x46\x09\x93\xf5\xf2\x14\xac\xd4\xd8\x9b\xe6\xd4\x6c\x1e\x19\xe7\x45\xb3\xc8\x3b\x36\xe5\x3f\xa2\xd1\x3e\x27\x79\xd4\xc0\xb4\xe6\x77\xa2\x99\xed\xb6\x4f\x31\x76\x98\xc3\x9e\x7d\x25\x5c\x80\xa4\x3c\xa6\x0e\xb0\xc6\x8c\xd5\x1a\xdb\x9c\xca\xcd\x39\xe1\x17\x8f\x1d\x2a\xa9\xb5\x4f\xc4\x50\x4a\x79\x0f\xb5\xc8\xd1\xad\x90\x6e\xa4\x74\xd0\x5a\x23\x92\x0e\xd9\xd8\x92\x3e\x77\xa7\xcd\x04\xe0\xe7\x0a\x33\xdb\x49\xb2\x86\x65\xa7\x73\xe5\x58\xda\x60\x59\x69\xb8\xab\x09\xb6\xb3\x3b\xc6\x85\x36\xc0\x45\x41\x58\x0e\xcb\x03\x64\x1b\xc6\x49\xc8\xde\x42\x18\xd1\x90\x76\x3f\x02\x17\xce\x46\xa2\x81\xb7\xac\xe4\x99\xdd\x96\x76\xcf\x6d\x10\x2d\xa0\xae\xf2\xae\xfd\x90\xd9\x73\xbf\x95\x72\x0d\x8a\x92\x6b\x42\x5b\xda\xf9\xe2\x1a\x89\xfe\x96\xe5\x1e\x92\x32\x85\x8d\x19\x0a\x57\x4d\x16\x4a\x0a\xa3\x8f\xee\xc3\xf8\x7a\x3e\x25\x40\x56\x76\xc5\xa2\x1c\x05\x95\x99\x14\xba\xde\xa2\x6a\x17\xac\x7a\xe5\x92\x1d\xeb\xc2\xca\xc9\x8c\x2f\xe5\xa8\xc2\x92\x7b\x31\xed\x77\x8f\x3d\x27\x36\x74\xc5\x17\xd6\x47\xc6\xfd\xd8\x24\x36\x5c\x40\x3a\x5e\x3e\xc1\x0f\x07\xb5\x59\x7f\x91\x9a\x80\x96\x71\xbd\x16\xbf\xc9\x47\xc2\xbd\x90\x7b\xbf\x9c\xec\x0f\xa9\x77\xbb\x86\x8e\x6f\x00\x73\x00\xab\x62\xca\x39\x73\x53\xb0\xb2\x52\x8a\x3b\xeb\x9d\x6e\xcd\xcd\x2f\x38\xda\x35\xb7\xb8\xbf\x63\x8d\xc8\x0f\xdc\x6c\x1f\x4a\x43\xf0\xa4\xfd\xbc\x0d\x56\xbc\x60\xcb\xaa\xaa\x09\xcb\x13\xdb\x96\x62\x06\xc8\xb4\x3d\x54\x6a\x0d\xd0\xa6\xce\x6f\x74\xcb\x77\x92\x8d\xa7\x1e\xf2\xec\x99\x03\x61\x31\x2c\x0a\xcc\x0c\xdf\x61\xff\xf1\xe1\xc3\x57\xf0\x65\x00\x70\xda\xfd\x3b\x76\xcd\x31\xde\xc0\x53\xf2\x5e\xcb\x94\x3c\xab\x75\x73\xe0\x13\xe6\xb5\xba\xc7\x43\x6a\xb5\x70\x32\x05\xb5\xa4\xbb\xfc\x30\x8e\x1e\xed\x49\x47\xbb\xb6\x1f\x54\xa1\xbd\x49\x48\x2f\x2d\x8d\x29\x2c\x18\x7f\x12\xb7\x87\x6c\x8e\x0d\x34\x05\x2b\xbd\x19\x8e\xf2\x71\x82\x2b\x52\xc0\x76\xe4\x5c\xb6\xf4\x2b\xfd\x76\x17\x80\x6e\x0e\xeb\xba\xc5\x0c\x7b\x44\xde\xd7\x95\x11\x59\xe7\xc7\x83\x3d\x1e\xee\xb5\x13\x44\xf4\x1b\xff\xe5\x37\xed\xc0\xc7\x7d\x2c\x38\xcb\x29\x0b\xb8\xbe\xf1\x3c\x25\xb7\xe2\xad\x8e\xba\xc7\x8c\xa3\xcb\xf0\x05\x06\xae\xa1\xa3\xc7\x74\xef\x81\x81\x37\x90\x75\x37\x13\xfe\xc7\x18\x78\x48\xfd\xa8\x8d\xf3\x22\xe2\x66\xc5\xf5\xa7\x7a\x4d\x6a\x5c\xc8\xc2\x25\x9c\x57\xe9\x9a\xf9\xf5\x62\x39\xb5\x61\xbc\xa7\x95\x98\x29\xb8\x02\xa3\xea\xf4\xf6\xf0\xe1\x46\xe2\x47\xb2\x19\x2c\xfd\x4f\x72\x4a\x73\xd8\xd0\x76\xd5\xe6\x6c\xd5\x36\x69\xed\xc5\x24\x71\x5d\xaf\x1b\x5e\x5a\x13\xb3\xd6\x05\x57\xe1\xc0\xab\x31\x13\x1c\x25\x6e\x8b\xc4\x8e\x78\x6b\x4d\x63\xe3\x79\x6b\x1a\x23\x07\xd3\xfb\x5d\xc8\xe6\xe2\xc1\x9e\xcd\x58\x9a\xab\x6f\x34\xbd\xf1\x26\xed\xa6\xb9\xd2\xf6\x33\xfe\xcb\xfc\x8d\xeb\x10\x00\x9e\x98\xd1\xb1\x58\xfa\xd1\x07\xcd\xfd\x06\x2d\xac\x76\x18\xdd\x86\xbb\x3b\xbe\xf3\x31\xd4\x1e\x62\xcb\x32\xac\x4c\x77\x96\xbc\x91\xba\x17\x99\x83\x2e\x61\xe6\xde\xf7\x83\x95\x3b\x6e\x6f\x09\xf9\x37\xef\xfc\x4f\xad\x9b\x78\x6d\xd5\x45\x44\x73\x2c\xa2\x56\xc7\x13\x5e\xbd\x90\x40\x07\x3d\x75\x34\x2e\x34\xcb\xa0\x7d\xb3\xa7\xcb\x23\x57\x03\x33\x70\x45\xe3\x28\x46\x6d\x6f\x8f\xe7\xcd\x9f\x7e\x8c\x6a\xca\xf4\x4e\x08\x2a\x8b\x06\x01\xf2\xe2\x02\x3e\x44\x2b\x23\xb3\xb6\x33\x8d\x86\x8e\x38\x11\xf8\xc1\x83\x37\x3a\x5c\x47\xd9\xd6\xbf\xd3\x89\x4a\x08\x85\x54\x3a\xb8\xa5\xdd\x75\xc9\xc4\x7d\xbc\xf4\xf3\x16\x6a\x8d\x0a\xb6\x34\xe7\x19\x2b\xcb\x96\xa0\xcd\xc5\x6d\x0e\xef\xf6\x71\x39\x38\x49\x2a\xc1\x3c\x7c\x7b\x88\x2f\x4d\xb4\x7b\x65\x51\xfb\x5a\x86\xb3\xbe\xb5\xd8\xb6\x80\x65\x2a\x58\x33\xa1\x3c\xf9\x5d\xff\xfd\x49\xd1\x24\xbd\xfa\x9b\x97\x24\x7a\x2a\xd4\xc2\x60\x16\xac\x2e\x83\x57\x28\xc1\x15\x5c\x78\xf6\x2e\x8a\x91\x85\x9c\xf8\xe1\xe4\x3b\xa4\xc6\x1e\x75\x37\xc7\x04\x4e\x7b\x19\x95\x97\xe6\xe1\xec\x7f\x03\x00\x00\xff\xff\xae\x3e\xb2\xd3\xdb\x4b\x00\x00"
+
+func importsFungibletokenswitchboardCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsFungibletokenswitchboardCdc,
+		"imports/FungibleTokenSwitchboard.cdc",
+	)
+}
+
+func importsFungibletokenswitchboardCdc() (*asset, error) {
+	bytes, err := importsFungibletokenswitchboardCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/FungibleTokenSwitchboard.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7f, 0x44, 0x1d, 0xcb, 0xf3, 0xd1, 0xc6, 0x2a, 0x1f, 0x39, 0x99, 0x3d, 0x4c, 0x4, 0x72, 0x2a, 0xb, 0x60, 0xda, 0xc7, 0x6f, 0xa, 0x76, 0x22, 0xc8, 0x74, 0x41, 0xe0, 0xb7, 0x7c, 0x9a, 0x84}}
+	return a, nil
+}
+
+var _importsMetadataviewsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7d\x7f\x73\xdb\x36\x16\xe0\xff\xf9\x14\x2f\xde\x99\xd4\xba\x93\x25\x27\xdb\xed\xdd\x69\xaa\x76\xd3\xc4\xee\x7a\x2f\x71\x33\xb6\xd3\xbd\x99\xb4\x53\x43\x24\x24\x61\x4d\x02\x2c\x00\x5a\xd6\x66\xf2\xdd\x6f\xf0\xf0\x83\x00\x49\x59\xb4\x9b\x6e\xf4\x47\x62\x89\xc0\xc3\xc3\xc3\xfb\x8d\x07\x90\x95\x95\x90\x1a\x4e\x6b\xbe\x62\x8b\x82\x5e\x89\x1b\xca\x61\x29\x45\x09\xc7\x77\xa7\xef\xcf\x7f\x3c\xfb\xe1\xcd\xc9\xd5\x4f\xff\xf7\xe4\xfc\xe5\xeb\xd7\x17\x27\x97\x97\x4f\x5c\x87\x73\xc1\x7b\xfb\x9c\x9f\x5e\xb5\x5a\xfe\xcc\xe8\xe6\x82\x2a\x51\xdc\x52\xe9\x5b\xfd\x7c\x76\xf2\xaf\x8b\x93\xcb\x9f\xde\xfc\x7c\x72\xe1\x9b\x3f\x99\x4e\xa7\x70\xb5\x66\x0a\x32\xc1\xb5\x24\x99\x06\x56\x56\x05\x2d\x29\xd7\x0a\xf4\x9a\x42\x49\x35\xc9\x89\x26\xa0\x34\xe1\x39\x91\x39\x54\x52\x54\x42\xd1\x1c\xfb\x32\x0e\xa7\x6f\xce\xde\x1d\x1d\x7f\xf3\xd7\x6f\x26\xe6\x17\xfc\xf5\x82\x2e\x67\xb0\xd6\xba\x52\xb3\xe9\x74\xc5\xf4\xba\x5e\x4c\x32\x51\x4e\x05\x5f\x16\x62\x33\x5d\x16\xac\x52\xd3\x45\x21\x16\xd3\x92\x30\x3e\x25\x55\x55\xb0\x8c\x68\x26\xf8\xf4\xc5\xf1\x8b\xe7\xc7\xff\xe7\xf9\x37\x47\x7c\xa9\x8f\xfc\xe0\x93\x32\x0f\xb0\x2f\xb5\xac\x33\xad\x80\xf0\x1c\x24\x55\xa2\x96\x19\x55\x90\x11\xde\x60\x0e\x82\x53\x10\x12\x4a\x21\x29\xf6\x09\x93\xd0\xdb\x8a\xaa\x31\x64\xa4\x28\x68\x0e\xb7\x8c\x6e\xd4\x04\x4e\x48\xb6\xc6\xbf\xf1\x31\x48\x5a\x49\xaa\x0c\x01\xb0\x2f\x81\x9c\x2d\x97\x54\x1a\xb8\x37\x8c\xe7\x20\x96\x01\xde\x18\x54\x9d\xad\x81\x28\x20\x90\x49\x4a\xb4\x90\xb0\x60\x62\x25\x49\xb5\xde\x62\x6f\x21\x81\xc0\x3f\xdf\x9d\xfc\x08\xac\x24\x2b\x0a\x4b\x56\x50\x4b\x27\x92\x65\x54\xa9\x43\x52\x14\xa3\x86\xf8\x6f\x1d\x60\xb3\x7e\x0a\x3e\x3e\x79\x02\x00\x60\xe0\x9c\xd6\x3c\x33\xf4\x01\x2d\x70\xd6\xc5\x2d\x35\x63\xfa\x7e\x88\xfd\x82\x28\x9a\x83\xe0\x60\xe7\x09\x2c\xa7\x5c\xb3\x25\xa3\xd2\x10\x8d\xf1\x55\x80\x66\x68\x17\x26\x3c\x81\x1f\x84\x94\x62\x63\xd7\x3b\x80\x24\x0a\x9e\x7d\x8c\xf9\xe8\x13\x76\x0b\x30\xf4\x9a\x72\x24\xa4\xf2\x08\xbd\x72\x5d\x4d\x27\x58\x0a\x89\xf0\x54\x45\x33\x83\x43\x6e\x71\xf2\x23\x4f\x3c\x9c\x00\xef\xef\x15\x91\xa4\x0c\x4b\x7a\xb5\xad\xe8\x59\x98\xc0\x0c\xae\xd6\xb4\x33\x2b\xb1\xc4\x21\x7c\x97\x36\x28\x33\x8e\x01\x63\x3b\x9b\xbf\x7c\x8f\x9c\x2a\x26\x69\x0b\x13\xf8\xbb\xa4\xba\x96\x1c\x5e\x82\x42\x1e\xab\x65\xc4\x0c\x8c\xaf\xdc\x60\xbf\xd7\x54\x69\xdf\x19\xce\x96\x40\xf8\x56\xaf\xcd\xf3\x25\x61\x05\xcd\xc7\x60\xe1\x28\xe0\xac\x48\xa6\x19\x2f\xf9\xb2\xe6\x7d\x74\x3b\x95\xa2\x4c\xa7\x7e\x88\x5d\xcd\x67\x17\x69\xec\xe2\x8e\x43\xbb\x68\xde\xdb\xca\x52\x65\x34\x83\x97\x7c\x6b\x45\xe7\x7b\xf8\x18\x9a\xb2\x25\x14\x54\x27\x90\x61\x0e\xaf\x44\x59\x09\xc5\x34\x7e\x3f\xec\x1f\x76\x14\x41\x89\x20\xdd\x46\x0c\x73\x41\x97\x30\x87\x15\xd5\x2f\xb3\x4c\xd4\x5c\x27\x90\x26\x24\xcf\x25\x55\xea\xe9\x68\xe2\x59\x4e\x4d\x16\xc8\x88\xdf\xb6\xf8\xee\xbb\xc3\x64\x24\xf3\xe1\xa4\xa4\xb3\x04\xed\x00\xe5\x9c\x94\xf4\x69\xd2\xa1\x8d\xaa\xa5\x25\x2e\x75\x0b\xdd\x49\xcf\x8a\x24\x58\xa7\x63\x8e\x23\x52\xfb\xbf\x46\xc9\x50\x9f\x80\x16\x8a\xee\x1e\xdf\x73\x48\x68\xff\xe4\xde\x9e\x3d\xbd\x6c\x8f\x4f\x4f\x1a\x55\xf1\x9a\xa9\xaa\x20\x5b\x60\x46\x2b\x2d\x88\x62\x99\x93\xf5\x35\xd1\xc0\x78\x56\xd4\x39\xb5\xb2\x6e\xa8\x38\x36\xc2\x90\x49\x56\xa1\x76\x21\x3c\x8f\x04\xbc\x2e\x17\x9c\xb0\x02\x65\x99\x70\x10\x8b\x7f\xd3\x4c\x4f\xe0\xad\x50\xda\x7d\x51\xa0\xd6\xa2\x2e\xf2\x48\xf7\x6a\x63\x4d\xba\x22\x1e\xf3\xbe\x15\xaf\x80\xa8\x53\x73\x7e\xdc\x2b\x87\x99\x17\x56\x37\x6c\xdc\xa6\xd5\x9e\x29\x58\x32\x5a\xe4\xb0\x61\x45\x01\x0b\x0a\xb9\x85\x4c\x73\x63\x9f\x0a\xa6\x9c\xb9\xd0\x6b\x2a\xe9\x52\x48\xea\xb0\x4e\xc0\x2c\xf0\x57\xa9\xcd\x4c\x33\xc1\x33\xa6\x68\xff\x98\xf1\x4c\x0c\xcb\x5b\x5e\x74\x1a\x36\x01\xf9\x12\x36\x92\x69\x4d\x79\x42\xe3\xcf\x34\x2d\x02\x39\xd5\xa8\x70\xec\xf2\x26\x60\xc7\x09\x28\x25\xd0\x3e\x2e\x28\x5a\x44\xb8\xa5\x72\x21\x14\x85\x43\x3a\x59\x4d\x80\x80\xd1\x96\x68\xb2\x80\x71\xa5\x29\x41\x13\x47\x40\x31\xbe\x2a\x28\x14\x8c\xd3\xd1\x30\x4a\x44\xb3\xdc\x45\x10\x55\x92\xa2\x88\x58\x2b\xe8\x57\xf2\x48\xda\x38\xfe\x5b\x18\x73\xb8\xa1\x8b\xa3\xa5\x64\x94\xe7\xc5\x16\x2d\x2d\x1c\xb2\x09\x45\xf3\x3b\x86\x77\xe7\x3f\x8e\x12\x20\x28\x0f\x8e\x2e\x5d\x86\x19\x9b\x89\xdf\x40\x25\x29\x7a\x09\x63\xa0\x3a\x1b\x46\x85\x30\xb9\x19\x7c\x3c\x65\x05\xfd\xf4\x24\xd1\xcc\xc0\x38\xd3\xa9\x46\x8b\x39\x68\x9c\x3c\xe9\xa1\x68\xda\xa0\x3b\x98\x7f\xd2\xd6\x7a\x8a\x16\xcb\x09\xca\xd5\x1c\x07\xec\x3e\x8c\x99\x74\x1e\x0f\xdd\x6d\xda\x2c\xe0\xbc\x41\xa1\xab\x93\x3c\xa9\xff\x41\x8b\x8a\x4a\xe3\xbb\xac\x68\x23\xf7\xcc\x7b\x2a\x8a\x2c\x29\x6c\xc8\x76\x97\x4f\x10\x6b\xe9\x19\xbc\x04\x49\xd1\x1f\xcb\xa8\x81\xe8\x3d\x00\x74\x75\xbb\xae\x80\xb7\xe9\x1c\x04\xce\x85\x14\x61\x7c\xab\x86\xee\xb5\xd0\x2b\xaa\x5d\xeb\xc3\xdf\x5a\x68\xa4\x46\x6a\x12\xac\xd5\x08\x66\x7e\x84\x1e\x4b\x8b\x1c\x30\x4f\x40\x79\xa3\x83\xc6\xc6\x98\x90\x6f\x5d\xf7\xef\x0e\x47\xbb\xac\xac\x03\x01\x44\x7d\x1f\xa9\x51\x68\x7d\xbc\x91\xdb\x65\x62\x9e\xb4\x1a\x7a\xbb\x12\xad\xdc\x8f\x94\x53\xc9\x32\x60\x5c\x53\xb9\x24\x86\xe4\x46\x6c\x1a\x1f\x19\x88\x95\x34\xa5\x85\xb4\xde\xa7\x30\x8e\xd9\x12\xb2\x35\x61\x7c\x02\x86\x29\x55\x00\xe7\xc4\xad\x36\x8e\x2a\x7a\xb2\x6e\x21\x95\x75\x8f\x95\xb1\xa8\x39\x15\x56\x5d\x0b\xa3\xaf\xa1\xa4\x39\x23\x7b\x6d\x49\x83\x9f\x19\x30\xa2\x45\xdc\x16\x49\x66\x96\xb5\x96\xec\x70\x34\x8b\xbd\xe2\x68\xca\xe8\xbe\x6a\x01\xf4\xce\x84\x39\x7e\x7e\xd6\x7a\x2a\x07\xcf\x84\x60\x40\xd0\x56\xfc\xe3\xea\xea\x1d\x1c\x0a\x89\x7f\x5c\x8e\xe0\xfd\xc5\x9b\xbd\xd8\x9a\xa6\x06\xcf\xd9\x7d\xd8\x9a\x85\xae\x65\xd1\xd5\xa4\x8d\x16\x89\x1e\xf7\x8a\x7b\x2d\x8d\x80\xd6\x32\x16\xcd\x07\x50\xa6\xdf\xfb\xf0\x90\x77\x8b\x7b\x3f\x05\x1b\x0e\x39\x7b\x77\x7a\xd9\xb8\xdd\xe6\x9b\x5b\x7e\x20\xe8\x72\x3b\xa6\xc8\x61\xb1\x35\xe2\xcd\x24\x46\x25\xc6\xb9\x88\x9c\xff\xc3\x57\x67\xaf\x47\x01\x88\x24\xc8\x2c\x7a\x4d\xd0\x32\x32\x49\x33\x0d\xef\x2f\xce\x26\xf0\x12\xb2\x82\x99\xbe\x51\x94\x89\x7c\x58\x2b\x6a\x9d\x95\x57\x67\xaf\x1b\xa7\x47\xc0\xd2\x04\x79\x86\xff\x0a\x41\xd0\x67\x70\xa1\xdb\x2d\x23\x66\xbd\x11\xdd\x15\xd1\x74\x43\xb6\x7b\x17\xda\x34\x4e\x16\x3a\xb1\x40\xaf\xce\x5e\x1b\x96\xf2\x61\x57\x6b\x82\x36\x82\x62\xca\x8e\x68\x03\xc7\xa8\x77\x02\x29\x09\xb8\x73\x91\xa9\x09\xab\x96\x6a\xc2\xc4\xd4\xb8\x32\xb4\xd2\x6a\xea\x46\x38\x72\x7e\x37\xe3\xab\xe9\x20\x73\x96\xb1\xbc\xdf\x98\xbf\x23\x7a\x8d\x12\x11\xa9\xd6\xca\xfc\xe6\x94\x32\x2e\xba\x57\xc8\xa8\xec\x1d\xf1\xec\xea\x08\xb9\x1d\x64\xe0\x99\x02\xc1\x8b\x2d\x70\x4a\x73\x63\x9f\x97\x0d\x70\xa6\x8c\xc7\xc2\x72\x1a\x96\xfc\x5e\xa0\x03\x88\x64\xc0\x1e\xa9\xad\xd2\xb4\x54\xc3\xc8\x63\x66\xec\xe9\xf3\x7d\x9f\x8c\x46\xf4\x1b\xa7\xad\x7b\x45\x36\x63\x39\xcc\x0d\xd1\xbb\x8f\x90\xb8\x73\x84\xd1\x27\xcf\x0d\xdd\x7c\xae\xc0\xc7\xa1\x86\x60\x48\x79\x4e\x34\xbb\xa5\x46\x45\x35\xdc\xd5\x61\xac\x7b\xe8\xb4\x16\x9b\x23\x2d\xa6\x8e\x85\x8e\xcc\xcf\x47\x82\x1f\x6d\xe8\x62\xfa\x17\x0b\xfb\xa8\x96\x85\xda\xb9\x02\xde\x1a\x1b\x17\x5f\x59\x15\x63\xd8\x92\x30\xee\xc3\x6b\x5c\xd7\x5a\xb2\xbd\xb4\x1f\xa4\xb1\x9c\xb9\x74\x84\x6b\x88\xb8\xd3\x54\x1e\x98\x29\xcd\xa6\xd3\x5f\x0e\xfd\x62\x8c\xa6\xbf\x1c\x9a\x3e\xa3\x83\x96\x15\xed\x53\x8d\x3d\xdd\x0f\x76\xab\x49\x9f\x67\xb0\x96\xd0\x19\x54\x20\xb0\x72\x36\x17\xd5\xd7\x5b\xb2\x4d\x2d\xa6\x6f\x67\xa8\xf5\xfe\xe2\x0c\xc4\x32\x4e\xc9\xc0\xf9\xe9\x15\x6c\x4c\x9c\x83\xdf\xb4\x4b\x7a\x98\x86\x4c\x01\x17\x1a\x88\x31\x5d\x5a\xa0\xd7\x4b\x35\x95\x25\xe3\x34\x47\x47\x79\x82\x76\x69\x9c\x28\x66\xeb\xf7\x8e\xf6\xe9\xb9\xf7\x17\x67\x1d\x5b\xe6\x63\xb9\x05\x51\x16\xd3\x4a\xd2\x25\xbb\x1b\x9b\x55\x21\x7c\x3b\x81\x73\xa1\xbd\x54\x63\x84\x59\x14\xa6\x99\x1a\xc3\xa2\xd6\xb0\xa6\x45\xb5\xac\x8b\x04\x9a\x69\xa5\x44\x89\xd4\x80\x8c\x28\xaa\xe0\x54\x48\xa0\x77\xc4\x44\x9f\x63\xa8\xab\x9c\x60\xa2\x86\xc0\x66\x2d\x0a\x4b\x8c\x4c\x14\x05\x45\x79\xf8\x2a\x61\x4c\xa7\xd5\xd7\x26\x98\xa5\x44\xb1\x62\x3b\x48\xde\xcd\x6c\x70\xb6\x5e\xe4\xdb\xd3\x35\x33\x75\xbc\x7d\x4b\x8a\x9a\x26\x0d\xce\x7f\xba\x3a\x99\x59\xb9\x63\x0a\x14\xd5\xc6\x1c\x1a\x35\xe1\x72\x88\x82\x67\x44\x53\x9e\x04\x43\x6e\x44\x1f\xc1\x26\xf0\x70\x04\x43\x50\xdf\xe8\xe9\x3c\xc9\x0e\xf4\xcc\xc4\x70\xa6\x9d\x0a\x76\xee\xea\xf6\x3f\xe4\x17\xa4\x53\x8e\xa4\x04\x75\x61\x9b\x78\xe3\x14\x87\x5e\x85\xe8\x67\x36\xf7\x73\xec\x36\xb1\x54\x98\xb7\x88\x00\xdf\xfb\x1f\x9e\x4e\x2c\x61\x0f\xb1\xa1\x71\xd0\xdb\x68\x46\xc8\xf6\x70\xf7\x5b\xe3\x82\xb6\x8d\x37\x72\xbb\xcf\x72\xa2\x93\x3a\x88\x7f\x96\xe8\x0d\xb4\x03\x43\x9b\xa4\xce\x19\x39\x42\x89\xcd\x44\x49\x8d\xd9\xb3\x2a\x51\xc8\x12\x79\x61\x5b\xd1\xa9\xaa\x17\x36\x8f\xaa\x5c\x80\xb6\xa0\x39\xa0\xb8\x27\xb0\x82\xf6\xa6\xb7\xb4\x10\x15\x95\x93\x52\xfc\x87\x15\x05\x99\x08\xb9\x9a\x52\x7e\xf4\xfe\x12\x35\xfb\xf4\x5f\x74\x31\x35\x52\x3f\xfd\x81\x28\x96\xa9\xdf\xc4\xf2\x37\xfc\xfa\xf6\xec\xed\xc9\x6f\x18\x9b\x0d\x9a\x15\xe2\x6e\x13\x61\xbb\xbd\xd5\x78\xea\xe3\x6e\x97\x74\xf5\x71\x61\x4d\x8f\xb9\xf9\xa7\xfd\x20\x74\x9e\x87\xbf\x76\xeb\xd9\x7f\x49\x52\x99\xf0\xf3\xd6\xe7\xa5\xcb\xba\xd0\xac\x2a\xdc\xb2\xd9\x6d\x80\x7d\x1a\x0e\x79\x40\xb5\x99\xe0\x25\x07\x22\x17\x4c\x4b\x22\xb7\x47\x8a\xfd\x87\xe6\x98\x3d\x70\x19\xb3\x2d\xf0\xba\x5c\xd8\x44\xb5\xe5\x21\x66\x1c\x8b\x9d\x54\xc4\xa7\x33\xf8\x80\x6d\x7f\xed\x23\xe1\x6f\xad\x36\xbd\x12\x83\x4d\x60\xde\x1a\x6c\x4f\x50\xee\xe6\xf7\x5f\x8d\xc9\x1b\xbf\xd1\x8d\x3e\x2c\x22\xb7\x8d\x1f\x14\x90\xdb\x2e\x8f\x8d\xc7\x6d\xef\x81\xe1\x78\x60\x14\x68\x7d\x3e\x43\x34\xee\x03\xab\xd8\x5b\x28\x58\x46\xb9\x89\xb2\xb2\x4c\xc8\x1c\xfd\x28\x11\xe4\x5f\x55\xf9\x1d\x8a\xbc\x6b\xa5\x9a\x75\xbc\xf2\x79\xda\x24\x28\x77\xee\xb5\x0f\x47\x84\x31\xd5\x68\x46\x99\xf2\x23\xe5\x7b\xa3\x9e\x37\x0e\xa5\xdd\x71\xad\xc1\xab\xbb\x85\xb1\x83\xe3\x3b\xed\x7a\xb9\x3e\x05\x69\xd8\x3f\x7c\x19\x2a\x03\x1e\xef\x2f\x95\x98\xf2\xe3\x0f\x13\x03\xd7\xfa\x41\x72\xe0\xfa\x3c\x56\x10\x5c\xf7\x81\x92\xd0\x65\x83\x3f\x41\x14\x42\x8e\xc1\x04\x35\x48\x75\xe3\x5b\x69\x5a\xe2\x46\x28\x07\x7a\xa7\xa9\x34\xc4\x55\x4c\xd3\x49\xca\xfd\x31\xe3\x2f\xb6\x71\x82\xc0\x30\xfb\x0d\x85\x49\xc8\x05\xfc\x50\x88\xcc\x40\x17\x3e\xb7\x50\x2b\x2a\x15\xc4\x79\x03\x4c\x5c\x4b\xb6\x62\x66\x34\x4c\x1e\xbb\x7d\x13\x23\x3e\xb8\x0f\x5c\x49\xf1\x6f\xd3\xb7\x32\x8e\x27\x26\x94\xbc\x0d\x57\xc1\x77\x6f\xdc\xd5\x06\x59\xba\x0a\x02\xbd\xd9\x6c\x26\xe5\x16\x37\xc7\x1d\x34\xbb\xb1\x7e\x4b\xa5\xa1\xfb\x91\x58\xe2\xb3\x06\xca\x3e\x59\x3d\x71\xf4\x31\xe4\x7b\x74\x1e\xea\x37\x18\x90\x89\x9a\xdf\x9b\x33\x4a\x25\x31\xc6\xea\x4b\x49\x63\x8c\xc3\x30\x89\x8c\x7a\x3c\x48\x2a\xa3\x7e\x8f\x95\xcc\x08\xc4\x40\xe9\xec\x5f\xf8\xcf\x2e\xa1\x96\xcb\x97\x8c\x53\x9f\xe8\x2a\x2b\xa1\x30\x08\x95\x62\x4b\x0a\xbd\x6d\x2a\x4b\xb0\xf1\x8a\xdd\x52\x05\x25\x91\x37\x54\x57\x05\xc9\xa8\x09\x8c\x02\xd0\x9a\xdb\x62\x82\x28\x1f\x2d\x40\xd5\x95\xad\x8f\x39\xbd\x72\x40\x19\x55\x7b\xad\xd4\x85\x1b\xbe\xe5\xd2\xf9\x8c\x77\x5a\x69\x73\x41\x33\xca\x6e\x43\x56\x8e\xc2\x82\x72\xba\x64\x19\x23\x72\x1b\xea\x10\x2c\xc0\x04\xda\x2b\x82\x9c\xe1\x8d\x6a\x26\xa9\x6e\xa2\x72\xcb\x93\x0e\xf0\x86\xe9\x75\xf8\x36\x59\x51\x8d\x9b\xef\xa3\x56\x66\x26\x13\x65\x49\x79\x6e\x03\xc4\x23\x78\x8f\x5a\xc8\xed\x81\x61\x05\x8a\x51\x85\x9c\x6e\x22\x05\x04\xa7\x85\xd8\xd8\x59\x24\xc0\x64\x3a\x25\xa6\xa0\x56\xc6\x7d\xb8\x5e\x51\xed\x68\xe3\x67\xfd\xae\x5e\x14\x2c\x7b\x47\xf4\xfa\x70\x74\x3d\x46\x85\xc8\x85\x4e\xc1\xd9\x74\x2a\x35\x8b\x4d\xea\x42\x47\xa3\x86\x49\x59\xad\x8b\xbb\x99\xa4\x28\xc4\xc6\x29\x51\x2d\x6c\xe4\xde\x8a\x61\x90\x64\xa4\x22\x0b\x56\x30\x8d\xbb\x45\x18\x0d\xd5\x58\x97\x61\xfa\xa0\xda\xc7\x1d\x4d\x9f\x31\x69\x9a\xef\xd4\x64\x1e\x99\x19\xbc\x0a\x8d\xbf\x7d\xf6\x31\x59\xed\x89\x9f\xf7\xa7\xef\x52\xde\x78\x6b\x03\x07\xe3\x5f\xf8\x84\x4c\x46\x8a\xac\x2e\x0c\xf2\x06\x3b\x52\x8a\xda\xba\x4d\x8a\x14\xd4\x85\xe7\x5a\x12\xae\x96\x54\x4a\xdb\x23\x5d\x04\xc7\x84\x0d\x8d\xce\x85\xa6\x70\x04\x67\x3a\xda\xda\x5c\x50\xbd\xa1\x94\xc3\xf1\xe4\x18\x89\xff\x7c\x72\x9c\x82\x39\xb9\x33\x5d\x2c\x47\x45\x23\x33\x05\x77\xd8\xa1\x6c\x10\x67\x0a\x8e\x27\x7f\xfb\xc6\xd6\xef\x44\x6c\x0b\x3d\x89\x85\x8d\x47\x00\x7b\xfc\x0f\xb8\x9b\x74\x45\x85\x14\xc5\x16\x2a\x2a\x33\xca\xb5\xb1\x6b\x2b\x1a\x6d\x0f\xd9\x0d\x55\x4d\x65\xa9\x0c\x51\x16\x44\x31\x05\x95\x60\x5c\xb7\x72\x31\x1c\x94\x28\x58\x6e\x16\xda\x96\x31\xa9\x92\x48\x1d\xaa\x91\x14\x6c\xd6\x26\xde\xce\x48\x8e\x0a\x5d\x2c\x97\x86\x73\xae\xdf\x9f\xb2\xbb\x6f\xbe\xbe\x6e\x33\x0e\xd1\x40\x0a\x49\x49\xbe\xf5\xba\x41\xf9\x54\x4a\x18\x3f\x24\x91\x60\x41\x33\x62\xbe\x30\xad\x52\x40\x26\x70\x76\xee\x00\x91\x14\x8c\x3b\x29\x69\xb1\x0d\x79\x33\xa6\xb4\xdb\x1a\x5b\x99\x20\x2f\x6a\xcd\xf3\xa0\x94\x52\x21\xa9\x0c\x07\xfc\x6f\x8f\x82\x58\x42\x25\x69\xc6\x54\x30\xf7\x7d\x2c\x9b\xd5\x7a\x06\x76\xa6\x29\x3b\xfe\xe4\x4d\x55\xb2\x4d\x1c\xbb\x36\x56\x86\xcc\xe4\xcc\x50\x64\x1b\xaa\x98\xec\x9a\x8f\x3b\x02\x27\x69\x61\xe7\xb0\x66\x55\x60\x3b\xf3\xe0\x7a\x43\x8a\x82\xea\x6b\x9f\x86\x32\xca\x76\x0c\x36\xcc\xb5\xd5\x4f\xb4\x50\xb4\xbb\x0e\xe8\x15\x6d\x38\x95\x50\xb2\xd5\x5a\xc3\x86\x70\x4c\x78\xda\xba\xb0\xed\xee\x59\xdf\x5b\x4c\xd0\xb8\x1e\x0f\x94\xe7\x71\x4c\xcd\x71\xdf\x20\x6d\xdb\x59\xc9\x3e\x0f\x36\xab\x35\x7c\x37\x47\x81\x7c\xf6\x0c\xbf\x7d\x3b\x47\xb1\x9c\x75\x9a\x9a\xcf\x41\x52\xd8\x37\x71\xea\x75\x62\x66\x60\x10\xe7\x46\x9b\x9a\x2f\x8c\x14\xec\x3f\x76\xd9\xbc\x79\xf2\x3d\xd1\x9e\x3e\x85\x83\x5e\xf8\x3e\xc1\x75\x70\xb5\xa6\xc6\xcd\xbc\x65\x39\xcd\x83\x64\x1b\xf4\xac\x40\x8b\x25\xfc\x72\x98\xd5\x7a\x64\xf7\x4e\x6e\x49\xc1\xf2\x09\x1c\x8c\xee\x07\x9a\xe8\x22\x63\xa7\x9c\x2e\xc6\xee\x20\x09\x5f\x45\x2a\xca\x29\x28\x60\x13\x0a\x1f\x8e\xc7\xcf\x7f\x6d\x41\xff\xd4\x75\x0e\x83\x25\x9a\x07\x3d\xd8\x6d\x64\x26\x31\x37\x53\xe9\x3e\xda\x5f\xb8\xf0\x80\x9c\x90\xa7\xbb\x2d\x0e\x0d\x1d\xde\xc6\x0e\x89\x91\xae\xdf\x6b\x2a\xb7\xd6\x6e\x5e\x5f\x78\xa7\xe3\xda\x3b\x17\x58\x73\x7b\x7e\x7a\x15\x85\x08\x86\x2e\xa8\x46\xee\x2a\x9a\x69\x6b\x0b\x2a\xb2\x6d\x3c\x96\xa6\x80\x53\xaf\xa9\x0a\xa5\x93\x2e\x22\x19\xe8\xcf\x18\x38\xed\x24\x95\x94\x64\xeb\xa4\x51\x92\xec\xc6\xea\x42\xc6\x73\x76\xcb\xf2\x9a\x14\x0d\x06\x6d\x61\x6c\x72\xc5\x59\xad\xcf\xf8\x52\xa8\x19\x7c\x70\x04\xfa\xf5\x9e\x8c\xb1\x0b\x0a\x7a\x3a\xb5\xa5\xcb\xf8\x89\x86\x8b\xac\x01\x25\x1a\x54\x8d\xc9\x4e\x52\x14\xc8\xb6\x8d\xe1\x0a\x6e\x8e\x91\x95\x05\x85\x15\x7a\x3b\x6e\xcb\xf7\xf9\xe4\x38\x01\x7b\x4b\x4c\x28\xa1\x49\xf1\x0a\xb9\xe6\xb8\xf5\xd8\x2c\xb8\x17\x0e\xc6\x03\x9e\x3d\x72\x1e\x01\x09\x7f\xfe\x4f\xdf\x77\xd2\xe6\xc6\x94\xb7\x89\x52\x54\xea\x6e\xf1\x62\x00\x64\x55\xc6\xb8\xd3\xa0\xa4\x4a\x91\x15\x7d\x80\x2a\x31\xfe\xee\x2e\x65\xd2\xf0\xc5\xc3\x54\x89\xdd\xa5\xb3\xcb\x11\x28\x14\xaf\x07\x53\xf0\xcb\xa1\x9f\xcc\x08\x77\x6d\x98\xde\xb3\x4a\x2d\x65\x30\x6a\x33\xc3\x4b\xa5\xd8\xca\x6a\x17\x3f\x66\xaf\x2a\xb0\xd8\xcc\xbb\x8d\x5a\x49\xf5\x0b\x1b\x9e\xc4\xf0\x30\x43\x1b\x37\x4a\x3a\x44\x91\x20\x41\xb9\x89\x36\x28\x6d\xe9\x1a\x8d\x24\xd6\x8a\xde\xfe\x1c\x79\xd8\x47\x09\xbe\x35\xa3\xea\x70\x14\x09\xc6\x3d\x7b\x2a\x3d\x73\x84\xfb\x82\xe7\x66\xc1\xbf\x50\x36\xf7\xa2\x45\x9f\x7d\x71\x73\x43\x91\x87\x44\xcd\xa1\xd7\x63\x63\xe6\x00\x60\x60\xc4\x1c\x2b\xd8\xb6\xd4\x7c\x96\x52\x2b\xeb\x2d\xd9\x1a\x0a\xd4\x85\xc1\xe8\x62\xb4\x81\x5a\x0b\xed\xa3\x61\xc6\x54\x69\x87\x9c\x16\x56\xfd\x36\x20\x30\xd8\xa2\xb7\x94\xeb\x1a\x1d\xf5\x18\x56\xb3\xd3\xac\x36\x4c\x67\xeb\x85\x30\x41\xb8\xb7\xc0\xcd\x3e\xf0\xda\x32\x82\x2f\xcb\x35\x72\x8e\x60\xb1\x2c\x23\x41\x2e\x10\xc8\x7c\xe3\xa2\xaf\xca\x3f\xaa\x00\x68\xa2\xca\x10\x55\x7b\x84\x4c\x20\x1f\x7b\x02\x7d\xcc\xd3\x95\xa9\xde\x78\x75\x16\x8f\xf3\xb1\xbd\x0e\xd3\x0a\x1f\x4e\x5d\xd4\x7f\x7a\x75\x11\x0f\xbb\x27\xf5\xee\x2a\x64\x6d\x9d\x4a\x74\x2c\xc4\xa5\x1e\xcf\x4f\xaf\x26\x9d\xc5\xf1\x71\x23\x26\x05\x24\x61\x36\x0a\x88\x8c\xf1\x0d\xdd\x4e\xad\xb3\x56\x11\x26\x15\x90\x42\xf0\x95\xcd\x0e\x28\x51\x36\x72\x87\x29\xfa\x3b\xb3\xac\xb8\xed\x84\xe3\x92\x85\xa8\x2d\x13\x21\xe8\x7d\x1e\xc3\x95\x69\x94\xec\xd8\x77\x8a\xaf\x11\xce\x04\xde\xb0\x1b\x0a\x3f\x90\xec\x66\x25\x45\xcd\xf3\x31\x9c\x6c\xa9\x1a\xc3\x3f\x08\x93\xad\xca\xd8\xa1\xd5\xd1\x38\x52\xcd\x73\x2a\x8b\x6d\xd8\x2b\x4f\x46\x1d\x7b\xc5\xa3\xfd\xcf\x48\x68\x65\xab\x93\xb1\x89\x77\x75\x3d\x31\xbc\xb6\x42\x60\xbb\x71\x72\x9b\xce\xe1\x44\x44\x82\x97\x2b\x05\xc6\xa3\x10\xd1\x7a\xa9\xb5\xd8\xd8\xe2\x03\x3f\x96\x25\xf6\xc6\x06\x39\x4c\x59\xb2\xe1\xf9\x16\x9c\x4a\x60\x94\x18\xb8\xe1\x73\xc6\x95\x26\x3c\xa3\x63\xd8\x8a\x1a\x32\x14\x71\xe5\xb1\x32\x43\x11\xa8\x39\xbb\x03\xcd\x4a\xaa\x34\x29\x2b\x9b\x70\x71\x01\x53\x82\x1f\x51\x70\xf0\x9a\x68\x7a\x80\x13\xa7\x45\xbc\xf1\x0f\x55\x41\xf4\x52\x98\xc8\x5b\x0b\x83\xb4\xaa\x4b\x57\xf0\x66\x69\x87\x15\x07\xe8\x78\xf9\x7c\x0e\x71\xfb\x95\xbb\x63\xb2\x66\xec\x9e\x9a\x27\x63\x6e\x89\x34\x21\xbc\xf1\x8f\x49\xa1\x44\xd0\x0e\x36\x69\x5e\x6c\x9d\x64\x10\xad\x25\x5b\xd4\x3a\x29\x3c\x4a\x99\xc3\x4a\x4b\x30\x29\x3e\x46\x47\x34\x8b\xa2\x81\xa0\xb0\x84\xc4\x4d\xd1\xfd\xe6\xd9\xe0\xfc\xf4\xea\x2b\x05\x12\x71\xda\xcd\x0d\xf6\xf9\xcc\xe1\xde\x5b\xc3\x95\x14\x68\x77\xd8\x67\xdc\x4b\x97\x71\x1b\xf0\xc3\x0b\xb2\x7d\x5d\x43\x5a\xad\x10\x1e\xc7\x9c\x30\x8f\x71\xe8\x89\xb0\xec\xba\xcc\x1d\x4e\x03\xe3\x22\x54\x77\xa8\x26\xbd\xe7\xe3\x35\xd6\x7e\xfd\xe6\x3a\xba\x0e\xb8\xb3\x3c\x40\xc5\x05\x70\xb1\xa4\xf5\xa8\x38\x4a\xb2\xb5\xd3\x4d\xf7\x2a\x37\x75\xcf\x9e\x86\x45\x6d\x06\x1f\xb0\xe5\x8e\xed\xf6\x56\xa3\xde\x35\x74\x73\x9c\xbb\xc6\x3d\x46\xdf\x7c\xd2\x90\x2c\xcf\x55\x63\x40\xac\x1e\x76\x4c\xeb\xf0\x36\x48\x24\x5d\x52\x2f\xd5\xba\x6d\xd8\xd6\x9d\x8d\x43\x18\x4d\x41\xd9\x82\x02\xc9\x73\x9a\xef\x75\x4d\x8d\x05\x25\x79\x8e\xa0\xcc\x84\x67\x16\xea\x3d\x33\x9d\x18\x16\xe1\xf9\xa1\x1e\x0d\xf5\x48\xa3\x39\x7d\x29\x9f\xd4\xa1\x30\xcc\x21\xb5\x8d\x1f\xe4\x8d\xda\x2e\x8f\x75\x45\x6d\xef\x81\x7e\x68\x87\xb3\xfd\xe7\x33\x38\xa1\x6e\xdd\x96\xd1\x71\x53\x5b\x1c\x67\x84\xf1\x96\x4a\x8d\xa5\xb6\xf8\x8c\xc8\x2d\xae\x84\xe5\x09\xac\xc4\x3b\x3f\xbd\x82\xc8\x41\xf1\x7b\x90\xca\x6d\x03\x09\x54\xdf\xa8\xaf\x29\xc3\x7a\x6d\x7f\xde\xc7\xaf\x12\x6a\x05\x67\xe1\xaf\xac\x13\x10\xe0\xa1\xe9\x2a\xa9\x5e\x8b\x70\xea\x47\xd5\xcb\x25\xb3\x0c\xb1\x62\xb7\xe8\xa3\x96\x68\x5f\x30\x72\x13\x4b\x97\xa1\x72\x28\xee\x62\x34\x33\x1f\x2b\x44\xe9\xcc\x16\xd4\x4f\xda\xaa\xb4\xab\x46\xbc\xa3\xde\xf4\x0e\x4f\xd4\xe5\xe7\xa4\xa4\x6a\x96\xec\x20\xba\x6a\x39\x8b\x8d\xb3\xdf\x3e\x03\x7b\x6d\xc6\xba\x0e\xc0\xfc\xe7\x86\x6e\x1d\xb5\x88\xb4\xd6\x6e\x43\xb8\x1b\x7f\x41\x33\xa3\x15\xaf\x2d\x1e\xd7\xbd\x3e\x35\x3a\xd0\xc4\x74\x68\xeb\x91\x5d\xec\x6e\xf0\xb8\x12\x8e\xe3\x2d\x29\x3e\x5a\xc4\x23\x13\xf7\x69\xdc\x9e\xe7\x07\xdb\xe6\xd7\xef\x47\xb3\x2e\x43\x4e\xa7\xf0\x2a\xac\xbe\x4d\xff\x2a\x97\xff\xf5\x53\x0a\x26\xc5\x39\x75\x36\xa5\xc8\x64\xe3\x44\xbb\xa3\x8a\xf9\xa4\xe5\x35\x6e\x5b\x99\xe4\x35\xe1\x79\x41\xad\xc5\x40\x22\x9b\x40\x07\x53\xd3\xba\x69\xfc\xef\x5a\x45\x63\x23\x9f\x78\xf8\x78\x8e\xa3\x28\x26\xb1\xe0\x26\x93\xf5\xf5\x84\x1f\x3b\x39\xa4\x1b\x83\x76\xd2\xf6\x69\x8f\x58\x1a\xa2\x4e\x24\x2d\xc5\x2d\x3d\xbc\xa1\xdb\x19\xdc\xec\xca\x88\x46\xc9\x8b\x1e\x0b\x05\x73\xf8\xf0\xeb\x93\xce\xf8\x08\x1e\xf9\x26\x1d\x3a\x40\x80\xb9\x5d\x21\xe7\xc6\xdc\x04\x0f\xc6\xf4\xfc\x70\xf3\xeb\xd3\x96\x03\xc3\x59\xd1\x38\x2f\x9c\x15\x29\xb6\x2d\x1b\x80\xb6\xa2\x6f\x02\x9e\x29\x2d\x63\xd9\x5e\xa3\xb6\xba\x09\x3b\x18\x21\x0f\xdb\xd1\x1a\x4c\xa9\x9a\x36\xe9\x59\x77\xee\x34\x40\xc0\xc0\xc8\x6e\x7b\x95\x78\xe8\x5f\xb1\x92\x15\x44\x46\x67\xf4\x97\x4d\x8d\xb0\x51\x0e\xff\xcf\x28\x86\xe7\xc7\xc7\xc6\xe9\xb6\x5b\x92\x01\x18\xe3\xc6\x61\xb6\x9b\xab\xd6\x97\x59\xd6\xf6\xf8\xab\xdd\xfd\xb0\x3b\x3b\xf1\xde\x74\xe3\x00\xbd\xb4\x85\x1e\x96\xdd\x16\xc6\xb5\x91\x18\xb8\x04\xcc\x69\xce\x70\x5a\x63\xd8\xac\x59\x86\x47\x27\x36\x6b\x3c\xe0\xe2\x1f\xed\xc2\xc3\x92\xd2\x70\xaa\xb2\xda\xcd\x55\x1c\x82\xad\x38\x44\xfd\xb2\x2f\xd6\x3b\xb1\x43\xec\x3b\x6c\x1b\x63\xe2\xdb\x24\x35\xd6\xda\x5e\x16\xe1\xf2\x12\x97\x54\x8f\xe1\x5d\x41\xb6\x63\xb8\xa4\x92\x51\x95\xee\x28\xb9\x2a\x48\x7b\x90\x6b\x43\xb6\x51\x0d\x8c\x05\x91\x15\x44\x29\x13\xd5\x18\xfd\xe1\x09\x34\x28\x96\xfc\xbe\x3b\x0f\xd7\x3f\x2a\xba\xdc\x71\x96\x14\x67\x44\x38\x1c\xbc\xf8\xda\xf3\xc2\xe1\x5f\x5e\x7c\x3d\x7d\x7e\x7c\x3c\x3a\xc0\xe2\x21\x1b\x7b\x3a\x40\x4c\xc1\x8b\xaf\xef\x89\x70\xb1\xd5\x0c\xde\x9f\x71\xdd\xde\xa1\x33\x68\x95\xe4\xae\x17\x35\x13\x88\xb9\x42\x00\xc7\xd4\x93\x56\xdf\xf6\x21\x57\x9f\x70\x09\x1b\x3c\x86\x33\x0b\x56\x32\x4d\xf3\x23\x37\x04\xcd\xfb\xa1\x0d\x98\xb2\x41\x94\x29\xf3\xac\xb7\x2b\x16\x55\xa1\xb8\xd5\xdc\x0d\xea\xe7\x65\xfb\x36\xe9\x2a\x13\xce\x6a\x61\x74\xc7\xb0\x23\xb3\x25\xb9\xf3\xf4\xdb\x1b\x7f\x7d\x3f\x6e\x51\x7c\x9c\x74\xef\x71\xa0\x0c\x6e\xbd\x2a\x1c\xee\x49\xd2\x9b\x8f\x5b\xad\x6f\xe7\x06\xc4\xd3\x6e\x9e\x1e\xf6\xe5\xea\xa1\x9b\xaf\x77\x72\xd8\xce\xd6\xc7\x3b\x7f\x5e\x54\x07\xa5\xeb\x61\xe7\xee\x5f\x97\xe7\x7e\x39\xb4\x7f\x8f\x4c\x80\xde\x97\xa0\xd7\x3b\xd9\xf5\x97\x43\x43\x82\xd1\xa4\x67\xab\x70\xef\xf6\xde\xbd\x31\xae\x1b\x62\xde\x4e\x3d\x84\x06\x06\x1f\x5c\x80\x81\x41\x6b\xb2\x99\xe7\x49\x39\xa8\xc4\xdb\x35\xfe\x03\x45\xde\x1d\x0d\x96\x6c\x83\x27\xe6\x81\x78\x03\xb1\x53\x28\x8c\x11\x78\xc3\x94\x9e\xc1\x07\x87\xd9\xae\x92\xf0\x6e\xc3\xfe\xba\x70\xd7\x0e\xe6\xa1\xcb\xd0\x00\x2e\x90\xe6\x8b\x15\xe3\x79\x04\x06\x56\xe2\xb9\xe6\x0f\x2b\xc3\x73\x9d\x1e\x5d\x83\xe7\xfa\x0f\x2d\xc0\x6b\xd8\xad\x2d\x52\x9f\xab\xfa\x2e\xb9\xbd\x26\xd8\xde\x23\x5b\x8f\x97\x83\xa2\x92\x91\xc2\xf3\xaf\xdd\x12\xf0\x9b\xce\x86\x5b\x03\xb0\x77\xb6\xa3\x82\x35\xb9\xa5\xd1\x7d\x48\x08\xc8\xcd\x02\xbd\x24\x0c\x5c\x5a\x70\x83\x59\x08\xe0\x2e\x8d\xab\x5e\x92\x6d\xa8\x19\xc3\x8d\x72\x49\x57\xb5\x71\xdc\xce\x5e\xdb\x7c\x67\xdc\x28\xba\x84\xa9\x89\x2f\xad\xef\xe0\x8f\xf4\xda\x53\x9b\x13\xbb\x6b\x99\x20\xc0\x54\xb2\xe7\xbe\xa0\x50\x73\xf6\x7b\x8d\xd5\x5a\xd1\xa5\x48\xd6\x4b\x41\x54\x42\x8d\x03\xd1\x9e\x68\xfb\x94\xc7\xa5\x1d\x72\x77\xba\x69\x97\x9b\x10\x4b\x72\xda\xa6\x3f\x61\xb8\x43\x5f\xee\x11\x60\x87\xde\x97\x12\x5f\x37\xfc\x30\xe1\xb5\x8d\x1f\x24\xba\xb6\xcb\x63\x05\xd7\xf6\x1e\x28\xb6\x9d\x85\xfe\xdc\x42\xdb\x14\xb5\xbb\xac\x6d\x1c\x0d\x38\x21\xb5\x79\xc3\x28\x99\x8b\x87\x0b\x85\x2f\xa2\x20\xbe\x2b\xa7\x34\x57\x36\x48\xbe\xa5\x3e\xe9\xa2\x32\x21\x31\x54\x8a\xeb\x66\xdc\xee\x7d\x46\x78\x93\x71\xc1\x4e\x0b\xd1\xa4\x65\x77\x31\xbf\x4b\xfb\x77\xcf\x7e\xba\xa1\x5c\xa9\xab\x6d\x85\xfb\x0e\x7b\x36\x1a\xb0\x9f\x2f\xd3\xea\x71\xf5\x4b\x72\xc7\xca\xba\x6c\x76\x8d\xb0\xc3\x1e\xff\x72\x17\xb0\x9e\xcb\x79\x62\x54\xed\x61\xce\x3d\x67\xd5\x43\x44\xf4\x86\xae\x28\xcf\x89\xdc\x8e\xe1\xa4\x62\xd9\xd8\xd0\x86\x8e\xe1\x3d\xcf\x44\x59\x1a\x4f\xf9\x15\xfe\x9f\x86\x46\xbd\xe7\x45\x07\x14\xc4\xf5\x3a\xcb\x29\xed\xc6\xc9\xe4\x7b\x2b\xde\xfa\x7c\x66\xbb\x70\x73\xeb\x35\x3f\x7b\x96\xd0\x68\xbe\xcb\x97\xae\x08\x67\xd9\x61\xbb\x40\xc5\x6e\xbf\xdc\x5b\xea\x66\xa9\x3d\xd0\xdf\xed\xf7\x76\x2d\xc2\xc6\x70\x24\xf7\x59\x49\xcb\xc0\x70\xcd\x59\x71\x3d\x81\x97\x7e\xac\x20\x18\xca\x73\x5b\x42\x1a\x4c\x1f\x99\x8e\x07\xa3\xb6\x7b\xdb\x55\xc9\x8e\x56\x2d\x1e\x84\xdd\x0e\x2c\xfc\x81\xc2\xb5\x56\xa9\x87\x9d\xcd\x97\x4c\xac\x3b\x14\x06\x56\x7a\x60\xe3\x87\x95\x79\xd8\xdd\xb3\xc7\xd6\x78\x60\xef\xa1\x05\x1e\x6d\x35\xe6\x3f\x9f\x41\xb5\x9f\x9f\x5e\xa1\x76\xdf\x48\x52\x29\x4c\x7e\xbe\xc2\xbb\xb8\xf0\xa2\x47\xbb\x01\x76\xcd\x72\x5b\x5e\x7b\x5d\xd7\xe6\x4f\x9b\x19\xb5\xbb\xbf\x7e\x67\x2d\xc0\xf3\x29\x6f\x82\x27\x2a\x0a\xaa\x29\x54\xcc\xde\x59\x18\x0e\xed\xb9\xab\xda\xd0\xa5\xe9\xbf\xa7\x2d\x80\x1b\x74\x61\x9b\x9f\xc3\x6e\x27\x87\xe5\xc1\xc1\xd9\xd5\xc4\xcc\x6d\x6f\x23\x97\x8f\x9c\xa5\x17\x62\x4e\xfc\xbd\x4a\x3b\xfb\xd1\xe6\x50\x4b\xbb\x6f\x7c\xc8\x66\x67\xff\x26\xfb\xf8\x9a\x68\x32\x33\x33\x7e\x95\xfc\x34\xa8\xab\x47\x3e\xed\xbd\x0f\xf7\x50\x3d\x13\x97\x36\xed\x6c\xed\x73\xc3\x6e\xdf\x69\xef\x1d\x63\x2c\x87\x90\x30\x49\x1e\x98\xf5\xd8\xf1\xc8\xad\x02\xec\x5a\x86\xb4\x75\x44\xfb\x4e\x8f\x98\xf8\x69\xaf\x94\xe2\xd0\x47\xf2\x9d\x1d\x02\x7a\xbd\x84\x4e\xbb\x35\xb5\x49\x31\x79\x5b\x97\xa9\xb5\x68\xea\x7f\xef\x8f\xa6\x73\x3c\x63\xda\x7d\x80\x04\x9d\x23\x5d\x7b\x34\xbe\xc3\x39\xec\xd7\x77\x9b\xc4\x74\x9c\xc7\x54\xed\x36\x6d\x11\x6f\xde\xa2\xe6\xbd\x1d\x02\x22\x9d\xdf\xba\xdd\x1a\xe2\xcd\x7b\x8a\x85\x61\xd8\x46\xf8\x4e\x23\xe6\x8e\x48\x22\xe3\xee\xb2\x59\x46\x67\x5c\xb9\x1c\x0a\xcb\xff\x14\x8b\xe6\xb5\xdb\x30\x4b\xe6\x5a\x1f\x36\xca\x6c\xfc\x00\xa3\xd6\xd5\xa4\x18\x22\x2e\xed\xdd\xb9\xfb\x8c\x9a\xeb\x6d\xac\x5a\x6c\x14\x7d\xf7\xde\x5c\xa7\xb7\x4c\xb6\xcd\x53\x20\xea\xa9\xc7\x22\x5a\xa7\xb6\x21\xf3\xb3\xec\xaa\x12\x96\x77\xd5\xc8\x2c\xc5\xdb\xfc\xd4\xab\x50\xda\xda\x21\xba\x65\x2f\x06\x30\x1a\xae\x5f\x5a\x87\x2f\xef\x81\xd2\xd1\x37\xc8\xb9\x76\x41\x53\xbd\x33\x10\x4a\x50\x42\xfd\x80\xf6\xcf\x2b\xd6\x4c\x1e\x46\x53\x11\x7b\x4f\x47\x27\x6e\x4d\x2f\xb7\xd7\x96\x74\x69\x94\xd8\x9e\x60\xd3\x9e\x09\x68\x22\x4d\x77\x33\x0f\xde\xda\xe6\x2e\xdb\xd6\x92\xd1\x5b\xda\x5f\xfa\x73\xdf\x59\x6a\xeb\x66\xd7\x15\x90\xd6\x11\x67\xbb\x9d\x50\x49\x61\xb4\x41\x80\x67\x86\x24\x2b\x3b\xa8\x2d\xcf\x6c\x0e\xf6\x0d\x39\xd8\xd9\x59\xc9\x56\x60\x6a\x2f\x2e\xe3\x61\x1c\x7f\x5d\x12\x53\xfe\xa6\x03\xe9\xcf\x59\x86\x8c\x91\xbd\xbc\x6e\xf7\x26\x90\x83\xf5\xce\xdd\xef\x15\xbe\xb4\xae\x4c\xb3\xb3\xc1\xf2\x5c\xbb\x09\x58\xd6\x0a\xd3\xc1\x05\xe3\x37\x76\x30\xb7\x1c\x3d\x13\x0f\xdb\x46\x3e\x35\x07\x61\xbb\x30\x2b\x6a\xbc\xfa\x21\x1c\xa5\xc5\x89\xf8\x33\xb2\x6e\xdb\xd2\x49\x8c\x75\x39\x9b\x87\x3b\xe7\x54\x85\xba\xd9\xb8\x86\xb6\x1b\x3f\xf7\x9e\x6b\x8d\x16\xd9\x5f\x9d\x68\x67\x96\x7b\x9d\x6c\xc1\x27\xd0\xb8\x70\x27\x86\x29\xd7\x4c\xfb\x6b\xe8\xe9\x1d\x53\x7a\x0c\x4c\x03\x17\x60\x3c\x65\x2a\x9b\xf8\x6d\x61\x4b\x44\x25\xf3\xe9\xbd\x28\x85\x19\xe6\xb8\x67\x8a\x0d\xb7\xb8\x2b\xb3\xd3\x29\x9a\x59\xb5\xea\xb1\xdd\x72\xb9\xc4\x3e\x59\x0a\x89\xb8\xda\xfd\xb7\xaa\x59\xe5\x3d\x03\xbf\x41\x30\xd1\x5d\xdd\xc9\xc0\xcd\x9d\xef\xf6\x40\x63\x21\x36\xca\x1e\xf2\x75\x99\x0a\xc2\x81\x96\x95\xde\xb6\xa5\xca\x13\xdc\xcc\xdf\xf3\x30\x32\x70\x02\xde\xb3\xd2\x3d\x07\x0f\x71\x6f\xe8\xc4\x0c\x11\x93\x68\x59\xf3\xc3\xd1\x0c\xfe\xfe\xb1\xfd\x3e\x82\x49\xd3\x6a\xff\xa5\xb7\xbb\x24\x26\xd5\x71\xfd\x3c\xd8\xd7\xa6\xbd\x88\x7d\x6d\xda\xf4\x6e\x29\xf5\xbe\xe9\xfa\x45\x18\x3a\xed\xa0\x6e\x07\x1d\x23\x6c\xa3\x35\x61\xea\xd2\xde\xf0\x74\x28\x96\x16\xc7\x6f\x9f\xdd\x3b\xa0\x71\x02\x06\x1d\x14\xea\x68\xc5\x7b\x53\x32\x5d\x1d\xfa\xf0\x73\x88\x4e\xdb\x79\xd5\x66\xc4\xe8\xdb\x5f\x0e\xbb\x53\x6e\xee\x94\xff\xce\x72\x6d\x26\xa4\xc4\x9b\xc6\xcf\x74\x50\x90\x04\xfc\xd5\x57\x4e\xc1\xdc\x43\x94\x46\xf0\xdb\xfb\x95\x3d\xbb\x93\x11\x1f\xce\xa3\xbf\xbb\x0d\x1b\x56\x9c\x37\x7f\xee\x6a\xd6\x20\x33\x6f\xff\xb0\xab\x4b\x43\x92\x79\xfb\x87\x1e\x47\xbe\x8f\x57\xe7\xf7\x72\xf0\x50\x77\xbc\xbb\xf4\x98\x59\xda\xf8\x33\x84\x78\xf6\xc3\xd7\x05\x73\x5c\xd7\x3c\x14\xf2\xfc\x77\x72\x4e\x5d\x14\x07\x3b\xed\x2d\x1f\xef\x21\x99\xa8\x6e\x64\xfa\xc8\xa4\x54\x07\xd0\xc0\xfc\xd4\x7d\x8e\x8d\xff\x7c\xfe\x5d\x88\x1d\x8e\xa1\x3b\x13\x81\x27\xe8\xbd\x29\xf9\x2a\xba\xe9\xb9\xb9\xc7\x66\x90\x83\x68\x93\x59\x1c\xfc\x4d\x36\xe9\xcb\x46\xf0\x7a\x7a\x96\xa9\x20\xfc\x6d\x83\xe7\x7c\xb7\x05\x35\xfe\x81\x01\xf8\x40\x2f\xb1\x73\x87\xf6\x74\x0a\xe7\xa4\xec\x18\x7e\x44\x7f\xb3\xa6\xdc\xc7\x32\xb6\x9e\xd3\x0d\xdf\xbe\xbc\xa7\x3d\xf4\xbd\x07\x62\x5e\x47\xc9\xe0\xbe\x51\xfb\x88\xe4\x3d\xc2\x21\x03\xef\xb9\x9d\x3f\x5c\x08\x63\xaf\x0e\x41\x4f\xca\xdd\xa9\x84\x43\xe1\x45\x1b\x31\x1f\xf8\xc3\x46\x03\x87\x1f\x96\x9a\x4b\x30\xba\xfc\xbd\x26\x92\xba\x92\x0b\x7b\x5d\x67\xeb\x0a\xd4\x81\x63\x2b\x04\x74\x66\x20\xb4\xc7\xc6\xeb\xda\x92\x51\x7f\x20\x9c\x53\x99\x8c\x1a\x6e\x48\x69\x06\x1b\xb7\x83\x04\xdc\x2c\x23\x58\x92\x07\x9c\x12\x09\xcf\xbf\x3e\x3e\xbe\xfb\xeb\xdf\x8e\x77\xa3\xb5\xc0\x91\x06\xa2\x75\x29\x32\xe6\x16\x47\x59\x32\xe0\x19\x88\x14\xab\xaf\x14\x28\xdb\x6e\x2d\x4a\x5a\x91\x15\x4d\xca\xc0\xe0\x9d\x70\x77\x97\x63\xbd\x68\x69\xef\x96\x3d\xc0\x13\x49\x2b\x49\xca\x83\x31\x1c\xe8\x0d\xd3\x9a\x4a\xf3\x67\xce\x54\x26\x64\x7e\xb0\xe7\x88\x97\x1d\x51\x45\x75\xc3\x3b\x97\xf7\x4f\x7d\x17\xc2\x30\x0e\x4b\xfb\xec\xe3\x8c\xb4\xf5\xbe\x05\x6b\xc1\x7e\x08\x5d\x7c\xa7\x3f\xf5\xb5\x0d\x0f\x48\x2d\x46\x84\x81\x79\x4c\xa6\x6e\xd3\x88\x2a\x78\x09\x6b\xf8\xd6\x03\xd5\x92\xc4\x40\xb4\x7f\x3d\xce\x29\x89\x5f\x20\xd1\xef\x97\x38\xb7\x24\x40\xfb\x82\xfe\xc9\xa3\x7c\x93\x47\xbc\x74\xa2\x37\x09\xfe\x59\x3c\x94\x07\xbd\x8e\x62\x8f\x5d\xf5\x9f\xc7\xfb\x29\x10\xa7\x9d\xac\x69\x8a\xee\xc8\x5e\x6c\xe1\x95\xbd\x24\xe8\xc8\xdd\x76\x5e\xf9\xe2\x25\x2d\xdc\x4d\x64\xf6\xa4\x41\xe4\xaa\xd8\x4b\x86\x8e\xec\xdd\x36\x26\x98\x38\x2a\xe8\x2d\x2d\x9a\xb3\x08\xc9\xe5\x9a\x27\x3f\xbf\x3d\xca\x44\x59\x11\x8d\xaa\xd4\x5a\xc4\xa8\x12\xfc\x92\xde\x52\x49\x0a\x38\xb9\x78\x15\x12\x32\xca\xbd\x6d\xe7\xe4\xe2\xd5\x8b\xe3\xb1\xf9\xef\x7f\xbd\x78\xee\x2e\xd7\xf6\x8e\x56\x38\x40\xaa\xb6\xe5\x42\x04\x56\xf5\x87\x62\x1a\xf4\x89\x52\xd4\x1e\xaa\xdb\xd0\xa2\x30\xff\x37\x53\x78\xd6\x3f\x81\xf8\x18\x08\x5c\x63\x93\xf7\x17\x67\x87\x35\xe3\xfa\xc5\xdf\xbe\x19\xb9\x7d\x47\x0f\xc6\x3c\x1a\x5d\xbb\xc3\x36\x6a\x12\x91\x9a\x72\xb2\x88\x5f\xe8\xe1\x68\xdd\x47\xe4\x70\xa2\x43\x6c\x78\x74\x91\xd3\x1a\x73\x3c\x74\xeb\x2e\x97\x2a\xd8\x4d\x23\x4a\xad\x13\x20\xfe\x96\x72\x5b\xad\x86\x5e\xd7\x42\xb2\x7c\x65\x0d\xee\xc9\xcf\x6f\xf7\x3a\x79\x27\x3f\xbf\xfd\xc1\xf6\x08\xe1\xeb\x9e\x02\x78\xa4\x6d\xdc\xe2\xc1\x8e\x9c\xab\x7c\xc1\x35\x7c\x38\x54\xdb\x6f\x37\x5c\x7b\xe5\x7b\x03\x14\x8e\x9c\x0f\x40\xb8\xaf\xef\x59\x34\x6f\xf1\x73\x6c\x20\x64\x9a\xf9\x89\x38\xc4\xc0\xcb\x69\x45\x39\x72\xb7\xe0\xd1\xe5\xf1\xcd\x49\x9c\xf0\x8a\xbf\xdc\xc4\xe4\x69\xee\xb1\x93\x2d\x6d\x54\xe7\xc9\xcf\x6f\xbb\xef\x8f\xb4\x95\x82\xc8\x05\x66\x79\xd3\xab\x38\xa0\x92\xb4\x22\x92\xc2\x56\xd4\xb6\x9e\xf7\x2b\xe5\x04\x0c\x5f\xb9\x97\x9e\xeb\x6e\x57\xe2\x24\x07\x15\xec\x85\x61\x5b\x51\xa3\x7a\xc8\xd6\x02\x63\x19\x01\x9a\xdc\x50\x20\xf9\x2d\xb1\xd7\x95\x89\x25\x08\x8e\xef\xaa\x49\x40\x35\xa7\x47\x88\x0a\xef\xe8\x30\xa1\x0e\x96\x17\x0b\xa5\x03\xe7\x9f\x9f\x5e\xa9\x71\x18\x07\x4f\x3d\xdb\xc1\x5a\x24\x8f\xfc\x56\x9c\x1c\xae\xde\x57\x2a\x3e\xef\x64\x5f\xd2\x80\xf7\x04\xf9\x6b\x50\x8c\xdc\x61\x8d\x13\x49\x8b\x8a\xdd\xbb\x34\x4e\xed\x8b\x55\x90\xd7\xf1\x4e\x31\xa7\xa2\xd0\x20\x36\xef\x0d\x81\x97\x05\x9a\x7a\xa3\x0f\x8b\x2d\x62\x9b\xce\x96\x6c\x5d\xcd\xa4\xf1\x5e\x11\x3d\x7b\x76\xba\x51\x80\x0d\x52\xfe\xe8\xf8\x3f\x2f\x7f\x3a\x77\x95\x4b\x09\x30\x6c\x6f\x3c\x8c\xf8\xdd\x5e\xee\xd4\x9b\x85\xea\x08\x1e\x41\x77\x97\x16\x30\x77\x42\x3d\x81\x67\x1d\xc5\x88\x05\x07\xc9\x51\x2d\x59\xf7\x9a\xf5\x9e\xc3\xdc\xa9\xbc\x8d\xe3\x7e\x0f\x77\xc5\x9c\xd0\xcf\x63\x0d\x9e\x34\xa8\x25\xc3\x17\xf2\xb0\x7e\xbf\xa7\xaf\x92\xb9\xa3\xc1\x1e\x56\xd3\xdc\xe9\xfe\xe8\xea\xe6\x0e\xa4\xa1\x75\xce\x7d\x3a\x18\x5a\x9f\x3f\x90\xb6\xf8\xf4\xe4\xff\x07\x00\x00\xff\xff\x39\xf3\xf9\x7c\x50\x77\x00\x00"
+
+func importsMetadataviewsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsMetadataviewsCdc,
+		"imports/MetadataViews.cdc",
+	)
+}
+
+func importsMetadataviewsCdc() (*asset, error) {
+	bytes, err := importsMetadataviewsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/MetadataViews.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1b, 0xac, 0x4c, 0xf5, 0x44, 0x9f, 0xc6, 0x56, 0x6d, 0xb5, 0x22, 0x20, 0x3a, 0xcf, 0xd2, 0x4c, 0x4, 0xab, 0x85, 0xde, 0xb9, 0x7b, 0xb, 0xfb, 0xea, 0xe8, 0xef, 0x8b, 0x89, 0xaa, 0xd3, 0x70}}
+	return a, nil
+}
+
+var _importsNonfungibletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x5f\x73\x23\x37\x72\x7f\xe7\xa7\x68\xef\x55\x65\x25\x17\x45\xb9\x2a\xa9\xa4\x8a\x15\xc7\xd6\x5a\xab\x8b\xe2\x8b\xee\x4a\xa2\xd7\x0f\xa9\xd4\x12\x9c\x69\x72\xe0\xc5\x00\x63\x00\x43\x9a\xde\xdb\x6f\x96\xb7\x7c\xb1\x54\x37\x80\xf9\x43\xce\x88\xd4\xda\x97\x4a\xf4\x60\x2f\x67\x06\x8d\x46\xff\xfd\x75\x37\xae\xbf\xfc\x72\x32\xf9\xc3\x1f\x60\x51\x20\xdc\x29\xb3\x83\x07\xa3\xaf\xee\x6a\xbd\x91\x2b\x85\xb0\x30\x1f\x50\x83\xf3\x42\xe7\xc2\xe6\xfc\xe1\xf2\xc1\xe8\xf4\x9e\x5f\x2f\x21\x33\xda\x5b\x91\xf9\xc9\x84\xa8\x48\xed\xd1\xae\x45\x86\xe0\x0b\xe1\x41\x28\x35\x44\x33\xad\x71\xe0\x0a\x53\xab\x9c\x1e\xac\x8d\x2d\xc1\x9b\xd9\xe4\x7e\x0d\x02\x6a\x87\x16\x76\x42\x7b\x07\xde\x40\x8e\x95\x32\x7b\x10\xa0\x71\x07\x0f\x77\x8b\x86\xc0\x14\x7c\x81\xd2\x36\xbf\x13\x3d\x59\x56\x0a\x4b\xd4\x9e\x99\xf2\xfb\x0a\x1d\xe4\xb8\x96\x1a\x73\x28\xd0\xe2\x64\x72\x7d\x7d\x0d\xdf\xd1\x2a\xb9\xaa\xbd\xb1\x0e\x2e\x2a\x85\xc2\x21\x88\x3c\xa7\x2d\x7d\x21\x1d\x28\xe9\x3c\xc8\x35\xec\x4d\x1d\xb6\xa0\x8f\xf1\x8b\xcb\x39\x2f\xbf\x82\x7f\x33\xae\xa8\x05\xfc\xab\xd0\x5a\x68\xb8\x82\xc2\xfb\xca\xcd\xaf\xaf\x37\xd2\x17\xf5\x6a\x96\x99\xf2\xfa\x27\xfe\xa4\xe0\x2f\xe2\xaa\x37\xc2\x79\x29\x34\xfc\xfb\x7f\xff\x97\x52\x68\x3b\xeb\xfc\x4e\x7a\x8f\x96\x17\xfa\xda\xae\x8c\xa2\x23\x84\x55\xb7\xe8\x11\x9e\x0a\x69\x15\xee\x47\x96\xe4\xe8\xf1\x9f\xfe\x3e\xed\xf2\x93\xb0\x1e\xe1\x7b\x61\x95\x43\x3d\xb2\xe2\xab\x5f\xc2\x67\x1f\xe2\xa2\x9b\xda\x79\xa9\xe1\x7b\x25\x35\x8e\x2c\x11\xfc\xc9\xfb\xb5\x32\x3b\xbf\x8f\xcb\xfe\x28\xcd\x56\x68\x2d\xe1\x49\xe8\xac\xc0\x5f\x47\x96\x6e\xa4\x79\x2f\x75\x66\x36\x5a\x7a\xd3\x1c\x4b\xcb\x5f\xe1\x6d\x2e\x75\x26\x3f\x8c\xac\x5b\xa9\x1a\x9d\xdc\xb0\xfc\x78\xd9\x23\x56\x06\x2c\xae\xd1\xa2\xce\x70\x3e\x24\x77\xa3\x89\xc3\x6b\xfa\xcf\x95\x5e\xfb\x68\xbd\x77\x8b\x25\x58\x74\xa6\xb6\x59\xc7\x56\x83\xe9\x66\xc6\x62\xfb\x92\x6c\x26\xd8\xb0\xc5\xca\xa2\x43\x32\x45\xa1\xd9\xfa\xa4\x26\xb3\x03\x57\x0a\xeb\x1b\xd3\x9b\x85\x2d\xbe\x33\x4a\x61\xe6\xa5\xd1\x4b\x78\x1c\xd9\xa9\xdd\x84\xe8\x3b\x6f\x2c\xba\x68\xf3\xaf\x5d\xb4\xef\x44\x65\x36\xb9\xf7\x20\x75\xa6\xea\x9c\x3f\x5a\xe3\x0e\xd6\xb5\xe6\x77\xec\x1b\x42\x91\xe3\x12\x3f\x66\xa7\xd1\xd2\x23\x14\x4e\xaa\xfd\xa4\x34\x5b\x04\x4f\x0e\xe7\x88\x65\xa1\x73\x30\xb5\x07\xb3\xe6\xaf\xbb\x5b\x30\xe7\x7f\xb1\x66\x2b\x73\xb4\x4b\xfe\x72\xf9\x88\x19\xca\x2d\xfd\x3c\x16\x98\xe3\x73\xb8\xee\x13\xc8\x31\x53\xc2\x62\x87\xb9\x9d\xf4\x05\x38\x53\x22\x54\x16\x99\x68\x65\x1c\x0b\x2c\x97\xfc\xc5\x24\xca\xf7\xe7\x5a\x5a\x64\xa6\x5a\xe9\xd1\x39\xd6\x86\xcf\x96\xa1\xf5\x42\x6a\xd0\xa2\x94\x7a\xc3\x84\x56\x58\x88\xad\x34\xb6\x89\x4e\x6e\xc6\x2c\xed\x81\x58\x70\x58\x09\x2b\x3c\xc2\x0a\x33\x51\x13\x9b\x1e\x36\x72\xcb\x4c\x6e\x51\x99\x0a\xad\xe3\xed\xc4\x4a\x2a\xe9\xf7\x21\xc4\x50\x74\x68\xb9\x0f\xbc\x65\x42\x93\x5a\x40\xe8\x7d\xc7\x22\x9a\xe8\xc2\x54\x5c\x5f\x30\x6f\xf6\x50\x3b\xe2\x33\x89\xcd\x31\xc7\xed\x27\x53\x56\xb4\x23\x3d\x90\xaa\xfb\x56\xe4\x78\x4b\x87\x3a\x9f\xd0\x2a\x1b\x94\x90\xb4\x58\x21\xda\x2b\x6f\xae\xe8\xff\x53\x96\x2f\x29\x94\x44\xa1\x37\x74\x08\xde\x84\xc2\x20\x8b\x5e\x40\x86\x44\x55\x81\xc2\x7c\x83\x76\x72\x64\xb0\x0b\xc3\x5b\x25\xbb\x26\x6b\xd2\xc6\x17\x68\x99\xc5\x69\x13\x87\x39\xa6\x3a\x3a\xf6\x9e\x49\xe7\x56\x04\x93\x7b\xb8\x5b\x4c\xd6\xd6\x94\x31\x0c\xb7\xea\xe3\xc0\xac\x21\xa3\x04\x40\x1f\xe6\x58\x19\x27\x7d\x23\x5f\x30\xba\xb7\xd7\x6b\x37\xe9\xeb\x3e\x33\x24\x64\x1f\xcc\xc2\x5b\xa1\xdd\x1a\xed\x6c\x32\xf9\xf2\x7a\x32\x91\x65\x65\xac\x87\x77\x12\x77\xe4\x62\x6a\x8b\x16\x98\x8b\xaf\x7e\x79\x77\xff\xf6\xc7\xc7\xb7\x4f\x7f\xfe\xd3\xbb\xb7\x8f\x37\xb7\xb7\x8f\x6f\x9f\x9e\x42\xac\x27\xbf\x2b\xc9\x8a\xba\xe9\xa3\xd5\xca\x0c\xfe\xcc\xbc\x74\xdf\x92\x05\x2b\x05\x61\x37\x26\xc2\x8a\x4c\xca\x0f\x29\xa2\x4d\x78\xc2\xc1\x0e\xe3\x71\x7b\x7e\x11\xd2\x0e\x07\x8d\xee\x02\x8e\x66\x22\xcb\xd0\xb9\x0b\xa1\xd4\xe5\x00\x57\x70\x98\x6e\xe7\xfd\x43\x7f\x9c\x4c\x00\x00\x88\xb5\x1b\x0d\xa8\xbd\xf4\x91\xb7\xb5\xb1\x21\x32\xb0\x65\x14\xd8\xa8\x4d\x28\x0e\x00\xc1\x9e\x58\x68\x02\xde\x89\x5a\x79\xa6\xd4\x65\xa7\x4b\xee\xc7\xb8\xfa\xbc\xfd\xea\x2a\x17\x3e\xda\x7d\xf8\x37\xe0\x96\xdd\x85\x3f\x63\x1d\x3c\xbb\xdd\x0f\xbc\xa8\xdd\xec\xed\x36\xc8\x5b\xf8\x63\xe8\x80\xa5\xf4\xb0\x23\x63\xa3\x63\x96\xe8\x45\x2e\xbc\xa0\x43\xa6\x68\xed\x22\x13\x79\x43\xef\x3e\x78\xb6\xd1\x6a\x0f\x2b\x64\x12\x1e\x73\x58\xed\xd9\x60\x93\xc8\x96\xf4\xfc\xe1\x6e\x11\xb8\xc9\x97\x8d\xf1\x36\x74\x82\x9b\x69\x58\x86\x4f\x96\xe9\x10\x79\x9b\x9c\x02\x96\xc0\xe0\x5f\x74\x80\x9d\x38\xe6\x87\x8c\xb3\x7b\xfe\xca\x46\x79\xb9\x4a\x94\x25\x05\x0b\xd6\x54\xcb\x9c\x8c\x4f\x5a\x07\x72\xaf\x3b\x11\xdd\x35\x94\x53\x04\xe4\xa3\x66\x26\x0f\x86\x40\xd9\xa0\xf3\x39\x18\x1b\x78\x2b\x04\x6d\x89\x99\x14\xaa\x3d\x4a\x50\x52\x43\x31\x9e\xa7\xb3\x19\x09\xbd\x30\x79\x74\x97\x15\x85\x55\xc5\xe7\xde\xa0\xe7\x6f\x8f\xa5\xd2\x50\xeb\x8b\x80\xd5\x5c\x8a\x0f\xe8\x28\x64\x3b\x13\xb8\xf2\x85\xb4\xf9\x55\x25\xac\xdf\x83\xd4\x39\xfe\x42\x02\x21\xfd\x95\x86\x80\x84\x0d\x7b\xb0\xc0\x1a\x72\x64\x7a\x3f\xd7\x68\xf7\xfc\x32\xca\xbb\xb5\x8e\x14\xb3\x42\xce\xec\xcb\x6e\x96\x88\x1c\x9b\xe8\xb6\x35\xce\xfc\x82\xf2\xc1\x1c\x9e\xbc\x95\x7a\x33\x05\x99\xcf\xe1\x87\x7b\xed\xff\xf1\x1f\xa6\x50\xd7\xdd\x5f\xbc\xc5\x1c\x6e\xf2\xdc\xa2\x73\xdf\x5c\x1e\x91\xdd\xca\x90\xd3\xa1\x6f\x6f\x17\xef\x41\xaf\xfd\x23\xae\xe7\x20\x6a\x5f\x5c\x84\xc7\x97\xf0\x77\x1f\x0f\xa3\xc2\xec\xe1\x6e\xf1\x29\xd0\xfd\xc8\xff\xa5\x3f\xf6\x8b\x3e\xaf\x81\xdc\x6c\x83\x7e\xb1\xaf\xf0\xe2\x72\x26\x73\x52\xcd\x5a\x52\xac\x27\x96\xe3\x07\x32\x4f\x67\x88\x0f\xe8\x47\x73\x90\xf8\x8c\x7f\x7d\x33\x13\xe1\x58\x61\xf7\x4f\x93\x41\x9f\x95\xae\x71\x31\x76\x54\x11\xe2\x0f\x3d\x4f\x61\x49\x4f\x9b\x85\x52\xe7\x32\x13\x3e\x79\x21\xb1\x4e\xdc\x05\x96\xa6\x1d\xa4\x73\x04\x64\xe2\x6e\xc1\xc7\x1a\xca\xac\xec\x69\xcf\x32\x68\xd9\x0f\x3f\xdc\xdf\x26\x12\x2d\xc2\x19\x5c\x0b\xb5\xab\x85\x52\xfb\x9e\xd3\xf4\xcd\x84\xa3\xca\x11\x3f\xd2\x81\x36\x3e\x80\x2f\x52\xb9\xa9\xb5\x7f\xed\x18\xf1\x89\x0d\x4e\x61\x49\xe4\x97\x8d\xdf\x2c\xb5\x54\xcb\x53\xe6\x97\x42\xb1\x3e\xdb\x00\x69\x93\xd6\xfe\xa6\x50\x45\xa0\x47\x12\x48\x5f\x5d\x0e\x2a\x6e\x4c\x6b\x31\x9b\x23\x57\x4a\x83\x42\x81\xfb\xa0\x45\x74\xbf\x49\x89\xdd\x8d\x9e\x57\x61\x57\xea\xc7\x6b\x7f\x37\x5d\x4d\x5f\xa6\xac\xdb\xc4\xc3\xd9\xca\xf2\xa6\xab\xaa\x96\xbf\x11\x65\xdd\xf7\xeb\xed\x98\x69\x1c\x94\x75\x40\xda\xb1\xaa\x1e\x65\xf3\x18\xdb\xd3\xfa\x3e\xcc\x98\x1d\xe2\x8d\xb4\x79\xad\xe5\xcf\x35\xc2\xfd\x2d\xe7\xf5\x84\x07\xd3\x17\xdd\x6d\x14\xfa\xce\x99\xfb\x54\x86\x03\x85\xa8\xbd\x29\x85\x97\x19\x3b\x1e\x6e\x39\x94\xcb\x12\x41\x74\x78\x26\x25\x3b\x6f\xcd\x3e\xe6\xd2\x6e\x32\x61\xb8\x2e\x59\x00\x22\x29\x38\xd6\x51\x79\xaa\xe0\x9a\x7c\x10\xb4\xe5\x0c\xd9\x4e\x34\x04\x8d\x48\x5f\x0a\xae\xfa\x84\xdd\xd4\xdc\x4e\x18\x3a\x5c\x58\x9c\x8a\xbd\xdb\xc4\xd1\x45\x7b\x60\xf8\x1a\x1c\xaa\x6e\x60\xed\x3f\xa7\x67\x97\x7d\xa9\x64\x16\x85\xc7\xb7\x65\xe5\xf7\x1d\x60\x1c\x9e\x32\x4b\x48\xaf\x7a\x05\x53\x94\x60\xca\xbe\x5c\x57\x1e\x69\x25\xf9\x8f\x45\x5f\x5b\xcd\x79\x36\x65\x74\xc1\xdd\x88\x36\xeb\xe2\x3e\xa0\xa4\x1d\xe3\x28\xd7\x25\xd1\x23\xf7\x6d\xa0\x05\x37\x2d\x5b\x87\xee\xcc\x45\x4d\xe4\x47\xba\x43\x86\x06\xa5\x4a\x09\x71\x50\x08\x17\x97\x73\xf8\xf6\x63\xfb\xfb\x53\x27\xe9\xd1\x1f\x17\x99\xfd\x47\xf4\x67\xd1\xd5\xca\x53\xf2\xfb\x13\xea\x8d\x2f\x2e\x2e\xe1\xeb\xaf\xe1\xab\x39\x1c\x7d\x49\x7f\xaf\x86\x72\xec\x6c\x90\x9f\x39\x7c\x27\x34\x59\x4c\x78\x3b\x24\x84\x2f\xe0\xd5\xe0\x2e\xb3\xcc\xe8\x4c\xf8\x8b\x57\xdc\x7f\xe0\xe5\xf9\x11\xe6\xef\xea\x98\x00\x9a\x00\x6d\xf4\xd5\xaf\x68\x0d\x28\x3e\xc9\x0c\x5e\x5d\x3e\x4f\x1e\x6e\x40\xe3\x8e\x10\x60\xdc\xa3\xa3\x1f\x8e\x14\x0c\x82\x2b\xbf\xff\x62\x80\x52\x14\x9c\x74\x4f\x75\x45\x95\x10\xe6\x0f\x77\x0b\x06\x10\x21\xa6\xb1\x05\x37\x98\xe2\xf2\xff\x97\x40\x73\x83\xc1\xdd\x5d\x38\x5c\x88\x9e\x94\x53\x28\x7c\xfc\xf3\x09\xc1\xf6\x8f\xde\x81\x53\x27\xf4\xf1\x2f\x33\x58\xf4\xb3\x0f\x6b\xe1\x77\xe6\xa1\xb3\xdd\x01\x8d\x4f\x93\xf6\x5f\x3d\x57\xfe\x23\x7a\x07\xa9\x66\x67\x36\x12\x02\x0f\x7d\xa9\x5c\x5a\xcc\xbc\xda\x53\x50\x38\x27\x20\xe4\x92\x8f\x27\xec\x9e\x0b\x32\xa5\xc0\xd5\xab\x87\xbb\xc5\x13\x7c\xc0\x7d\xa8\xb8\xe8\x90\x27\x83\x41\x03\x91\x37\xe8\x6f\xb6\x42\x2a\x0a\x72\x4f\x81\x14\xc5\x83\x8f\x0b\x36\xc5\xff\x08\x61\xf5\x3f\x0f\x43\x42\xe4\xe7\xe3\x73\xe7\xe6\xc4\xd2\x29\xda\x52\x4f\xa4\x77\xfe\xc3\x63\xc3\x1b\x43\x15\x60\xcc\x0e\x8e\xbb\x4f\xa6\xe2\x23\xab\x7e\x73\x2e\xf6\x57\xb2\xc2\x18\xd7\x3b\x2f\x14\x66\x47\x51\x38\x05\x64\x57\xaf\x82\xe4\x73\xac\x50\xe7\x04\x83\x8d\x86\x1d\x77\xd3\x7b\xfb\x44\x18\xd7\xcf\x7c\x77\xc6\x02\xfe\x22\xca\x4a\x11\xde\x5a\xc3\x92\xc4\xbb\x8c\x71\x63\x2b\x54\x8d\x53\x58\xd5\x1e\x96\x32\x5f\xb2\xf1\xeb\xd7\xa1\x89\xce\x0c\xf6\x33\x90\xd0\x91\x5d\xd8\x15\x32\x2b\x82\x00\xd6\x51\x22\xdc\x0c\x33\x49\xb2\x92\xe1\x94\xe5\x94\x2c\xe0\x55\x8e\x6b\x51\x2b\xff\xaa\x47\xef\x7e\x0d\xab\x20\xad\x08\x9e\x62\x8f\xa8\x35\x33\x2e\x52\x43\x9a\x10\xe0\xa4\xde\xa8\xc0\x16\x71\xf2\x13\x39\x48\xd8\xad\x47\x95\x16\x92\x37\x49\x07\x05\xaa\xca\xc5\x34\xe6\x60\x57\x18\xda\x4a\xbf\x26\xbf\xb2\x18\x24\xe8\x53\x8b\x50\x19\xf3\x81\x44\x4b\xc0\x65\xdc\x8e\x2b\x61\x45\x09\x21\xcc\x91\xc3\x92\x95\x25\xc0\x99\xa3\x93\x96\xa2\xcb\x41\x72\x8d\x8b\x28\xc9\xf3\x40\x24\x4f\x0b\xa2\x05\xac\x8c\xb5\x66\x77\x86\xef\x38\x6f\xeb\xcc\xd7\xdc\x94\x8e\x1d\xe8\x54\x13\x59\xfc\xb9\x46\x47\xb1\x8d\x1c\x63\x36\x9a\x3f\x37\xe8\x83\x93\xc4\x50\xbd\x88\x30\xbc\x01\x92\x30\x1f\x2b\x27\xbf\x19\x76\x21\x2d\xd5\xa4\x1f\x45\x3e\x0d\x82\x51\x03\x25\xe6\x92\xa2\x77\xdb\x78\x6a\xfa\x4d\x09\xc0\x75\x0b\xab\x36\x26\xbf\x04\xab\xa6\x9e\x75\x1f\x99\xc2\x8f\x18\xdb\x42\xa9\x57\x99\xda\x4f\xa9\xec\x4f\x25\x50\x87\x54\xea\x94\x10\x68\xa6\xa8\xa5\x37\xcd\xf2\x2e\xe9\x48\x29\x5a\x96\x70\xfc\x7d\x68\xf8\x7a\x13\xa1\xa0\x92\xce\xa3\x26\x23\x8c\xef\x55\x24\x98\xba\xa0\xb1\x59\xd1\x53\x7c\xc3\xab\xc5\xd2\x6c\xb1\x19\x36\x34\x3c\x77\xb2\x05\x01\xb8\xf0\xd1\x21\x7c\xeb\x7b\x9c\xef\xe4\xb7\x0a\x33\xb9\xde\x53\x29\xc7\x3d\x23\x5a\x72\x7f\x4b\xfe\x1a\xaa\x28\x4b\x5f\x9d\xf2\x84\xc4\x23\x95\x22\x83\xc6\xdd\x1c\x62\x80\xeb\x41\x33\xff\xf6\x23\x19\x5b\xa0\xd6\x6b\x91\x35\x25\xf8\x73\xb9\x21\xd5\xc2\xc1\xda\xd3\x92\x8b\x2e\x9b\xd1\xd0\xe7\x71\xa7\x17\x61\x45\x99\x13\x44\xec\x52\x3b\x13\xda\x24\xb3\x9c\xa5\xb5\x0d\xa4\x69\x04\xf4\x70\xb7\x38\x0b\xc4\xb4\x15\x6e\xdb\x94\x20\x41\x5d\x9c\x80\x05\xcd\x11\x66\xde\x84\xaa\xf3\xe2\xf2\x04\x2a\xb9\x6c\xb0\x20\x4f\xb7\x44\xc9\x3d\xed\x7e\xbc\xb9\xbf\x3d\xb9\x73\x2b\xaf\x17\x6c\x7d\x88\x50\x20\x75\xb0\x0e\xdb\x1d\x2d\x8c\x1f\xe9\x61\x35\x07\x4f\xa5\x56\x7c\x10\x1a\x0f\xa1\x17\xc2\x98\xa9\xdf\xc0\x3a\x6c\x8b\x74\xea\xb2\x2e\x4f\x9f\x5e\x18\xfd\xa2\xc7\xbb\xe4\xa5\x9f\x17\xe6\xd2\x2c\xee\xb0\x00\x4f\xf1\xc4\x73\xcb\x34\x06\x8c\x7e\xc5\xca\xb1\x42\xe4\x79\x37\x54\x7c\x37\xe2\x93\x31\xdd\x85\x41\xc3\xa2\xf5\xea\xb8\xcd\x68\x9a\x89\xef\x2f\xe2\xca\xe0\x69\x07\xf5\x2c\xa7\xa2\x7e\xfd\xe0\x1a\xcc\x23\xc2\xa4\x3d\x4e\xc4\xc2\xb8\xbe\x05\x5e\x36\x9d\x9e\xf6\xad\xbc\x3b\x0b\x6b\x06\x22\xa5\xa8\xaa\xd0\xa5\x5a\x19\xa3\x50\xf0\x24\xb4\x69\x2f\x32\x6a\x91\x7d\x7a\xe9\xcf\x62\x26\x91\x36\x8d\xa0\x9c\xe4\x77\x12\x9a\x1e\x9d\xb0\x83\x4d\xdf\x18\xa3\x0e\x50\xe7\x63\x3c\x7e\x8a\xc9\x21\x08\xb3\x8a\x36\x72\x8b\x3a\xf6\x30\x5c\x3c\x78\xc4\xcb\xe3\x41\xf5\x66\xb0\xee\x0e\x8b\xdb\x11\x66\x9c\x9b\x74\x00\x15\x78\x5b\x23\xd1\x8e\xb8\x6d\x1c\x04\xdd\xe8\x46\x43\x23\x5a\x88\x72\x1e\x10\x73\xab\x47\xe2\x2a\xca\xf7\x10\x4a\xf5\x49\x0f\xca\x79\xb4\x10\xa5\x7f\x5e\x06\x41\x1f\xfa\xe6\xf7\x24\x01\xc2\x7a\x2b\x91\x7d\xd8\x09\x9b\xbb\xab\xcc\x94\x95\xf0\x32\x4e\x80\x2d\x0a\x97\xe6\x26\x27\x9c\xb1\xf5\x9e\xbf\xd4\x2b\x25\xb3\x4e\xfe\x38\xd3\x31\x4e\x99\x51\x6a\x50\xcc\x29\xa6\x9c\xfc\xfa\xfe\x96\xcd\x2c\xd5\x3e\xa3\xcc\xac\x8d\x7d\x2b\xb2\xe2\xfe\xf6\xe2\x3d\xac\xe7\xfc\xe8\xa2\xc9\x8e\x24\xb4\xcb\x39\xbc\x33\x32\x7f\x7e\xc3\x00\x5f\x09\x52\xbe\xef\x02\x49\xc6\x91\x04\x1b\x0f\x25\xff\x18\xa6\xfb\xcd\x90\x30\x98\xaf\xce\x2c\xfa\xc3\xdb\x16\xb1\x29\xd7\x0c\x58\xc9\x76\x9a\xdb\x45\xd0\x8e\x9f\xc6\x26\xb0\x33\x78\x92\x5c\xbc\xf5\xe7\xb2\x52\x17\x68\x65\x44\x7f\x6d\x0f\x39\x16\xb9\x61\x82\xa6\x91\x8e\x4a\xa1\xa3\x3b\xa7\x6f\x27\xf8\xfd\xb1\x3f\x85\xd2\xdc\xb0\xaf\x76\xe8\x39\xc3\x3d\xc3\x30\x87\xe7\x3e\x63\x67\x83\xce\x40\x38\x1c\x33\x5c\x5a\xe2\xee\x24\xf1\x4c\xf1\xcf\xbd\x24\x25\x74\xdb\x25\x09\x6e\x4c\x9b\x44\x31\x3d\xb2\xd2\xe9\x70\x4b\xb7\xd3\x0a\x39\xc8\x2d\x77\x12\x55\xde\xce\x5c\x85\xd4\xc3\x9d\x81\x1e\x30\xed\xd5\xc7\x3d\xfb\x11\x96\x1b\xfe\xe4\xb2\x8e\x3c\x21\xd8\xcd\x1c\x86\xab\x8f\x4f\xcf\xe6\xb9\xb1\x34\x17\x6f\xd2\x48\x9f\x4c\x69\x0c\x7c\x9e\x48\x74\x24\xe6\xc3\x29\xe7\x0b\x7c\x7c\x70\x3a\x77\x08\x3c\x2d\x0e\xe0\xce\x4e\xe9\xd2\xbd\x34\x11\xaa\x8a\x78\xa6\xde\x0d\xa3\xf6\x32\xc1\x00\xa9\x54\xd1\x8c\xaf\xe2\xdc\xa2\x4a\x02\xc1\x42\xed\xc4\x3e\xa0\xbd\xb5\xd4\x42\x71\x67\x5d\x6a\xd1\x3b\x7b\x87\x78\x7b\x89\x80\x24\xdf\x70\x5a\x4a\xe7\x78\x26\x1c\x06\xd6\xb5\xf3\xa6\x6c\x02\x3f\x15\x43\x94\x7a\x56\xd8\x56\x4d\x43\xb4\x89\x62\x21\x6c\x1e\x1a\x0c\x14\x2d\x64\x68\x69\x1f\x94\x57\xc3\x88\xf1\x70\xe6\xc2\x6c\x3e\x03\x18\xc3\xfb\x16\x2f\x86\xdf\x71\x4e\x65\x46\xc0\xe2\xe1\x60\xe6\x0c\xb8\x78\xdc\x68\xe3\x2b\x48\xa5\xa9\x75\x82\x3e\x61\xdc\xd4\xce\x27\x4e\x14\x4f\x37\x9a\x55\xb9\xe1\xf8\xd2\x1b\x9a\x3a\xf9\x2b\x1e\x4f\xc6\x5e\x96\x78\x86\x1b\x00\x8d\x34\xd8\x93\x67\xa1\x03\x3d\x76\xca\x1b\xa5\xcc\x8e\x1c\x36\xc0\x99\xe6\xf2\x0f\x85\x48\x8f\x7c\x45\xcb\x17\xd6\xd4\x9b\x82\x39\x25\xc5\xf6\xd6\x9b\x75\x08\x1a\xec\x9f\xf7\xb7\xe1\x1a\x5b\x37\xde\xa4\x2b\x50\x6b\x69\x0f\x96\xb6\x97\xa2\x94\x11\x79\x73\x0d\xc1\x62\xbc\xd4\xa9\x19\xa4\x97\xc6\xee\x7f\x73\xc2\x3c\x10\xd4\x81\x84\x22\x95\xef\x71\x7f\xb1\xbe\x1c\x13\xd4\x1b\xce\xaa\x6e\xa4\x07\x79\x96\x51\xdc\xb7\x85\x38\x5f\x4b\x61\x33\xe0\xa2\x5f\x86\xba\xad\x1d\x70\xf6\xa9\x4c\x0f\xfa\x69\xed\x25\xb8\x04\x15\xa3\xea\xb9\x69\xc7\xce\x49\x74\x2a\xa1\x65\x36\x3b\xd5\x31\x48\x6d\xb0\x04\xf1\xf4\xda\x83\x1c\x38\x4a\xa7\x97\x98\x64\x90\x21\x69\x60\x36\x66\xf9\x4d\x9b\x75\xe8\xaa\xcd\xe7\x63\x98\x73\x7a\x61\x23\x5d\x83\x58\x73\xc3\xd7\x5f\x83\x96\xea\x12\xfe\xfa\xd7\xf4\xe8\x9b\xd8\x4a\x90\xf9\xf9\xe3\x91\x36\x60\xcf\x1a\x7e\x9b\x36\x42\x78\xc2\xca\x6e\x8e\xff\xc2\x76\x42\x90\x64\xef\xaa\xd2\x79\x3d\x85\x2f\x5e\xd8\x54\x68\x5a\x50\xa5\xf0\x59\x91\x1a\x4f\x4d\xa3\xa7\xb5\xd1\x53\xdb\xbf\x68\xdb\x73\x27\x1e\x2f\x9f\xb3\x46\xf9\x71\x7f\xe4\xa8\x10\x7a\x6e\xb4\x3a\x52\x31\x0d\x0d\x4d\x47\xf7\xf8\xdf\x99\x90\x86\x4c\x49\x16\xdb\x9f\x2e\x7d\x8e\xf1\x9e\x35\xe2\xfb\x4d\xf3\xbd\x6e\x14\x39\x67\x62\xf6\x4c\x0b\xe9\xd4\xdc\xae\x35\xe6\x42\x6c\xb1\xaf\xa3\xd4\x2c\x3b\xac\xc1\xf9\xc2\x9f\x0b\x85\x41\x3c\xae\xf4\x7f\xc3\xd1\xe2\xf8\x08\xf7\x68\xf6\xfd\x7f\x5c\x9b\xc3\xd3\xee\xbf\xd9\xb0\xfb\x99\xde\xde\xcb\xa3\x44\xaa\x75\xdb\xf0\xd6\xeb\x6e\xfc\xc6\x6b\x18\x9d\x0c\xab\xd7\x7e\xd1\x0c\xa8\xba\x69\xf6\x60\x44\xd7\xc3\x4d\x4d\x62\x3d\x48\xaa\xc2\x5a\xb1\x4f\x7d\xb8\x45\xb7\x0f\x37\x52\x97\xc7\xeb\xcd\xf1\xd2\xe4\x79\xd1\xa9\xe5\x38\x34\x6c\x06\x4a\xa6\xe1\xd8\x35\x10\xb7\x5a\xcb\xe6\x36\x48\xc4\xa3\x63\x37\x3b\x8e\x4d\xfb\x77\xb3\xe7\xcf\xb3\xe5\xa1\x9b\x1b\x0d\xa5\xcf\xb1\xe3\x64\xb9\x9f\x26\xff\x13\x00\x00\xff\xff\x81\x7a\x5d\x4c\xdc\x35\x00\x00"
+
+func importsNonfungibletokenCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsNonfungibletokenCdc,
+		"imports/NonFungibleToken.cdc",
+	)
+}
+
+func importsNonfungibletokenCdc() (*asset, error) {
+	bytes, err := importsNonfungibletokenCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/NonFungibleToken.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x44, 0x18, 0x68, 0x42, 0x5b, 0x57, 0x2f, 0x27, 0x6b, 0xcf, 0xf4, 0x3b, 0x9b, 0x4a, 0x6b, 0xb5, 0x2, 0xfb, 0x36, 0xae, 0x9b, 0x9d, 0x10, 0xc, 0x83, 0xc9, 0x6, 0x1a, 0xd9, 0x4f, 0x5, 0x2d}}
+	return a, nil
+}
+
+var _importsPacknftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\xdd\x6e\xdb\xb8\x12\xbe\xf7\x53\x4c\x73\x51\x48\x3d\x8e\xdd\x03\x1c\x1c\x2c\x84\xa4\x69\xb6\x8e\xb1\x06\x5a\x37\x88\xbd\xed\x45\x11\x14\xb4\x34\x8e\x88\xc8\xa4\x40\x8e\xe2\x35\x02\xbf\xfb\x82\x94\x64\xfd\x51\xae\x0d\xec\xc5\xfa\xc2\x89\xc4\xf9\x66\x86\xf3\xc7\x8f\xe6\x9b\x54\x2a\x82\xb9\x14\xd3\x4c\x3c\xf1\x55\x82\x4b\xf9\x8c\x02\xd6\x4a\x6e\xe0\xfd\x5f\xf3\xe9\xf2\x76\x32\x79\xb8\x5b\x2c\x06\x85\xe4\x17\x24\x16\x31\x62\xdf\x38\x6e\x75\x29\xf6\xe5\x6e\x79\x3b\xb9\x5d\xde\x7e\x9b\xdd\x7d\x5f\xb4\x00\x46\xf0\x01\xb5\x4c\x5e\x50\x95\xf2\x46\xee\xe1\x6e\xf1\xf5\xf3\xb7\xbb\x87\x52\x7c\x30\x7e\x37\x00\x00\xb8\x67\xe1\xf3\x7c\xba\x04\xae\x81\x81\x26\x26\xa2\x4b\x2e\x60\x2d\x15\x50\x8c\x87\xd5\x50\x0a\x52\x2c\x24\xa0\x98\x11\xc4\x32\x89\x34\x2c\x65\x0a\x8b\x58\x12\xa4\x2c\x7c\xd6\x56\x99\x14\x40\xa8\x49\x20\x01\x13\x11\x6c\x18\x17\x02\x69\x04\x33\x82\x67\xc4\x54\x5b\x9d\x69\xb6\x4a\x78\x08\x3a\x53\x6b\x16\xa2\x7d\x55\xaa\xb2\x4a\x48\x31\xa1\x59\x48\x5c\x0a\x6d\xd5\xe0\x0b\x0a\x82\x08\x43\x19\xa1\xd2\xa0\x30\xd9\x19\x4b\x9e\x41\x86\x32\x49\xd0\xca\x42\xca\x28\xd6\x43\xa3\xcf\xaa\xf9\x54\xad\x28\xd4\x32\x53\x21\x5a\x6d\x06\xf5\x80\x2f\xc8\x12\x2c\x54\xfb\xa0\x65\xbe\x31\xb3\x86\x9b\x2c\x61\x24\x15\x84\x4c\xc0\x2a\xd7\xb5\x92\x92\x34\x29\x96\xa6\x18\xc1\x96\x53\x2c\x33\x82\x35\x52\x18\x73\xf1\x94\x6f\x4a\xc9\x28\xcb\xad\x95\xb1\x1a\x0d\x0e\x01\xd6\xc0\x14\xc2\x86\x0b\xc2\xc8\x3a\xa1\x4a\x07\x56\x3b\x0b\xff\x9a\xa2\xb2\x46\x35\x49\x85\x11\x70\x61\x5f\x47\x98\x26\x72\x67\x6c\xb0\x30\x94\x99\xa0\xd1\xe0\xdd\x78\x30\x60\x61\x88\x5a\x7b\x2c\x49\xfc\x2a\x33\x45\xaa\x82\x6e\x71\xbd\xe6\x8e\xd4\x51\x2f\x4c\x01\x49\x62\xc9\x22\x4b\xd3\x64\x17\xc0\x9f\x33\x41\xff\xff\x5f\x57\x30\x41\xaa\x45\x72\x41\x52\xb1\x27\xbc\x67\x14\x07\x50\x7b\xf8\x05\xec\xde\x66\x3c\x47\x55\xff\x3b\x41\x65\x20\x7a\x2d\x75\x50\x79\x75\x7c\xb1\xb1\xf5\x78\x54\x6e\x65\x08\x31\xd3\x71\x00\x3f\xcc\xe3\x6f\x8f\x43\x88\xb8\xa6\xd9\x61\xd9\xef\xd1\x53\x56\x46\x43\x93\x66\x09\x19\x2f\x14\x17\x4f\x43\x10\x6b\xd2\xe5\x93\xdf\xf5\xe7\x50\x6c\xce\x64\x8c\x4c\x37\xbd\x5a\x90\x6b\xfb\x95\xd5\x5e\x91\xc6\xb6\x7a\xa5\x9a\xbb\x1d\x1c\xe4\xb8\xe0\x74\x46\x94\x6a\xae\x9a\x8f\xc6\x64\x3d\xe2\x11\x5c\x03\x8f\xba\x0b\x46\x15\x5c\x5b\x8d\xdd\xc5\x5c\x31\x5c\x17\x16\x0e\x02\xfb\x81\x73\x0f\x2f\x1c\xb7\xb0\xce\x04\x3c\x21\xd9\xd9\xe7\xf9\x01\xfc\x58\xee\x52\x7c\x6c\xf9\xa4\x90\x32\x25\xf2\xb5\xab\xc6\xb8\x1c\x4d\xb8\x4e\x13\xb6\xfb\xe0\xf9\x8f\xbf\xb2\x67\x4c\xa9\x7c\x6c\x1a\xac\xf7\xd3\x7a\x10\x80\xd1\xea\x07\x70\x2b\x76\x0b\x52\x59\x48\x37\xed\x88\x6c\x39\x85\x71\xee\x6e\x73\xc5\x7c\x42\xa6\x11\x8e\x3a\x16\x74\x30\xb5\x3d\x39\x41\x9e\x13\x61\x3e\x82\x6d\x30\x80\x0b\x33\x08\x2e\x86\xbd\x52\x11\xea\x50\xf1\xd4\xf4\x65\x00\x17\x8d\x21\x7e\x04\x45\x71\xb6\x59\x09\xc6\x93\xa0\xe5\xd5\x1f\xcb\xe5\xfd\x94\x27\xe8\x65\x2a\x09\xe0\xe2\xc2\x77\xaa\x68\xbe\xdd\xbb\x12\x28\x78\x72\x4a\x92\x42\x85\x8c\xf0\x6e\x93\xd2\xae\x1a\x30\xa6\x38\x3e\xbe\x76\x7a\xad\x12\xd8\xbb\x8b\xe6\xea\xb2\x1c\x9b\x23\xb7\x5e\xb1\x26\x93\xbe\xbc\x0e\xae\x3e\x96\xc2\xf3\xe9\xf2\x83\xe7\xfb\x83\xe6\x86\xf6\x47\x86\x41\xa5\xd3\x31\x13\x6a\x47\x95\x7b\x34\x98\x71\x2d\xb7\x02\xa3\xf9\x74\xa9\xcd\x56\xf3\xf6\x0c\xa0\xbb\xe7\xf9\x74\xb9\xdf\xb7\xfa\xdd\xd9\xc6\x07\x7d\x26\x0a\xaf\xfb\x73\x1a\xd2\x1c\x1a\x52\x91\x85\x9b\xc0\xd8\xe6\x7c\xcd\x03\xf5\xbb\x94\x49\x4f\xb4\x5f\x5d\x41\x0c\x80\x54\x86\xa7\x9b\xe7\xba\x6d\xdd\xa3\x43\x86\xfc\xdc\xbe\xdb\xbc\x11\x83\xeb\x6b\x67\x2a\x8f\x98\xef\x44\xf8\x3b\xa7\x38\x52\x6c\x9b\xd7\xe3\xb6\x78\xf2\xca\x7f\x66\x93\xc3\xf4\x74\x16\xa5\x49\x50\xcb\x41\x33\xb0\xc9\x1e\xd5\x57\x97\xad\xe4\x8c\x14\x6e\xe4\x0b\x7a\xcf\xb8\x0b\xa0\x32\xd1\xed\xb2\x9b\x1b\x48\x99\xe0\xa1\x77\x51\xee\xac\xaa\xaa\x51\x09\x0c\xe0\x93\xcc\x92\x08\x84\x24\x58\x73\x11\x01\xb3\x7d\x6f\x15\xc3\x6c\x02\x17\xa3\x50\x8a\x90\x51\x6d\x33\x23\x92\xf9\x51\xe7\xf9\xbe\x3f\xe8\x69\x22\xeb\xfc\x29\xdd\x1b\x61\x2a\x35\x27\xcf\x02\x7a\xc3\xd3\xae\x57\x13\x1f\xeb\x67\x69\x0a\x98\x7e\x03\xf5\x14\x1e\x29\xef\x1f\x06\x39\xe2\xd1\x23\x5c\x5d\xbe\xb1\x6a\xce\x29\xf5\xd9\x24\x3f\x79\xf2\x8c\xf6\x9c\x3d\xad\x94\x3d\xe3\x4e\x9f\x63\xe3\x33\x8a\x27\x8a\x8d\x99\x99\xa0\x93\x2c\x24\x16\x71\xb2\x8d\x95\x54\x4a\x6e\xe7\xd3\xa5\xf7\xb3\xc6\x30\xfc\x00\xde\xba\xe3\xdf\x3e\xe4\x0a\x27\xde\xb6\x02\xcb\xa3\x93\x8e\xd5\x7f\xff\xc4\x1e\x8f\x2b\x02\x6e\x48\xba\xce\xaf\x34\x35\xa6\x6e\x6f\x2e\x5c\x59\xb6\x8d\x82\x74\xff\xa0\x3f\x28\x7a\xed\x8f\x88\xb1\xe1\x35\x99\x56\x8b\x88\x99\xe8\xd4\x5c\x6f\xc5\xa2\x5c\xa9\x31\x78\xb8\x76\xbe\xfd\x0f\xfc\xb7\xb7\x93\xf2\xf8\x19\x9a\x6a\x09\xa1\x03\x5e\x3a\x65\xbe\x2b\x6a\x98\xff\x6d\x4e\x20\xdc\xf0\x06\x07\x2f\x7a\xee\xb8\x82\x9e\xfc\xf6\xb5\xe8\x78\x6c\xa9\x77\x7e\x53\x0d\xe5\x66\xc3\x40\x63\xca\x14\x33\x97\xaa\x84\x6b\x02\xb9\xb6\xb7\x26\x7b\xa8\x15\x37\x28\xa3\x6c\x08\x4c\x9b\x0b\x63\x71\x1d\x3d\xc2\xff\x4c\xaa\x4f\x65\xfe\xad\x9c\xd8\x08\x34\x6e\x0f\x66\xfb\x39\xde\x7c\x97\x68\xf3\x7d\x02\x71\xe8\x6f\x9c\x46\x81\x9f\xd3\x45\x55\x84\x8b\xcc\xd7\xbb\xb1\xcf\x8f\xfa\x9c\xfa\x54\x5c\x35\x73\x3e\x5e\x56\x7c\xe5\xcc\x8d\x8b\xa2\x97\xf4\xbc\x11\x2c\x07\x25\x9e\x4f\x97\x95\x3f\x13\x46\xac\x7e\x2a\x3f\x1e\x0b\x53\x41\xdb\xeb\xde\xb9\x9c\x1b\xda\xbd\x34\x42\xe7\x64\xf5\x35\x46\x6f\xc4\x5a\x69\xee\x63\xf4\x2e\xf7\xbb\xdc\xde\xc9\xeb\x3b\x50\x37\xc3\xd7\xf5\x1b\x71\xf7\x8c\xaf\xdd\x91\xdd\x2c\x3e\xad\x5f\xc2\x3b\xf0\xea\x5a\x7e\x0c\x5d\x67\xb1\x36\x08\x6f\xbb\x9a\x3e\x78\xfe\x31\x15\x9f\xb9\x78\xc6\xa8\x36\xa2\xcf\x51\xe1\xec\x88\x69\x26\x0a\x97\xbc\x75\x76\xfe\xc1\xe2\xc8\xcf\x3f\x75\xc8\xd4\x3f\xfb\xee\xeb\xf6\x18\x80\xee\x65\xa8\x28\xf9\x0e\x8b\xb7\x27\x71\x73\xfc\xbf\x6f\x2e\x3a\x2b\x03\xae\x61\x5c\xd4\xd1\xb8\x70\xba\x92\xeb\xc3\x57\xa5\x61\xe0\x79\x16\xbb\xe8\xfb\x6c\xd5\x54\xe0\xf8\x25\xc7\x61\xbe\x94\x1a\x34\xc1\xe5\xcf\x5d\x85\xf4\x48\xb3\x17\xf4\xaa\xc9\x55\xa2\x3c\x7f\x08\x24\x83\x5e\x83\xe5\x58\xdb\x0f\xfe\x0e\x00\x00\xff\xff\xbc\xee\x08\xa1\x79\x15\x00\x00"
+
+func importsPacknftCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsPacknftCdc,
+		"imports/PackNFT.cdc",
+	)
+}
+
+func importsPacknftCdc() (*asset, error) {
+	bytes, err := importsPacknftCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/PackNFT.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8c, 0x20, 0x27, 0x2e, 0x7b, 0xdd, 0x46, 0xfd, 0xe9, 0x8, 0xf, 0xe0, 0x98, 0x4d, 0xd4, 0x81, 0x90, 0x64, 0x3d, 0xd9, 0xd0, 0xe9, 0xa0, 0x3d, 0xe6, 0x31, 0x15, 0x60, 0x82, 0xb, 0xfd, 0xe4}}
+	return a, nil
+}
+
+var _importsTokenforwardingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5b\x6f\x1b\xb9\x15\x7e\x9f\x5f\x71\xe2\x02\x8d\x14\x78\x47\x7d\x28\xfa\x20\xc4\x4d\x9c\xd8\xde\x06\x2d\xb2\x85\x2f\xdb\x87\x62\xd1\xa5\x38\x67\x34\x5c\x53\xe4\x80\xe4\x68\x22\x18\xfa\xef\xc5\x21\x39\x57\x8d\x64\xa7\x68\xb1\x28\x36\x7e\xb1\x66\x74\x78\xae\xdf\xb9\x51\x8b\x37\x6f\x92\xe4\x77\x70\x53\xa9\xb5\x58\x49\x84\x7b\xfd\x88\x0a\x6e\xb4\xa9\x99\xc9\x84\x5a\xc3\x47\xad\x9c\x61\xdc\x25\xc9\x7d\x21\x2c\xf0\xf8\x08\xb6\xd0\xb5\x85\x42\xd7\xc0\x14\x30\xce\x75\xa5\x1c\x70\x5d\xc9\x0c\x2c\x3a\xa8\x4a\x60\xc0\x2b\xeb\xf4\xa6\x65\x1e\x78\xdf\x22\x47\xb1\x45\x93\x38\x0d\x4c\x4a\x5d\x83\x2b\x70\x03\x4e\x43\x1e\xa4\x82\x23\x3a\x4b\x6f\x18\x64\x22\xcf\xd1\xa0\x72\xad\x8c\xba\x40\x85\x5b\x34\x74\x6c\x07\x26\x70\x8b\x67\x52\xd2\x12\x77\xc0\x99\x82\xb2\x5a\x49\x61\x0b\x70\xa4\x76\x34\x08\x0d\x18\xb4\xba\x32\x1c\x81\x59\x60\xad\x32\xc0\x59\xc9\x56\x42\x0a\xb7\x83\x5f\x2a\xeb\x40\x8a\x47\x04\x06\x3f\xb2\x4a\xba\xf3\x84\xa9\x8c\xc4\x81\x45\x45\x3c\x32\x8d\x56\xbd\x76\x80\x5b\x54\xa0\x10\x49\x65\x78\x54\xba\x06\xe1\x40\xd8\x4e\xe9\x34\x49\xfe\x51\xa0\xea\xbb\xa8\x66\xca\x79\xdb\xb8\x41\xe6\x48\x46\xab\xdb\x79\x30\x89\x33\x29\xbd\xb4\x40\xf1\x19\xeb\x96\x22\xc9\x2b\xc5\x9d\xd0\xc4\x31\x83\xd2\xe8\xad\xc8\x90\x84\xd6\xc2\x15\xfe\x4c\x6b\x90\x41\xaf\x02\x47\x70\x05\x73\x81\x33\xc9\xee\x39\x3a\x71\x05\x0a\xd3\xb9\x3b\x4d\x92\x37\x8b\x24\x11\x9b\x52\x1b\x37\x8a\x5a\x6e\xf4\x06\xfe\xf0\xe5\xe6\xe1\xf3\xf7\x9f\x3e\xfc\xed\xfa\xfe\x87\xbf\x5e\x7f\xbe\xbc\xba\xba\xbd\xbe\xbb\x4b\x12\xc6\x39\x5a\x3b\x63\x52\xce\x3b\x80\xf8\x73\x3d\x20\x3d\x25\x09\x00\x40\x9f\x16\x95\x13\x4e\xe2\x86\xc2\xfb\x43\xad\xd0\x04\x92\xc5\x02\xae\xb7\xf4\xce\x6b\x2e\x2c\xe0\x46\x38\x87\x99\x0f\x7d\xa3\x2e\x33\x08\x19\x96\xda\x0a\x17\xfc\x4f\xd6\x3b\x66\xd6\xe8\x1a\x50\x98\x43\x81\x9e\x6d\xe3\xce\xec\x2a\x9c\x9f\xb1\x0d\x85\x66\x09\x0f\x37\xe2\xcb\x9f\xfe\x78\xde\xf1\x7d\x78\xf8\x74\xb5\x84\x87\x4f\xca\xd1\x6b\xf2\xc1\x12\x2e\xb3\xcc\xa0\xb5\xef\xce\xc1\xe9\xe1\xd3\x90\xba\x65\x72\xbf\x2b\x71\x09\x77\xce\x08\xb5\x9e\xbf\xc8\xc2\x02\xc9\x04\x51\x0a\x22\xd1\x39\xb0\x26\x62\x68\xa0\x60\x16\x78\xc1\xd4\x1a\xb3\x67\xcc\x33\xb7\x0d\x8f\x87\x32\x63\x0e\xb3\x99\x26\x1f\xf7\x75\xd6\x32\x6b\x89\xfa\xef\x15\xd6\x27\xde\x7b\xdf\xf6\xad\x1a\x7c\xd1\xf7\xc2\xfc\x30\xe6\x6d\xfe\x09\xe5\xd0\xe4\x8c\x63\xa7\xf0\xdf\x29\x65\x79\x83\x94\xe0\xa8\x05\xfc\x05\x65\x89\x06\x5a\xe8\x53\xee\x14\xc8\x1f\xc9\x59\xae\x40\xe3\xeb\xcd\xcf\xad\xc3\x7e\xee\xe5\xf2\x80\x8f\xb0\xa0\xb4\x03\xc9\x9c\x77\xab\x09\x39\xd6\xe5\xbd\x13\x01\x48\x0c\xdc\xae\x44\x22\xdf\x32\x29\xb2\xb4\x65\xd2\x37\x23\xaf\x54\xd0\x62\x36\x5f\xc2\x07\xad\xe5\x50\xe7\xef\x91\x92\x3c\xc4\x31\xe4\x23\xb3\x56\xac\x55\x23\xa1\x0b\x6f\xa7\x40\x3a\xe0\xe0\xab\x2d\xa9\x8c\x24\x94\x99\x1d\xac\x90\xb3\xca\xa2\xcf\x75\x5d\x39\x10\xee\x3c\xd6\x1b\x32\xab\xd4\xd6\xfa\x0a\xee\x34\x48\xad\x1f\xa1\xf2\xa5\x8a\x74\x28\xb4\xce\x7c\xc1\xb0\x88\x20\x08\x4e\xc7\x3d\x44\x68\x53\x80\x5f\x4a\xe4\x3e\xaf\xc8\x13\xda\x90\x84\x34\xa8\x54\xa0\x2c\x2d\xac\x2b\x2a\xd4\x6c\xcd\x84\xb2\x0e\x84\xca\x85\x12\x0e\xe5\x8e\xa0\x29\xc8\xca\x71\xf6\x6b\x03\xda\x07\x4b\x28\xef\x55\x18\x08\xde\x30\x29\xb8\xd0\x95\x85\x47\xa1\x32\xaf\x45\xe5\x31\x6b\x43\x86\x84\xbe\x52\x9a\x00\x70\x29\xac\x13\x6a\x6d\x43\x51\x5a\x21\xf1\xdf\xb0\x2c\x56\x3a\x2a\x0c\x41\x84\x56\x60\x9d\x36\x98\x1b\xad\x9c\x1d\xb8\x77\x20\xfd\xbd\x41\x57\x19\x5f\xa5\x75\x49\x10\x63\xb2\x8b\x5b\x0f\x20\xb9\x36\x54\xde\x6c\xb5\x41\xe3\x75\x24\xe7\x8e\x0d\x6d\xd0\xb9\xf0\x3a\x50\x85\xd7\x3e\xa3\x85\x01\x5d\xab\xa3\x58\xb2\x2c\xc7\x0f\xda\x18\x5d\x13\xa0\x7e\xff\x34\xa8\xbe\x69\x93\x5b\xfb\x77\x9e\xc1\xfe\x44\x5a\xb5\xc9\xb4\x84\x69\x1e\xe7\xa7\xf3\xad\x05\x5e\x5d\xa0\x41\x6f\x62\xbf\xcc\xfa\xda\x5b\x0b\x29\x61\xe5\xdb\xa1\x4b\x87\x67\x31\x26\x8f\xca\x04\xef\xe2\x17\x60\xca\xfa\x4d\x29\x66\x41\x57\xa7\x03\x8b\xb1\x83\x2c\xca\x7c\x0e\x5b\x66\xba\x94\x59\xc2\xc7\x0e\xbe\x7d\xe9\x51\xcf\x29\x6e\x8b\x05\x79\x23\xd6\x0f\xdf\x0f\xd9\x23\xda\xa6\xc1\x83\x5e\xfd\x82\xdc\xf9\x91\x40\x01\x33\xeb\xca\xb7\x24\x4a\x99\x58\x78\x6d\x9f\x93\x70\x4d\xbf\x69\x75\x7a\x6d\x23\xa7\xca\x7a\x10\xd0\xac\x40\xd0\xcb\x3a\x93\x4f\x18\xd9\xa2\x20\x5a\x30\x0b\x9d\xe6\xfd\x08\x05\x5e\xc2\x7e\x0e\x4f\xed\x79\xfa\x93\xbd\x86\x77\x8b\x39\x5c\x00\xf9\x2c\x6d\x55\x4b\x57\x1e\x56\x6f\x8f\x62\xea\xcf\xb3\xf9\xab\xe4\x80\xe5\x8a\x49\x46\x81\xba\xf0\x59\x96\xc6\xc7\x43\xba\xaa\x12\x59\x43\x44\x9f\x87\x14\xd4\xd7\x8e\xf7\xdb\xc8\xf4\xa0\xe1\x12\x9f\xa6\xdd\x7a\x63\x7c\xe3\x7a\x97\xb2\xd0\x88\x42\xe7\xed\xd9\x3c\xf1\x7d\x60\xd4\xa7\x09\x4c\x47\x5d\xd9\xab\xbd\x46\x47\x4f\xb3\x79\x2a\x32\x1a\x49\x72\x81\x66\x3e\xb4\xa3\xcf\x68\x18\xa4\xb7\xdf\xd1\xff\x79\x4b\xbd\xff\x7f\x6c\x5e\x23\x48\xc5\x7a\x38\xc2\x91\x3f\x71\x12\x46\xc7\x9c\xf0\xad\x1b\x7e\xeb\x86\xff\x93\x6e\xf8\x22\xdc\xbe\xa0\xfe\x4d\x03\x37\xce\xd9\xb7\x1d\x3a\xfd\xb3\x3d\x1c\xd0\xe9\x45\x37\xa2\xc7\xe6\x10\xd7\xb3\xac\x23\x3d\xd1\x02\xfc\xfa\xd3\x64\xe6\x40\xea\xec\x5f\xa3\x71\xbc\xeb\x7e\xe3\x56\x50\x1a\x1c\xbd\xa1\xbf\xfe\xe9\x97\x38\x03\x5e\x5d\x80\x12\x72\x79\xc0\x88\xfe\xce\x46\x11\x4e\xdb\x61\x22\x1d\xe9\xbd\x84\x8f\x1e\xa7\x94\x8e\x41\x6a\x7f\xdf\xee\x26\x01\x0f\x5b\xf2\x97\xc2\xba\x67\x5b\x0a\x67\x93\x0a\xa4\x5c\x2b\xce\xdc\xec\xac\x29\x09\xb4\xa9\xcb\xae\x1e\x0c\x63\xd3\x6c\xdd\x67\xf3\x93\xcc\x06\x2e\x8a\x5d\x24\x75\x3a\x6c\x37\xb3\xf9\xe9\xc3\x67\x7e\x27\x23\x33\xe3\xc5\x07\xa9\x30\xdc\x9e\xe3\x94\x61\xc2\xa5\x04\xef\xa7\x11\xd7\xc6\x20\x77\x92\x0c\x7e\x46\xce\x8f\x68\x44\xbe\x6b\xf7\x79\x88\x8a\x82\xbf\x96\xf1\x5c\xa0\xb9\xa8\x68\xec\x26\xcd\x7c\xa7\x88\xdf\x07\x45\x88\xaa\x57\x69\x47\x72\xf7\x07\x3d\xde\xbb\x87\x26\x8b\xaf\x85\xd2\xab\x03\x56\x7e\xe3\xfc\x4f\x87\x94\x3e\xb3\xc1\x64\x71\x74\xd5\x9d\x9c\x1e\x86\x5b\x6f\xd0\xe8\x80\x68\x98\x73\xc1\x03\x47\x88\x7a\xfb\x70\xa4\x9b\x9a\x28\x26\x96\xe4\x48\x4d\x93\xc9\x30\x04\x43\xe7\x8c\x1c\x7f\xac\xcb\x5e\xc2\x1a\x9d\x1b\x0c\x1b\x84\x94\x50\x14\x03\x0c\xfc\xfc\xee\xdb\x98\x05\x5b\x95\xa5\x36\xd4\xd6\x56\xbb\x70\x49\xd6\xec\x10\xe7\x03\xb6\x75\x21\x78\xe1\x6f\xd4\x56\xfd\x55\xa0\x1b\x74\x5f\xc7\x97\xaf\x5b\xc1\xcf\x37\x97\x4b\x63\xd8\x8e\x2a\xe7\xcd\x7d\x54\x27\x74\xb6\x91\x94\xe9\xf1\x65\x2b\xb0\xf6\x95\x72\x8d\xee\xae\xb1\xc2\x23\x9b\x9c\x6e\xa9\x65\x3c\x85\x70\xd0\x6c\xb3\x1f\x95\x44\x91\xc3\xab\xaf\x9e\x6c\x26\xca\x6a\xb4\xe4\x69\xff\x4c\xf2\x6c\x49\xb1\xff\x0e\xe6\x89\x9d\x1d\x18\x6c\x47\xa6\x5e\x8c\xf5\x19\x91\xff\xb3\xd1\xa6\x83\xe8\x4f\x70\x01\xce\x54\x38\xd9\x49\x87\xa7\x8f\x21\xef\x36\x42\xac\x99\x69\xc3\x88\xe4\xc1\xb1\x16\xdb\x88\x38\xbf\x01\x72\x8e\x65\x0b\xb9\xee\xb6\x72\x84\x63\xaf\x64\x87\x88\x70\x0a\x98\xda\x05\x46\xb6\xf0\x8d\xc5\xdf\xd3\x46\x45\xc9\x00\x62\x9a\x61\x4e\x67\x4f\xc3\x46\xd8\x43\xd4\xcc\x9c\xf7\x22\x7d\x9c\x9e\x89\x27\x7c\xdf\x44\xf4\x18\x0c\xc7\xb0\x1b\xb0\xa0\xc3\xa3\xd8\x90\x0a\x3f\x1d\x47\x5a\x4b\x3e\x04\x1c\xa0\xb4\x08\x4f\x0d\x55\xce\xe8\x71\x3f\x15\x2a\x9a\x4a\x67\x93\x6b\xf4\xcb\x06\x89\xaf\x42\xee\xaf\x35\x45\xfc\x4a\x13\x84\xf9\xed\x8e\x0f\x63\xc1\xfb\xd3\xad\xec\x70\x1a\xde\xf7\x2f\xb5\x68\xec\x3e\xf8\xdd\x23\xbe\xb2\xc0\xfc\x94\xd8\xff\x35\xa7\x01\x40\xfb\x0b\xc8\x91\xb1\x3b\x36\xa2\x83\x35\xf8\x40\xd6\x91\x0c\x59\xc2\xfb\x4e\x6c\x97\x1b\x31\xeb\xde\x7e\x17\x7f\xce\x99\x64\xd3\x7e\x9c\x47\x4b\xf7\xc9\xbf\x03\x00\x00\xff\xff\x74\xe7\xd2\x00\x78\x1b\x00\x00"
+
+func importsTokenforwardingCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsTokenforwardingCdc,
+		"imports/TokenForwarding.cdc",
+	)
+}
+
+func importsTokenforwardingCdc() (*asset, error) {
+	bytes, err := importsTokenforwardingCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/TokenForwarding.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x52, 0xca, 0xf1, 0xe8, 0x81, 0x32, 0xcf, 0x3c, 0xef, 0xbe, 0xfd, 0x30, 0x18, 0x79, 0xc8, 0x62, 0xc6, 0xe1, 0xb2, 0xf7, 0xf0, 0x82, 0x37, 0x29, 0xf0, 0x31, 0x47, 0x44, 0x2a, 0xe1, 0x7f, 0x46}}
+	return a, nil
+}
+
+var _importsViewresolverCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\xc1\x6e\xe3\x36\x10\xbd\xfb\x2b\xa6\x97\x36\x06\xb2\xf6\xa5\xe8\xc1\x97\x34\xd8\x45\x80\x1c\xba\x28\xba\xee\x5e\x16\x41\x31\x16\xc7\x16\x11\x8a\xa3\x72\x28\x6b\x85\x20\xff\x5e\x0c\x29\x53\xb2\xb3\xd9\x45\x8b\xa2\xa7\xfa\x60\x0b\xb2\xf8\xde\x9b\x37\x6f\x48\xad\xd7\xb0\xc5\x47\xf2\xb0\x0f\xdc\x40\xac\x09\xde\xdf\x6d\xe1\x17\x8a\x68\x30\x22\x48\x44\x6f\x30\x98\x6b\x88\xb5\x15\xa8\xd8\xc7\x80\x55\x04\xfa\xdc\xb2\x90\x00\x7a\xb0\x3e\x52\xd8\x63\x45\x10\x19\x1c\x45\x58\xac\xd7\x80\x7e\x60\x4f\xb0\xe3\x10\xb8\x07\x9c\x16\xa2\x37\x10\x48\xd8\x1d\x09\x8e\x96\x7a\x01\xf6\x60\xe3\x6a\xb1\x5e\xeb\xba\xad\xb2\xf4\xd6\x39\x40\xe7\xb8\x87\x81\x3b\x85\xe5\x5d\x44\xab\x54\x7b\x0e\x0d\x46\xcb\x1e\x70\xc7\x5d\x9c\x23\xf7\x36\xd6\x7a\xcb\x53\x45\x22\x18\xac\x1b\xe0\xd1\x73\x6f\xfd\x41\xe5\xc4\x3a\x5d\xa4\x55\x99\x0f\x6e\x9d\x4b\x04\x9e\xc8\x80\x15\xb0\x51\x00\x8d\x09\x24\x92\x74\x7a\x6c\x28\x5d\x0c\xdc\xfd\x10\x08\x0e\xcc\x46\xd5\x1c\xf8\xbb\x05\x56\xca\x72\x85\xce\x2d\x27\x09\x93\x15\x1f\x2d\xf5\xbf\xe5\x32\x03\x3c\x2d\x16\x00\x00\xeb\xf5\x1a\xee\x3a\x5f\x25\xf9\xb1\xc6\x08\x81\x62\x17\xbc\x68\xad\xc9\xfa\x62\xfb\xc7\xe4\x8c\x6d\x5a\x47\x0d\xf9\x48\x06\x76\x43\x7a\x22\x5b\xa7\x95\x9c\x48\x57\x05\xfb\x03\x37\x54\x6e\x0b\x34\x38\x40\x8d\x47\x82\xa6\x73\xd1\xb6\x2e\x2f\xee\x82\x36\x6a\x68\x49\xb2\x04\xe9\xda\x96\x43\x84\xe6\x44\x9d\x9a\x52\x30\x85\x95\x36\x90\xda\x83\x1e\xb8\x55\xf1\xe8\xa0\xc5\x80\x0d\x45\x0a\xea\x87\xb4\x54\xd9\xfd\x00\x7d\x6d\xab\xfa\x9c\x25\x89\xae\xd0\x39\x0a\x05\xd3\x0a\x04\xfa\xb3\x23\x89\x5a\x47\x0e\xc1\x9e\xc3\x2b\x85\xbc\x71\x74\x24\x37\x3e\xa6\x35\xed\x32\xf2\x1b\x3c\x78\x96\x68\xab\x15\xdc\x8f\x76\x56\x28\x74\x9d\x19\xc7\xc5\x53\x1d\x35\x77\xce\x8c\x86\xa7\x47\x44\x9b\x9b\x51\x03\x1d\x30\x18\xa7\x6d\xe7\x3d\xf4\x0a\x95\xb4\x5b\x81\x16\x45\x34\x1c\xbe\xa8\x2b\x90\x3f\x27\x0f\x4a\xb9\xdb\xa1\xa5\x0d\xdc\xce\x3c\xba\x30\x82\x4f\xec\xa5\xe2\x09\x6a\xfc\xe7\xd6\x03\x86\x80\x83\xca\xd8\xa6\x1e\x19\xda\x5b\xaf\x36\xa9\xe4\x79\x1e\x12\xc8\x2a\xcf\xcb\x11\x5d\x47\x79\x6a\x76\x04\x9d\xa4\xb8\x14\xf0\xd3\xc7\xa8\x8f\xdc\x52\x10\xd5\xa2\x93\x31\x36\xec\xac\x97\x5a\xb0\xfe\x4e\x61\x23\x0d\xe3\xd5\x52\x13\x52\xb3\x39\xf7\x61\x3e\x05\xaa\x08\xf6\x9d\x87\x03\xc5\xb7\xa3\xff\x29\xc7\x57\xe7\x16\xe9\xf7\xcd\x72\x03\x9f\xf4\xe2\xe1\xf5\xd1\x48\xdc\x02\x78\x1e\x4d\xf5\x2d\xef\x45\x91\x1f\xc9\xff\x47\xe9\xff\xc6\x0c\xa8\xa6\xbf\x3f\x04\x8e\xf9\x51\x5b\xab\xab\xff\x9f\x82\x02\xa5\x7f\x6c\x60\x5b\x53\x4a\x8a\x6a\x51\xa1\x86\xc4\x86\x31\xf7\xab\x97\x83\x03\x12\x43\x57\xc5\x2e\x68\x9f\xdb\x40\x42\x3e\x9e\xc6\x66\xdc\x6c\x2e\x17\xbf\x08\xb0\x66\x77\x8c\xdd\x3c\xbf\x5f\x8a\xef\x75\x82\x9a\x6e\x2c\xb5\xe6\xe1\x43\xd2\x70\x33\x45\xfa\xd7\xc0\x47\x6b\x34\xc4\x89\x46\xab\x47\x10\x8a\x5a\xd4\x79\xe4\x56\xa5\x04\xe0\x00\x05\xa0\x98\x77\x45\xab\xc3\x4a\xd3\xf7\xfe\x6e\xbb\x84\x4a\x8f\xdd\xd3\x66\x90\xa7\xe1\xec\x14\x6e\x33\xef\x8c\xb6\x20\xaa\x21\xd9\xfa\x14\x16\x5b\x66\x40\x5e\x37\xa6\xa8\x98\x48\x2e\xcf\xb6\x12\xda\x74\x64\x8a\xee\x01\xf9\x0c\xc3\x1d\x1f\xe9\x1a\x76\x5d\xd4\x83\x1e\xc7\x31\xb1\x55\x7a\xcd\xb0\x5e\x22\xa1\x51\x3b\xf0\x3c\xad\x5f\xdb\x5c\xf2\xa6\x72\xb1\x83\x5c\x0a\x98\xed\x5d\xff\x96\x86\x59\x3e\x52\x2e\xfe\x38\x45\xf5\x45\x00\x74\xe5\xf3\x14\x83\x5b\x38\x04\xee\x5a\xa5\x48\x75\x8c\x20\x41\xbb\x66\xe8\x73\x3e\xdd\xef\xdf\xfd\xa3\x06\xbc\x65\xe7\x28\xef\x9b\x4f\x5f\x77\x2e\xbf\x8b\xcd\x5f\x4c\xae\xac\xd9\xc0\xef\xf7\x3e\xfe\xf4\xe3\x72\x03\xdf\x3f\x9d\xee\x3f\xdf\xcc\xb0\xf4\x33\x4e\x99\xb7\xae\xdc\x7e\x5e\x7c\xb3\x4f\xf7\xef\x72\x97\x32\xc3\xc3\x97\x31\x3f\x3d\xcc\x20\xf3\xf7\xf3\x02\xfe\x0a\x00\x00\xff\xff\x45\x5d\x54\x82\x96\x0a\x00\x00"
+
+func importsViewresolverCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_importsViewresolverCdc,
+		"imports/ViewResolver.cdc",
+	)
+}
+
+func importsViewresolverCdc() (*asset, error) {
+	bytes, err := importsViewresolverCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "imports/ViewResolver.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x37, 0x4a, 0x19, 0x94, 0x4, 0x6b, 0xac, 0x9f, 0x62, 0x28, 0xb4, 0x84, 0x3c, 0xb3, 0x23, 0x93, 0xef, 0x40, 0x55, 0x4d, 0xf9, 0xbd, 0x99, 0x7, 0xa7, 0x2, 0xd0, 0x98, 0xa2, 0x98, 0x7b, 0xde}}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -349,15 +559,25 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"FastBreakV1.cdc":                  fastbreakv1Cdc,
-	"Market.cdc":                       marketCdc,
-	"TopShot.cdc":                      topshotCdc,
-	"TopShotLocking.cdc":               topshotlockingCdc,
-	"TopShotMarketV2.cdc":              topshotmarketv2Cdc,
-	"TopShotMarketV3.cdc":              topshotmarketv3Cdc,
-	"TopShotShardedCollection.cdc":     topshotshardedcollectionCdc,
-	"TopshotAdminReceiver.cdc":         topshotadminreceiverCdc,
-	"imports/CrossVMMetadataViews.cdc": importsCrossvmmetadataviewsCdc,
+	"FastBreakV1.cdc":                        fastbreakv1Cdc,
+	"Market.cdc":                             marketCdc,
+	"TopShot.cdc":                            topshotCdc,
+	"TopShotLocking.cdc":                     topshotlockingCdc,
+	"TopShotMarketV2.cdc":                    topshotmarketv2Cdc,
+	"TopShotMarketV3.cdc":                    topshotmarketv3Cdc,
+	"TopShotShardedCollection.cdc":           topshotshardedcollectionCdc,
+	"TopshotAdminReceiver.cdc":               topshotadminreceiverCdc,
+	"imports/Burner.cdc":                     importsBurnerCdc,
+	"imports/CrossVMMetadataViews.cdc":       importsCrossvmmetadataviewsCdc,
+	"imports/DapperUtilityCoin.cdc":          importsDapperutilitycoinCdc,
+	"imports/FungibleToken.cdc":              importsFungibletokenCdc,
+	"imports/FungibleTokenMetadataViews.cdc": importsFungibletokenmetadataviewsCdc,
+	"imports/FungibleTokenSwitchboard.cdc":   importsFungibletokenswitchboardCdc,
+	"imports/MetadataViews.cdc":              importsMetadataviewsCdc,
+	"imports/NonFungibleToken.cdc":           importsNonfungibletokenCdc,
+	"imports/PackNFT.cdc":                    importsPacknftCdc,
+	"imports/TokenForwarding.cdc":            importsTokenforwardingCdc,
+	"imports/ViewResolver.cdc":               importsViewresolverCdc,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -413,7 +633,17 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"TopShotShardedCollection.cdc": {topshotshardedcollectionCdc, map[string]*bintree{}},
 	"TopshotAdminReceiver.cdc": {topshotadminreceiverCdc, map[string]*bintree{}},
 	"imports": {nil, map[string]*bintree{
+		"Burner.cdc": {importsBurnerCdc, map[string]*bintree{}},
 		"CrossVMMetadataViews.cdc": {importsCrossvmmetadataviewsCdc, map[string]*bintree{}},
+		"DapperUtilityCoin.cdc": {importsDapperutilitycoinCdc, map[string]*bintree{}},
+		"FungibleToken.cdc": {importsFungibletokenCdc, map[string]*bintree{}},
+		"FungibleTokenMetadataViews.cdc": {importsFungibletokenmetadataviewsCdc, map[string]*bintree{}},
+		"FungibleTokenSwitchboard.cdc": {importsFungibletokenswitchboardCdc, map[string]*bintree{}},
+		"MetadataViews.cdc": {importsMetadataviewsCdc, map[string]*bintree{}},
+		"NonFungibleToken.cdc": {importsNonfungibletokenCdc, map[string]*bintree{}},
+		"PackNFT.cdc": {importsPacknftCdc, map[string]*bintree{}},
+		"TokenForwarding.cdc": {importsTokenforwardingCdc, map[string]*bintree{}},
+		"ViewResolver.cdc": {importsViewresolverCdc, map[string]*bintree{}},
 	}},
 }}
 
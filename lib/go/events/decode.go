@@ -0,0 +1,19 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events/decoder"
+)
+
+// decodeEvent decodes an event of the qualified identifier into a map of its fields
+func decodeEvent(b []byte, qualifiedIdentifier string) (map[string]any, error) {
+	cadenceValue, err := decoder.GetCadenceEvent(b)
+	if err != nil {
+		return nil, err
+	}
+	if cadenceValue.EventType.QualifiedIdentifier != qualifiedIdentifier {
+		return nil, fmt.Errorf("unexpected event type: %s", cadenceValue.EventType.QualifiedIdentifier)
+	}
+	return decoder.ConvertEvent(cadenceValue)
+}
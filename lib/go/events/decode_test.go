@@ -0,0 +1,17 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/tests/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeEvent(t *testing.T, identifier string, fields []cadence.Field, values ...cadence.Value) []byte {
+	eventType := cadence.NewEventType(utils.TestLocation, identifier, fields, nil)
+	payload, err := jsoncdc.Encode(cadence.NewEvent(values).WithType(eventType))
+	require.NoError(t, err, "failed to encode %s cadence event", identifier)
+	return payload
+}
@@ -0,0 +1,81 @@
+package events
+
+const (
+	EventFastBreakGameCreated      = "FastBreakV1.FastBreakGameCreated"
+	EventFastBreakGameStatusChange = "FastBreakV1.FastBreakGameStatusChange"
+)
+
+type FastBreakGameCreatedEvent interface {
+	ID() string
+	Name() string
+	FastBreakRunID() string
+	SubmissionDeadline() uint64
+	NumPlayers() uint64
+}
+
+type fastBreakGameCreatedEvent map[string]any
+
+var _ FastBreakGameCreatedEvent = (*fastBreakGameCreatedEvent)(nil)
+
+func (evt fastBreakGameCreatedEvent) ID() string {
+	return evt["id"].(string)
+}
+
+func (evt fastBreakGameCreatedEvent) Name() string {
+	return evt["name"].(string)
+}
+
+func (evt fastBreakGameCreatedEvent) FastBreakRunID() string {
+	return evt["fastBreakRunID"].(string)
+}
+
+func (evt fastBreakGameCreatedEvent) SubmissionDeadline() uint64 {
+	return evt["submissionDeadline"].(uint64)
+}
+
+func (evt fastBreakGameCreatedEvent) NumPlayers() uint64 {
+	return evt["numPlayers"].(uint64)
+}
+
+func DecodeFastBreakGameCreatedEvent(b []byte) (FastBreakGameCreatedEvent, error) {
+	eventMap, err := decodeEvent(b, EventFastBreakGameCreated)
+	if err != nil {
+		return nil, err
+	}
+	return fastBreakGameCreatedEvent(eventMap), nil
+}
+
+type FastBreakGameStatusChangeEvent interface {
+	ID() string
+	NewRawStatus() uint8
+}
+
+type fastBreakGameStatusChangeEvent map[string]any
+
+var _ FastBreakGameStatusChangeEvent = (*fastBreakGameStatusChangeEvent)(nil)
+
+func (evt fastBreakGameStatusChangeEvent) ID() string {
+	return evt["id"].(string)
+}
+
+func (evt fastBreakGameStatusChangeEvent) NewRawStatus() uint8 {
+	return evt["newRawStatus"].(uint8)
+}
+
+func DecodeFastBreakGameStatusChangeEvent(b []byte) (FastBreakGameStatusChangeEvent, error) {
+	eventMap, err := decodeEvent(b, EventFastBreakGameStatusChange)
+	if err != nil {
+		return nil, err
+	}
+	return fastBreakGameStatusChangeEvent(eventMap), nil
+}
+
+// uint64Array converts the array of an event map, where an empty array is nil
+func uint64Array(value any) []uint64 {
+	items, _ := value.([]any)
+	ids := make([]uint64, len(items))
+	for i, item := range items {
+		ids[i] = item.(uint64)
+	}
+	return ids
+}
@@ -0,0 +1,37 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCadenceEvents_FastBreakGame(t *testing.T) {
+	payload := encodeEvent(t, EventFastBreakGameCreated, []cadence.Field{
+		{Identifier: "id", Type: cadence.StringType},
+		{Identifier: "name", Type: cadence.StringType},
+		{Identifier: "fastBreakRunID", Type: cadence.StringType},
+		{Identifier: "submissionDeadline", Type: cadence.UInt64Type},
+		{Identifier: "numPlayers", Type: cadence.UInt64Type},
+	}, cadence.String("def-456"), cadence.String("fb0"), cadence.String("abc-123"), cadence.NewUInt64(200), cadence.NewUInt64(5))
+
+	created, err := DecodeFastBreakGameCreatedEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, "def-456", created.ID())
+	assert.Equal(t, "fb0", created.Name())
+	assert.Equal(t, "abc-123", created.FastBreakRunID())
+	assert.Equal(t, uint64(200), created.SubmissionDeadline())
+	assert.Equal(t, uint64(5), created.NumPlayers())
+
+	payload = encodeEvent(t, EventFastBreakGameStatusChange, []cadence.Field{
+		{Identifier: "id", Type: cadence.StringType},
+		{Identifier: "newRawStatus", Type: cadence.UInt8Type},
+	}, cadence.String("def-456"), cadence.NewUInt8(3))
+
+	changed, err := DecodeFastBreakGameStatusChangeEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, "def-456", changed.ID())
+	assert.Equal(t, uint8(3), changed.NewRawStatus())
+}
@@ -0,0 +1,71 @@
+package events
+
+const (
+	EventFastBreakRunCreated      = "FastBreakV1.FastBreakRunCreated"
+	EventFastBreakRunStatusChange = "FastBreakV1.FastBreakRunStatusChange"
+)
+
+type FastBreakRunCreatedEvent interface {
+	ID() string
+	Name() string
+	RunStart() uint64
+	RunEnd() uint64
+	FatigueModeOn() bool
+}
+
+type fastBreakRunCreatedEvent map[string]any
+
+var _ FastBreakRunCreatedEvent = (*fastBreakRunCreatedEvent)(nil)
+
+func (evt fastBreakRunCreatedEvent) ID() string {
+	return evt["id"].(string)
+}
+
+func (evt fastBreakRunCreatedEvent) Name() string {
+	return evt["name"].(string)
+}
+
+func (evt fastBreakRunCreatedEvent) RunStart() uint64 {
+	return evt["runStart"].(uint64)
+}
+
+func (evt fastBreakRunCreatedEvent) RunEnd() uint64 {
+	return evt["runEnd"].(uint64)
+}
+
+func (evt fastBreakRunCreatedEvent) FatigueModeOn() bool {
+	return evt["fatigueModeOn"].(bool)
+}
+
+func DecodeFastBreakRunCreatedEvent(b []byte) (FastBreakRunCreatedEvent, error) {
+	eventMap, err := decodeEvent(b, EventFastBreakRunCreated)
+	if err != nil {
+		return nil, err
+	}
+	return fastBreakRunCreatedEvent(eventMap), nil
+}
+
+type FastBreakRunStatusChangeEvent interface {
+	ID() string
+	NewRawStatus() uint8
+}
+
+type fastBreakRunStatusChangeEvent map[string]any
+
+var _ FastBreakRunStatusChangeEvent = (*fastBreakRunStatusChangeEvent)(nil)
+
+func (evt fastBreakRunStatusChangeEvent) ID() string {
+	return evt["id"].(string)
+}
+
+func (evt fastBreakRunStatusChangeEvent) NewRawStatus() uint8 {
+	return evt["newRawStatus"].(uint8)
+}
+
+func DecodeFastBreakRunStatusChangeEvent(b []byte) (FastBreakRunStatusChangeEvent, error) {
+	eventMap, err := decodeEvent(b, EventFastBreakRunStatusChange)
+	if err != nil {
+		return nil, err
+	}
+	return fastBreakRunStatusChangeEvent(eventMap), nil
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCadenceEvents_FastBreakRun(t *testing.T) {
+	payload := encodeEvent(t, EventFastBreakRunCreated, []cadence.Field{
+		{Identifier: "id", Type: cadence.StringType},
+		{Identifier: "name", Type: cadence.StringType},
+		{Identifier: "runStart", Type: cadence.UInt64Type},
+		{Identifier: "runEnd", Type: cadence.UInt64Type},
+		{Identifier: "fatigueModeOn", Type: cadence.BoolType},
+	}, cadence.String("abc-123"), cadence.String("R0"), cadence.NewUInt64(50), cadence.NewUInt64(1000), cadence.NewBool(true))
+
+	created, err := DecodeFastBreakRunCreatedEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, "abc-123", created.ID())
+	assert.Equal(t, "R0", created.Name())
+	assert.Equal(t, uint64(50), created.RunStart())
+	assert.Equal(t, uint64(1000), created.RunEnd())
+	assert.True(t, created.FatigueModeOn())
+
+	payload = encodeEvent(t, EventFastBreakRunStatusChange, []cadence.Field{
+		{Identifier: "id", Type: cadence.StringType},
+		{Identifier: "newRawStatus", Type: cadence.UInt8Type},
+	}, cadence.String("abc-123"), cadence.NewUInt8(2))
+
+	changed, err := DecodeFastBreakRunStatusChangeEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, "abc-123", changed.ID())
+	assert.Equal(t, uint8(2), changed.NewRawStatus())
+
+	_, err = DecodeFastBreakRunCreatedEvent(payload)
+	assert.ErrorContains(t, err, "unexpected event type: FastBreakV1.FastBreakRunStatusChange")
+}
@@ -0,0 +1,149 @@
+package events
+
+const (
+	EventFastBreakPlayerCreated         = "FastBreakV1.FastBreakPlayerCreated"
+	EventFastBreakGameTokenMinted       = "FastBreakV1.FastBreakGameTokenMinted"
+	EventFastBreakGameSubmissionUpdated = "FastBreakV1.FastBreakGameSubmissionUpdated"
+	EventFastBreakGameWinner            = "FastBreakV1.FastBreakGameWinner"
+)
+
+type FastBreakPlayerCreatedEvent interface {
+	ID() uint64
+	PlayerName() string
+}
+
+type fastBreakPlayerCreatedEvent map[string]any
+
+var _ FastBreakPlayerCreatedEvent = (*fastBreakPlayerCreatedEvent)(nil)
+
+func (evt fastBreakPlayerCreatedEvent) ID() uint64 {
+	return evt["id"].(uint64)
+}
+
+func (evt fastBreakPlayerCreatedEvent) PlayerName() string {
+	return evt["playerName"].(string)
+}
+
+func DecodeFastBreakPlayerCreatedEvent(b []byte) (FastBreakPlayerCreatedEvent, error) {
+	eventMap, err := decodeEvent(b, EventFastBreakPlayerCreated)
+	if err != nil {
+		return nil, err
+	}
+	return fastBreakPlayerCreatedEvent(eventMap), nil
+}
+
+// FastBreakGameTokenMintedEvent is emitted when a player plays a game, for
+// the game token of the submission
+type FastBreakGameTokenMintedEvent interface {
+	ID() uint64
+	FastBreakGameID() string
+	SerialNumber() uint64
+	MintingDate() uint64
+	TopShots() []uint64
+	MintedTo() uint64
+}
+
+type fastBreakGameTokenMintedEvent map[string]any
+
+var _ FastBreakGameTokenMintedEvent = (*fastBreakGameTokenMintedEvent)(nil)
+
+func (evt fastBreakGameTokenMintedEvent) ID() uint64 {
+	return evt["id"].(uint64)
+}
+
+func (evt fastBreakGameTokenMintedEvent) FastBreakGameID() string {
+	return evt["fastBreakGameID"].(string)
+}
+
+func (evt fastBreakGameTokenMintedEvent) SerialNumber() uint64 {
+	return evt["serialNumber"].(uint64)
+}
+
+func (evt fastBreakGameTokenMintedEvent) MintingDate() uint64 {
+	return evt["mintingDate"].(uint64)
+}
+
+func (evt fastBreakGameTokenMintedEvent) TopShots() []uint64 {
+	return uint64Array(evt["topShots"])
+}
+
+func (evt fastBreakGameTokenMintedEvent) MintedTo() uint64 {
+	return evt["mintedTo"].(uint64)
+}
+
+func DecodeFastBreakGameTokenMintedEvent(b []byte) (FastBreakGameTokenMintedEvent, error) {
+	eventMap, err := decodeEvent(b, EventFastBreakGameTokenMinted)
+	if err != nil {
+		return nil, err
+	}
+	return fastBreakGameTokenMintedEvent(eventMap), nil
+}
+
+// FastBreakGameSubmissionUpdatedEvent is emitted when a player changes the
+// top shots of a submission
+type FastBreakGameSubmissionUpdatedEvent interface {
+	PlayerID() uint64
+	FastBreakGameID() string
+	TopShots() []uint64
+}
+
+type fastBreakGameSubmissionUpdatedEvent map[string]any
+
+var _ FastBreakGameSubmissionUpdatedEvent = (*fastBreakGameSubmissionUpdatedEvent)(nil)
+
+func (evt fastBreakGameSubmissionUpdatedEvent) PlayerID() uint64 {
+	return evt["playerId"].(uint64)
+}
+
+func (evt fastBreakGameSubmissionUpdatedEvent) FastBreakGameID() string {
+	return evt["fastBreakGameID"].(string)
+}
+
+func (evt fastBreakGameSubmissionUpdatedEvent) TopShots() []uint64 {
+	return uint64Array(evt["topShots"])
+}
+
+func DecodeFastBreakGameSubmissionUpdatedEvent(b []byte) (FastBreakGameSubmissionUpdatedEvent, error) {
+	eventMap, err := decodeEvent(b, EventFastBreakGameSubmissionUpdated)
+	if err != nil {
+		return nil, err
+	}
+	return fastBreakGameSubmissionUpdatedEvent(eventMap), nil
+}
+
+// FastBreakGameWinnerEvent is emitted when the oracle scores a submission
+// as a win it was not, as the run win count of the player is incremented
+type FastBreakGameWinnerEvent interface {
+	PlayerID() uint64
+	SubmittedAt() uint64
+	FastBreakGameID() string
+	TopShots() []uint64
+}
+
+type fastBreakGameWinnerEvent map[string]any
+
+var _ FastBreakGameWinnerEvent = (*fastBreakGameWinnerEvent)(nil)
+
+func (evt fastBreakGameWinnerEvent) PlayerID() uint64 {
+	return evt["playerId"].(uint64)
+}
+
+func (evt fastBreakGameWinnerEvent) SubmittedAt() uint64 {
+	return evt["submittedAt"].(uint64)
+}
+
+func (evt fastBreakGameWinnerEvent) FastBreakGameID() string {
+	return evt["fastBreakGameID"].(string)
+}
+
+func (evt fastBreakGameWinnerEvent) TopShots() []uint64 {
+	return uint64Array(evt["topShots"])
+}
+
+func DecodeFastBreakGameWinnerEvent(b []byte) (FastBreakGameWinnerEvent, error) {
+	eventMap, err := decodeEvent(b, EventFastBreakGameWinner)
+	if err != nil {
+		return nil, err
+	}
+	return fastBreakGameWinnerEvent(eventMap), nil
+}
@@ -0,0 +1,73 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func topShotsArray(ids ...uint64) cadence.Array {
+	values := make([]cadence.Value, len(ids))
+	for i, id := range ids {
+		values[i] = cadence.NewUInt64(id)
+	}
+	return cadence.NewArray(values).WithType(cadence.NewVariableSizedArrayType(cadence.UInt64Type))
+}
+
+func TestCadenceEvents_FastBreakSubmission(t *testing.T) {
+	payload := encodeEvent(t, EventFastBreakPlayerCreated, []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "playerName", Type: cadence.StringType},
+	}, cadence.NewUInt64(1), cadence.String("houseofhufflepuff"))
+
+	player, err := DecodeFastBreakPlayerCreatedEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), player.ID())
+	assert.Equal(t, "houseofhufflepuff", player.PlayerName())
+
+	payload = encodeEvent(t, EventFastBreakGameTokenMinted, []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "fastBreakGameID", Type: cadence.StringType},
+		{Identifier: "serialNumber", Type: cadence.UInt64Type},
+		{Identifier: "mintingDate", Type: cadence.UInt64Type},
+		{Identifier: "topShots", Type: cadence.NewVariableSizedArrayType(cadence.UInt64Type)},
+		{Identifier: "mintedTo", Type: cadence.UInt64Type},
+	}, cadence.NewUInt64(77), cadence.String("def-456"), cadence.NewUInt64(1), cadence.NewUInt64(150), topShotsArray(1, 2), cadence.NewUInt64(1))
+
+	minted, err := DecodeFastBreakGameTokenMintedEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(77), minted.ID())
+	assert.Equal(t, "def-456", minted.FastBreakGameID())
+	assert.Equal(t, uint64(1), minted.SerialNumber())
+	assert.Equal(t, uint64(150), minted.MintingDate())
+	assert.Equal(t, []uint64{1, 2}, minted.TopShots())
+	assert.Equal(t, uint64(1), minted.MintedTo())
+
+	payload = encodeEvent(t, EventFastBreakGameSubmissionUpdated, []cadence.Field{
+		{Identifier: "playerId", Type: cadence.UInt64Type},
+		{Identifier: "fastBreakGameID", Type: cadence.StringType},
+		{Identifier: "topShots", Type: cadence.NewVariableSizedArrayType(cadence.UInt64Type)},
+	}, cadence.NewUInt64(1), cadence.String("def-456"), topShotsArray())
+
+	updated, err := DecodeFastBreakGameSubmissionUpdatedEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), updated.PlayerID())
+	assert.Equal(t, "def-456", updated.FastBreakGameID())
+	assert.Equal(t, []uint64{}, updated.TopShots())
+
+	payload = encodeEvent(t, EventFastBreakGameWinner, []cadence.Field{
+		{Identifier: "playerId", Type: cadence.UInt64Type},
+		{Identifier: "submittedAt", Type: cadence.UInt64Type},
+		{Identifier: "fastBreakGameID", Type: cadence.StringType},
+		{Identifier: "topShots", Type: cadence.NewVariableSizedArrayType(cadence.UInt64Type)},
+	}, cadence.NewUInt64(1), cadence.NewUInt64(150), cadence.String("def-456"), topShotsArray(2))
+
+	winner, err := DecodeFastBreakGameWinnerEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), winner.PlayerID())
+	assert.Equal(t, uint64(150), winner.SubmittedAt())
+	assert.Equal(t, "def-456", winner.FastBreakGameID())
+	assert.Equal(t, []uint64{2}, winner.TopShots())
+}
@@ -0,0 +1,150 @@
+package events
+
+// The prices of the market events are UFix64 values, in units of 10^-8
+const (
+	EventMarketV3MomentListed       = "TopShotMarketV3.MomentListed"
+	EventMarketV3MomentPriceChanged = "TopShotMarketV3.MomentPriceChanged"
+	EventMarketV3MomentPurchased    = "TopShotMarketV3.MomentPurchased"
+	EventMarketV3MomentWithdrawn    = "TopShotMarketV3.MomentWithdrawn"
+)
+
+type MarketMomentListedEvent interface {
+	Id() uint64
+	Price() uint64
+	Seller() string
+}
+
+type marketMomentListedEvent map[string]any
+
+var _ MarketMomentListedEvent = (*marketMomentListedEvent)(nil)
+
+func (evt marketMomentListedEvent) Id() uint64 {
+	return evt["id"].(uint64)
+}
+
+func (evt marketMomentListedEvent) Price() uint64 {
+	return evt["price"].(uint64)
+}
+
+func (evt marketMomentListedEvent) Seller() string {
+	return optionalAddress(evt["seller"])
+}
+
+func DecodeMarketMomentListedEvent(b []byte) (MarketMomentListedEvent, error) {
+	eventMap, err := decodeEvent(b, EventMarketV3MomentListed)
+	if err != nil {
+		return nil, err
+	}
+	return marketMomentListedEvent(eventMap), nil
+}
+
+type MarketMomentPriceChangedEvent interface {
+	Id() uint64
+	NewPrice() uint64
+	Seller() string
+}
+
+type marketMomentPriceChangedEvent map[string]any
+
+var _ MarketMomentPriceChangedEvent = (*marketMomentPriceChangedEvent)(nil)
+
+func (evt marketMomentPriceChangedEvent) Id() uint64 {
+	return evt["id"].(uint64)
+}
+
+func (evt marketMomentPriceChangedEvent) NewPrice() uint64 {
+	return evt["newPrice"].(uint64)
+}
+
+func (evt marketMomentPriceChangedEvent) Seller() string {
+	return optionalAddress(evt["seller"])
+}
+
+func DecodeMarketMomentPriceChangedEvent(b []byte) (MarketMomentPriceChangedEvent, error) {
+	eventMap, err := decodeEvent(b, EventMarketV3MomentPriceChanged)
+	if err != nil {
+		return nil, err
+	}
+	return marketMomentPriceChangedEvent(eventMap), nil
+}
+
+type MarketMomentPurchasedEvent interface {
+	Id() uint64
+	Price() uint64
+	Seller() string
+	MomentName() string
+	MomentDescription() string
+	MomentThumbnailURL() string
+}
+
+type marketMomentPurchasedEvent map[string]any
+
+var _ MarketMomentPurchasedEvent = (*marketMomentPurchasedEvent)(nil)
+
+func (evt marketMomentPurchasedEvent) Id() uint64 {
+	return evt["id"].(uint64)
+}
+
+func (evt marketMomentPurchasedEvent) Price() uint64 {
+	return evt["price"].(uint64)
+}
+
+func (evt marketMomentPurchasedEvent) Seller() string {
+	return optionalAddress(evt["seller"])
+}
+
+func (evt marketMomentPurchasedEvent) MomentName() string {
+	name, _ := evt["momentName"].(string)
+	return name
+}
+
+func (evt marketMomentPurchasedEvent) MomentDescription() string {
+	description, _ := evt["momentDescription"].(string)
+	return description
+}
+
+func (evt marketMomentPurchasedEvent) MomentThumbnailURL() string {
+	url, _ := evt["momentThumbnailURL"].(string)
+	return url
+}
+
+func DecodeMarketMomentPurchasedEvent(b []byte) (MarketMomentPurchasedEvent, error) {
+	eventMap, err := decodeEvent(b, EventMarketV3MomentPurchased)
+	if err != nil {
+		return nil, err
+	}
+	return marketMomentPurchasedEvent(eventMap), nil
+}
+
+type MarketMomentWithdrawnEvent interface {
+	Id() uint64
+	Owner() string
+}
+
+type marketMomentWithdrawnEvent map[string]any
+
+var _ MarketMomentWithdrawnEvent = (*marketMomentWithdrawnEvent)(nil)
+
+func (evt marketMomentWithdrawnEvent) Id() uint64 {
+	return evt["id"].(uint64)
+}
+
+func (evt marketMomentWithdrawnEvent) Owner() string {
+	return optionalAddress(evt["owner"])
+}
+
+func DecodeMarketMomentWithdrawnEvent(b []byte) (MarketMomentWithdrawnEvent, error) {
+	eventMap, err := decodeEvent(b, EventMarketV3MomentWithdrawn)
+	if err != nil {
+		return nil, err
+	}
+	return marketMomentWithdrawnEvent(eventMap), nil
+}
+
+// optionalAddress returns the address of an optional field, undefined when it is nil
+func optionalAddress(value any) string {
+	if value == nil {
+		return "undefined"
+	}
+	return value.(string)
+}
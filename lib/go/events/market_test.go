@@ -0,0 +1,71 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCadenceEvents_MarketV3(t *testing.T) {
+	address := flow.HexToAddress("0x12345678")
+	seller := cadence.NewOptional(cadence.NewAddress([8]byte(address)))
+	price, err := cadence.NewUFix64("12.50000000")
+	require.NoError(t, err)
+
+	payload := encodeEvent(t, EventMarketV3MomentListed, []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "price", Type: cadence.UFix64Type},
+		{Identifier: "seller", Type: &cadence.OptionalType{Type: cadence.AddressType}},
+	}, cadence.NewUInt64(1234), price, seller)
+
+	listed, err := DecodeMarketMomentListedEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1234), listed.Id())
+	assert.Equal(t, uint64(1_250_000_000), listed.Price())
+	assert.Equal(t, address.String(), listed.Seller())
+
+	_, err = DecodeMarketMomentPurchasedEvent(payload)
+	assert.ErrorContains(t, err, "unexpected event type: TopShotMarketV3.MomentListed")
+
+	payload = encodeEvent(t, EventMarketV3MomentPriceChanged, []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "newPrice", Type: cadence.UFix64Type},
+		{Identifier: "seller", Type: &cadence.OptionalType{Type: cadence.AddressType}},
+	}, cadence.NewUInt64(1234), price, cadence.NewOptional(nil))
+
+	changed, err := DecodeMarketMomentPriceChangedEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1_250_000_000), changed.NewPrice())
+	assert.Equal(t, "undefined", changed.Seller())
+
+	payload = encodeEvent(t, EventMarketV3MomentPurchased, []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "price", Type: cadence.UFix64Type},
+		{Identifier: "seller", Type: &cadence.OptionalType{Type: cadence.AddressType}},
+		{Identifier: "momentName", Type: cadence.StringType},
+		{Identifier: "momentDescription", Type: cadence.StringType},
+		{Identifier: "momentThumbnailURL", Type: cadence.StringType},
+	}, cadence.NewUInt64(1234), price, seller, NewCadenceString("Stephen Curry 3 Pointer"), NewCadenceString(""), NewCadenceString("https://assets.nbatopshot.com/media/1234"))
+
+	purchased, err := DecodeMarketMomentPurchasedEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1234), purchased.Id())
+	assert.Equal(t, uint64(1_250_000_000), purchased.Price())
+	assert.Equal(t, address.String(), purchased.Seller())
+	assert.Equal(t, "Stephen Curry 3 Pointer", purchased.MomentName())
+	assert.Equal(t, "", purchased.MomentDescription())
+	assert.Equal(t, "https://assets.nbatopshot.com/media/1234", purchased.MomentThumbnailURL())
+
+	payload = encodeEvent(t, EventMarketV3MomentWithdrawn, []cadence.Field{
+		{Identifier: "id", Type: cadence.UInt64Type},
+		{Identifier: "owner", Type: &cadence.OptionalType{Type: cadence.AddressType}},
+	}, cadence.NewUInt64(1234), seller)
+
+	withdrawn, err := DecodeMarketMomentWithdrawnEvent(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1234), withdrawn.Id())
+	assert.Equal(t, address.String(), withdrawn.Owner())
+}
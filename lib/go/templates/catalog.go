@@ -0,0 +1,176 @@
+package templates
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Catalog maps the name of every transaction and script template, its path under
+// the transactions directory without the .cdc extension, to its generator.
+//
+// It lets tools refer to templates by name, e.g. "admin/batch_mint_moment"
+// or "scripts/get_totalSupply", instead of linking against each generator.
+var Catalog = map[string]func(Environment) []byte{
+	// TopShot admin
+	"admin/create_play":                          GenerateMintPlayScript,
+	"admin/update_tagline":                       GenerateUpdateTaglineScript,
+	"admin/create_set":                           GenerateMintSetScript,
+	"admin/add_play_to_set":                      GenerateAddPlayToSetScript,
+	"admin/add_plays_to_set":                     GenerateAddPlaysToSetScript,
+	"admin/mint_moment":                          GenerateMintMomentScript,
+	"admin/batch_mint_moment":                    GenerateBatchMintMomentScript,
+	"admin/retire_play_from_set":                 GenerateRetirePlayScript,
+	"admin/retire_all":                           GenerateRetireAllPlaysScript,
+	"admin/lock_set":                             GenerateLockSetScript,
+	"admin/fulfill_pack":                         GenerateFulfillPackScript,
+	"admin/transfer_admin":                       GenerateTransferAdminScript,
+	"admin/start_new_series":                     GenerateChangeSeriesScript,
+	"admin/create_set_and_play_struct":           GenerateCreateSetandPlayDataScript,
+	"admin/mint_moment_with_subedition":          GenerateMintMomentWithSubeditionScript,
+	"admin/batch_mint_moment_with_subedition":    GenerateBatchMintMomentWithSubeditionScript,
+	"admin/create_new_subedition_admin_resource": GenerateCreateNewSubeditionAdminResourceScript,
+	"admin/create_subedition":                    GenerateCreateSubeditionScript,
+	"admin/set_nft_subedition":                   GenerateSetNFTsubedtitionScript,
+
+	// TopShot user
+	"user/setup_collection":          GenerateSetupAccountScript,
+	"user/transfer_moment":           GenerateTransferMomentScript,
+	"user/batch_transfer":            GenerateBatchTransferMomentScript,
+	"user/transfer_moment_v3_sale":   GenerateTransferMomentV3Script,
+	"user/destroy_moments":           GenerateDestroyMomentsScript,
+	"user/destroy_moments_v2":        GenerateDestroyMomentsV2Script,
+	"user/setup_switchboard_account": GenerateSetupSwitchboardScript,
+
+	// Sharded collection
+	"shardedCollection/setup_sharded_collection": GenerateSetupShardedCollectionScript,
+	"shardedCollection/transfer_from_sharded":    GenerateTransferMomentfromShardedCollectionScript,
+	"shardedCollection/batch_from_sharded":       GenerateBatchTransferMomentfromShardedCollectionScript,
+
+	// TopShotLocking
+	"user/lock_moment":                           GenerateTopShotLockingLockMomentScript,
+	"user/unlock_moment":                         GenerateTopShotLockingUnlockMomentScript,
+	"user/batch_lock_moments":                    GenerateBatchLockMomentScript,
+	"user/batch_unlock_moments":                  GenerateBatchUnlockMomentScript,
+	"scripts/collections/get_moment_isLocked":    GenerateGetMomentIsLockedScript,
+	"scripts/collections/get_moment_lockExpiry":  GenerateGetMomentLockExpiryScript,
+	"user/lock_fake_nft":                         GenerateLockFakeNFTScript,
+	"admin/mark_moment_unlockable":               GenerateAdminMarkMomentUnlockableScript,
+	"admin/batch_mark_moments_unlockable":        GenerateAdminBatchMarkMomentsUnlockableScript,
+	"admin/unlock_all_moments":                   GenerateAdminUnlockAllMomentsScript,
+	"admin/grant_topshot_locking_admin":          GenerateTopShotLockingAdminGrantAdminScript,
+	"scripts/collections/get_locked_nfts_length": GenerateGetLockedNFTsLengthScript,
+	"admin/set_nfts_lock_expiry":                 GenerateTopShotLockingAdminSetLockedNFTsExpiryScript,
+
+	// TopShot scripts
+	"scripts/get_currentSeries":                        GenerateGetSeriesScript,
+	"scripts/get_totalSupply":                          GenerateGetSupplyScript,
+	"scripts/plays/get_all_plays":                      GenerateGetAllPlaysScript,
+	"scripts/plays/get_nextPlayID":                     GenerateGetNextPlayIDScript,
+	"scripts/plays/get_play_metadata":                  GenerateGetPlayMetadataScript,
+	"scripts/plays/get_play_metadata_field":            GenerateGetPlayMetadataFieldScript,
+	"scripts/sets/get_edition_retired":                 GenerateGetIsEditionRetiredScript,
+	"scripts/sets/get_numMoments_in_edition":           GenerateGetNumMomentsInEditionScript,
+	"scripts/sets/get_setIDs_by_name":                  GenerateGetSetIDsByNameScript,
+	"scripts/sets/get_setName":                         GenerateGetSetNameScript,
+	"scripts/sets/get_setSeries":                       GenerateGetSetSeriesScript,
+	"scripts/sets/get_nextSetID":                       GenerateGetNextSetIDScript,
+	"scripts/sets/get_plays_in_set":                    GenerateGetPlaysInSetScript,
+	"scripts/sets/get_set_locked":                      GenerateGetIsSetLockedScript,
+	"scripts/sets/get_set_data":                        GenerateGetSetMetadataScript,
+	"scripts/collections/get_collection_ids":           GenerateGetCollectionIDsScript,
+	"scripts/collections/get_metadata":                 GenerateGetMomentMetadataScript,
+	"scripts/collections/get_metadata_field":           GenerateGetMomentMetadataFieldScript,
+	"scripts/collections/get_moment_series":            GenerateGetMomentSeriesScript,
+	"scripts/collections/get_id_in_Collection":         GenerateIsIDInCollectionScript,
+	"scripts/collections/get_moment_playID":            GenerateGetMomentPlayScript,
+	"scripts/collections/get_moment_setID":             GenerateGetMomentSetScript,
+	"scripts/collections/get_moment_setName":           GenerateGetMomentSetNameScript,
+	"scripts/collections/get_moment_serialNum":         GenerateGetMomentSerialNumScript,
+	"scripts/collections/get_setplays_are_owned":       GenerateSetPlaysOwnedByAddressScript,
+	"scripts/get_nft_metadata":                         GenerateGetNFTMetadataScript,
+	"scripts/get_topshot_metadata":                     GenerateGetTopShotMetadataScript,
+	"scripts/subeditions/get_nft_subedition":           GenerateGetNFTSubeditionScript,
+	"scripts/subeditions/get_all_subeditions":          GenerateGetAllSubeditionScript,
+	"scripts/subeditions/get_nextSubeditionID":         GenerateGetNextSubeditionIDScript,
+	"scripts/subeditions/get_subedition_by_id":         GenerateGetSubeditionByIDScript,
+	"scripts/subeditions/get_numMoments_in_subedition": GenerateGetNumMomentsInSubeditionScript,
+	"scripts/collections/borrow_nft_safe":              GenerateBorrowNFTSafeScript,
+
+	// Market
+	"market/create_sale":                 GenerateCreateSaleScript,
+	"market/start_sale":                  GenerateStartSaleScript,
+	"market/create_start_sale":           GenerateCreateAndStartSaleScript,
+	"market/stop_sale":                   GenerateWithdrawFromSaleScript,
+	"market/change_price":                GenerateChangePriceScript,
+	"market/change_percentage":           GenerateChangePercentageScript,
+	"market/change_receiver":             GenerateChangeOwnerReceiverScript,
+	"market/purchase_moment":             GenerateBuySaleScript,
+	"market/mint_and_purchase":           GenerateMintTokensAndBuyScript,
+	"market/scripts/get_sale_price":      GenerateGetSalePriceScript,
+	"market/scripts/get_sale_percentage": GenerateGetSalePercentageScript,
+	"market/scripts/get_sale_len":        GenerateGetSaleLenScript,
+	"market/scripts/get_sale_set_id":     GenerateGetSaleSetIDScript,
+
+	// TopShotMarketV3
+	"marketV3/create_sale":                 GenerateCreateSaleV3Script,
+	"marketV3/start_sale":                  GenerateStartSaleV3Script,
+	"marketV3/create_start_sale":           GenerateCreateAndStartSaleV3Script,
+	"marketV3/stop_sale":                   GenerateCancelSaleV3Script,
+	"marketV3/change_price":                GenerateChangePriceV3Script,
+	"marketV3/change_receiver":             GenerateChangeOwnerReceiverV3Script,
+	"marketV3/purchase_moment":             GenerateBuySaleV3Script,
+	"marketV3/mint_and_purchase":           GenerateMintTokensAndBuyV3Script,
+	"marketV3/upgrade_sale":                GenerateUpgradeSaleV3Script,
+	"marketV3/purchase_both_markets":       GenerateMultiContractP2PPurchaseScript,
+	"marketV3/purchase_group_of_moments":   GeneratePurchaseGroupOfMomentsScript,
+	"marketV3/scripts/get_sale_price":      GenerateGetSalePriceV3Script,
+	"marketV3/scripts/get_sale_percentage": GenerateGetSalePercentageV3Script,
+	"marketV3/scripts/get_sale_len":        GenerateGetSaleLenV3Script,
+	"marketV3/scripts/get_sale_set_id":     GenerateGetSaleSetIDV3Script,
+
+	// Fast Break oracle
+	"fastbreak/oracle/create_run":                  GenerateCreateRunScript,
+	"fastbreak/oracle/create_game":                 GenerateCreateGameScript,
+	"fastbreak/oracle/add_stat_to_game":            GenerateAddStatToGameScript,
+	"fastbreak/oracle/update_fast_break_game":      GenerateUpdateFastBreakGameScript,
+	"fastbreak/oracle/score_fast_break_submission": GenerateScoreFastBreakSubmissionScript,
+	"fastbreak/oracle/update_run_status":           GenerateUpdateFastBreakRunStatusScript,
+
+	// Fast Break player
+	"fastbreak/player/create_player": GenerateFastBreakCreateAccountScript,
+	"fastbreak/player/play":          GeneratePlayFastBreakScript,
+
+	// Fast Break scripts
+	"fastbreak/scripts/get_fast_break":               GenerateGetFastBreakScript,
+	"fastbreak/scripts/get_token_count":              GenerateGetFastBreakTokenCountScript,
+	"fastbreak/scripts/get_player_score":             GenerateGetPlayerScoreScript,
+	"fastbreak/scripts/get_fast_break_stats":         GenerateGetFastBreakStatsScript,
+	"fastbreak/scripts/get_current_player":           GenerateCurrentPlayerScript,
+	"fastbreak/scripts/get_player_win_count_for_run": GenerateGetPlayerWinCountForRunScript,
+	"fastbreak/scripts/get_player_address":           GenerateGetFastBreakPlayerAddressScript,
+	"fastbreak/scripts/get_fast_break_run":           GenerateGetFastBreakRunScript,
+	"fastbreak/scripts/get_account_player_id":        GenerateGetAccountPlayerIDScript,
+	"fastbreak/scripts/get_playable_top_shots":       GenerateGetPlayableTopShotsScript,
+}
+
+// CatalogNames returns the names of every template in the Catalog in sorted order
+func CatalogNames() []string {
+	names := make([]string, 0, len(Catalog))
+	for name := range Catalog {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// GenerateByName returns the template registered in the Catalog under the name
+// with the addresses of the environment filled in
+func GenerateByName(name string, env Environment) ([]byte, error) {
+	generate, ok := Catalog[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown template: %s", name)
+	}
+
+	return generate(env), nil
+}
@@ -10,6 +10,7 @@ const (
 	addStatToFastBreakGameFilename   = "fastbreak/oracle/add_stat_to_game.cdc"
 	updateFastBreakGameFilename      = "fastbreak/oracle/update_fast_break_game.cdc"
 	scoreFastBreakSubmissionFilename = "fastbreak/oracle/score_fast_break_submission.cdc"
+	updateFastBreakRunStatusFilename = "fastbreak/oracle/update_run_status.cdc"
 )
 
 func GenerateCreateRunScript(env Environment) []byte {
@@ -41,3 +42,9 @@ func GenerateScoreFastBreakSubmissionScript(env Environment) []byte {
 
 	return []byte(replaceAddresses(code, env))
 }
+
+func GenerateUpdateFastBreakRunStatusScript(env Environment) []byte {
+	code := assets.MustAssetString(transactionsPath + updateFastBreakRunStatusFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
@@ -7,12 +7,16 @@ import (
 const (
 	fastBreakScriptsPath = "../../../transactions/fastbreak/scripts/"
 
-	getFastBreakByIdFilename        = "get_fast_break.cdc"
-	getFastBreakTokenCountFilename  = "get_token_count.cdc"
-	getScoreByPlayerFilename        = "get_player_score.cdc"
-	getFastBreakStatsFilename       = "get_fast_break_stats.cdc"
-	fastBreakCurrentPlayer          = "get_current_player.cdc"
-	getPlayerWinCountForRunFilename = "get_player_win_count_for_run.cdc"
+	getFastBreakByIdFilename          = "get_fast_break.cdc"
+	getFastBreakTokenCountFilename    = "get_token_count.cdc"
+	getScoreByPlayerFilename          = "get_player_score.cdc"
+	getFastBreakStatsFilename         = "get_fast_break_stats.cdc"
+	fastBreakCurrentPlayer            = "get_current_player.cdc"
+	getPlayerWinCountForRunFilename   = "get_player_win_count_for_run.cdc"
+	getFastBreakPlayerAddressFilename = "get_player_address.cdc"
+	getFastBreakRunFilename           = "get_fast_break_run.cdc"
+	getAccountPlayerIDFilename        = "get_account_player_id.cdc"
+	getPlayableTopShotsFilename       = "get_playable_top_shots.cdc"
 )
 
 func GenerateGetFastBreakScript(env Environment) []byte {
@@ -50,3 +54,27 @@ func GenerateGetPlayerWinCountForRunScript(env Environment) []byte {
 
 	return []byte(replaceAddresses(code, env))
 }
+
+func GenerateGetFastBreakPlayerAddressScript(env Environment) []byte {
+	code := assets.MustAssetString(fastBreakScriptsPath + getFastBreakPlayerAddressFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetFastBreakRunScript(env Environment) []byte {
+	code := assets.MustAssetString(fastBreakScriptsPath + getFastBreakRunFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetAccountPlayerIDScript(env Environment) []byte {
+	code := assets.MustAssetString(fastBreakScriptsPath + getAccountPlayerIDFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateGetPlayableTopShotsScript(env Environment) []byte {
+	code := assets.MustAssetString(fastBreakScriptsPath + getPlayableTopShotsFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
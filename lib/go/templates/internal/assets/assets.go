@@ -2,6 +2,7 @@
 // sources:
 // ../../../transactions/admin/add_play_to_set.cdc (994B)
 // ../../../transactions/admin/add_plays_to_set.cdc (782B)
+// ../../../transactions/admin/batch_mark_moments_unlockable.cdc (1.343kB)
 // ../../../transactions/admin/batch_mint_moment.cdc (1.554kB)
 // ../../../transactions/admin/batch_mint_moment_with_subedition.cdc (1.66kB)
 // ../../../transactions/admin/create_new_subedition_admin_resource.cdc (625B)
@@ -29,14 +30,19 @@
 // ../../../transactions/fastbreak/oracle/create_run.cdc (752B)
 // ../../../transactions/fastbreak/oracle/score_fast_break_submission.cdc (811B)
 // ../../../transactions/fastbreak/oracle/update_fast_break_game.cdc (605B)
+// ../../../transactions/fastbreak/oracle/update_run_status.cdc (566B)
 // ../../../transactions/fastbreak/oracle/update_submission_deadline.cdc (629B)
 // ../../../transactions/fastbreak/player/create_player.cdc (1.051kB)
 // ../../../transactions/fastbreak/player/play.cdc (971B)
 // ../../../transactions/fastbreak/player/update_submission.cdc (610B)
+// ../../../transactions/fastbreak/scripts/get_account_player_id.cdc (396B)
 // ../../../transactions/fastbreak/scripts/get_current_player.cdc (115B)
 // ../../../transactions/fastbreak/scripts/get_fast_break.cdc (157B)
+// ../../../transactions/fastbreak/scripts/get_fast_break_run.cdc (155B)
 // ../../../transactions/fastbreak/scripts/get_fast_break_stats.cdc (190B)
 // ../../../transactions/fastbreak/scripts/get_fast_break_submission_deadline.cdc (157B)
+// ../../../transactions/fastbreak/scripts/get_playable_top_shots.cdc (1.237kB)
+// ../../../transactions/fastbreak/scripts/get_player_address.cdc (152B)
 // ../../../transactions/fastbreak/scripts/get_player_score.cdc (374B)
 // ../../../transactions/fastbreak/scripts/get_player_win_count_for_run.cdc (321B)
 // ../../../transactions/fastbreak/scripts/get_token_count.cdc (114B)
@@ -103,6 +109,7 @@
 // ../../../transactions/scripts/subeditions/get_all_subeditions.cdc (291B)
 // ../../../transactions/scripts/subeditions/get_nextSubeditionID.cdc (325B)
 // ../../../transactions/scripts/subeditions/get_nft_subedition.cdc (236B)
+// ../../../transactions/scripts/subeditions/get_numMoments_in_subedition.cdc (916B)
 // ../../../transactions/scripts/subeditions/get_subedition_by_id.cdc (445B)
 // ../../../transactions/scripts/users/is_account_all_set_up.cdc (471B)
 // ../../../transactions/shardedCollection/batch_from_sharded.cdc (1.14kB)
@@ -230,6 +237,26 @@ func TransactionsAdminAdd_plays_to_setCdc() (*asset, error) {
 	return a, nil
 }
 
+var _TransactionsAdminBatch_mark_moments_unlockableCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x4d\x6f\x9c\x30\x10\xbd\xf3\x2b\x46\x7b\x48\x40\x8a\xa0\x87\xaa\x87\x55\x9b\x68\x9b\x74\xdb\xa8\xe9\x6e\x14\x48\x2f\x55\x0f\x5e\x33\xec\x5a\x01\x0f\xb2\x8d\x12\x29\xda\xff\x5e\xf9\x03\x82\xd3\x54\xe5\x84\x86\x37\x33\xef\x3d\x3f\x23\xba\x9e\x94\x81\x8a\xfa\xf2\x40\x06\x1a\x45\x1d\xbc\x7b\xaa\xb6\xb7\xe5\xb7\x6d\xb5\xba\xba\xba\xfb\x52\x96\x49\x0c\xba\x21\xfe\x20\xe4\xfe\x15\xf6\x66\x7b\xf9\xfd\x7a\xf3\x75\x6c\x49\x8a\x02\xaa\x83\xd0\x60\x14\x93\x9a\x71\x23\x48\x42\xc7\xd4\x83\x86\x8e\x3a\x94\x46\x03\x35\xc0\x24\xd0\xa3\x44\x05\x4c\xc3\x20\x5b\xe2\x0f\x6c\xd7\xe2\x99\x6d\x6e\xd1\x18\xbb\xc5\x1c\x30\x60\x3c\xc0\x16\x3a\xd8\x61\x43\x0a\xed\xbb\x50\xe0\xca\xf8\xd4\x0b\x85\xda\x2d\xbe\x65\x8a\x75\x68\x50\xe9\x65\x52\x14\xb6\xe2\x26\xac\xea\x5a\xa1\xd6\x4b\x37\x73\xdd\xd2\x23\x30\x5f\xb1\x54\x6c\x8d\x71\x4e\x83\x34\x70\xa0\xb6\x1e\x77\x07\xb6\x76\x88\xa8\x43\xef\xf5\xd5\xd4\x32\x8a\x31\xe4\xd4\xc5\x42\x92\x64\xa6\x3e\x8d\x39\x84\x97\x33\x3f\xf5\xd7\xfd\xb5\x34\x1f\xde\xff\xce\xe0\x39\x01\x00\xab\x1e\x58\xdd\x09\x79\x87\xcd\x12\x4e\x62\xe7\xf3\x95\xfd\x92\x38\x60\xaf\xb0\x67\x0a\x53\xc6\xb9\x59\x02\x1b\xcc\x21\xfd\x4c\x4a\xd1\xe3\x4f\xd6\x0e\x98\xc1\xc9\xca\x6b\x1a\x07\xdb\xa7\x28\xa0\xc4\xbf\x8e\xd3\xad\x03\x85\xcd\x84\xd3\xd8\x36\xf9\xc8\x02\x3e\x59\x7b\x4c\xae\x0d\x29\xb6\xc7\x7c\xe7\xb6\x7c\x7c\x93\xda\x79\x6a\xb3\xb1\x84\x22\x80\x8b\x18\xe4\x30\xd9\xb4\xc6\x3e\x17\x17\xd0\x33\x29\x78\xba\xb8\xa4\xa1\xad\x41\xda\x2c\x0a\x59\x5b\x3e\xa8\x50\x72\xb4\x0e\xbf\x62\xbc\x0a\x8c\x35\x0d\x8a\xe3\xc2\x4f\x3c\x7a\x5f\xf0\x09\xf9\x60\xf0\x4d\xd5\xe0\xa2\xbe\x59\x57\xb0\x75\xc1\xe2\xd4\xb6\xe8\x13\x3a\x97\x6f\xcf\xe0\xe5\x93\xb7\x60\x8f\x26\x18\x1a\x1d\x67\x96\x73\xd6\xb3\x9d\x68\x85\x11\xa8\x27\x6f\x9e\x03\xe1\xfc\x87\x4b\xc9\xe5\x34\xec\x76\xd8\xb5\x82\x1f\xcf\xd3\xa2\x77\x6f\xc5\x6b\xc0\xff\xdd\x79\x31\xc6\x11\x39\x1d\xef\xd5\x8c\xf2\x22\x4b\xa6\x31\x0d\x29\x10\x35\x08\x69\xf3\x36\x73\x25\x38\xe3\x19\xbb\x44\xcb\x66\x36\x3c\xc2\x59\x43\x64\x63\xbc\x13\x91\x33\x41\xf1\x66\x5d\xa5\xa2\x8e\xb9\xff\x8b\x7f\xd8\x18\x48\x2f\x72\x4e\x92\x33\x93\x8a\x3a\x37\x54\x1a\x25\xe4\x3e\xcd\xb2\xb1\xba\xf0\x3f\x9b\xe9\x5f\x70\xaa\x23\x99\x33\x9d\x41\x8f\xbb\x8b\xa3\x9a\xf8\x4e\xce\x91\x51\xc2\x73\xdb\xb4\x59\x57\xf7\x13\x36\xf5\x6a\x97\x41\xf5\x8b\xb0\x63\x88\xda\xf1\x4f\x00\x00\x00\xff\xff\x0e\xc1\xf1\xac\x3f\x05\x00\x00"
+
+func TransactionsAdminBatch_mark_moments_unlockableCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_TransactionsAdminBatch_mark_moments_unlockableCdc,
+		"../../../transactions/admin/batch_mark_moments_unlockable.cdc",
+	)
+}
+
+func TransactionsAdminBatch_mark_moments_unlockableCdc() (*asset, error) {
+	bytes, err := TransactionsAdminBatch_mark_moments_unlockableCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "../../../transactions/admin/batch_mark_moments_unlockable.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x26, 0x51, 0x34, 0xeb, 0x8e, 0xf3, 0x9, 0x83, 0x91, 0x10, 0xc2, 0xb1, 0x13, 0xe0, 0xec, 0x11, 0x6a, 0x87, 0x0, 0x3c, 0x3, 0xbf, 0x27, 0x94, 0x94, 0xe9, 0xf9, 0x6a, 0x8c, 0x3f, 0x9a, 0x38}}
+	return a, nil
+}
+
 var _TransactionsAdminBatch_mint_momentCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xcf\x6e\xdb\x38\x10\xc6\xef\x7a\x8a\xd9\x1c\xb2\x32\x90\x58\x8b\xdd\xc5\x1e\x8c\xfc\x81\x37\xde\x6c\x03\x34\x89\x11\xbb\xbd\x8f\xa8\xb1\xc5\x86\x22\x55\x72\x14\x27\x08\xfc\xee\x05\x49\x49\x96\xdc\xa2\xa8\x2f\xa6\x86\x33\xf3\xfd\x66\xf8\xc9\xaa\x36\x96\x61\x6d\xea\x55\x69\x18\x36\xd6\x54\xf0\xc7\xeb\xfa\x71\xb9\xfa\xf0\xb8\x9e\x2f\x16\x4f\xff\xad\x56\x49\x92\x65\xb0\x2e\xa5\x03\xb6\xa8\x1d\x0a\x96\x46\x43\x25\x35\x3b\xa8\x1a\xc5\xb2\x56\x04\x95\xa9\xc8\x07\x7c\x6e\xe8\x82\xe0\xa4\xde\x2a\x02\x47\x9c\xd5\x0a\xdf\x40\x98\x2a\x97\x1a\x43\x75\x6a\xb8\x24\xbb\x93\x8e\xe0\x59\x9b\x9d\x06\x74\x40\x85\xf4\x77\x93\xa0\xb7\x44\x8b\x15\x31\x59\x37\x4b\xb2\xcc\x47\x1c\xf1\xdd\x62\x06\x5c\x12\xdc\x2d\xc0\x6c\xc2\xc9\x11\x03\x1b\xc8\x29\xf0\x50\x11\xb4\x7d\xb6\x57\xfc\x3e\x7d\xe9\x39\x02\xde\xae\x94\xa2\x0c\xb1\xfb\x96\x1c\x6d\xdf\xc4\x37\xf8\xda\xa0\x66\xc9\x6f\xb1\x45\xf7\xe5\x1b\x75\x05\x43\x5d\x5f\x61\x49\xc8\x5a\x92\xe6\x79\x51\xd8\x58\x76\xab\xcc\x0e\xb0\x28\x2c\x39\xd7\x31\xa0\x10\xa6\xd1\xec\xd3\x49\xbe\x48\xbd\x0d\x51\x61\x94\xa2\xb8\x59\xb3\xe9\x38\xda\xa5\x26\xc9\x60\xf1\x69\xbb\x87\x4f\x77\x9a\xff\xfa\xf3\xac\x1f\xb4\xfb\x3e\x70\xfb\xc8\x3f\x7f\x9f\x1d\x73\xcd\x23\xce\x04\xde\x93\x04\x00\x20\xcb\xe0\xa3\x11\xa8\xe0\x05\xad\xc4\x5c\x11\x6c\x8c\x0d\x4c\x6c\x6a\xe7\x5d\x31\x2f\x2a\xa9\xc1\xe4\x5f\x48\x70\x28\x51\xc4\x80\x3e\xf8\x44\x9b\x19\x9c\xb6\xee\x99\x86\xbc\xd8\xb4\xb6\x54\xa3\xa5\x14\x85\xe0\x19\x60\xc3\x65\xfa\xaf\xb1\xd6\xec\x3e\xa3\x6a\x68\x02\xa7\xf3\xb8\x85\x9e\xa2\x25\xc9\x43\x12\x20\x58\xda\x90\x25\x2d\x3c\x45\x80\x89\x10\x96\x9c\x69\xac\x20\x90\x1a\x1c\x1b\x8b\x5b\xea\xcb\x1d\xa9\xcd\xb4\xc3\x82\x4b\xbf\x68\x9e\xb6\x49\xd3\xd8\xf8\x62\xcc\x7a\x95\x7a\x2f\xcc\x20\x6b\xb3\xb2\xf6\x36\x5c\x4e\x7e\x0b\x9d\xf7\x91\x8f\x5e\x49\x34\x4c\xbf\x8c\xfb\x63\x63\x76\xa5\x7e\x81\x8e\x38\x72\x8e\xb8\x5b\xd0\x15\x71\xf7\xd0\xe1\x6f\x32\x92\xbd\x97\x9a\x01\x95\x0a\x4a\x9a\x76\xf0\x70\xbb\x76\xa3\xe6\x03\x3b\x5d\x9c\xb7\x52\xd3\x1c\x59\x94\xbe\x36\x3a\x38\xed\xac\x13\xff\x87\xd6\xe9\x4e\x63\xd9\xff\xfd\x4c\x03\x07\x47\x47\xf4\x76\xe9\x7d\xd6\x39\xbd\x9d\x9c\xcd\x33\xe9\x31\xde\x21\xf5\x12\xb6\xc4\xad\x19\xd2\x91\x53\xc7\xda\xdb\x56\xfb\xe6\x30\xd8\x61\xe7\x03\x02\x92\x2f\x64\x8f\xb5\x42\x30\x2e\xbb\x97\x98\x0a\xac\x31\x97\x4a\xb2\x24\xd7\xdb\xe3\xbd\xf3\x47\x5c\xd1\x41\x6d\xd9\xe4\x4a\x8a\xfd\x55\x9a\xd5\xe1\x94\x1d\x27\x4c\x7a\x51\xff\xbb\xbe\x86\x1a\xb5\x14\xe9\xc9\x0d\x6a\x6d\xf8\x27\x4e\xe9\x89\x7e\x77\x83\x67\x3b\x19\x8f\x5f\x50\x6d\x9c\x8c\x2b\x78\xb8\x5d\x7b\xfb\x0f\x07\x1e\x56\xf6\x65\x83\xc1\xe3\xd3\x2f\x62\x93\x34\x3e\xc8\x0c\x2e\xce\xc5\x11\xfe\x3e\xd9\x7f\x0b\x00\x00\xff\xff\x33\x5c\x99\x4b\x12\x06\x00\x00"
 
 func TransactionsAdminBatch_mint_momentCdcBytes() ([]byte, error) {
@@ -770,6 +797,26 @@ func TransactionsFastbreakOracleUpdate_fast_break_gameCdc() (*asset, error) {
 	return a, nil
 }
 
+var _TransactionsFastbreakOracleUpdate_run_statusCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\x41\x6b\xfa\x40\x10\xc5\xef\xf9\x14\x83\x07\x49\x40\xc2\xff\x7f\x2b\xa1\xad\xc4\xaa\x50\x7a\x68\x49\x6a\xef\xe3\x66\xa2\x4b\xe3\x4e\x98\x9d\xa5\x42\xf1\xbb\x97\x98\x18\x94\xde\x3a\x97\x84\x59\x78\xef\x37\xef\xd9\x43\xcb\xa2\xb0\x46\xaf\x0b\x21\xfc\xfc\xf8\x0f\xb5\xf0\x01\xfe\x1d\xd7\x79\xf9\xbe\x28\x56\xf9\x4b\xbe\x5c\x16\xab\xb2\x8c\x22\x15\x74\x1e\x8d\x5a\x76\xb1\xad\x32\x28\x55\xac\xdb\xcd\xc0\x2b\x6a\xf0\x19\x6c\x9e\x9d\xde\x25\xf0\x1d\x45\x00\x00\x0d\x29\xb0\xa0\x69\xa8\xa0\x3a\x03\x0c\xba\x8f\xaf\x6c\xd2\x4d\x5b\xa1\x52\x02\xd3\xeb\xe5\xf8\xbf\x44\x3a\xb0\xeb\x95\x5a\xa1\x16\x85\x62\x34\x46\x07\xa1\x52\x59\x70\x47\x33\x78\xc2\x16\xb7\xb6\xb1\x6a\xc9\x27\x30\xcd\x8d\xe1\xe0\xb4\x83\x80\x61\x3c\x35\x75\x3a\x82\xc0\x03\x74\x32\xa9\xef\x05\xd2\x2d\x8b\xf0\xd7\xfd\x9f\xe8\x1e\xe3\x2e\xaa\xec\x3a\xbc\xf4\xf5\x6c\x34\xe0\xbd\xa1\xee\x93\x11\xa4\x9b\xf9\x1c\x5a\x74\xd6\xc4\x13\xc3\xa1\xa9\xc0\xb1\x42\x8f\x00\x08\x42\x35\x09\x39\x43\xa0\x0c\xba\xa7\x21\x3e\x10\xf2\x1c\xc4\xd0\xa4\xd7\x3a\xf5\xa9\xd0\x91\x4c\x50\xba\xc4\xfd\xfb\xd4\x34\x9c\x6f\x18\xe9\x8a\xe0\xca\x73\x53\xf1\x0d\x52\xd7\xa4\xad\x66\x37\xbb\x4b\xa3\xfd\x77\x7c\xba\xf8\x9f\x7e\x02\x00\x00\xff\xff\x8a\x4e\xfc\x31\x36\x02\x00\x00"
+
+func TransactionsFastbreakOracleUpdate_run_statusCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_TransactionsFastbreakOracleUpdate_run_statusCdc,
+		"../../../transactions/fastbreak/oracle/update_run_status.cdc",
+	)
+}
+
+func TransactionsFastbreakOracleUpdate_run_statusCdc() (*asset, error) {
+	bytes, err := TransactionsFastbreakOracleUpdate_run_statusCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "../../../transactions/fastbreak/oracle/update_run_status.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x23, 0xe4, 0xc3, 0xfe, 0x81, 0x51, 0xc6, 0xd, 0x97, 0x49, 0xde, 0x43, 0xc, 0x38, 0x2b, 0xb0, 0x49, 0x74, 0xc7, 0xf3, 0x72, 0xf3, 0x2d, 0xdb, 0x5e, 0xc3, 0x3c, 0xa1, 0xc7, 0xc8, 0x10, 0xd0}}
+	return a, nil
+}
+
 var _TransactionsFastbreakOracleUpdate_submission_deadlineCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\xcf\x4b\xfb\x40\x10\xc5\xef\xf9\x2b\x86\x1e\x4a\x02\x25\xf4\xdb\xef\x2f\x1b\xd4\xa2\x2d\x4a\x4f\x8a\xa5\xde\x27\x9b\x49\xbb\x98\xec\x84\xd9\x09\x16\xa4\xff\xbb\xc4\xa4\xa1\x35\x37\xe7\xb4\x0c\x6f\xdf\x7e\xf6\x3d\x5b\x56\x2c\x0a\x0f\xe8\xf5\x5e\x08\xdf\x5e\x7f\x41\x2e\x5c\xc2\xf4\x30\x4d\x67\xf8\x7b\x36\x9f\x1b\x73\xf5\xf7\x3f\xcd\xe6\x41\xa0\x82\xce\xa3\x51\xcb\x2e\xcc\x4f\x17\x1e\xb1\xa4\xf5\x2a\x81\x8d\x8a\x75\xbb\x09\xf8\x3a\x2d\xad\xf7\x96\xdd\x8a\x30\x2b\xac\xa3\x04\xb6\x6b\xa7\xff\xfe\x44\xf0\x11\x04\x00\x00\x05\x29\xb0\xa0\x29\xe8\x85\xf2\x04\xb0\xd6\x7d\x78\x06\x10\x6f\xab\x0c\x95\x22\x18\x9f\x2f\xfb\xf3\x0a\xa9\x64\xd7\x3a\x55\x42\x15\x0a\x85\x68\x8c\x76\x46\x1b\x65\xc1\x1d\x4d\x60\x89\x15\xa6\xb6\xb0\x6a\xc9\x47\x30\xbe\x33\x86\x6b\xa7\x0d\x04\x74\xe3\xa9\xc8\xe3\x1e\x04\x6e\xa0\xb1\x89\x7d\x6b\x10\xa7\x2c\xc2\xef\xd7\x3f\xa2\xbb\x0d\x9b\x10\x93\xf3\x58\xe3\xa7\xaf\x87\x3a\xbc\x67\xd4\x7d\xd4\x83\x34\xb3\x58\x40\x85\xce\x9a\x70\xb4\xe4\xba\xc8\xc0\xb1\x42\x8b\x00\x08\x42\x39\x09\x39\x43\xa0\x0c\xba\xa7\x2e\x3e\x10\xf2\x5c\x8b\xa1\x51\xeb\x75\x6c\x53\xa1\x03\x99\x5a\xe9\x14\xf7\xf0\xab\xb1\x27\xdd\x0c\x7a\x0a\x2f\x78\x06\x05\x7f\x5b\x4c\x2e\xd4\x59\xdf\xf5\xb0\xff\x5e\x78\x82\x3c\x7e\x06\x00\x00\xff\xff\xa3\x09\x06\x34\x75\x02\x00\x00"
 
 func TransactionsFastbreakOracleUpdate_submission_deadlineCdcBytes() ([]byte, error) {
@@ -850,6 +897,26 @@ func TransactionsFastbreakPlayerUpdate_submissionCdc() (*asset, error) {
 	return a, nil
 }
 
+var _TransactionsFastbreakScriptsGet_account_player_idCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\x41\x6b\x02\x31\x10\x85\xef\xfb\x2b\xde\x49\x76\xa1\xac\x2d\x94\x1e\xc4\x2a\x2b\x2a\x94\x5e\xc4\x6d\xbd\x96\x69\x32\x9a\xd0\x35\x91\x64\x82\x2d\xa5\xff\xbd\x98\xd5\x22\xf4\x36\xcc\xe3\xfb\x92\x79\x76\x7f\xf0\x41\xb0\xa4\x28\xb3\xc0\xf4\xb1\xb9\xc3\x36\xf8\x3d\x6e\x3f\x97\x4d\xfb\x32\x5b\x2f\x9a\xe7\x66\x3e\x5f\x2f\xda\xb6\x28\x86\xc3\x21\xd6\x2c\x29\xb8\x08\x31\x0c\xab\xe1\xb7\x79\x3a\xe1\xc8\x3c\x0e\x1d\x7d\x71\x40\x14\x1f\x58\xc3\xba\x9c\x93\x52\x3e\x39\xb9\x81\xb3\x1d\x8e\x86\x5d\x76\x5d\x25\x30\x14\xe1\xbc\x40\x05\x26\x61\x0d\xef\x18\x47\x2b\xe6\xbc\x78\xeb\xb5\xb5\xd2\xaa\x20\xa5\x38\xc6\x92\xba\xae\xc2\x36\x39\xec\xc9\xba\x92\xb4\x0e\x1c\xe3\x08\x4d\x3f\x54\x23\xbc\x3e\x39\x79\xb8\x9f\xe2\xbb\x00\x80\x8e\xe5\xef\xb1\x47\xec\x58\x9a\x24\xa6\xe9\x17\x63\x4a\x62\xca\x99\x0f\xc1\x1f\x37\xd4\x25\xae\x30\x38\x47\x93\x8b\xb9\xca\x96\x90\xcf\xbf\x88\xea\xd3\x95\xb4\xe3\xfa\x3d\xa3\xe3\xc1\x55\x8d\xf5\x2a\xff\x78\x52\x9e\xea\x1c\xe1\x7f\xd2\xf6\xec\x8a\xc4\x54\xd3\xda\xea\xe2\xe7\x37\x00\x00\xff\xff\x6c\xc2\xb4\xc4\x8c\x01\x00\x00"
+
+func TransactionsFastbreakScriptsGet_account_player_idCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_TransactionsFastbreakScriptsGet_account_player_idCdc,
+		"../../../transactions/fastbreak/scripts/get_account_player_id.cdc",
+	)
+}
+
+func TransactionsFastbreakScriptsGet_account_player_idCdc() (*asset, error) {
+	bytes, err := TransactionsFastbreakScriptsGet_account_player_idCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "../../../transactions/fastbreak/scripts/get_account_player_id.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0x50, 0xea, 0x43, 0x8f, 0x61, 0x32, 0xe, 0xb6, 0xe5, 0x19, 0x2d, 0x96, 0x19, 0x1, 0xd4, 0x15, 0x49, 0xd6, 0x4b, 0x6, 0x5b, 0xa4, 0x2e, 0xfa, 0x98, 0xcd, 0xdc, 0x62, 0xa1, 0x1, 0x6e}}
+	return a, nil
+}
+
 var _TransactionsFastbreakScriptsGet_current_playerCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\xcc\x2d\xc8\x2f\x2a\x51\x70\x4b\x2c\x2e\x71\x2a\x4a\x4d\xcc\x0e\x33\x54\x48\x2b\xca\xcf\x55\x30\xa8\x70\x73\x0c\x0e\x71\x0a\x72\x75\xf4\x76\x74\x71\x09\x72\x0d\x0e\xe6\xe2\x4a\x4c\x4e\x4e\x2d\x2e\xd6\x48\xcc\xc9\xd1\x54\x48\x2b\xcd\x53\xc8\x4d\xcc\xcc\xd3\xd0\xb4\x52\x08\xf5\xcc\x2b\x31\x33\x51\xa8\xe6\xe2\x52\x50\x50\x50\x28\x4a\x2d\x29\x2d\xca\x43\x36\x51\x2f\x2f\xb5\xa2\x24\x20\x27\xb1\x32\xb5\xc8\x33\x85\xab\x16\x10\x00\x00\xff\xff\x73\xce\x3b\x15\x73\x00\x00\x00"
 
 func TransactionsFastbreakScriptsGet_current_playerCdcBytes() ([]byte, error) {
@@ -890,6 +957,26 @@ func TransactionsFastbreakScriptsGet_fast_breakCdc() (*asset, error) {
 	return a, nil
 }
 
+var _TransactionsFastbreakScriptsGet_fast_break_runCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\xcc\x2d\xc8\x2f\x2a\x51\x70\x4b\x2c\x2e\x71\x2a\x4a\x4d\xcc\x0e\x33\x54\x48\x2b\xca\xcf\x55\x30\xa8\x70\x73\x0c\x0e\x71\x0a\x72\x75\xf4\x76\x74\x71\x09\x72\x0d\x0e\xe6\xe2\x4a\x4c\x4e\x4e\x2d\x2e\xd6\x48\xcc\xc9\xd1\x54\x48\x2b\xcd\x53\xc8\x4d\xcc\xcc\xd3\xc8\x4c\xb1\x52\x08\x2e\x29\xca\xcc\x4b\xd7\xb4\x52\x50\x43\x32\x47\x0f\xce\x0e\x2a\xcd\xb3\x57\xa8\xe6\x52\x50\x50\x50\x28\x4a\x2d\x29\x2d\xca\x43\xb6\x4e\x2f\x3d\xb5\x04\x59\x25\xd8\xc0\xcc\x14\x4d\xae\x5a\x40\x00\x00\x00\xff\xff\xf2\xa6\x01\xb6\x9b\x00\x00\x00"
+
+func TransactionsFastbreakScriptsGet_fast_break_runCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_TransactionsFastbreakScriptsGet_fast_break_runCdc,
+		"../../../transactions/fastbreak/scripts/get_fast_break_run.cdc",
+	)
+}
+
+func TransactionsFastbreakScriptsGet_fast_break_runCdc() (*asset, error) {
+	bytes, err := TransactionsFastbreakScriptsGet_fast_break_runCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "../../../transactions/fastbreak/scripts/get_fast_break_run.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7b, 0xce, 0x33, 0x0, 0x7e, 0xd8, 0x58, 0x41, 0x38, 0x2e, 0x9c, 0xc4, 0xf4, 0x20, 0xfd, 0x91, 0x6d, 0x56, 0x6b, 0xe9, 0x80, 0x92, 0xce, 0x2f, 0x2b, 0x11, 0x49, 0x6c, 0xaf, 0x4c, 0xc7, 0x14}}
+	return a, nil
+}
+
 var _TransactionsFastbreakScriptsGet_fast_break_statsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8d\xb1\x0a\xc2\x30\x14\x45\xf7\x7c\xc5\x9d\xa4\x5d\x8a\xae\x5d\xa4\xa5\xad\x88\x5b\x23\x2e\xe2\xf0\xa8\x49\x09\x36\xa9\xbc\xbc\x82\x20\xfe\xbb\x38\x28\xc1\xed\x0e\xf7\x9c\xe3\xfc\x7d\x66\x41\x47\x51\x6a\x36\x74\x3b\x6d\x60\x79\xf6\x58\x3f\xba\x4a\x1f\xeb\xbe\xad\x0e\x55\xd3\xf4\xad\xd6\x4a\xd1\x30\x98\x18\x33\x9a\xa6\x1c\x76\x09\xf0\xe4\x42\x66\xbf\xe4\x8e\xbc\xd9\x37\x25\xb4\xb0\x0b\x63\x5e\x62\x75\x4e\xac\xc5\x6f\x6b\x21\xb9\x6c\xf1\x54\x00\xc0\x46\x16\x0e\x69\xbe\x18\x8d\x74\xa9\xf3\xf3\x8f\x99\xbb\x96\xf8\x4b\xe5\xea\xf5\x0e\x00\x00\xff\xff\x72\xce\x7d\xe1\xbe\x00\x00\x00"
 
 func TransactionsFastbreakScriptsGet_fast_break_statsCdcBytes() ([]byte, error) {
@@ -930,6 +1017,46 @@ func TransactionsFastbreakScriptsGet_fast_break_submission_deadlineCdc() (*asset
 	return a, nil
 }
 
+var _TransactionsFastbreakScriptsGet_playable_top_shotsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x52\x51\x6f\xda\x3c\x14\x7d\xe7\x57\x9c\xbe\xa0\x20\xa1\xf0\x21\x3e\xed\x01\x8d\x4d\xac\xed\x34\x34\xa1\x56\xc0\x78\xa9\xfa\x60\xcc\xcd\x62\xe1\xd8\x91\x6d\xc6\xaa\x95\xff\x3e\xd9\x4e\x42\x8a\x98\xda\xfa\x29\xf1\x3d\xf7\x9c\xe3\x73\xaf\x28\x4a\x6d\x1c\x56\xba\x5c\xe6\xda\x21\x33\xba\xc0\x7f\xbf\x57\x77\xf7\xcb\x6f\x77\xab\xe9\xcd\xcd\xe2\x76\xb9\xec\x54\xa0\x39\x33\x3b\x6a\x30\xf3\xe9\xe2\xfb\xed\x39\xa4\xe2\x89\xc8\xf5\xe8\x25\x76\x3d\xaa\xd1\x9d\xc1\x60\x80\x05\xb9\xbd\x51\x16\x2e\x27\x38\x5d\xc2\xe6\xda\xc5\x3f\xc6\xb9\xde\x2b\x07\xce\x14\x4a\xc9\x9e\x20\x14\xbe\x32\xeb\xf0\xc5\x10\xdb\xf5\xe1\x72\x6d\xc9\x5f\x0a\x67\x03\x15\xd7\x52\x12\x77\x42\x2b\x68\x03\x29\xac\xa3\x6d\x55\x87\x65\x92\x5a\x00\xdb\x07\xb3\x81\x2d\x90\xad\x87\xe9\xbd\x64\x4f\x64\x52\x2f\x14\xc9\x72\xe2\xbb\x60\xa4\x48\x31\x73\x10\x16\x4a\x48\x1c\x72\x52\x2f\xdc\xe5\xcc\x42\x69\x14\xba\x20\xef\xb5\x51\x48\x3b\x8c\x73\xb2\x36\x61\x52\xf6\x90\xed\x15\x0a\x26\x54\xc2\xb6\x5b\x43\xd6\x8e\x31\x8d\x1f\x7d\xff\x68\x1f\x96\x1d\xe3\xe1\xc7\x4c\xb9\x0f\xff\x3f\xf6\x4e\x9f\x9f\xf1\xa7\x03\x00\x92\x9c\x57\x74\x98\xe0\x27\xb9\x69\xd4\xae\xc9\x7a\x0d\xe4\x24\xbf\xa0\x0c\x93\xd0\x92\x72\x56\xb2\x8d\x90\xc2\x09\xb2\xe9\x46\x1b\xa3\x0f\x1f\xbb\xd5\x88\xd2\xeb\xa6\xe3\x53\x32\x28\xf7\x1b\x29\xf8\x60\x1e\xde\x72\xaa\x44\x7e\x91\x9d\xd3\x4f\x42\x22\xd1\xa0\x3f\x26\x8c\xd2\x5f\x86\xab\x63\x63\xab\xa8\x16\xe1\xfa\xbd\xf6\xea\x0d\x4a\x97\x4c\xd2\x25\xab\x4e\x97\x7e\x61\x7c\xf9\xd7\xe8\xdc\x71\x4b\x7a\xf8\x76\xe9\xa8\xf9\x16\xc5\xb6\x5e\x23\xe8\x17\x88\x6d\x24\x9d\x66\x88\x09\x1e\x1e\x43\x3d\xd3\x06\x99\xd4\x87\x59\x58\xcb\x7a\xf0\xad\x04\xcf\x33\xbe\xaa\x5c\xc5\x89\x24\x62\x3b\xae\xfa\x7b\xb8\x9a\x34\x41\xd7\xe7\xf9\x19\xc9\xe5\xa8\x23\x18\xdd\xee\xe5\x51\xbc\x2a\xd3\xfb\x97\xce\xf0\x15\x9d\xe1\x3b\x75\x5a\x59\xf8\x53\x87\x99\xb2\xb2\x24\xb5\x4d\x2a\x74\x83\x39\xb6\x16\xad\xda\xbe\xba\xa5\x73\xfc\x1b\x00\x00\xff\xff\x4b\x31\x35\xba\xd5\x04\x00\x00"
+
+func TransactionsFastbreakScriptsGet_playable_top_shotsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_TransactionsFastbreakScriptsGet_playable_top_shotsCdc,
+		"../../../transactions/fastbreak/scripts/get_playable_top_shots.cdc",
+	)
+}
+
+func TransactionsFastbreakScriptsGet_playable_top_shotsCdc() (*asset, error) {
+	bytes, err := TransactionsFastbreakScriptsGet_playable_top_shotsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "../../../transactions/fastbreak/scripts/get_playable_top_shots.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf1, 0xa9, 0xe0, 0xad, 0xe3, 0xd8, 0x1e, 0x29, 0x9c, 0x0, 0xa9, 0x84, 0xc5, 0x13, 0x99, 0x5e, 0x4e, 0x8a, 0xc3, 0x94, 0xe, 0x52, 0x4d, 0x47, 0x22, 0x79, 0x39, 0x7b, 0x9a, 0x86, 0xbb, 0xf9}}
+	return a, nil
+}
+
+var _TransactionsFastbreakScriptsGet_player_addressCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\xcc\x2d\xc8\x2f\x2a\x51\x70\x4b\x2c\x2e\x71\x2a\x4a\x4d\xcc\x0e\x33\x54\x48\x2b\xca\xcf\x55\x30\xa8\x70\x73\x0c\x0e\x71\x0a\x72\x75\xf4\x76\x74\x71\x09\x72\x0d\x0e\xe6\xe2\x4a\x4c\x4e\x4e\x2d\x2e\xd6\x48\xcc\xc9\xd1\x54\x48\x2b\xcd\x53\xc8\x4d\xcc\xcc\xd3\x28\xc8\x49\xac\x4c\x2d\xf2\x4c\xb1\x52\x08\xf5\xcc\x2b\x31\x33\xd1\xb4\x52\x70\x4c\x49\x29\x4a\x2d\x2e\xb6\x57\xa8\xe6\x52\x50\x50\x50\x28\x4a\x2d\x29\x2d\xca\x43\xb6\x42\x2f\x3d\xb5\x04\xce\x0d\x00\x1b\xa0\x91\x99\x62\xa5\x00\x33\x4b\x93\xab\x16\x10\x00\x00\xff\xff\x36\xe3\x2e\x34\x98\x00\x00\x00"
+
+func TransactionsFastbreakScriptsGet_player_addressCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_TransactionsFastbreakScriptsGet_player_addressCdc,
+		"../../../transactions/fastbreak/scripts/get_player_address.cdc",
+	)
+}
+
+func TransactionsFastbreakScriptsGet_player_addressCdc() (*asset, error) {
+	bytes, err := TransactionsFastbreakScriptsGet_player_addressCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "../../../transactions/fastbreak/scripts/get_player_address.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x16, 0x19, 0x43, 0xca, 0x11, 0x71, 0xef, 0xa6, 0x94, 0x26, 0x55, 0xd, 0x59, 0xaf, 0xc0, 0x73, 0x26, 0x57, 0xef, 0x12, 0x70, 0x91, 0xf9, 0xc6, 0x8b, 0x33, 0x6c, 0x60, 0x31, 0x80, 0x9a, 0xc}}
+	return a, nil
+}
+
 var _TransactionsFastbreakScriptsGet_player_scoreCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\xc1\x6a\xeb\x30\x14\x44\xf7\xfa\x8a\x79\x3b\x1b\x1e\x21\x85\xd2\x85\x21\x18\x99\x24\x25\x74\x53\xa2\xb6\x7b\xd5\x92\x83\xa8\x25\x19\xdd\x6b\xa8\x29\xfd\xf7\x42\x6a\x2b\x09\x5d\x49\x42\x33\x67\x66\x9c\x1f\x62\x62\xec\x35\x71\x93\xac\xfe\x78\xbb\x43\x97\xa2\xc7\xfa\x73\x2f\xd5\x4b\x73\xdc\xc9\x27\xb9\xdd\x1e\x77\x4a\x09\xa1\xdb\xd6\x12\x15\xba\xef\x4b\x74\x63\x80\xd7\x2e\x14\xce\x54\x50\x9c\x5c\x38\xfd\xc7\xd0\xeb\xc9\x26\x69\x4c\xb2\x44\x15\xe6\x4b\x59\xe1\xf5\x10\xf8\xe1\x1e\x5f\x02\x00\x7a\xcb\xb3\xf2\x60\xb0\xb9\x8e\x5e\x9d\x2c\x3f\xcf\x3f\xcd\x24\xdb\x36\x8e\x81\x0b\xfd\x7b\x66\xec\x4d\x4a\x99\x91\xdd\xc2\xf9\xcb\xcc\xcf\x47\xed\xed\xb9\xb1\x33\x17\x23\x8d\xef\xde\x11\xb9\x18\xb0\xb9\x50\xea\x1b\xa3\xca\x9a\x66\x5a\x1a\x16\xcb\x88\x2a\xcf\x29\xff\x89\x33\x36\x59\x1e\x53\xb8\x22\xd7\xab\x21\xba\xc0\x84\xba\xc6\x5a\x7c\xff\x04\x00\x00\xff\xff\x7e\x62\x96\x1e\x76\x01\x00\x00"
 
 func TransactionsFastbreakScriptsGet_player_scoreCdcBytes() ([]byte, error) {
@@ -2250,6 +2377,26 @@ func TransactionsScriptsSubeditionsGet_nft_subeditionCdc() (*asset, error) {
 	return a, nil
 }
 
+var _TransactionsScriptsSubeditionsGet_nummoments_in_subeditionCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x41\x8b\xdb\x30\x10\x85\xef\xfe\x15\x8f\x3d\x2c\x36\x94\xa4\xb4\xb7\xb0\xdd\x25\x25\x85\xe6\xd0\xdd\xb0\x4e\x7b\x9f\xd8\x93\x48\x60\x49\xae\x34\x22\x2d\xa5\xff\xbd\x48\x8e\xd7\xd9\xc0\xa6\xb9\x28\x9a\x19\x7d\x9e\x37\x6f\xb4\xe9\x9d\x17\x6c\x5d\x5f\x2b\x27\xd8\x7b\x67\xf0\xfe\xd7\xf6\x69\x53\x7f\x7d\xda\x2e\x57\xab\xe7\x2f\x75\x5d\x14\xf3\x39\xb6\x4a\x07\x84\xc6\xeb\x5e\xe0\x99\xda\x00\x51\x0c\x1b\xcd\x8e\x3d\xdc\x1e\xc6\x19\xb6\x12\x60\xb4\x15\x6e\x71\xd4\xa2\x40\x08\x71\xc7\xad\x16\xed\x6c\xaa\x21\x9b\x48\x63\x20\x7f\x2b\x41\xea\x97\xa2\x65\x6b\xb4\x45\x10\xe7\xb9\x85\xb6\x39\x3b\xb6\x46\x4d\xe3\xa2\x95\xdc\xcc\x86\x3c\x19\x16\xf6\x61\x51\xcc\xe7\x29\x12\x58\xd6\xab\x05\xb6\x8a\x11\xad\xfe\x19\x19\xeb\x15\xf6\xce\x67\x44\x60\xc1\x51\xb9\xc0\x68\x49\x08\x96\x39\xb5\xef\xb0\xe3\x2c\x25\xbd\xef\x3b\xfa\xfd\x36\x20\x65\xff\x47\x98\xa4\x5e\x69\x64\x1a\xc7\x35\x5a\xc2\x3d\xb3\x44\x6f\xc3\x02\xdf\xd7\x56\x3e\x7e\x48\xa1\xeb\xc3\x7e\xcd\x2f\x0a\x6a\x1a\x0e\xa1\xa4\xae\xab\xb0\x8f\x16\x86\xb4\x2d\x4f\x63\x1a\x98\xef\x5e\x54\x8f\xf7\xd7\x1a\x86\x68\x35\xfe\xc1\x9f\xa2\x00\x80\x8e\xe5\xac\x70\xb0\xec\x13\x0e\x2c\xcb\x28\x6a\x39\xb8\x74\x47\x51\x54\xf9\xd9\x79\xef\x8e\x3f\xa8\x8b\x5c\xe1\xf6\x94\xba\x2f\x2f\xf7\xab\xca\xd8\xf4\x9b\x25\xeb\xe9\xc0\xb3\x5d\x7e\x79\x77\x7b\x32\x7f\x76\xb1\x22\xf7\x65\x5a\x9e\x05\xde\x48\xd7\x03\x65\x43\xa2\xca\x6a\xa2\x3f\x3c\xa0\x27\xab\x9b\xf2\xe6\xd1\x9d\x5b\x41\x59\x82\xe7\xe0\xa2\x6f\x18\xa7\x0d\xa4\x03\xdf\x54\x83\x62\x9f\xbd\xb8\x14\x3d\x3b\xb0\x3c\x66\x4b\xbe\x65\x1b\x36\xec\xa7\x3e\xc6\x49\xe7\x63\x1a\xf4\x70\x5e\x0e\xfa\xfc\x56\x15\x7f\xff\x05\x00\x00\xff\xff\x00\xe6\xa7\xda\x94\x03\x00\x00"
+
+func TransactionsScriptsSubeditionsGet_nummoments_in_subeditionCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_TransactionsScriptsSubeditionsGet_nummoments_in_subeditionCdc,
+		"../../../transactions/scripts/subeditions/get_numMoments_in_subedition.cdc",
+	)
+}
+
+func TransactionsScriptsSubeditionsGet_nummoments_in_subeditionCdc() (*asset, error) {
+	bytes, err := TransactionsScriptsSubeditionsGet_nummoments_in_subeditionCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "../../../transactions/scripts/subeditions/get_numMoments_in_subedition.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe4, 0x6d, 0x3e, 0x85, 0xf0, 0xce, 0x65, 0xe5, 0xb0, 0x61, 0xe4, 0xe7, 0xf9, 0x68, 0xb5, 0xf0, 0x9b, 0x63, 0xb6, 0xc9, 0x54, 0x59, 0xd8, 0xaa, 0x3e, 0x1d, 0xab, 0xc2, 0xeb, 0x1, 0xcf, 0xe}}
+	return a, nil
+}
+
 var _TransactionsScriptsSubeditionsGet_subedition_by_idCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x90\xc1\x6e\xab\x30\x10\x45\xf7\xfe\x8a\xbb\x7a\x0a\x9b\xf0\xd4\xee\x90\xba\x68\x45\xa5\xb2\x4a\x14\xe8\x07\x38\x66\x28\x96\xc0\xa6\xe3\xb1\xda\xa8\xea\xbf\x57\x40\x08\xb4\x4b\x5b\x77\xce\x3d\x33\xb6\x1f\x3c\x0b\x2a\x3f\x94\xad\x17\x34\xec\x7b\xfc\xff\xac\x0e\xc7\xf2\xe5\x50\x3d\xe6\xf9\xe9\xb9\x2c\x95\x4a\x53\x54\xad\x0d\x08\x86\xed\x20\x60\x92\xc8\x2e\x40\x5a\x42\x13\xbb\x0e\x65\x3c\x53\x6d\xc5\x7a\x07\x72\x62\xe5\x32\x81\xc6\xb1\x31\xb2\xc0\x43\xaf\x59\x60\xbc\x13\xd6\x46\x26\xea\x51\xb3\xee\x49\x88\x43\xa6\xd2\x74\xfc\x09\x37\x54\x91\x67\xa8\x5a\x42\x74\xf6\x3d\x12\x8a\x1c\x8d\xe7\x09\xb8\x66\xf0\xd1\xfa\x40\xa8\xb5\x68\x38\xa2\x3a\x40\x3c\xce\x04\x26\x5d\x4f\x05\xa7\x59\x35\xdb\x28\x4e\x2d\xc2\xd1\x5c\xd7\x5d\xf4\x56\x31\x6d\x0c\x85\xb0\xd3\x5d\x97\xa0\x89\x0e\xbd\xb6\x6e\xf7\x5b\xec\xb5\x70\x72\x7f\x97\x64\xf8\x77\x1d\xdf\x6f\x6e\xf0\xa5\x14\x00\x74\x24\x37\x55\xf1\x0e\x0f\x4b\xd5\xfe\x8d\x64\x8d\x3f\x5d\x8a\xfc\x0f\x7d\xfb\x4a\x66\xd8\x7c\xf3\x2d\x4f\x7d\xff\x04\x00\x00\xff\xff\xa1\x98\xbe\xbf\xbd\x01\x00\x00"
 
 func TransactionsScriptsSubeditionsGet_subedition_by_idCdcBytes() ([]byte, error) {
@@ -2703,6 +2850,7 @@ func AssetNames() []string {
 var _bindata = map[string]func() (*asset, error){
 	"../../../transactions/admin/add_play_to_set.cdc":                                TransactionsAdminAdd_play_to_setCdc,
 	"../../../transactions/admin/add_plays_to_set.cdc":                               TransactionsAdminAdd_plays_to_setCdc,
+	"../../../transactions/admin/batch_mark_moments_unlockable.cdc":                  TransactionsAdminBatch_mark_moments_unlockableCdc,
 	"../../../transactions/admin/batch_mint_moment.cdc":                              TransactionsAdminBatch_mint_momentCdc,
 	"../../../transactions/admin/batch_mint_moment_with_subedition.cdc":              TransactionsAdminBatch_mint_moment_with_subeditionCdc,
 	"../../../transactions/admin/create_new_subedition_admin_resource.cdc":           TransactionsAdminCreate_new_subedition_admin_resourceCdc,
@@ -2730,14 +2878,19 @@ var _bindata = map[string]func() (*asset, error){
 	"../../../transactions/fastbreak/oracle/create_run.cdc":                          TransactionsFastbreakOracleCreate_runCdc,
 	"../../../transactions/fastbreak/oracle/score_fast_break_submission.cdc":         TransactionsFastbreakOracleScore_fast_break_submissionCdc,
 	"../../../transactions/fastbreak/oracle/update_fast_break_game.cdc":              TransactionsFastbreakOracleUpdate_fast_break_gameCdc,
+	"../../../transactions/fastbreak/oracle/update_run_status.cdc":                   TransactionsFastbreakOracleUpdate_run_statusCdc,
 	"../../../transactions/fastbreak/oracle/update_submission_deadline.cdc":          TransactionsFastbreakOracleUpdate_submission_deadlineCdc,
 	"../../../transactions/fastbreak/player/create_player.cdc":                       TransactionsFastbreakPlayerCreate_playerCdc,
 	"../../../transactions/fastbreak/player/play.cdc":                                TransactionsFastbreakPlayerPlayCdc,
 	"../../../transactions/fastbreak/player/update_submission.cdc":                   TransactionsFastbreakPlayerUpdate_submissionCdc,
+	"../../../transactions/fastbreak/scripts/get_account_player_id.cdc":              TransactionsFastbreakScriptsGet_account_player_idCdc,
 	"../../../transactions/fastbreak/scripts/get_current_player.cdc":                 TransactionsFastbreakScriptsGet_current_playerCdc,
 	"../../../transactions/fastbreak/scripts/get_fast_break.cdc":                     TransactionsFastbreakScriptsGet_fast_breakCdc,
+	"../../../transactions/fastbreak/scripts/get_fast_break_run.cdc":                 TransactionsFastbreakScriptsGet_fast_break_runCdc,
 	"../../../transactions/fastbreak/scripts/get_fast_break_stats.cdc":               TransactionsFastbreakScriptsGet_fast_break_statsCdc,
 	"../../../transactions/fastbreak/scripts/get_fast_break_submission_deadline.cdc": TransactionsFastbreakScriptsGet_fast_break_submission_deadlineCdc,
+	"../../../transactions/fastbreak/scripts/get_playable_top_shots.cdc":             TransactionsFastbreakScriptsGet_playable_top_shotsCdc,
+	"../../../transactions/fastbreak/scripts/get_player_address.cdc":                 TransactionsFastbreakScriptsGet_player_addressCdc,
 	"../../../transactions/fastbreak/scripts/get_player_score.cdc":                   TransactionsFastbreakScriptsGet_player_scoreCdc,
 	"../../../transactions/fastbreak/scripts/get_player_win_count_for_run.cdc":       TransactionsFastbreakScriptsGet_player_win_count_for_runCdc,
 	"../../../transactions/fastbreak/scripts/get_token_count.cdc":                    TransactionsFastbreakScriptsGet_token_countCdc,
@@ -2804,6 +2957,7 @@ var _bindata = map[string]func() (*asset, error){
 	"../../../transactions/scripts/subeditions/get_all_subeditions.cdc":              TransactionsScriptsSubeditionsGet_all_subeditionsCdc,
 	"../../../transactions/scripts/subeditions/get_nextSubeditionID.cdc":             TransactionsScriptsSubeditionsGet_nextsubeditionidCdc,
 	"../../../transactions/scripts/subeditions/get_nft_subedition.cdc":               TransactionsScriptsSubeditionsGet_nft_subeditionCdc,
+	"../../../transactions/scripts/subeditions/get_numMoments_in_subedition.cdc":     TransactionsScriptsSubeditionsGet_nummoments_in_subeditionCdc,
 	"../../../transactions/scripts/subeditions/get_subedition_by_id.cdc":             TransactionsScriptsSubeditionsGet_subedition_by_idCdc,
 	"../../../transactions/scripts/users/is_account_all_set_up.cdc":                  TransactionsScriptsUsersIs_account_all_set_upCdc,
 	"../../../transactions/shardedCollection/batch_from_sharded.cdc":                 TransactionsShardedcollectionBatch_from_shardedCdc,
@@ -2875,6 +3029,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 					"admin": {nil, map[string]*bintree{
 						"add_play_to_set.cdc": {TransactionsAdminAdd_play_to_setCdc, map[string]*bintree{}},
 						"add_plays_to_set.cdc": {TransactionsAdminAdd_plays_to_setCdc, map[string]*bintree{}},
+						"batch_mark_moments_unlockable.cdc": {TransactionsAdminBatch_mark_moments_unlockableCdc, map[string]*bintree{}},
 						"batch_mint_moment.cdc": {TransactionsAdminBatch_mint_momentCdc, map[string]*bintree{}},
 						"batch_mint_moment_with_subedition.cdc": {TransactionsAdminBatch_mint_moment_with_subeditionCdc, map[string]*bintree{}},
 						"create_new_subedition_admin_resource.cdc": {TransactionsAdminCreate_new_subedition_admin_resourceCdc, map[string]*bintree{}},
@@ -2905,6 +3060,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 							"create_run.cdc": {TransactionsFastbreakOracleCreate_runCdc, map[string]*bintree{}},
 							"score_fast_break_submission.cdc": {TransactionsFastbreakOracleScore_fast_break_submissionCdc, map[string]*bintree{}},
 							"update_fast_break_game.cdc": {TransactionsFastbreakOracleUpdate_fast_break_gameCdc, map[string]*bintree{}},
+							"update_run_status.cdc": {TransactionsFastbreakOracleUpdate_run_statusCdc, map[string]*bintree{}},
 							"update_submission_deadline.cdc": {TransactionsFastbreakOracleUpdate_submission_deadlineCdc, map[string]*bintree{}},
 						}},
 						"player": {nil, map[string]*bintree{
@@ -2913,10 +3069,14 @@ var _bintree = &bintree{nil, map[string]*bintree{
 							"update_submission.cdc": {TransactionsFastbreakPlayerUpdate_submissionCdc, map[string]*bintree{}},
 						}},
 						"scripts": {nil, map[string]*bintree{
+							"get_account_player_id.cdc": {TransactionsFastbreakScriptsGet_account_player_idCdc, map[string]*bintree{}},
 							"get_current_player.cdc": {TransactionsFastbreakScriptsGet_current_playerCdc, map[string]*bintree{}},
 							"get_fast_break.cdc": {TransactionsFastbreakScriptsGet_fast_breakCdc, map[string]*bintree{}},
+							"get_fast_break_run.cdc": {TransactionsFastbreakScriptsGet_fast_break_runCdc, map[string]*bintree{}},
 							"get_fast_break_stats.cdc": {TransactionsFastbreakScriptsGet_fast_break_statsCdc, map[string]*bintree{}},
 							"get_fast_break_submission_deadline.cdc": {TransactionsFastbreakScriptsGet_fast_break_submission_deadlineCdc, map[string]*bintree{}},
+							"get_playable_top_shots.cdc": {TransactionsFastbreakScriptsGet_playable_top_shotsCdc, map[string]*bintree{}},
+							"get_player_address.cdc": {TransactionsFastbreakScriptsGet_player_addressCdc, map[string]*bintree{}},
 							"get_player_score.cdc": {TransactionsFastbreakScriptsGet_player_scoreCdc, map[string]*bintree{}},
 							"get_player_win_count_for_run.cdc": {TransactionsFastbreakScriptsGet_player_win_count_for_runCdc, map[string]*bintree{}},
 							"get_token_count.cdc": {TransactionsFastbreakScriptsGet_token_countCdc, map[string]*bintree{}},
@@ -3001,6 +3161,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 							"get_all_subeditions.cdc": {TransactionsScriptsSubeditionsGet_all_subeditionsCdc, map[string]*bintree{}},
 							"get_nextSubeditionID.cdc": {TransactionsScriptsSubeditionsGet_nextsubeditionidCdc, map[string]*bintree{}},
 							"get_nft_subedition.cdc": {TransactionsScriptsSubeditionsGet_nft_subeditionCdc, map[string]*bintree{}},
+							"get_numMoments_in_subedition.cdc": {TransactionsScriptsSubeditionsGet_nummoments_in_subeditionCdc, map[string]*bintree{}},
 							"get_subedition_by_id.cdc": {TransactionsScriptsSubeditionsGet_subedition_by_idCdc, map[string]*bintree{}},
 						}},
 						"users": {nil, map[string]*bintree{
@@ -14,10 +14,11 @@ const (
 	getLockedNFTsLengthFilename = "collections/get_locked_nfts_length.cdc"
 	lockFakeNFTFilename         = "user/lock_fake_nft.cdc"
 
-	adminMarkMomentUnlockableFilename = "admin/mark_moment_unlockable.cdc"
-	adminUnlockAllMomentsFilename     = "admin/unlock_all_moments.cdc"
-	adminGrantAdmin                   = "admin/grant_topshot_locking_admin.cdc"
-	adminSetLockNFTsExpiryFilename    = "admin/set_nfts_lock_expiry.cdc"
+	adminMarkMomentUnlockableFilename       = "admin/mark_moment_unlockable.cdc"
+	adminBatchMarkMomentsUnlockableFilename = "admin/batch_mark_moments_unlockable.cdc"
+	adminUnlockAllMomentsFilename           = "admin/unlock_all_moments.cdc"
+	adminGrantAdmin                         = "admin/grant_topshot_locking_admin.cdc"
+	adminSetLockNFTsExpiryFilename          = "admin/set_nfts_lock_expiry.cdc"
 )
 
 // GenerateTopShotLockingLockMomentScript creates a script that locks a moment.
@@ -76,6 +77,13 @@ func GenerateAdminMarkMomentUnlockableScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+// GenerateAdminBatchMarkMomentsUnlockableScript creates a script that marks moments of an owner as unlockable
+func GenerateAdminBatchMarkMomentsUnlockableScript(env Environment) []byte {
+	code := assets.MustAssetString(transactionsPath + adminBatchMarkMomentsUnlockableFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 // GenerateAdminUnlockAllMomentsScript creates a script that unlocks all moments
 func GenerateAdminUnlockAllMomentsScript(env Environment) []byte {
 	code := assets.MustAssetString(transactionsPath + adminUnlockAllMomentsFilename)
@@ -46,10 +46,11 @@ const (
 	getTopShotMetadataFilename = "get_topshot_metadata.cdc"
 
 	//subedition scripts
-	getNFTSubeditionFilename    = "subeditions/get_nft_subedition.cdc"
-	getAllSubeditionFilename    = "subeditions/get_all_subeditions.cdc"
-	getSubeditionByIDFilename   = "subeditions/get_subedition_by_id.cdc"
-	getNextSubeditionIDFilename = "subeditions/get_nextSubeditionID.cdc"
+	getNFTSubeditionFilename       = "subeditions/get_nft_subedition.cdc"
+	getAllSubeditionFilename       = "subeditions/get_all_subeditions.cdc"
+	getSubeditionByIDFilename      = "subeditions/get_subedition_by_id.cdc"
+	getNextSubeditionIDFilename    = "subeditions/get_nextSubeditionID.cdc"
+	numMomentsInSubeditionFilename = "subeditions/get_numMoments_in_subedition.cdc"
 )
 
 // Global Data Gettetrs
@@ -267,6 +268,13 @@ func GenerateGetSubeditionByIDScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+// GenerateGetNumMomentsInSubeditionScript creates a script that returns the number of moments minted with a subedition of an edition.
+func GenerateGetNumMomentsInSubeditionScript(env Environment) []byte {
+	code := assets.MustAssetString(scriptsPath + numMomentsInSubeditionFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 func GenerateBorrowNFTSafeScript(env Environment) []byte {
 	code := assets.MustAssetString(scriptsPath + borrowNFTSafeFilename)
 
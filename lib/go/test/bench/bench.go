@@ -0,0 +1,262 @@
+// Package bench measures how much computation, how many events and how much
+// account storage the batch transactions use as their batch size grows.
+//
+// Every case runs one template at a given batch size on an emulator
+// bootstrapped by testkit, under the same computation limit as mainnet, and
+// records a Measurement. The Report they make up is written as JSON so that
+// the reports of two commits can be compared with Compare.
+package bench
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-emulator/convert"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
+)
+
+const (
+	// ComputeLimit is the computation limit the measured transactions run under,
+	// the maximum a transaction may set on mainnet
+	ComputeLimit = 9999
+
+	// momentPrice is the price moments are listed at before being purchased in a group
+	momentPrice = "1.0"
+
+	// lockDuration is the duration moments are locked for, in seconds
+	lockDuration = "86400.0"
+)
+
+// storageUsedScript returns the storage used by each of the addresses
+const storageUsedScript = `
+access(all) fun main(addresses: [Address]): [UInt64] {
+    let used: [UInt64] = []
+    for address in addresses {
+        used.append(getAccount(address).storage.used)
+    }
+    return used
+}
+`
+
+// Case is a template measured across batch sizes
+type Case struct {
+	// Template is the templates.Catalog name of the transaction
+	Template string
+	// Prepare sets up the state for a batch of the size and returns the transaction to measure
+	Prepare func(t testing.TB, b *Env, size int) Tx
+}
+
+// Tx is a prepared transaction and the accounts whose storage it is expected to change
+type Tx struct {
+	Args   []cadence.Value
+	Signer testkit.Account
+	// Accounts are the accounts whose storage is measured, by role
+	Accounts map[string]testkit.Account
+}
+
+// Env is an emulator with an edition ready to mint moments of
+type Env struct {
+	*testkit.Blockchain
+	SetID  uint32
+	PlayID uint32
+}
+
+// Cases are the batch transactions measured by default
+var Cases = []Case{
+	{Template: "admin/batch_mint_moment", Prepare: prepareBatchMint},
+	{Template: "user/batch_lock_moments", Prepare: prepareBatchLock},
+	{Template: "marketV3/purchase_group_of_moments", Prepare: preparePurchaseGroup},
+	{Template: "user/destroy_moments_v2", Prepare: prepareDestroy},
+}
+
+// NewEnv bootstraps an emulator with a play in a set to mint moments of
+func NewEnv(t testing.TB) *Env {
+	b := testkit.NewBlockchain(t)
+
+	playID := b.CreatePlay(t, map[string]string{"FullName": "Benchmark"})
+	setID := b.CreateSet(t, "Benchmark")
+	b.AddPlayToSet(t, setID, playID)
+
+	return &Env{Blockchain: b, SetID: setID, PlayID: playID}
+}
+
+// Run measures every case at every batch size
+func Run(t testing.TB, cases []Case, sizes []int) Report {
+	b := NewEnv(t)
+
+	var report Report
+	for _, c := range cases {
+		for _, size := range sizes {
+			report.Measurements = append(report.Measurements, b.Measure(t, c, size))
+		}
+	}
+	report.Sort()
+
+	return report
+}
+
+// Measure prepares and runs the case at the batch size.
+//
+// A transaction that fails, e.g. because it exceeds ComputeLimit,
+// is recorded with its error rather than failing the test.
+func (b *Env) Measure(t testing.TB, c Case, size int) Measurement {
+	code, err := templates.GenerateByName(c.Template, b.Env)
+	require.NoError(t, err)
+
+	tx := c.Prepare(t, b, size)
+
+	roles := make([]string, 0, len(tx.Accounts))
+	for role := range tx.Accounts {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	before := b.storageUsed(t, tx.Accounts, roles)
+	result := b.submit(t, code, tx.Args, tx.Signer)
+	after := b.storageUsed(t, tx.Accounts, roles)
+
+	m := Measurement{
+		Template:        c.Template,
+		BatchSize:       size,
+		ComputationUsed: result.ComputationUsed,
+		MemoryEstimate:  result.MemoryEstimate,
+		Events:          map[string]int{},
+		StorageDelta:    map[string]int64{},
+	}
+	if result.Error != nil {
+		m.Error = firstLine(result.Error.Error())
+	}
+	for _, event := range result.Events {
+		m.Events[qualifiedIdentifier(event.Type)]++
+	}
+	for i, role := range roles {
+		m.StorageDelta[role] = int64(after[i]) - int64(before[i])
+	}
+
+	return m
+}
+
+// submit runs the transaction under ComputeLimit without asserting that it succeeds
+func (b *Env) submit(t testing.TB, code []byte, args []cadence.Value, signer testkit.Account) *types.TransactionResult {
+	tx := testkit.NewTx(b.Blockchain.Blockchain, code, signer.Address).
+		SetComputeLimit(ComputeLimit)
+	for _, arg := range args {
+		require.NoError(t, tx.AddArgument(arg))
+	}
+
+	require.NoError(t, tx.SignPayload(signer.Address, 0, signer.Signer))
+	require.NoError(t, tx.SignEnvelope(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKeySigner))
+
+	require.NoError(t, b.AddTransaction(*convert.SDKTransactionToFlow(*tx)))
+	result, err := b.ExecuteNextTransaction()
+	require.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	require.NoError(t, err)
+
+	return result
+}
+
+func (b *Env) storageUsed(t testing.TB, accounts map[string]testkit.Account, roles []string) []uint64 {
+	addresses := make([]cadence.Value, len(roles))
+	for i, role := range roles {
+		addresses[i] = cadence.NewAddress(accounts[role].Address)
+	}
+
+	result := b.ExecuteScript(t, []byte(storageUsedScript), cadence.NewArray(addresses).
+		WithType(cadence.NewVariableSizedArrayType(cadence.AddressType)))
+
+	used := make([]uint64, len(roles))
+	for i, value := range result.(cadence.Array).Values {
+		used[i] = uint64(value.(cadence.UInt64))
+	}
+	return used
+}
+
+func prepareBatchMint(t testing.TB, b *Env, size int) Tx {
+	recipient := b.SetupAccount(t)
+
+	return Tx{
+		Args: []cadence.Value{
+			cadence.NewUInt32(b.SetID),
+			cadence.NewUInt32(b.PlayID),
+			cadence.NewUInt64(uint64(size)),
+			cadence.NewAddress(recipient.Address),
+		},
+		Signer:   b.TopShotAdmin,
+		Accounts: map[string]testkit.Account{"admin": b.TopShotAdmin, "recipient": recipient},
+	}
+}
+
+func prepareBatchLock(t testing.TB, b *Env, size int) Tx {
+	owner := b.SetupAccount(t)
+	momentIDs := b.BatchMint(t, b.SetID, b.PlayID, uint64(size), owner)
+
+	return Tx{
+		Args:     []cadence.Value{testkit.UInt64Array(momentIDs...), testkit.CadenceUFix64(lockDuration)},
+		Signer:   owner,
+		Accounts: map[string]testkit.Account{"owner": owner, "locking": b.Locking},
+	}
+}
+
+func preparePurchaseGroup(t testing.TB, b *Env, size int) Tx {
+	seller := b.SetupAccount(t)
+	buyer := b.SetupAccount(t)
+
+	momentIDs := b.BatchMint(t, b.SetID, b.PlayID, uint64(size), seller)
+	for _, momentID := range momentIDs {
+		b.ListForSaleV3(t, seller, momentID, momentPrice)
+	}
+	total := fmt.Sprintf("%d.0", size)
+	b.MintDUC(t, buyer, total)
+
+	momentsBySeller := cadence.NewDictionary([]cadence.KeyValuePair{{
+		Key:   cadence.NewAddress(seller.Address),
+		Value: testkit.UInt64Array(momentIDs...),
+	}}).WithType(cadence.NewDictionaryType(cadence.AddressType, cadence.NewVariableSizedArrayType(cadence.UInt64Type)))
+
+	return Tx{
+		Args:   []cadence.Value{momentsBySeller, testkit.CadenceUFix64(total)},
+		Signer: buyer,
+		Accounts: map[string]testkit.Account{
+			"buyer":       buyer,
+			"seller":      seller,
+			"beneficiary": b.MarketBeneficiary,
+		},
+	}
+}
+
+func prepareDestroy(t testing.TB, b *Env, size int) Tx {
+	owner := b.SetupAccount(t)
+	momentIDs := b.BatchMint(t, b.SetID, b.PlayID, uint64(size), owner)
+
+	return Tx{
+		Args:     []cadence.Value{testkit.UInt64Array(momentIDs...)},
+		Signer:   owner,
+		Accounts: map[string]testkit.Account{"owner": owner},
+	}
+}
+
+// qualifiedIdentifier strips the address from an event type,
+// e.g. A.f8d6e0586b0a20c7.TopShot.MomentMinted becomes TopShot.MomentMinted
+func qualifiedIdentifier(eventType string) string {
+	parts := strings.SplitN(eventType, ".", 3)
+	if len(parts) == 3 && parts[0] == "A" {
+		return parts[2]
+	}
+	return eventType
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
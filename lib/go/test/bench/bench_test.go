@@ -0,0 +1,72 @@
+package bench_test
+
+import (
+	"flag"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/bench"
+)
+
+var (
+	sizes     = flag.String("bench.sizes", defaultSizes, "comma separated batch sizes to measure")
+	output    = flag.String("bench.report", "", "file to write the JSON report to")
+	baseline  = flag.String("bench.baseline", "", "JSON report of an earlier commit to compare with")
+	tolerance = flag.Float64("bench.tolerance", 0.05, "growth over the baseline tolerated before failing, as a fraction")
+)
+
+// TestTransactionCosts measures the batch transactions and, given a baseline
+// report, fails if any of them got more expensive. The regular test run
+// only measures batches of 1, the bench build tag adds those of 10 and 50:
+//
+//	go test ./bench -tags bench -run TestTransactionCosts -bench.report=head.json -bench.baseline=base.json
+func TestTransactionCosts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping transaction cost measurements in short mode")
+	}
+
+	var batchSizes []int
+	for _, s := range strings.Split(*sizes, ",") {
+		size, err := strconv.Atoi(strings.TrimSpace(s))
+		require.NoError(t, err)
+		batchSizes = append(batchSizes, size)
+	}
+
+	report := bench.Run(t, bench.Cases, batchSizes)
+	require.NoError(t, report.WriteTable(os.Stdout))
+
+	for _, m := range report.Measurements {
+		assert.NotZero(t, m.ComputationUsed, "%s (batch of %d)", m.Template, m.BatchSize)
+	}
+
+	if *output != "" {
+		f, err := os.Create(*output)
+		require.NoError(t, err)
+		defer f.Close()
+		require.NoError(t, report.WriteJSON(f))
+	}
+
+	if *baseline != "" {
+		base, err := bench.ReadReport(*baseline)
+		require.NoError(t, err)
+
+		comparison := bench.Compare(base, report, *tolerance)
+		for _, difference := range comparison.Differences {
+			t.Error(difference)
+		}
+		for _, m := range comparison.Failures {
+			t.Errorf("%s (batch of %d) started failing: %s", m.Template, m.BatchSize, m.Error)
+		}
+		for _, m := range comparison.Added {
+			t.Logf("%s (batch of %d) is not in the baseline", m.Template, m.BatchSize)
+		}
+		for _, m := range comparison.Removed {
+			t.Logf("%s (batch of %d) is no longer measured", m.Template, m.BatchSize)
+		}
+	}
+}
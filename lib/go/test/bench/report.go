@@ -0,0 +1,193 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Measurement is what one transaction used at one batch size
+type Measurement struct {
+	Template        string `json:"template"`
+	BatchSize       int    `json:"batchSize"`
+	ComputationUsed uint64 `json:"computationUsed"`
+	MemoryEstimate  uint64 `json:"memoryEstimate"`
+	// Events counts the emitted events by qualified identifier
+	Events map[string]int `json:"events"`
+	// StorageDelta is the change in storage used, in bytes, by account role
+	StorageDelta map[string]int64 `json:"storageDelta"`
+	// Error is set if the transaction failed
+	Error string `json:"error,omitempty"`
+}
+
+// Report is the set of measurements of a commit
+type Report struct {
+	Measurements []Measurement `json:"measurements"`
+}
+
+// Difference is a metric that changed between two reports
+type Difference struct {
+	Template  string
+	BatchSize int
+	Metric    string
+	Base      int64
+	Head      int64
+}
+
+// Sort orders the measurements by template and batch size so that reports diff cleanly
+func (r *Report) Sort() {
+	sort.Slice(r.Measurements, func(i, j int) bool {
+		a, b := r.Measurements[i], r.Measurements[j]
+		if a.Template != b.Template {
+			return a.Template < b.Template
+		}
+		return a.BatchSize < b.BatchSize
+	})
+}
+
+// ReadReport reads a report written by WriteJSON
+func ReadReport(path string) (Report, error) {
+	var r Report
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return r, err
+	}
+
+	if err := json.Unmarshal(contents, &r); err != nil {
+		return r, fmt.Errorf("failed to parse report %s: %w", path, err)
+	}
+
+	return r, nil
+}
+
+// WriteJSON writes the report as indented JSON
+func (r Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteTable writes the report as a table for humans
+func (r Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "template\tbatch\tcomputation\tmemory\tevents\tstorage delta\terror\t")
+
+	for _, m := range r.Measurements {
+		events := 0
+		for _, count := range m.Events {
+			events += count
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%s\t%s\t\n",
+			m.Template, m.BatchSize, m.ComputationUsed, m.MemoryEstimate, events, formatDeltas(m.StorageDelta), m.Error)
+	}
+
+	return tw.Flush()
+}
+
+// Comparison is what changed between the reports of two commits
+type Comparison struct {
+	// Differences are the metrics of head that grew by more than the tolerance
+	Differences []Difference
+	// Failures are the measurements of head whose transaction fails, but not in base
+	Failures []Measurement
+	// Added are the measurements of head that base does not have
+	Added []Measurement
+	// Removed are the measurements of base that head does not have
+	Removed []Measurement
+}
+
+// Regressed reports whether a metric grew too much or a transaction started failing
+func (c Comparison) Regressed() bool {
+	return len(c.Differences) > 0 || len(c.Failures) > 0
+}
+
+// Compare compares the measurements of head with those of base, reporting
+// the metrics that grew by more than the tolerance, a fraction of the base
+// value, the transactions that fail in head but not in base, and the
+// measurements that only one of the reports has.
+func Compare(base, head Report, tolerance float64) Comparison {
+	type key struct {
+		template string
+		size     int
+	}
+
+	baseByKey := map[key]Measurement{}
+	for _, m := range base.Measurements {
+		baseByKey[key{m.Template, m.BatchSize}] = m
+	}
+
+	var c Comparison
+	inHead := map[key]bool{}
+	for _, h := range head.Measurements {
+		inHead[key{h.Template, h.BatchSize}] = true
+		b, ok := baseByKey[key{h.Template, h.BatchSize}]
+		if !ok {
+			c.Added = append(c.Added, h)
+			continue
+		}
+
+		if h.Error != "" && b.Error == "" {
+			c.Failures = append(c.Failures, h)
+			continue
+		}
+
+		add := func(metric string, base, head int64) {
+			if exceeds(base, head, tolerance) {
+				c.Differences = append(c.Differences, Difference{h.Template, h.BatchSize, metric, base, head})
+			}
+		}
+
+		add("computation", int64(b.ComputationUsed), int64(h.ComputationUsed))
+		add("memory", int64(b.MemoryEstimate), int64(h.MemoryEstimate))
+		for _, event := range sortedKeys(h.Events) {
+			add("events "+event, int64(b.Events[event]), int64(h.Events[event]))
+		}
+		for _, role := range sortedKeys(h.StorageDelta) {
+			add("storage "+role, b.StorageDelta[role], h.StorageDelta[role])
+		}
+	}
+
+	for _, m := range base.Measurements {
+		if !inHead[key{m.Template, m.BatchSize}] {
+			c.Removed = append(c.Removed, m)
+		}
+	}
+
+	return c
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("%s (batch of %d): %s went from %d to %d", d.Template, d.BatchSize, d.Metric, d.Base, d.Head)
+}
+
+// exceeds reports whether head grew from base by more than the tolerance of
+// its magnitude, which is a storage delta freed when base is negative
+func exceeds(base, head int64, tolerance float64) bool {
+	if head <= base {
+		return false
+	}
+	return float64(head-base) > math.Abs(float64(base))*tolerance
+}
+
+func formatDeltas(deltas map[string]int64) string {
+	parts := make([]string, 0, len(deltas))
+	for _, role := range sortedKeys(deltas) {
+		parts = append(parts, fmt.Sprintf("%s %+d", role, deltas[role]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,118 @@
+package bench_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/bench"
+)
+
+func measurement(template string, size int, computation uint64, minted int, storage int64) bench.Measurement {
+	return bench.Measurement{
+		Template:        template,
+		BatchSize:       size,
+		ComputationUsed: computation,
+		Events:          map[string]int{"TopShot.MomentMinted": minted},
+		StorageDelta:    map[string]int64{"recipient": storage},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	base := bench.Report{Measurements: []bench.Measurement{
+		measurement("admin/batch_mint_moment", 1, 100, 1, 500),
+		measurement("admin/batch_mint_moment", 10, 1000, 10, 5000),
+		measurement("user/destroy_moments_v2", 10, 400, 0, -5000),
+	}}
+
+	t.Run("Should accept growth within the tolerance", func(t *testing.T) {
+		head := bench.Report{Measurements: []bench.Measurement{
+			measurement("admin/batch_mint_moment", 1, 104, 1, 500),
+			measurement("admin/batch_mint_moment", 10, 900, 10, 5100),
+		}}
+
+		comparison := bench.Compare(base, head, 0.05)
+		assert.Empty(t, comparison.Differences)
+		assert.False(t, comparison.Regressed())
+	})
+
+	t.Run("Should apply the tolerance to the magnitude of negative baselines", func(t *testing.T) {
+		head := bench.Report{Measurements: []bench.Measurement{
+			measurement("user/destroy_moments_v2", 10, 400, 0, -4999),
+		}}
+
+		assert.Empty(t, bench.Compare(base, head, 0.05).Differences)
+	})
+
+	t.Run("Should report every metric that grew too much", func(t *testing.T) {
+		head := bench.Report{Measurements: []bench.Measurement{
+			measurement("admin/batch_mint_moment", 10, 1200, 11, 5000),
+			measurement("user/destroy_moments_v2", 10, 400, 0, -4000),
+		}}
+
+		comparison := bench.Compare(base, head, 0.05)
+		assert.True(t, comparison.Regressed())
+		differences := comparison.Differences
+		require.Len(t, differences, 3)
+		assert.Equal(t, bench.Difference{
+			Template:  "admin/batch_mint_moment",
+			BatchSize: 10,
+			Metric:    "computation",
+			Base:      1000,
+			Head:      1200,
+		}, differences[0])
+		assert.Equal(t, "events TopShot.MomentMinted", differences[1].Metric)
+		assert.Equal(t, "storage recipient", differences[2].Metric)
+	})
+
+	t.Run("Should report transactions that started failing", func(t *testing.T) {
+		failing := measurement("admin/batch_mint_moment", 1, 9999, 0, 0)
+		failing.Error = "computation exceeds limit (9999)"
+
+		comparison := bench.Compare(base, bench.Report{Measurements: []bench.Measurement{failing}}, 0.05)
+		assert.Empty(t, comparison.Differences)
+		assert.Equal(t, []bench.Measurement{failing}, comparison.Failures)
+		assert.True(t, comparison.Regressed())
+	})
+
+	t.Run("Should report added and removed measurements apart from regressions", func(t *testing.T) {
+		added := measurement("admin/batch_mint_moment", 100, 10000, 100, 50000)
+		head := bench.Report{Measurements: []bench.Measurement{base.Measurements[0], base.Measurements[1], added}}
+
+		comparison := bench.Compare(base, head, 0.05)
+		assert.Empty(t, comparison.Differences)
+		assert.Empty(t, comparison.Failures)
+		assert.Equal(t, []bench.Measurement{added}, comparison.Added)
+		assert.Equal(t, []bench.Measurement{base.Measurements[2]}, comparison.Removed)
+		assert.False(t, comparison.Regressed())
+	})
+}
+
+func TestReportRoundTrip(t *testing.T) {
+	report := bench.Report{Measurements: []bench.Measurement{
+		measurement("user/destroy_moments_v2", 10, 400, 0, -5000),
+		measurement("admin/batch_mint_moment", 10, 1000, 10, 5000),
+		measurement("admin/batch_mint_moment", 1, 100, 1, 500),
+	}}
+	report.Sort()
+	assert.Equal(t, 1, report.Measurements[0].BatchSize)
+	assert.Equal(t, "user/destroy_moments_v2", report.Measurements[2].Template)
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, report.WriteJSON(f))
+	require.NoError(t, f.Close())
+
+	read, err := bench.ReadReport(path)
+	require.NoError(t, err)
+	assert.Equal(t, report, read)
+
+	var table bytes.Buffer
+	require.NoError(t, report.WriteTable(&table))
+	assert.Contains(t, table.String(), "recipient +5000")
+}
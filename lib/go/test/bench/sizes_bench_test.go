@@ -0,0 +1,6 @@
+//go:build bench
+
+package bench_test
+
+// defaultSizes are the batch sizes measured with the bench build tag
+const defaultSizes = "1,10,50"
@@ -0,0 +1,7 @@
+//go:build !bench
+
+package bench_test
+
+// defaultSizes are the batch sizes the regular test run measures, the
+// larger batches being measured with the bench build tag
+const defaultSizes = "1"
@@ -3,7 +3,6 @@ package test
 import (
 	"fmt"
 	"io/ioutil"
-	"net/http"
 	"testing"
 
 	"github.com/onflow/flow-go-sdk"
@@ -13,8 +12,9 @@ import (
 
 	"github.com/onflow/cadence"
 
-	"github.com/onflow/flow-emulator/convert"
 	"github.com/onflow/flow-emulator/emulator"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
 )
 
 // ReadFile reads a file from the file system
@@ -26,19 +26,6 @@ func ReadFile(path string) []byte {
 	return contents
 }
 
-// DownloadFile will download a url a byte slice
-func DownloadFile(url string) ([]byte, error) {
-
-	// Get the data
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	return ioutil.ReadAll(resp.Body)
-}
-
 // newBlockchain returns an emulator blockchain for testing.
 func newBlockchain(opts ...emulator.Option) *emulator.Blockchain {
 	b, err := emulator.New(
@@ -72,13 +59,7 @@ func createTxWithTemplateAndAuthorizer(
 }
 
 // signAndSubmit signs a transaction with an array of signers and adds their signatures to the transaction
-// before submitting it to the emulator.
-//
-// If the private keys do not match up with the addresses, the transaction will not succeed.
-//
-// The shouldRevert parameter indicates whether the transaction should fail or not.
-//
-// This function asserts the correct result and commits the block if it passed.
+// before submitting it to the emulator, see testkit.SignAndSubmit.
 func signAndSubmit(
 	t *testing.T,
 	b *emulator.Blockchain,
@@ -87,21 +68,7 @@ func signAndSubmit(
 	signers []crypto.Signer,
 	shouldRevert bool,
 ) {
-	// sign transaction with each signer
-	for i := len(signerAddresses) - 1; i >= 0; i-- {
-		signerAddress := signerAddresses[i]
-		signer := signers[i]
-
-		if i == 0 {
-			err := tx.SignEnvelope(signerAddress, 0, signer)
-			assert.NoError(t, err)
-		} else {
-			err := tx.SignPayload(signerAddress, 0, signer)
-			assert.NoError(t, err)
-		}
-	}
-
-	Submit(t, b, tx, shouldRevert)
+	testkit.SignAndSubmit(t, b, tx, signerAddresses, signers, shouldRevert)
 }
 
 // Submit submits a transaction and checks if it fails or not.
@@ -111,35 +78,12 @@ func Submit(
 	tx *flow.Transaction,
 	shouldRevert bool,
 ) {
-	// submit the signed transaction
-	flowTx := convert.SDKTransactionToFlow(*tx)
-	err := b.AddTransaction(*flowTx)
-	require.NoError(t, err)
-
-	result, err := b.ExecuteNextTransaction()
-	require.NoError(t, err)
-
-	if shouldRevert {
-		assert.True(t, result.Reverted())
-	} else {
-		if !assert.True(t, result.Succeeded()) {
-			t.Log(result.Error.Error())
-		}
-	}
-
-	_, err = b.CommitBlock()
-	assert.NoError(t, err)
+	testkit.Submit(t, b, tx, shouldRevert)
 }
 
 // executeScriptAndCheck executes a script and checks to make sure that it succeeded.
 func executeScriptAndCheck(t *testing.T, b *emulator.Blockchain, script []byte, arguments [][]byte) cadence.Value {
-	result, err := b.ExecuteScript(script, arguments)
-	require.NoError(t, err)
-	if !assert.True(t, result.Succeeded()) {
-		t.Log(result.Error.Error())
-	}
-
-	return result.Value
+	return testkit.ExecuteScriptAndCheck(t, b, script, arguments)
 }
 
 func readFile(path string) []byte {
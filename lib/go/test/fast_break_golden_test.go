@@ -0,0 +1,172 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
+)
+
+// fastBreakGolden is the part of a golden of lib/go/tools/fastbreak the
+// emulator replays: the games are played and scored as the engine scored
+// them, and the chain must record the same points and run win counts.
+type fastBreakGolden struct {
+	Run struct {
+		ID            string `json:"id"`
+		Name          string `json:"name"`
+		RunStart      int64  `json:"runStart"`
+		RunEnd        int64  `json:"runEnd"`
+		FatigueModeOn bool   `json:"fatigueModeOn"`
+	} `json:"run"`
+	Moments map[uint64]struct {
+		Player string `json:"player"`
+	} `json:"moments"`
+	Games []struct {
+		Game struct {
+			ID                 string `json:"id"`
+			Name               string `json:"name"`
+			SubmissionDeadline int64  `json:"submissionDeadline"`
+			NumPlayers         uint64 `json:"numPlayers"`
+			Stats              []struct {
+				Name        string `json:"name"`
+				Type        string `json:"type"`
+				ValueNeeded uint64 `json:"valueNeeded"`
+			} `json:"stats"`
+			Submissions map[uint64]struct {
+				TopShots []uint64 `json:"topShots"`
+			} `json:"submissions"`
+		} `json:"game"`
+	} `json:"games"`
+	Expected struct {
+		Games map[string]struct {
+			Scores [][]struct {
+				PlayerID uint64 `json:"playerId"`
+				Points   uint64 `json:"points"`
+				Win      bool   `json:"win"`
+			} `json:"scores"`
+			Winner uint64 `json:"winner"`
+		} `json:"games"`
+		RunWinCount map[uint64]uint64 `json:"runWinCount"`
+	} `json:"expected"`
+}
+
+// TestFastBreakGoldens replays the goldens of the scoring engine on the
+// emulator, from the game of TestFastBreak to fatigue and stat corrections
+func TestFastBreakGoldens(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("..", "tools", "fastbreak", "testdata", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			var golden fastBreakGolden
+			require.NoError(t, json.Unmarshal(data, &golden))
+
+			replayFastBreakGolden(t, testkit.NewBlockchain(t), golden)
+		})
+	}
+}
+
+func replayFastBreakGolden(t *testing.T, b *testkit.Blockchain, golden fastBreakGolden) {
+	// players are created with ids 1 to n, and own the moments they play first
+	var players []testkit.Account
+	owners := map[uint64]uint64{}
+	for _, played := range golden.Games {
+		playerIDs := make([]uint64, 0, len(played.Game.Submissions))
+		for playerID := range played.Game.Submissions {
+			playerIDs = append(playerIDs, playerID)
+		}
+		sort.Slice(playerIDs, func(i, j int) bool { return playerIDs[i] < playerIDs[j] })
+		for _, playerID := range playerIDs {
+			for uint64(len(players)) < playerID {
+				player := b.SetupAccount(t)
+				b.SetupFastBreakPlayer(t, player, fmt.Sprintf("player %d", len(players)+1))
+				players = append(players, player)
+			}
+			for _, momentID := range played.Game.Submissions[playerID].TopShots {
+				if _, ok := owners[momentID]; !ok {
+					owners[momentID] = playerID
+				}
+			}
+		}
+	}
+
+	// moments are minted in the order of their ids, of a play named after their player
+	momentIDs := make([]uint64, 0, len(golden.Moments))
+	for momentID := range golden.Moments {
+		momentIDs = append(momentIDs, momentID)
+	}
+	sort.Slice(momentIDs, func(i, j int) bool { return momentIDs[i] < momentIDs[j] })
+	setID := b.CreateSet(t, "Fast Break")
+	plays := map[string]uint32{}
+	for _, momentID := range momentIDs {
+		name := golden.Moments[momentID].Player
+		if _, ok := plays[name]; !ok {
+			plays[name] = b.CreatePlay(t, map[string]string{"FullName": name})
+			b.AddPlayToSet(t, setID, plays[name])
+		}
+		owner, ok := owners[momentID]
+		require.True(t, ok, "moment %d is not played", momentID)
+		require.Equal(t, momentID, b.Mint(t, setID, plays[name], players[owner-1]))
+	}
+
+	run := golden.Run
+	b.CreateFastBreakRun(t, run.ID, run.Name, time.Unix(run.RunStart, 0), time.Unix(run.RunEnd, 0), run.FatigueModeOn)
+	for _, played := range golden.Games {
+		game := played.Game
+		b.CreateFastBreakGame(t, game.ID, game.Name, run.ID, time.Unix(game.SubmissionDeadline, 0), game.NumPlayers)
+		for _, stat := range game.Stats {
+			rawType := uint8(0)
+			if stat.Type != "INDIVIDUAL" {
+				rawType = 1
+			}
+			b.AddStatToFastBreakGame(t, game.ID, stat.Name, rawType, stat.ValueNeeded)
+		}
+
+		// OPEN
+		b.UpdateFastBreakGame(t, game.ID, 1, 0)
+		for playerID, submission := range game.Submissions {
+			b.PlayFastBreak(t, players[playerID-1], game.ID, submission.TopShots...)
+		}
+		// STARTED
+		b.UpdateFastBreakGame(t, game.ID, 2, 0)
+
+		expected := golden.Expected.Games[game.ID]
+		for _, scores := range expected.Scores {
+			for _, score := range scores {
+				b.ScoreFastBreakSubmission(t, game.ID, players[score.PlayerID-1], score.Points, score.Win)
+			}
+		}
+		// CLOSED
+		b.UpdateFastBreakGame(t, game.ID, 3, expected.Winner)
+
+		final := expected.Scores[len(expected.Scores)-1]
+		for _, score := range final {
+			points := b.ExecuteScript(t, templates.GenerateGetPlayerScoreScript(b.Env),
+				testkit.CadenceString(game.ID), cadence.NewAddress(players[score.PlayerID-1].Address))
+			assert.Equal(t, cadence.NewUInt64(score.Points), points, "points of player %d in %s", score.PlayerID, game.ID)
+		}
+	}
+
+	for i, player := range players {
+		playerID := uint64(i + 1)
+		address := b.ExecuteScript(t, templates.GenerateGetFastBreakPlayerAddressScript(b.Env), cadence.NewUInt64(playerID))
+		require.Equal(t, cadence.NewOptional(cadence.NewAddress(player.Address)), address, "account of player %d", playerID)
+
+		winCount := b.ExecuteScript(t, templates.GenerateGetPlayerWinCountForRunScript(b.Env),
+			testkit.CadenceString(run.ID), cadence.NewAddress(player.Address))
+		assert.Equal(t, cadence.NewUInt64(golden.Expected.RunWinCount[playerID]), winCount, "run wins of player %d", playerID)
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/onflow/cadence"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/flow-emulator/adapters"
-	fungibleToken "github.com/onflow/flow-ft/lib/go/contracts"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/crypto"
 	sdktemplates "github.com/onflow/flow-go-sdk/templates"
@@ -103,14 +102,7 @@ func TestFastBreak(t *testing.T) {
 	assert.Nil(t, updateErr)
 
 	// Should be able to deploy the token contract
-	tokenCode := fungibleToken.CustomToken(
-		defaultfungibleTokenAddr,
-		env.MetadataViewsAddress,
-		env.FungibleTokenMetadataViewsAddress,
-		"DapperUtilityCoin",
-		"dapperUtilityCoin",
-		"1000.0",
-	)
+	tokenCode := contracts.GenerateDapperUtilityCoinContract(defaultfungibleTokenAddr, env.MetadataViewsAddress, env.FungibleTokenMetadataViewsAddress)
 	tokenAddr, err := adapter.CreateAccount(context.Background(), nil, []sdktemplates.Contract{
 		{
 			Name:   "DapperUtilityCoin",
@@ -554,6 +546,52 @@ func TestFastBreak(t *testing.T) {
 		assert.Equal(t, cadence.NewUInt64(1), result)
 	})
 
+	t.Run("oracle should be able to update status of fast break run", func(t *testing.T) {
+		tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateUpdateFastBreakRunStatusScript(env), fastBreakAddr)
+
+		cdcId, _ := cadence.NewString(fastBreakRunId)
+
+		arg0Err := tx.AddArgument(cdcId)
+		assert.Nil(t, arg0Err)
+
+		// RUNNING
+		arg1Err := tx.AddArgument(cadence.NewUInt8(1))
+		assert.Nil(t, arg1Err)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{b.ServiceKey().Address, fastBreakAddr}, []crypto.Signer{serviceKeySigner, fastBreakSigner},
+			false,
+		)
+	})
+
+	t.Run("should get the account of a player", func(t *testing.T) {
+		result := executeScriptAndCheck(t, b, templates.GenerateGetFastBreakPlayerAddressScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewUInt64(playerId))})
+		assert.Equal(t, cadence.NewOptional(cadence.NewAddress(aliceAddress)), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetFastBreakPlayerAddressScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewUInt64(playerId + 1))})
+		assert.Equal(t, cadence.NewOptional(nil), result)
+	})
+
+	t.Run("should read the run, the player and the playable top shots of an account", func(t *testing.T) {
+		cdcRunId, _ := cadence.NewString(fastBreakRunId)
+		result := executeScriptAndCheck(t, b, templates.GenerateGetFastBreakRunScript(env), [][]byte{jsoncdc.MustEncode(cdcRunId)})
+		run, ok := result.(cadence.Optional).Value.(cadence.Struct)
+		require.True(t, ok)
+		assert.Equal(t, cadence.NewBool(fatigueModeOn), cadence.SearchFieldByName(run, "fatigueModeOn"))
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetAccountPlayerIDScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewAddress(aliceAddress))})
+		assert.Equal(t, cadence.NewOptional(cadence.NewUInt64(playerId)), result)
+		result = executeScriptAndCheck(t, b, templates.GenerateGetAccountPlayerIDScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewAddress(topshotAddr))})
+		assert.Equal(t, cadence.NewOptional(nil), result)
+
+		topShots := jsoncdc.MustEncode(cadence.NewArray([]cadence.Value{cadence.NewUInt64(1), cadence.NewUInt64(2)}))
+		result = executeScriptAndCheck(t, b, templates.GenerateGetPlayableTopShotsScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewAddress(aliceAddress)), topShots})
+		assert.Equal(t, cadence.NewOptional(cadence.NewArray([]cadence.Value{cadence.NewUInt64(1)}).WithType(cadence.NewVariableSizedArrayType(cadence.UInt64Type))), result)
+		result = executeScriptAndCheck(t, b, templates.GenerateGetPlayableTopShotsScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewAddress(fastBreakAddr)), topShots})
+		assert.Equal(t, cadence.NewOptional(nil), result)
+	})
+
 	t.Run("should verify getFastBreakGameStats returns reference", func(t *testing.T) {
 		// Test that getFastBreakGameStats returns a reference to the stats array
 		// Use a game that we know has stats (the one we added stats to earlier)
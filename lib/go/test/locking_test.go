@@ -15,7 +15,6 @@ import (
 	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
 	"github.com/onflow/cadence"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
-	fungibleToken "github.com/onflow/flow-ft/lib/go/contracts"
 	fungibleTokenTemplates "github.com/onflow/flow-ft/lib/go/templates"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/crypto"
@@ -41,14 +40,7 @@ func TestTopShotLocking(t *testing.T) {
 	adapter := adapters.NewSDKAdapter(&logger, b)
 
 	// Should be able to deploy the token contract
-	tokenCode := fungibleToken.CustomToken(
-		defaultfungibleTokenAddr,
-		env.MetadataViewsAddress,
-		env.FungibleTokenMetadataViewsAddress,
-		"DapperUtilityCoin",
-		"dapperUtilityCoin",
-		"1000.0",
-	)
+	tokenCode := contracts.GenerateDapperUtilityCoinContract(defaultfungibleTokenAddr, env.MetadataViewsAddress, env.FungibleTokenMetadataViewsAddress)
 	tokenAddr, err := adapter.CreateAccount(context.Background(), nil, []sdktemplates.Contract{
 		{
 			Name:   "DapperUtilityCoin",
@@ -80,7 +72,7 @@ func TestTopShotLocking(t *testing.T) {
 	assert.NoError(t, err)
 	env.TopShotMarketV3Address = marketV3Addr.String()
 
-	forwardingCode := fungibleToken.CustomTokenForwarding(defaultfungibleTokenAddr, "DapperUtilityCoin", "dapperUtilityCoin")
+	forwardingCode := contracts.GenerateTokenForwardingContract(defaultfungibleTokenAddr)
 	forwardingAddr, err := adapter.CreateAccount(context.Background(), nil, []sdktemplates.Contract{
 		{
 			Name:   "TokenForwarding",
@@ -564,4 +556,44 @@ func TestTopShotLocking(t *testing.T) {
 		resultTime := time.Unix(int64(result.(cadence.UFix64)/CadenceUFix64Factor), 0)
 		assert.WithinDuration(t, expectedExpiryTime, resultTime, 10*time.Second)
 	})
+
+	t.Run("Admin should be able to batch mark moments unlockable then the owner should be able to unlock them", func(t *testing.T) {
+		// locking admin marks the moment locked for 1 year unlockable
+		tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateAdminBatchMarkMomentsUnlockableScript(env), topShotLockingAddr)
+		_ = tx.AddArgument(cadence.Address(topshotAddr))
+		_ = tx.AddArgument(cadence.NewArray([]cadence.Value{cadence.NewUInt64(momentId)}))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{b.ServiceKey().Address, topShotLockingAddr}, []crypto.Signer{serviceKeySigner, lockingSigner},
+			false,
+		)
+
+		tx = createTxWithTemplateAndAuthorizer(b, templates.GenerateBatchUnlockMomentScript(env), topshotAddr)
+		_ = tx.AddArgument(cadence.NewArray([]cadence.Value{cadence.NewUInt64(momentId)}))
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{b.ServiceKey().Address, topshotAddr}, []crypto.Signer{serviceKeySigner, topshotSigner},
+			false,
+		)
+
+		// Verify moment is not locked
+		result := executeScriptAndCheck(t, b, templates.GenerateGetMomentIsLockedScript(env), [][]byte{
+			jsoncdc.MustEncode(cadence.Address(topshotAddr)),
+			jsoncdc.MustEncode(cadence.UInt64(momentId)),
+		})
+		assertEqual(t, cadence.NewBool(false), result)
+	})
+
+	t.Run("Admin should not be able to batch mark a moment missing from the owner's collection unlockable", func(t *testing.T) {
+		tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateAdminBatchMarkMomentsUnlockableScript(env), topShotLockingAddr)
+		_ = tx.AddArgument(cadence.Address(topshotAddr))
+		_ = tx.AddArgument(cadence.NewArray([]cadence.Value{cadence.NewUInt64(9999)}))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{b.ServiceKey().Address, topShotLockingAddr}, []crypto.Signer{serviceKeySigner, lockingSigner},
+			true,
+		)
+	})
 }
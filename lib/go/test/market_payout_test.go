@@ -0,0 +1,83 @@
+package test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
+)
+
+// marketPayout is a purchase of lib/go/tools/market/testdata/payouts.json,
+// with the payout the calculator expects the chain to make
+type marketPayout struct {
+	Name    string `json:"name"`
+	Listing struct {
+		Market        string `json:"market"`
+		Price         string `json:"price"`
+		CutPercentage string `json:"cutPercentage"`
+	} `json:"listing"`
+	Expected struct {
+		Beneficiary string `json:"beneficiary"`
+		Owner       string `json:"owner"`
+	} `json:"expected"`
+}
+
+// TestMarketPayouts buys each listing of the payout goldens through the V3
+// market of its seller, forwarded to a V1 sale collection for V1 listings,
+// and checks that the DUC balances of the beneficiary and the seller grow by
+// the payout the calculator expects
+func TestMarketPayouts(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "tools", "market", "testdata", "payouts.json"))
+	require.NoError(t, err)
+	var payouts []marketPayout
+	require.NoError(t, json.Unmarshal(data, &payouts))
+	require.NotEmpty(t, payouts)
+
+	b := testkit.NewBlockchain(t)
+	fixture := b.Bootstrap(t)
+
+	for _, payout := range payouts {
+		t.Run(payout.Name, func(t *testing.T) {
+			seller := b.SetupAccount(t)
+			momentID := b.Mint(t, fixture.SetID, fixture.PlayIDs[0], seller)
+			listing := []cadence.Value{
+				testkit.DUCReceiverPath,
+				cadence.NewAddress(b.MarketBeneficiary.Address),
+				testkit.CadenceUFix64(payout.Listing.CutPercentage),
+				cadence.NewUInt64(momentID),
+				testkit.CadenceUFix64(payout.Listing.Price),
+			}
+
+			switch payout.Listing.Market {
+			case "V3":
+				b.SendTx(t, templates.GenerateCreateAndStartSaleV3Script(b.Env), listing, false, seller)
+			case "V1":
+				b.SendTx(t, templates.GenerateCreateAndStartSaleScript(b.Env), listing, false, seller)
+				// the V3 sale collection forwards to the V1 one when created
+				// after it, at a cut of its own that the purchase must not take
+				b.ListForSaleV3(t, seller, b.Mint(t, fixture.SetID, fixture.PlayIDs[1], seller), "1.0")
+			default:
+				t.Fatalf("unknown market %q", payout.Listing.Market)
+			}
+
+			buyer := b.SetupAccount(t)
+			b.MintDUC(t, buyer, payout.Listing.Price)
+			beneficiaryBefore := b.DUCBalance(t, b.MarketBeneficiary)
+			sellerBefore := b.DUCBalance(t, seller)
+
+			b.PurchaseV3(t, buyer, seller, momentID, payout.Listing.Price)
+
+			assert.True(t, b.IsInCollection(t, buyer, momentID))
+			assert.Equal(t, testkit.CadenceUFix64("0.0"), b.DUCBalance(t, buyer))
+			assert.Equal(t, testkit.CadenceUFix64(payout.Expected.Beneficiary), b.DUCBalance(t, b.MarketBeneficiary)-beneficiaryBefore)
+			assert.Equal(t, testkit.CadenceUFix64(payout.Expected.Owner), b.DUCBalance(t, seller)-sellerBefore)
+		})
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/onflow/cadence/common"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/flow-emulator/adapters"
-	fungibleToken "github.com/onflow/flow-ft/lib/go/contracts"
 	fungibleTokenTemplates "github.com/onflow/flow-ft/lib/go/templates"
 	"github.com/rs/zerolog"
 
@@ -41,7 +40,7 @@ func TestMarketDeployment(t *testing.T) {
 	adapter := adapters.NewSDKAdapter(&logger, b)
 
 	// Should be able to deploy a token contract
-	tokenCode := fungibleToken.CustomToken(defaultfungibleTokenAddr, env.MetadataViewsAddress, env.FungibleTokenMetadataViewsAddress, "DapperUtilityCoin", "dapperUtilityCoin", "1000.0")
+	tokenCode := contracts.GenerateDapperUtilityCoinContract(defaultfungibleTokenAddr, env.MetadataViewsAddress, env.FungibleTokenMetadataViewsAddress)
 	tokenAccountKey, _ := accountKeys.NewWithSigner()
 	tokenAddr, err := adapter.CreateAccount(context.Background(), []*flow.AccountKey{tokenAccountKey}, []sdktemplates.Contract{
 		{
@@ -97,7 +96,7 @@ func TestMarketV1(t *testing.T) {
 	adapter := adapters.NewSDKAdapter(&logger, b)
 
 	// Should be able to deploy the token contract
-	tokenCode := fungibleToken.CustomToken(defaultfungibleTokenAddr, env.MetadataViewsAddress, env.FungibleTokenMetadataViewsAddress, "DapperUtilityCoin", "dapperUtilityCoin", "1000.0")
+	tokenCode := contracts.GenerateDapperUtilityCoinContract(defaultfungibleTokenAddr, env.MetadataViewsAddress, env.FungibleTokenMetadataViewsAddress)
 	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
 	tokenAddr, err := adapter.CreateAccount(context.Background(), []*flow.AccountKey{tokenAccountKey}, []sdktemplates.Contract{
 		{
@@ -130,7 +129,7 @@ func TestMarketV1(t *testing.T) {
 	env.TopShotMarketAddress = marketAddr.String()
 
 	// Should be able to deploy the token forwarding contract
-	forwardingCode := fungibleToken.CustomTokenForwarding(defaultfungibleTokenAddr, defaultTokenName, defaultTokenStorage)
+	forwardingCode := contracts.GenerateTokenForwardingContract(defaultfungibleTokenAddr)
 	forwardingAddr, err := adapter.CreateAccount(context.Background(), nil, []sdktemplates.Contract{
 		{
 			Name:   "TokenForwarding",
@@ -606,7 +605,7 @@ func TestMarketV3(t *testing.T) {
 	adapter := adapters.NewSDKAdapter(&logger, b)
 
 	// Should be able to deploy the token contract
-	tokenCode := fungibleToken.CustomToken(defaultfungibleTokenAddr, env.MetadataViewsAddress, env.FungibleTokenMetadataViewsAddress, "DapperUtilityCoin", "dapperUtilityCoin", "1000.0")
+	tokenCode := contracts.GenerateDapperUtilityCoinContract(defaultfungibleTokenAddr, env.MetadataViewsAddress, env.FungibleTokenMetadataViewsAddress)
 	tokenAccountKey, tokenSigner := accountKeys.NewWithSigner()
 	tokenAddr, err := adapter.CreateAccount(context.Background(), []*flow.AccountKey{tokenAccountKey}, []sdktemplates.Contract{
 		{
@@ -655,7 +654,7 @@ func TestMarketV3(t *testing.T) {
 	env.TopShotMarketV3Address = marketV3Addr.String()
 
 	// Should be able to deploy the token forwarding contract
-	forwardingCode := fungibleToken.CustomTokenForwarding(defaultfungibleTokenAddr, "DapperUtilityCoin", "dapperUtilityCoin")
+	forwardingCode := contracts.GenerateTokenForwardingContract(defaultfungibleTokenAddr)
 	forwardingAddr, err := adapter.CreateAccount(context.Background(), nil, []sdktemplates.Contract{
 		{
 			Name:   "TokenForwarding",
@@ -0,0 +1,121 @@
+package property
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Invariant is a property that must hold between the model and the contracts after every operation
+type Invariant struct {
+	Name  string
+	Check func(m *Model, s *State) error
+}
+
+// Invariants are the properties checked by default
+var Invariants = []Invariant{
+	{Name: "serial numbers are contiguous per edition and subedition", Check: contiguousSerialNumbers},
+	{Name: "totalSupply counts every moment minted and the collections hold those not destroyed", Check: supply},
+	{Name: "sets and editions are as the model expects", Check: editions},
+	{Name: "locked moments stay locked in their owner's collection", Check: lockedMoments},
+}
+
+// contiguousSerialNumbers checks that every series has minted serial
+// numbers 1 to n, and that the contract counts the moments of each edition
+// as the model does
+func contiguousSerialNumbers(m *Model, s *State) error {
+	for series, serials := range m.Serials {
+		for i, serial := range serials {
+			if serial != uint32(i+1) {
+				return fmt.Errorf("set %d play %d subedition %d minted serial numbers %v", series.SetID, series.PlayID, series.SubeditionID, serials)
+			}
+		}
+	}
+
+	for _, edition := range s.Editions {
+		if expected := m.MintedPerEdition[Edition{SetID: edition.SetID, PlayID: edition.PlayID}]; edition.Minted != expected {
+			return fmt.Errorf("set %d play %d counts %d moments instead of %d", edition.SetID, edition.PlayID, edition.Minted, expected)
+		}
+	}
+
+	return nil
+}
+
+// supply checks totalSupply and the collections.
+//
+// TopShot.totalSupply is also the ID of the last moment minted, so it is
+// never decremented: the moments minted minus those destroyed are the
+// moments left in the collections.
+func supply(m *Model, s *State) error {
+	if s.TotalSupply != m.Minted {
+		return fmt.Errorf("totalSupply is %d but %d moments were minted", s.TotalSupply, m.Minted)
+	}
+
+	held := uint64(0)
+	for user, ids := range s.Collections {
+		held += uint64(len(ids))
+
+		slices.Sort(ids)
+		if expected := m.owned(user); !slices.Equal(ids, expected) {
+			return fmt.Errorf("user %d holds moments %v instead of %v", user, ids, expected)
+		}
+	}
+	if held != m.Minted-m.Destroyed {
+		return fmt.Errorf("the collections hold %d moments but %d were minted and %d destroyed", held, m.Minted, m.Destroyed)
+	}
+
+	return nil
+}
+
+// editions checks the plays of every set, which are retired and which sets are locked.
+//
+// Together with the transactions the model expects to be rejected, this is
+// what keeps retired editions and locked sets from minting: a retired
+// edition mints nothing, and a locked set gains no plays to mint from.
+// A locked set still mints the plays it already has.
+func editions(m *Model, s *State) error {
+	plays := map[uint32][]uint32{}
+	for _, edition := range s.Editions {
+		plays[edition.SetID] = append(plays[edition.SetID], edition.PlayID)
+
+		set := m.set(edition.SetID)
+		if set == nil {
+			return fmt.Errorf("set %d is unknown to the model", edition.SetID)
+		}
+		if edition.Retired != set.Retired[edition.PlayID] {
+			return fmt.Errorf("set %d play %d has retired %t instead of %t", edition.SetID, edition.PlayID, edition.Retired, set.Retired[edition.PlayID])
+		}
+	}
+
+	for _, set := range m.Sets {
+		if !slices.Equal(plays[set.ID], set.Plays) {
+			return fmt.Errorf("set %d has plays %v instead of %v", set.ID, plays[set.ID], set.Plays)
+		}
+		if locked := slices.Contains(s.LockedSets, set.ID); locked != set.Locked {
+			return fmt.Errorf("set %d has locked %t instead of %t", set.ID, locked, set.Locked)
+		}
+	}
+
+	return nil
+}
+
+// lockedMoments checks that the locked moments are those the model locked.
+// With supply, which checks who holds every moment, this means a locked
+// moment never leaves its owner's collection.
+func lockedMoments(m *Model, s *State) error {
+	var expected []uint64
+	for id, moment := range m.Moments {
+		if moment.Locked {
+			expected = append(expected, id)
+		}
+	}
+	slices.Sort(expected)
+
+	locked := slices.Clone(s.Locked)
+	slices.Sort(locked)
+
+	if !slices.Equal(locked, expected) {
+		return fmt.Errorf("moments %v are locked instead of %v", locked, expected)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package property
+
+import (
+	"sort"
+)
+
+// Edition is a play in a set
+type Edition struct {
+	SetID  uint32
+	PlayID uint32
+}
+
+// Series is the sequence of serial numbers an edition mints, or one of its
+// subeditions when the edition is minted with subeditions
+type Series struct {
+	Edition
+	// SubeditionID is 0 for an edition minted without subeditions
+	SubeditionID uint32
+}
+
+// Set is what the model knows about a set
+type Set struct {
+	ID      uint32
+	Plays   []uint32
+	Retired map[uint32]bool
+	Locked  bool
+}
+
+// Moment is a moment that has not been destroyed
+type Moment struct {
+	ID           uint64
+	Series       Series
+	SerialNumber uint32
+	// Owner is the index of the user holding the moment
+	Owner  int
+	Locked bool
+	// Expired is whether the lock has expired. Moments are either locked for
+	// a duration of zero, which expires by the next block, or for a year.
+	Expired bool
+}
+
+// Model is the state the contracts are expected to be in after a sequence of operations
+type Model struct {
+	Plays       []uint32
+	Sets        []*Set
+	Subeditions []uint32
+	// Moments are the moments in the users' collections by ID
+	Moments map[uint64]*Moment
+	// Serials are the serial numbers minted in each series, including those of destroyed moments
+	Serials map[Series][]uint32
+	// MintedPerEdition is the number of moments minted per edition, across its subeditions
+	MintedPerEdition map[Edition]uint32
+	// WithSubeditions records, for every edition minted from, whether it was minted with subeditions
+	WithSubeditions map[Edition]bool
+	Minted          uint64
+	Destroyed       uint64
+	Users           int
+}
+
+// NewModel returns the model of a freshly deployed TopShot contract with the number of users
+func NewModel(users int) *Model {
+	return &Model{
+		Moments:          map[uint64]*Moment{},
+		Serials:          map[Series][]uint32{},
+		MintedPerEdition: map[Edition]uint32{},
+		WithSubeditions:  map[Edition]bool{},
+		Users:            users,
+	}
+}
+
+// hasPlay returns whether the play has been added to the set
+func (s *Set) hasPlay(playID uint32) bool {
+	for _, id := range s.Plays {
+		if id == playID {
+			return true
+		}
+	}
+	return false
+}
+
+// set returns the set with the ID
+func (m *Model) set(setID uint32) *Set {
+	for _, s := range m.Sets {
+		if s.ID == setID {
+			return s
+		}
+	}
+	return nil
+}
+
+// canMint returns whether moments of the edition can be minted: its play is in the set and not retired
+func (m *Model) canMint(e Edition) bool {
+	s := m.set(e.SetID)
+	return s != nil && s.hasPlay(e.PlayID) && !s.Retired[e.PlayID]
+}
+
+// owned returns the IDs of the moments held by the user in ascending order
+func (m *Model) owned(user int) []uint64 {
+	var ids []uint64
+	for id, moment := range m.Moments {
+		if moment.Owner == user {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// pickMoment returns the moment at the index, modulo the number of moments, in ascending order of ID
+func (m *Model) pickMoment(choice int) *Moment {
+	if len(m.Moments) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(m.Moments))
+	for id := range m.Moments {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return m.Moments[ids[choice%len(ids)]]
+}
+
+// mint records a moment minted into the user's collection
+func (m *Model) mint(id uint64, series Series, serialNumber uint32, owner int) {
+	m.Moments[id] = &Moment{ID: id, Series: series, SerialNumber: serialNumber, Owner: owner}
+	m.Serials[series] = append(m.Serials[series], serialNumber)
+	m.MintedPerEdition[series.Edition]++
+	m.WithSubeditions[series.Edition] = series.SubeditionID != 0
+	m.Minted++
+}
+
+// nextSerialNumber is the serial number the next moment of the series should get
+func (m *Model) nextSerialNumber(series Series) uint32 {
+	return uint32(len(m.Serials[series])) + 1
+}
@@ -0,0 +1,469 @@
+package property
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/onflow/cadence"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
+)
+
+const (
+	// maxIDs bounds the number of plays, sets and subeditions created.
+	// TopShot keys the subedition mint counters by the concatenation of the
+	// set, play and subedition IDs, so they are only unique while every ID is
+	// a single digit: set 1, play 12, subedition 3 and set 11, play 2,
+	// subedition 3 would share a counter.
+	maxIDs = 9
+
+	// maxBatch is the largest quantity of a batch mint and the largest number of plays added at once
+	maxBatch = 4
+
+	// lockForAYear is the duration of the locks that do not expire during a run
+	lockForAYear = "31536000.0"
+)
+
+// Kind is the kind of an operation
+type Kind int
+
+const (
+	CreatePlay Kind = iota
+	CreateSet
+	AddPlay
+	AddPlays
+	RetirePlay
+	RetireAll
+	LockSet
+	CreateSubedition
+	Mint
+	BatchMint
+	MintWithSubedition
+	Transfer
+	LockMoment
+	UnlockMoment
+	DestroyMoments
+	numKinds
+)
+
+var kindNames = [numKinds]string{
+	"CreatePlay",
+	"CreateSet",
+	"AddPlay",
+	"AddPlays",
+	"RetirePlay",
+	"RetireAll",
+	"LockSet",
+	"CreateSubedition",
+	"Mint",
+	"BatchMint",
+	"MintWithSubedition",
+	"Transfer",
+	"LockMoment",
+	"UnlockMoment",
+	"DestroyMoments",
+}
+
+// weights is how often each kind of operation is generated relative to the others
+var weights = [numKinds]int{
+	CreatePlay:         3,
+	CreateSet:          2,
+	AddPlay:            3,
+	AddPlays:           2,
+	RetirePlay:         1,
+	RetireAll:          1,
+	LockSet:            1,
+	CreateSubedition:   1,
+	Mint:               4,
+	BatchMint:          3,
+	MintWithSubedition: 3,
+	Transfer:           3,
+	LockMoment:         3,
+	UnlockMoment:       2,
+	DestroyMoments:     2,
+}
+
+func (k Kind) String() string {
+	if k < 0 || k >= numKinds {
+		return "Kind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return kindNames[k]
+}
+
+// Op is an operation whose arguments are choices rather than IDs: each picks
+// a play, set, subedition, user or moment by index, modulo the number the
+// model knows of when the operation runs. An operation therefore stays
+// meaningful when the operations before it are removed while shrinking.
+type Op struct {
+	Kind Kind
+	Args [3]int
+}
+
+// GoString formats the operation as a Go literal, so that a reproduction can be pasted into a test
+func (o Op) GoString() string {
+	return fmt.Sprintf("{Kind: property.%s, Args: [3]int{%d, %d, %d}}", o.Kind, o.Args[0], o.Args[1], o.Args[2])
+}
+
+// Generate returns a random sequence of n operations
+func Generate(r *rand.Rand, n int) []Op {
+	total := 0
+	for _, weight := range weights {
+		total += weight
+	}
+
+	ops := make([]Op, n)
+	for i := range ops {
+		pick := r.Intn(total)
+		kind := Kind(0)
+		for pick >= weights[kind] {
+			pick -= weights[kind]
+			kind++
+		}
+		ops[i] = Op{Kind: kind, Args: [3]int{r.Intn(100), r.Intn(100), r.Intn(100)}}
+	}
+	return ops
+}
+
+// action is an operation resolved against the model: the transaction to send,
+// whether the contracts should reject it and how it changes the model if not
+type action struct {
+	desc   string
+	script func(templates.Environment) []byte
+	args   []cadence.Value
+	// signer is the index of the user signing the transaction, or admin
+	signer int
+	revert bool
+	apply  func(m *Model, o *outcome) error
+}
+
+// admin is the signer of the transactions sent by the TopShot admin
+const admin = -1
+
+// resolve turns the operation into an action, or returns false if it
+// does not apply to the model, e.g. a mint before any set exists.
+//
+// The users are the accounts the model's user indexes refer to.
+func (m *Model) resolve(op Op, users []testkit.Account) (*action, bool) {
+	a, b, c := op.Args[0], op.Args[1], op.Args[2]
+
+	switch op.Kind {
+	case CreatePlay:
+		if len(m.Plays) >= maxIDs {
+			return nil, false
+		}
+		name := fmt.Sprintf("Play %d", len(m.Plays)+1)
+		return &action{
+			desc:   "create play " + strconv.Quote(name),
+			script: templates.GenerateMintPlayScript,
+			args:   []cadence.Value{testkit.CadenceStringDictionary(map[string]string{"FullName": name})},
+			signer: admin,
+			apply: func(m *Model, o *outcome) error {
+				if err := o.expectIDs("PlayCreated", o.plays, uint32(len(m.Plays)+1)); err != nil {
+					return err
+				}
+				m.Plays = append(m.Plays, o.plays[0])
+				return nil
+			},
+		}, true
+
+	case CreateSet:
+		if len(m.Sets) >= maxIDs {
+			return nil, false
+		}
+		name := fmt.Sprintf("Set %d", len(m.Sets)+1)
+		return &action{
+			desc:   "create set " + strconv.Quote(name),
+			script: templates.GenerateMintSetScript,
+			args:   []cadence.Value{testkit.CadenceString(name)},
+			signer: admin,
+			apply: func(m *Model, o *outcome) error {
+				if err := o.expectIDs("SetCreated", o.sets, uint32(len(m.Sets)+1)); err != nil {
+					return err
+				}
+				m.Sets = append(m.Sets, &Set{ID: o.sets[0], Retired: map[uint32]bool{}})
+				return nil
+			},
+		}, true
+
+	case CreateSubedition:
+		if len(m.Subeditions) >= maxIDs {
+			return nil, false
+		}
+		name := fmt.Sprintf("Subedition %d", len(m.Subeditions)+1)
+		return &action{
+			desc:   "create subedition " + strconv.Quote(name),
+			script: templates.GenerateCreateSubeditionScript,
+			args:   []cadence.Value{testkit.CadenceString(name), testkit.CadenceStringDictionary(map[string]string{})},
+			signer: admin,
+			apply: func(m *Model, o *outcome) error {
+				if err := o.expectIDs("SubeditionCreated", o.subeditions, uint32(len(m.Subeditions)+1)); err != nil {
+					return err
+				}
+				m.Subeditions = append(m.Subeditions, o.subeditions[0])
+				return nil
+			},
+		}, true
+
+	case AddPlay:
+		if len(m.Sets) == 0 || len(m.Plays) == 0 {
+			return nil, false
+		}
+		set, playID := m.Sets[a%len(m.Sets)], m.Plays[b%len(m.Plays)]
+		return &action{
+			desc:   fmt.Sprintf("add play %d to set %d", playID, set.ID),
+			script: templates.GenerateAddPlayToSetScript,
+			args:   []cadence.Value{cadence.NewUInt32(set.ID), cadence.NewUInt32(playID)},
+			signer: admin,
+			revert: set.Locked || set.hasPlay(playID),
+			apply: func(m *Model, o *outcome) error {
+				set.Plays = append(set.Plays, playID)
+				set.Retired[playID] = false
+				return nil
+			},
+		}, true
+
+	case AddPlays:
+		if len(m.Sets) == 0 || len(m.Plays) == 0 {
+			return nil, false
+		}
+		set := m.Sets[a%len(m.Sets)]
+		n := min(c%maxBatch+1, len(m.Plays))
+		playIDs := make([]uint32, n)
+		revert := set.Locked
+		for i := range playIDs {
+			playIDs[i] = m.Plays[(b+i)%len(m.Plays)]
+			revert = revert || set.hasPlay(playIDs[i])
+		}
+		return &action{
+			desc:   fmt.Sprintf("add plays %v to set %d", playIDs, set.ID),
+			script: templates.GenerateAddPlaysToSetScript,
+			args:   []cadence.Value{cadence.NewUInt32(set.ID), testkit.UInt32Array(playIDs...)},
+			signer: admin,
+			revert: revert,
+			apply: func(m *Model, o *outcome) error {
+				for _, playID := range playIDs {
+					set.Plays = append(set.Plays, playID)
+					set.Retired[playID] = false
+				}
+				return nil
+			},
+		}, true
+
+	case RetirePlay:
+		if len(m.Sets) == 0 || len(m.Plays) == 0 {
+			return nil, false
+		}
+		set, playID := m.Sets[a%len(m.Sets)], m.Plays[b%len(m.Plays)]
+		return &action{
+			desc:   fmt.Sprintf("retire play %d from set %d", playID, set.ID),
+			script: templates.GenerateRetirePlayScript,
+			args:   []cadence.Value{cadence.NewUInt32(set.ID), cadence.NewUInt32(playID)},
+			signer: admin,
+			revert: !set.hasPlay(playID),
+			apply: func(m *Model, o *outcome) error {
+				set.Retired[playID] = true
+				return nil
+			},
+		}, true
+
+	case RetireAll:
+		if len(m.Sets) == 0 {
+			return nil, false
+		}
+		set := m.Sets[a%len(m.Sets)]
+		return &action{
+			desc:   fmt.Sprintf("retire every play of set %d", set.ID),
+			script: templates.GenerateRetireAllPlaysScript,
+			args:   []cadence.Value{cadence.NewUInt32(set.ID)},
+			signer: admin,
+			apply: func(m *Model, o *outcome) error {
+				for _, playID := range set.Plays {
+					set.Retired[playID] = true
+				}
+				return nil
+			},
+		}, true
+
+	case LockSet:
+		if len(m.Sets) == 0 {
+			return nil, false
+		}
+		set := m.Sets[a%len(m.Sets)]
+		return &action{
+			desc:   fmt.Sprintf("lock set %d", set.ID),
+			script: templates.GenerateLockSetScript,
+			args:   []cadence.Value{cadence.NewUInt32(set.ID)},
+			signer: admin,
+			apply: func(m *Model, o *outcome) error {
+				set.Locked = true
+				return nil
+			},
+		}, true
+
+	case Mint, BatchMint:
+		if len(m.Sets) == 0 || len(m.Plays) == 0 {
+			return nil, false
+		}
+		series := Series{Edition: Edition{SetID: m.Sets[a%len(m.Sets)].ID, PlayID: m.Plays[b%len(m.Plays)]}}
+		if m.WithSubeditions[series.Edition] {
+			return nil, false
+		}
+		owner := c % m.Users
+		quantity := 1
+		desc := fmt.Sprintf("mint a moment of set %d play %d for user %d", series.SetID, series.PlayID, owner)
+		script := templates.GenerateMintMomentScript
+		args := []cadence.Value{cadence.NewUInt32(series.SetID), cadence.NewUInt32(series.PlayID)}
+		if op.Kind == BatchMint {
+			quantity = c%maxBatch + 1
+			desc = fmt.Sprintf("batch mint %d moments of set %d play %d for user %d", quantity, series.SetID, series.PlayID, owner)
+			script = templates.GenerateBatchMintMomentScript
+			args = append(args, cadence.NewUInt64(uint64(quantity)))
+		}
+		return &action{
+			desc:   desc,
+			script: script,
+			args:   append(args, cadence.NewAddress(users[owner].Address)),
+			signer: admin,
+			revert: !m.canMint(series.Edition),
+			apply:  applyMint(series, quantity, owner),
+		}, true
+
+	case MintWithSubedition:
+		if len(m.Sets) == 0 || len(m.Plays) == 0 || len(m.Subeditions) == 0 {
+			return nil, false
+		}
+		series := Series{
+			Edition:      Edition{SetID: m.Sets[a%len(m.Sets)].ID, PlayID: m.Plays[b%len(m.Plays)]},
+			SubeditionID: m.Subeditions[c%len(m.Subeditions)],
+		}
+		if minted, ok := m.WithSubeditions[series.Edition]; ok && !minted {
+			return nil, false
+		}
+		owner := (a + b + c) % m.Users
+		return &action{
+			desc:   fmt.Sprintf("mint a moment of set %d play %d subedition %d for user %d", series.SetID, series.PlayID, series.SubeditionID, owner),
+			script: templates.GenerateMintMomentWithSubeditionScript,
+			args: []cadence.Value{
+				cadence.NewUInt32(series.SetID),
+				cadence.NewUInt32(series.PlayID),
+				cadence.NewUInt32(series.SubeditionID),
+				cadence.NewAddress(users[owner].Address),
+			},
+			signer: admin,
+			revert: !m.canMint(series.Edition),
+			apply:  applyMint(series, 1, owner),
+		}, true
+
+	case Transfer:
+		moment := m.pickMoment(a)
+		if moment == nil {
+			return nil, false
+		}
+		from := moment.Owner
+		to := (from + 1 + c%(m.Users-1)) % m.Users
+		return &action{
+			desc:   fmt.Sprintf("user %d transfers moment %d to user %d", from, moment.ID, to),
+			script: templates.GenerateTransferMomentScript,
+			args:   []cadence.Value{cadence.NewAddress(users[to].Address), cadence.NewUInt64(moment.ID)},
+			signer: from,
+			revert: moment.Locked,
+			apply: func(m *Model, o *outcome) error {
+				moment.Owner = to
+				return nil
+			},
+		}, true
+
+	case LockMoment:
+		moment := m.pickMoment(a)
+		if moment == nil {
+			return nil, false
+		}
+		duration, expired := lockForAYear, false
+		if b%2 == 0 {
+			duration, expired = "0.0", true
+		}
+		return &action{
+			desc:   fmt.Sprintf("user %d locks moment %d for %s seconds", moment.Owner, moment.ID, duration),
+			script: templates.GenerateTopShotLockingLockMomentScript,
+			args:   []cadence.Value{cadence.NewUInt64(moment.ID), testkit.CadenceUFix64(duration)},
+			signer: moment.Owner,
+			apply: func(m *Model, o *outcome) error {
+				// locking a locked moment leaves its expiry as it was
+				if !moment.Locked {
+					moment.Locked, moment.Expired = true, expired
+				}
+				return nil
+			},
+		}, true
+
+	case UnlockMoment:
+		moment := m.pickMoment(a)
+		if moment == nil {
+			return nil, false
+		}
+		return &action{
+			desc:   fmt.Sprintf("user %d unlocks moment %d", moment.Owner, moment.ID),
+			script: templates.GenerateTopShotLockingUnlockMomentScript,
+			args:   []cadence.Value{cadence.NewUInt64(moment.ID)},
+			signer: moment.Owner,
+			revert: moment.Locked && !moment.Expired,
+			apply: func(m *Model, o *outcome) error {
+				moment.Locked, moment.Expired = false, false
+				return nil
+			},
+		}, true
+
+	case DestroyMoments:
+		moment := m.pickMoment(a)
+		if moment == nil {
+			return nil, false
+		}
+		owner := moment.Owner
+		owned := m.owned(owner)
+		n := min(c%maxBatch+1, len(owned))
+		ids := make([]uint64, n)
+		for i := range ids {
+			ids[i] = owned[(b+i)%len(owned)]
+		}
+		return &action{
+			desc:   fmt.Sprintf("user %d destroys moments %v", owner, ids),
+			script: templates.GenerateDestroyMomentsV2Script,
+			args:   []cadence.Value{testkit.UInt64Array(ids...)},
+			signer: owner,
+			apply: func(m *Model, o *outcome) error {
+				for _, id := range ids {
+					delete(m.Moments, id)
+				}
+				m.Destroyed += uint64(len(ids))
+				return nil
+			},
+		}, true
+	}
+
+	return nil, false
+}
+
+// applyMint checks the minted moments against the series and records them
+func applyMint(series Series, quantity int, owner int) func(m *Model, o *outcome) error {
+	return func(m *Model, o *outcome) error {
+		if len(o.minted) != quantity {
+			return fmt.Errorf("expected %d MomentMinted events, got %d", quantity, len(o.minted))
+		}
+
+		for _, minted := range o.minted {
+			if minted.series != series {
+				return fmt.Errorf("moment %d was minted as %+v instead of %+v", minted.id, minted.series, series)
+			}
+			if expected := m.Minted + 1; minted.id != expected {
+				return fmt.Errorf("moment %d should have had ID %d", minted.id, expected)
+			}
+			if expected := m.nextSerialNumber(series); minted.serialNumber != expected {
+				return fmt.Errorf("moment %d of %+v has serial number %d instead of %d", minted.id, series, minted.serialNumber, expected)
+			}
+			m.mint(minted.id, series, minted.serialNumber, owner)
+		}
+		return nil
+	}
+}
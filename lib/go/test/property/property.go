@@ -0,0 +1,156 @@
+// Package property checks invariants of the TopShot and TopShotLocking
+// contracts against random sequences of operations.
+//
+// Every sequence mixes the admin operations (creating plays, sets and
+// subeditions, adding and retiring plays, locking sets and minting, one at a
+// time, in batches or with subeditions) with the users' (transferring,
+// locking, unlocking and destroying moments). A Model tracks what the
+// contracts should do: which transactions they should reject, the IDs and
+// serial numbers they should hand out and who should hold which moment.
+// After every operation the Invariants compare the model with the
+// contracts' state.
+//
+// When a sequence breaks an invariant it is shrunk to a minimal sequence that
+// still breaks one, which is reported with the seed it was generated from:
+//
+//	go test ./property -property.seed=42 -property.runs=10
+package property
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// Config is how many sequences to generate, how long they are and what they must not break
+type Config struct {
+	// Seed is the seed of the first sequence. Run i uses Seed+i.
+	Seed int64
+	// Runs is the number of sequences to generate
+	Runs int
+	// Steps is the number of operations in every sequence
+	Steps int
+	// MaxShrinks bounds the number of sequences run while shrinking a failure
+	MaxShrinks int
+	// Invariants defaults to Invariants
+	Invariants []Invariant
+}
+
+// Failure is a sequence of operations that broke an invariant
+type Failure struct {
+	// Seed is the seed the sequence was generated from
+	Seed int64
+	Ops  []Op
+	// Step is the index of the operation after which the invariant broke
+	Step int
+	// Trace describes the operations up to Step as they were resolved against the model
+	Trace []string
+	Err   error
+}
+
+func (f *Failure) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "seed %d: operation %d broke %v\n", f.Seed, f.Step, f.Err)
+	for _, line := range f.Trace {
+		fmt.Fprintf(&b, "  %s\n", line)
+	}
+	b.WriteString("reproduce with property.Replay(t, []property.Op{\n")
+	for _, op := range f.Ops {
+		fmt.Fprintf(&b, "\t%#v,\n", op)
+	}
+	b.WriteString("})")
+	return b.String()
+}
+
+// Check generates the configured sequences and fails the test with a
+// minimal reproduction of the first one that breaks an invariant
+func Check(t *testing.T, config Config) {
+	if failure := Find(t, config); failure != nil {
+		t.Fatal(failure)
+	}
+}
+
+// Find generates the configured sequences and returns the first one that
+// breaks an invariant, shrunk, or nil if none does
+func Find(t testing.TB, config Config) *Failure {
+	if config.Invariants == nil {
+		config.Invariants = Invariants
+	}
+
+	m := NewMachine(t, config.Invariants)
+	for i := 0; i < config.Runs; i++ {
+		seed := config.Seed + int64(i)
+		ops := Generate(rand.New(rand.NewSource(seed)), config.Steps)
+
+		failure := m.Run(t, ops)
+		if failure == nil {
+			continue
+		}
+		t.Logf("seed %d broke an invariant, shrinking %d operations", seed, len(ops))
+
+		shrunk := Shrink(failure.Ops[:failure.Step+1], config.MaxShrinks, func(ops []Op) bool {
+			return m.Run(t, ops) != nil
+		})
+		if shrunkFailure := m.Run(t, shrunk); shrunkFailure != nil {
+			failure = shrunkFailure
+		}
+		failure.Seed = seed
+		return failure
+	}
+
+	return nil
+}
+
+// Replay runs a sequence of operations, typically a reproduction reported
+// by Check, and fails the test if it breaks an invariant
+func Replay(t *testing.T, ops []Op) {
+	if failure := NewMachine(t, Invariants).Run(t, ops); failure != nil {
+		t.Fatal(failure)
+	}
+}
+
+// Shrink returns a shorter or simpler sequence of operations that still fails,
+// running fails at most maxRuns times, or without bound if maxRuns is zero.
+//
+// It removes ever smaller chunks of operations for as long as the sequence
+// keeps failing, then lowers the arguments of the operations left, so that
+// they pick the first play, set, user or moment where they can.
+func Shrink(ops []Op, maxRuns int, fails func([]Op) bool) []Op {
+	runs := 0
+	try := func(candidate []Op) bool {
+		if maxRuns > 0 && runs >= maxRuns {
+			return false
+		}
+		runs++
+		return fails(candidate)
+	}
+
+	for size := len(ops) / 2; size > 0; size /= 2 {
+		for start := 0; start+size <= len(ops); {
+			candidate := append(append([]Op{}, ops[:start]...), ops[start+size:]...)
+			if try(candidate) {
+				ops = candidate
+			} else {
+				start += size
+			}
+		}
+	}
+
+	for i := range ops {
+		for arg := range ops[i].Args {
+			for _, value := range []int{0, ops[i].Args[arg] / 2} {
+				if value >= ops[i].Args[arg] {
+					continue
+				}
+				candidate := append([]Op{}, ops...)
+				candidate[i].Args[arg] = value
+				if try(candidate) {
+					ops = candidate
+				}
+			}
+		}
+	}
+
+	return ops
+}
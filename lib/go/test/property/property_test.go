@@ -0,0 +1,108 @@
+package property_test
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/property"
+)
+
+var (
+	seed    = flag.Int64("property.seed", 1, "seed of the first generated sequence")
+	runs    = flag.Int("property.runs", 3, "number of sequences to generate")
+	steps   = flag.Int("property.steps", 30, "number of operations in every sequence")
+	shrinks = flag.Int("property.shrinks", 200, "maximum number of sequences run while shrinking a failure")
+)
+
+func TestInvariants(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping property based tests in short mode")
+	}
+
+	property.Check(t, property.Config{
+		Seed:       *seed,
+		Runs:       *runs,
+		Steps:      *steps,
+		MaxShrinks: *shrinks,
+	})
+}
+
+func TestShrink(t *testing.T) {
+	// fails when a transfer follows a mint of a moment to user 0
+	fails := func(ops []property.Op) bool {
+		minted := false
+		for _, op := range ops {
+			if op.Kind == property.Mint && op.Args[2] == 0 {
+				minted = true
+			}
+			if minted && op.Kind == property.Transfer {
+				return true
+			}
+		}
+		return false
+	}
+
+	ops := []property.Op{
+		{Kind: property.CreatePlay, Args: [3]int{5, 6, 7}},
+		{Kind: property.Transfer, Args: [3]int{1, 2, 3}},
+		{Kind: property.CreateSet, Args: [3]int{8, 9, 10}},
+		{Kind: property.Mint, Args: [3]int{11, 12, 0}},
+		{Kind: property.LockMoment, Args: [3]int{13, 14, 15}},
+		{Kind: property.Transfer, Args: [3]int{16, 17, 18}},
+		{Kind: property.DestroyMoments, Args: [3]int{19, 20, 21}},
+	}
+	require.True(t, fails(ops))
+
+	t.Run("Should remove every operation that is not needed and lower the arguments", func(t *testing.T) {
+		assert.Equal(t, []property.Op{
+			{Kind: property.Mint, Args: [3]int{0, 0, 0}},
+			{Kind: property.Transfer, Args: [3]int{0, 0, 0}},
+		}, property.Shrink(ops, 0, fails))
+	})
+
+	t.Run("Should stop after the maximum number of runs", func(t *testing.T) {
+		calls := 0
+		shrunk := property.Shrink(ops, 3, func(ops []property.Op) bool {
+			calls++
+			return fails(ops)
+		})
+
+		assert.Equal(t, 3, calls)
+		assert.True(t, fails(shrunk))
+	})
+}
+
+func TestFindShrinksFailures(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping property based tests in short mode")
+	}
+
+	atMostTwoMoments := property.Invariant{
+		Name: "at most two moments are minted",
+		Check: func(m *property.Model, s *property.State) error {
+			if m.Minted > 2 {
+				return fmt.Errorf("%d moments were minted", m.Minted)
+			}
+			return nil
+		},
+	}
+
+	failure := property.Find(t, property.Config{
+		Seed:       *seed,
+		Runs:       5,
+		Steps:      25,
+		MaxShrinks: 60,
+		Invariants: append([]property.Invariant{atMostTwoMoments}, property.Invariants...),
+	})
+	require.NotNil(t, failure)
+	t.Log(failure)
+
+	assert.ErrorContains(t, failure.Err, atMostTwoMoments.Name)
+	// a play, a set, adding the play to the set and minting three moments in one or more operations
+	assert.LessOrEqual(t, len(failure.Ops), 6)
+	assert.Equal(t, failure.Step, len(failure.Ops)-1)
+}
@@ -0,0 +1,288 @@
+package property
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-emulator/emulator"
+	"github.com/onflow/flow-emulator/storage/sqlite"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
+)
+
+// users is the number of accounts the operations mint to and move moments between
+const users = 3
+
+// stateScript reads everything the invariants compare with the model in a single script
+const stateScript = `
+import TopShot from 0xTOPSHOTADDRESS
+import TopShotLocking from 0xTOPSHOTLOCKINGADDRESS
+
+access(all) struct Edition {
+    access(all) let setID: UInt32
+    access(all) let playID: UInt32
+    access(all) let minted: UInt32
+    access(all) let retired: Bool
+
+    init(setID: UInt32, playID: UInt32, minted: UInt32, retired: Bool) {
+        self.setID = setID
+        self.playID = playID
+        self.minted = minted
+        self.retired = retired
+    }
+}
+
+access(all) struct State {
+    access(all) let totalSupply: UInt64
+    access(all) let collections: [[UInt64]]
+    access(all) let locked: [UInt64]
+    access(all) let lockedSets: [UInt32]
+    access(all) let editions: [Edition]
+
+    init(totalSupply: UInt64, collections: [[UInt64]], locked: [UInt64], lockedSets: [UInt32], editions: [Edition]) {
+        self.totalSupply = totalSupply
+        self.collections = collections
+        self.locked = locked
+        self.lockedSets = lockedSets
+        self.editions = editions
+    }
+}
+
+access(all) fun main(owners: [Address], setIDs: [UInt32]): State {
+    let collections: [[UInt64]] = []
+    for owner in owners {
+        let collection = getAccount(owner).capabilities.borrow<&TopShot.Collection>(/public/MomentCollection)
+            ?? panic("Could not borrow the moment collection of ".concat(owner.toString()))
+        collections.append(collection.getIDs())
+    }
+
+    let lockedSets: [UInt32] = []
+    let editions: [Edition] = []
+    for setID in setIDs {
+        if TopShot.isSetLocked(setID: setID)! {
+            lockedSets.append(setID)
+        }
+        for playID in TopShot.getPlaysInSet(setID: setID)! {
+            editions.append(Edition(
+                setID: setID,
+                playID: playID,
+                minted: TopShot.getNumMomentsInEdition(setID: setID, playID: playID)!,
+                retired: TopShot.isEditionRetired(setID: setID, playID: playID)!
+            ))
+        }
+    }
+
+    return State(
+        totalSupply: TopShot.totalSupply,
+        collections: collections,
+        locked: TopShotLocking.getIDs(),
+        lockedSets: lockedSets,
+        editions: editions
+    )
+}
+`
+
+// EditionState is an edition as the contract sees it
+type EditionState struct {
+	SetID   uint32 `cadence:"setID"`
+	PlayID  uint32 `cadence:"playID"`
+	Minted  uint32 `cadence:"minted"`
+	Retired bool   `cadence:"retired"`
+}
+
+// State is the part of the contracts' state the invariants compare with the model
+type State struct {
+	TotalSupply uint64 `cadence:"totalSupply"`
+	// Collections are the moment IDs in each user's collection
+	Collections [][]uint64 `cadence:"collections"`
+	// Locked are the IDs of the locked moments
+	Locked     []uint64       `cadence:"locked"`
+	LockedSets []uint32       `cadence:"lockedSets"`
+	Editions   []EditionState `cadence:"editions"`
+}
+
+// mintedMoment is a MomentMinted event
+type mintedMoment struct {
+	id           uint64
+	series       Series
+	serialNumber uint32
+}
+
+// outcome is what a successful transaction emitted
+type outcome struct {
+	plays       []uint32
+	sets        []uint32
+	subeditions []uint32
+	minted      []mintedMoment
+}
+
+// expectIDs checks that a creation emitted exactly one event, for the expected ID
+func (o *outcome) expectIDs(event string, ids []uint32, expected uint32) error {
+	if len(ids) != 1 {
+		return fmt.Errorf("expected one %s event, got %d", event, len(ids))
+	}
+	if ids[0] != expected {
+		return fmt.Errorf("%s has ID %d instead of %d", event, ids[0], expected)
+	}
+	return nil
+}
+
+// Machine runs sequences of operations against an emulator, rolling it
+// back to the same starting point before each sequence so that runs and
+// shrinking do not pay for bootstrapping the contracts again
+type Machine struct {
+	kit        *testkit.Blockchain
+	users      []testkit.Account
+	height     uint64
+	invariants []Invariant
+	state      []byte
+}
+
+// NewMachine bootstraps an emulator with testkit, the subedition admin
+// resource and the users, and checks the invariants against it
+func NewMachine(t testing.TB, invariants []Invariant) *Machine {
+	store, err := sqlite.New(sqlite.InMemory)
+	require.NoError(t, err)
+
+	kit := testkit.NewBlockchain(t, emulator.WithStore(store))
+	kit.SendTx(t, templates.GenerateCreateNewSubeditionAdminResourceScript(kit.Env), nil, false, kit.TopShotAdmin)
+
+	m := &Machine{
+		kit:        kit,
+		invariants: invariants,
+		state: []byte(strings.NewReplacer(
+			"0xTOPSHOTADDRESS", "0x"+kit.Env.TopShotAddress,
+			"0xTOPSHOTLOCKINGADDRESS", "0x"+kit.Env.TopShotLockingAddress,
+		).Replace(stateScript)),
+	}
+	for range users {
+		m.users = append(m.users, kit.SetupAccount(t))
+	}
+
+	block, err := kit.GetLatestBlock()
+	require.NoError(t, err)
+	m.height = block.Height
+
+	return m
+}
+
+// Run runs the operations from the starting point and returns the first
+// invariant they break, or nil
+func (m *Machine) Run(t testing.TB, ops []Op) *Failure {
+	m.reset(t)
+
+	model := NewModel(len(m.users))
+	var trace []string
+
+	fail := func(step int, err error) *Failure {
+		return &Failure{Ops: ops, Step: step, Trace: trace, Err: err}
+	}
+
+	for i, op := range ops {
+		a, ok := model.resolve(op, m.users)
+		if !ok {
+			trace = append(trace, fmt.Sprintf("%d: %s does not apply", i, op.Kind))
+			continue
+		}
+		if a.revert {
+			trace = append(trace, fmt.Sprintf("%d: %s, which should be rejected", i, a.desc))
+		} else {
+			trace = append(trace, fmt.Sprintf("%d: %s", i, a.desc))
+		}
+
+		signer := m.kit.TopShotAdmin
+		if a.signer != admin {
+			signer = m.users[a.signer]
+		}
+		result := m.kit.TrySendTx(t, a.script(m.kit.Env), a.args, signer)
+
+		switch {
+		case a.revert && !result.Reverted():
+			return fail(i, fmt.Errorf("the transaction should have been rejected"))
+		case !a.revert && result.Reverted():
+			return fail(i, fmt.Errorf("the transaction failed: %s", firstLine(result.Error.Error())))
+		case !a.revert:
+			if err := a.apply(model, decodeOutcome(t, result)); err != nil {
+				return fail(i, err)
+			}
+		}
+
+		state := m.readState(t, model)
+		for _, invariant := range m.invariants {
+			if err := invariant.Check(model, state); err != nil {
+				return fail(i, fmt.Errorf("%s: %w", invariant.Name, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// reset rolls the emulator back to where NewMachine left it
+func (m *Machine) reset(t testing.TB) {
+	block, err := m.kit.GetLatestBlock()
+	require.NoError(t, err)
+
+	if block.Height > m.height {
+		require.NoError(t, m.kit.RollbackToBlockHeight(m.height))
+	}
+}
+
+func (m *Machine) readState(t testing.TB, model *Model) *State {
+	owners := make([]cadence.Value, len(m.users))
+	for i, user := range m.users {
+		owners[i] = cadence.NewAddress(user.Address)
+	}
+	setIDs := make([]uint32, len(model.Sets))
+	for i, set := range model.Sets {
+		setIDs[i] = set.ID
+	}
+
+	result := m.kit.ExecuteScript(t, m.state,
+		cadence.NewArray(owners).WithType(cadence.NewVariableSizedArrayType(cadence.AddressType)),
+		testkit.UInt32Array(setIDs...),
+	)
+
+	state := &State{}
+	require.NoError(t, cadence.DecodeFields(result.(cadence.Struct), state))
+	return state
+}
+
+func decodeOutcome(t testing.TB, result *types.TransactionResult) *outcome {
+	o := &outcome{}
+
+	for _, event := range testkit.DecodeEvents(t, result, events.EventPlayCreated, events.DecodePlayCreatedEvent) {
+		o.plays = append(o.plays, event.Id())
+	}
+	for _, event := range testkit.DecodeEvents(t, result, events.EventSetCreated, events.DecodeSetCreatedEvent) {
+		o.sets = append(o.sets, event.SetID())
+	}
+	for _, event := range testkit.DecodeEvents(t, result, events.EventSubeditionCreated, events.DecodeSubeditionCreatedEvent) {
+		o.subeditions = append(o.subeditions, event.SubeditionId())
+	}
+	for _, event := range testkit.DecodeEvents(t, result, events.EventMomentMinted, events.DecodeMomentMintedEvent) {
+		o.minted = append(o.minted, mintedMoment{
+			id: event.MomentId(),
+			series: Series{
+				Edition:      Edition{SetID: event.SetId(), PlayID: event.PlayId()},
+				SubeditionID: event.SubeditionId(),
+			},
+			serialNumber: event.SerialNumber(),
+		})
+	}
+
+	return o
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
@@ -0,0 +1,34 @@
+package scenario
+
+import (
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+)
+
+// decoders are the lib/go/events decoders of the events a scenario may expect.
+// Events of these types must decode before their fields are compared;
+// events of any other type are only compared field by field.
+var decoders = map[string]func([]byte) error{
+	events.GenericNFTEventDeposit:       decodeWith(events.DecodeDepositEvent),
+	events.TopShotEventDeposit:          decodeWith(events.DecodeDepositEvent),
+	events.EventWithdraw:                decodeWith(events.DecodeWithdrawEvent),
+	events.EventMomentMinted:            decodeWith(events.DecodeMomentMintedEvent),
+	events.EventMomentDestroyed:         decodeWith(events.DecodeMomentDestroyedEvent),
+	events.EventMomentDestroyedV2:       decodeWith(events.DecodeMomentDestroyedEvent),
+	events.MomentLocked:                 decodeWith(events.DecodeMomentLockedEvent),
+	events.MomentUnlocked:               decodeWith(events.DecodeMomentUnlockedEvent),
+	events.EventPlayCreated:             decodeWith(events.DecodePlayCreatedEvent),
+	events.EventSetCreated:              decodeWith(events.DecodeSetCreatedEvent),
+	events.EventSetLocked:               decodeWith(events.DecodeSetLockedEvent),
+	events.EventPlayAddedToSet:          decodeWith(events.DecodePlayAddedToSetEvent),
+	events.EventPlayRetiredFromSet:      decodeWith(events.DecodeSetPlayRetiredEvent),
+	events.EventSubeditionCreated:       decodeWith(events.DecodeSubeditionCreatedEvent),
+	events.EventSubeditionAddedToMoment: decodeWith(events.DecodeSubeditionAddedToMomentEvent),
+	events.EventRevealed:                decodeWith(events.DecodeRevealedEvent),
+}
+
+func decodeWith[T any](decode func([]byte) (T, error)) func([]byte) error {
+	return func(payload []byte) error {
+		_, err := decode(payload)
+		return err
+	}
+}
@@ -0,0 +1,238 @@
+package scenario
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events/decoder"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
+)
+
+// builtinActors are the accounts deployed by testkit that scenarios can sign with
+var builtinActors = map[string]func(*testkit.Blockchain) testkit.Account{
+	"admin":       func(b *testkit.Blockchain) testkit.Account { return b.TopShotAdmin },
+	"locking":     func(b *testkit.Blockchain) testkit.Account { return b.Locking },
+	"duc":         func(b *testkit.Blockchain) testkit.Account { return b.DUC },
+	"market":      func(b *testkit.Blockchain) testkit.Account { return b.Market },
+	"beneficiary": func(b *testkit.Blockchain) testkit.Account { return b.MarketBeneficiary },
+	"fastbreak":   func(b *testkit.Blockchain) testkit.Account { return b.FastBreak },
+	"service":     func(b *testkit.Blockchain) testkit.Account { return b.Service() },
+}
+
+// kitTemplates are scripts provided by testkit rather than the templates
+// catalog, looked up under the same kind of names
+var kitTemplates = map[string]func(*testkit.Blockchain) []byte{
+	"duc/get_balance": (*testkit.Blockchain).DUCBalanceScript,
+}
+
+// state is what a running scenario knows about: its actors and captured values
+type state struct {
+	kit    *testkit.Blockchain
+	actors map[string]testkit.Account
+	vars   map[string]cadence.Value
+}
+
+// RunDir runs every .yaml, .yml and .json scenario in the directory as a subtest
+func RunDir(t *testing.T, dir string) {
+	var paths []string
+	for _, pattern := range []string{"*.yaml", "*.yml", "*.json"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		require.NoError(t, err)
+		paths = append(paths, matches...)
+	}
+	sort.Strings(paths)
+	require.NotEmpty(t, paths, "no scenarios in %s", dir)
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			RunFile(t, path)
+		})
+	}
+}
+
+// RunFile loads the scenario in the file and runs it
+func RunFile(t *testing.T, path string) {
+	s, err := Load(path)
+	require.NoError(t, err)
+
+	Run(t, s)
+}
+
+// Run bootstraps a new emulator with testkit and runs the scenario against it.
+//
+// Steps run in order as subtests and the scenario stops at the first step that fails,
+// since later steps usually depend on it. Assertions run once every step has passed.
+func Run(t *testing.T, s *Scenario) {
+	require.NoError(t, s.Validate())
+
+	kit := testkit.NewBlockchain(t)
+	st := &state{
+		kit:    kit,
+		actors: map[string]testkit.Account{},
+		vars:   map[string]cadence.Value{},
+	}
+
+	for name, account := range builtinActors {
+		st.actors[name] = account(kit)
+	}
+
+	for _, actor := range s.Actors {
+		account := kit.SetupAccount(t)
+		if actor.DUC != "" {
+			kit.MintDUC(t, account, actor.DUC)
+		}
+		st.actors[actor.Name] = account
+	}
+
+	for i, step := range s.Steps {
+		if !t.Run(fmt.Sprintf("%02d %s", i+1, step.Title()), func(t *testing.T) {
+			st.run(t, step)
+		}) {
+			return
+		}
+	}
+
+	for _, step := range s.Assertions {
+		t.Run("assert "+step.Title(), func(t *testing.T) {
+			st.run(t, step)
+		})
+	}
+}
+
+func (s *state) run(t *testing.T, step Step) {
+	name := step.Tx
+	if name == "" {
+		name = step.Script
+	}
+	var code []byte
+	if generate, ok := kitTemplates[name]; ok {
+		code = generate(s.kit)
+	} else {
+		var err error
+		code, err = templates.GenerateByName(name, s.kit.Env)
+		require.NoError(t, err)
+	}
+
+	args := make([]cadence.Value, len(step.Args))
+	for i, arg := range step.Args {
+		argType, err := parseType(arg.Type)
+		require.NoError(t, err, "argument %d", i)
+
+		args[i], err = s.newValue(argType, arg.Value)
+		require.NoError(t, err, "argument %d", i)
+	}
+
+	if step.Tx != "" {
+		s.runTransaction(t, step, code, args)
+	} else {
+		s.runScript(t, step, code, args)
+	}
+}
+
+func (s *state) runTransaction(t *testing.T, step Step, code []byte, args []cadence.Value) {
+	signers := make([]testkit.Account, len(step.Signers))
+	for i, name := range step.Signers {
+		account, ok := s.actors[name]
+		require.True(t, ok, "unknown signer %q", name)
+		signers[i] = account
+	}
+
+	result := s.kit.SendTx(t, code, args, step.Expect.Revert, signers...)
+
+	if step.Expect.Error != "" {
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), step.Expect.Error)
+	}
+
+	for _, expected := range step.Expect.Events {
+		s.checkEvent(t, result, expected)
+	}
+}
+
+func (s *state) checkEvent(t *testing.T, result *types.TransactionResult, expected ExpectedEvent) {
+	payloads := testkit.EventPayloads(t, result, expected.Type)
+
+	if expected.Count != nil {
+		require.Len(t, payloads, *expected.Count, "number of %s events", expected.Type)
+		if *expected.Count == 0 {
+			return
+		}
+	}
+	require.NotEmpty(t, payloads, "no %s event was emitted", expected.Type)
+
+	var emitted []string
+	for _, payload := range payloads {
+		if decode, ok := decoders[expected.Type]; ok {
+			require.NoError(t, decode(payload), "decoding %s", expected.Type)
+		}
+
+		event, err := decoder.GetCadenceEvent(payload)
+		require.NoError(t, err)
+
+		if ok, err := s.matches(event, map[string]any(expected.Fields), false); err != nil || !ok {
+			require.NoError(t, err)
+			emitted = append(emitted, canonical(event))
+			continue
+		}
+
+		fields := cadence.FieldsMappedByName(event)
+		for name, field := range expected.Capture {
+			value, ok := fields[field]
+			require.True(t, ok, "%s has no field %q", expected.Type, field)
+			s.vars[name] = value
+		}
+		return
+	}
+
+	require.Failf(t, "no matching event", "no %s event has the fields %v, emitted:\n%s",
+		expected.Type, expected.Fields, strings.Join(emitted, "\n"))
+}
+
+func (s *state) runScript(t *testing.T, step Step, code []byte, args []cadence.Value) {
+	result := s.kit.ExecuteScript(t, code, args...)
+
+	if step.Expect.Value != nil {
+		ok, err := s.matches(result, step.Expect.Value, step.Expect.Unordered)
+		require.NoError(t, err)
+		assert.True(t, ok, "%s returned %s, expected %v", step.Script, canonical(result), step.Expect.Value)
+	}
+
+	if step.Capture != "" {
+		s.vars[step.Capture] = result
+	}
+}
+
+// resolve returns the captured value, or the address of the actor, with the name
+func (s *state) resolve(t cadence.Type, name string) (cadence.Value, error) {
+	if value, ok := s.vars[name]; ok {
+		return value, nil
+	}
+
+	account, ok := s.actors[name]
+	if !ok {
+		return nil, fmt.Errorf("$%s is neither an actor nor a captured value", name)
+	}
+
+	address := cadence.NewAddress(account.Address)
+	switch t := t.(type) {
+	case *cadence.OptionalType:
+		if t.Type == cadence.AddressType {
+			return cadence.NewOptional(address), nil
+		}
+	default:
+		if t == cadence.AddressType {
+			return address, nil
+		}
+	}
+
+	return nil, fmt.Errorf("actor $%s used as a %s", name, t.ID())
+}
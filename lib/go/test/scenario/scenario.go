@@ -0,0 +1,211 @@
+// Package scenario runs declarative integration tests against an emulator
+// bootstrapped by testkit.
+//
+// A scenario is a YAML or JSON file that names the actors taking part, the
+// transactions and scripts to run, referenced by their templates.Catalog
+// name, and what is expected of each of them:
+//
+//	name: Sell a moment on the V3 market
+//	actors:
+//	  - name: seller
+//	  - name: buyer
+//	    duc: "100.0"
+//	steps:
+//	  - name: create a play
+//	    tx: admin/create_play
+//	    signers: [admin]
+//	    args:
+//	      - {type: "{String: String}", value: {FullName: Lebron}}
+//	    expect:
+//	      events:
+//	        - type: TopShot.PlayCreated
+//	          capture: {playID: id}
+//	  ...
+//	assertions:
+//	  - script: scripts/get_totalSupply
+//	    expect:
+//	      value: 1
+//
+// Besides the catalog, the script duc/get_balance returns the DapperUtilityCoin
+// balance of an address.
+//
+// Every actor is a new account with a moment collection and a DUC vault.
+// The accounts deployed by testkit are available as the built-in actors
+// admin, locking, duc, market, beneficiary, fastbreak and service.
+//
+// Argument values starting with "$" refer to an actor, whose address is
+// used, or to a value captured from an earlier event or script result.
+package scenario
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario is a sequence of steps run against a freshly bootstrapped emulator
+type Scenario struct {
+	Name   string  `json:"name" yaml:"name"`
+	Actors []Actor `json:"actors" yaml:"actors"`
+	Steps  []Step  `json:"steps" yaml:"steps"`
+	// Assertions are scripts run once every step has passed to check the final state
+	Assertions []Step `json:"assertions" yaml:"assertions"`
+}
+
+// Actor is an account created for the scenario
+type Actor struct {
+	Name string `json:"name" yaml:"name"`
+	// DUC is an optional amount of DapperUtilityCoin minted to the actor
+	DUC string `json:"duc" yaml:"duc"`
+}
+
+// Step is a transaction or a script taken from the templates catalog
+type Step struct {
+	Name string `json:"name" yaml:"name"`
+	// Tx is the catalog name of a transaction, e.g. admin/mint_moment
+	Tx string `json:"tx" yaml:"tx"`
+	// Script is the catalog name of a script, e.g. scripts/get_totalSupply
+	Script string `json:"script" yaml:"script"`
+	// Signers are the actors authorizing a transaction
+	Signers []string `json:"signers" yaml:"signers"`
+	Args    []Arg    `json:"args" yaml:"args"`
+	Expect  Expect   `json:"expect" yaml:"expect"`
+	// Capture stores the result of a script under a name for later steps
+	Capture string `json:"capture" yaml:"capture"`
+}
+
+// Arg is a typed transaction or script argument, e.g. {type: UInt64, value: 1}
+type Arg struct {
+	Type  string `json:"type" yaml:"type"`
+	Value any    `json:"value" yaml:"value"`
+}
+
+// Expect describes the outcome of a step
+type Expect struct {
+	// Revert is whether a transaction should fail
+	Revert bool `json:"revert" yaml:"revert"`
+	// Error is a substring of the error of a reverted transaction
+	Error  string          `json:"error" yaml:"error"`
+	Events []ExpectedEvent `json:"events" yaml:"events"`
+	// Value is the result of a script, in the form of an argument value of the result's type
+	Value any `json:"value" yaml:"value"`
+	// Unordered compares an array result without regard to the order of its elements
+	Unordered bool `json:"unordered" yaml:"unordered"`
+}
+
+// ExpectedEvent is an event a transaction should emit
+type ExpectedEvent struct {
+	// Type is the qualified identifier of the event, e.g. TopShot.MomentMinted
+	Type string `json:"type" yaml:"type"`
+	// Count is the exact number of events of the type, if set
+	Count *int `json:"count" yaml:"count"`
+	// Fields must all match one of the emitted events of the type
+	Fields map[string]any `json:"fields" yaml:"fields"`
+	// Capture stores fields of the matching event under a name for later steps
+	Capture map[string]string `json:"capture" yaml:"capture"`
+}
+
+// Load reads a scenario from a .yaml, .yml or .json file
+func Load(path string) (*Scenario, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Scenario{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(contents))
+		decoder.UseNumber()
+		err = decoder.Decode(s)
+	case ".yaml", ".yml":
+		var document yaml.Node
+		if err = yaml.Unmarshal(contents, &document); err == nil {
+			keepDigits(&document)
+			err = document.Decode(s)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported scenario file: %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if s.Name == "" {
+		s.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	return s, s.Validate()
+}
+
+// keepDigits makes the floats, and the integers that do not fit in 64 bits,
+// decode as the strings they are written as, so that UFix64 and UInt64 values
+// are not rounded through a float64
+func keepDigits(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode {
+		switch node.ShortTag() {
+		case "!!float":
+			node.Tag = "!!str"
+		case "!!int":
+			if _, err := strconv.ParseInt(node.Value, 0, 64); err != nil {
+				if _, err := strconv.ParseUint(node.Value, 0, 64); err != nil {
+					node.Tag = "!!str"
+				}
+			}
+		}
+	}
+	for _, child := range node.Content {
+		keepDigits(child)
+	}
+}
+
+// Validate checks that every step names exactly one template and that every actor has a unique name
+func (s *Scenario) Validate() error {
+	seen := map[string]bool{}
+	for _, actor := range s.Actors {
+		if actor.Name == "" {
+			return fmt.Errorf("scenario %q: actor without a name", s.Name)
+		}
+		if _, ok := builtinActors[actor.Name]; ok || seen[actor.Name] {
+			return fmt.Errorf("scenario %q: actor %q is already defined", s.Name, actor.Name)
+		}
+		seen[actor.Name] = true
+	}
+
+	for i, step := range append(append([]Step{}, s.Steps...), s.Assertions...) {
+		if (step.Tx == "") == (step.Script == "") {
+			return fmt.Errorf("scenario %q: step %d must have exactly one of tx or script", s.Name, i)
+		}
+		if step.Script != "" && (len(step.Signers) != 0 || step.Expect.Revert || len(step.Expect.Events) != 0) {
+			return fmt.Errorf("scenario %q: script step %d cannot have signers, revert or events", s.Name, i)
+		}
+		if step.Tx != "" && (step.Expect.Value != nil || step.Capture != "") {
+			return fmt.Errorf("scenario %q: transaction step %d cannot expect or capture a value", s.Name, i)
+		}
+	}
+
+	for i, step := range s.Assertions {
+		if step.Script == "" {
+			return fmt.Errorf("scenario %q: assertion %d must be a script", s.Name, i)
+		}
+	}
+
+	return nil
+}
+
+// Title returns the name of the step, or the template it runs
+func (step Step) Title() string {
+	if step.Name != "" {
+		return step.Name
+	}
+	if step.Tx != "" {
+		return step.Tx
+	}
+	return step.Script
+}
@@ -0,0 +1,55 @@
+package scenario_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/scenario"
+)
+
+func TestScenarios(t *testing.T) {
+	scenario.RunDir(t, "testdata")
+}
+
+func TestLoadKeepsDigits(t *testing.T) {
+	files := map[string]string{
+		"values.yaml": `steps:
+  - tx: admin/mint_moment
+    args:
+      - {type: UInt64, value: 18446744073709551615}
+      - {type: UFix64, value: 92233720368.54775807}
+      - {type: UInt, value: 340282366920938463463374607431768211456}
+      - {type: UInt32, value: 7}
+`,
+		"values.json": `{"steps": [{"tx": "admin/mint_moment", "args": [
+	{"type": "UInt64", "value": 18446744073709551615},
+	{"type": "UFix64", "value": 92233720368.54775807},
+	{"type": "UInt", "value": 340282366920938463463374607431768211456},
+	{"type": "UInt32", "value": 7}
+]}]}`,
+	}
+	for name, contents := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			require.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+
+			s, err := scenario.Load(path)
+			require.NoError(t, err)
+			var values []string
+			for _, arg := range s.Steps[0].Args {
+				values = append(values, fmt.Sprint(arg.Value))
+			}
+			assert.Equal(t, []string{
+				"18446744073709551615",
+				"92233720368.54775807",
+				"340282366920938463463374607431768211456",
+				"7",
+			}, values)
+		})
+	}
+}
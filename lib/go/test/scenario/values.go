@@ -0,0 +1,388 @@
+package scenario
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/flow-go-sdk"
+)
+
+var primitiveTypes = map[string]cadence.Type{
+	"String":      cadence.StringType,
+	"Bool":        cadence.BoolType,
+	"Address":     cadence.AddressType,
+	"Int":         cadence.IntType,
+	"Int8":        cadence.Int8Type,
+	"Int16":       cadence.Int16Type,
+	"Int32":       cadence.Int32Type,
+	"Int64":       cadence.Int64Type,
+	"UInt":        cadence.UIntType,
+	"UInt8":       cadence.UInt8Type,
+	"UInt16":      cadence.UInt16Type,
+	"UInt32":      cadence.UInt32Type,
+	"UInt64":      cadence.UInt64Type,
+	"Word8":       cadence.Word8Type,
+	"Word16":      cadence.Word16Type,
+	"Word32":      cadence.Word32Type,
+	"Word64":      cadence.Word64Type,
+	"UFix64":      cadence.UFix64Type,
+	"Fix64":       cadence.Fix64Type,
+	"Path":        cadence.PathType,
+	"StoragePath": cadence.StoragePathType,
+	"PublicPath":  cadence.PublicPathType,
+}
+
+// parseType parses a Cadence type expression such as UInt64, [UInt32],
+// {String: String} or Address?
+func parseType(name string) (cadence.Type, error) {
+	name = strings.TrimSpace(name)
+
+	switch {
+	case strings.HasSuffix(name, "?"):
+		inner, err := parseType(strings.TrimSuffix(name, "?"))
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptionalType(inner), nil
+
+	case strings.HasPrefix(name, "[") && strings.HasSuffix(name, "]"):
+		element, err := parseType(name[1 : len(name)-1])
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewVariableSizedArrayType(element), nil
+
+	case strings.HasPrefix(name, "{") && strings.HasSuffix(name, "}"):
+		key, value, ok := splitDictionaryType(name[1 : len(name)-1])
+		if !ok {
+			return nil, fmt.Errorf("invalid dictionary type: %s", name)
+		}
+		keyType, err := parseType(key)
+		if err != nil {
+			return nil, err
+		}
+		valueType, err := parseType(value)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewDictionaryType(keyType, valueType), nil
+	}
+
+	if t, ok := primitiveTypes[name]; ok {
+		return t, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", name)
+}
+
+// splitDictionaryType splits "K: V" at the colon that is not nested in the value type
+func splitDictionaryType(body string) (string, string, bool) {
+	depth := 0
+	for i, c := range body {
+		switch c {
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		case ':':
+			if depth == 0 {
+				return body[:i], body[i+1:], true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// newValue builds a value of the type from its YAML or JSON representation
+func (s *state) newValue(t cadence.Type, raw any) (cadence.Value, error) {
+	if ref, ok := reference(raw); ok {
+		return s.resolve(t, ref)
+	}
+
+	switch t := t.(type) {
+	case *cadence.OptionalType:
+		if raw == nil {
+			return cadence.NewOptional(nil), nil
+		}
+		inner, err := s.newValue(t.Type, raw)
+		if err != nil {
+			return nil, err
+		}
+		return cadence.NewOptional(inner), nil
+
+	case *cadence.VariableSizedArrayType:
+		list, ok := raw.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected a list for %s, got %v", t.ID(), raw)
+		}
+		values := make([]cadence.Value, len(list))
+		for i, element := range list {
+			value, err := s.newValue(t.ElementType, element)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return cadence.NewArray(values).WithType(t), nil
+
+	case *cadence.DictionaryType:
+		entries, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected a mapping for %s, got %v", t.ID(), raw)
+		}
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		pairs := make([]cadence.KeyValuePair, 0, len(keys))
+		for _, key := range keys {
+			k, err := s.newValue(t.KeyType, key)
+			if err != nil {
+				return nil, err
+			}
+			v, err := s.newValue(t.ElementType, entries[key])
+			if err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, cadence.KeyValuePair{Key: k, Value: v})
+		}
+		return cadence.NewDictionary(pairs).WithType(t), nil
+	}
+
+	return newPrimitiveValue(t, scalar(raw))
+}
+
+func newPrimitiveValue(t cadence.Type, value string) (cadence.Value, error) {
+	switch t {
+	case cadence.StringType:
+		return cadence.NewString(value)
+	case cadence.BoolType:
+		b, err := strconv.ParseBool(value)
+		return cadence.NewBool(b), err
+	case cadence.AddressType:
+		return cadence.NewAddress(flow.HexToAddress(value)), nil
+	case cadence.IntType, cadence.UIntType:
+		i, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s: %s", t.ID(), value)
+		}
+		if t == cadence.IntType {
+			return cadence.NewIntFromBig(i), nil
+		}
+		return cadence.NewUIntFromBig(i)
+	case cadence.Int8Type, cadence.Int16Type, cadence.Int32Type, cadence.Int64Type:
+		return newSignedValue(t, value)
+	case cadence.UInt8Type, cadence.UInt16Type, cadence.UInt32Type, cadence.UInt64Type,
+		cadence.Word8Type, cadence.Word16Type, cadence.Word32Type, cadence.Word64Type:
+		return newUnsignedValue(t, value)
+	case cadence.UFix64Type:
+		return cadence.NewUFix64(value)
+	case cadence.Fix64Type:
+		return cadence.NewFix64(value)
+	case cadence.PathType, cadence.StoragePathType, cadence.PublicPathType:
+		parts := strings.SplitN(strings.TrimPrefix(value, "/"), "/", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid path: %s", value)
+		}
+		return cadence.NewPath(common.PathDomainFromIdentifier(parts[0]), parts[1])
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", t.ID())
+}
+
+func newSignedValue(t cadence.Type, value string) (cadence.Value, error) {
+	bits := map[cadence.Type]int{cadence.Int8Type: 8, cadence.Int16Type: 16, cadence.Int32Type: 32, cadence.Int64Type: 64}[t]
+	i, err := strconv.ParseInt(value, 10, bits)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case cadence.Int8Type:
+		return cadence.NewInt8(int8(i)), nil
+	case cadence.Int16Type:
+		return cadence.NewInt16(int16(i)), nil
+	case cadence.Int32Type:
+		return cadence.NewInt32(int32(i)), nil
+	default:
+		return cadence.NewInt64(i), nil
+	}
+}
+
+func newUnsignedValue(t cadence.Type, value string) (cadence.Value, error) {
+	bits := map[cadence.Type]int{
+		cadence.UInt8Type: 8, cadence.UInt16Type: 16, cadence.UInt32Type: 32, cadence.UInt64Type: 64,
+		cadence.Word8Type: 8, cadence.Word16Type: 16, cadence.Word32Type: 32, cadence.Word64Type: 64,
+	}[t]
+	u, err := strconv.ParseUint(value, 10, bits)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case cadence.UInt8Type:
+		return cadence.NewUInt8(uint8(u)), nil
+	case cadence.UInt16Type:
+		return cadence.NewUInt16(uint16(u)), nil
+	case cadence.UInt32Type:
+		return cadence.NewUInt32(uint32(u)), nil
+	case cadence.UInt64Type:
+		return cadence.NewUInt64(u), nil
+	case cadence.Word8Type:
+		return cadence.NewWord8(uint8(u)), nil
+	case cadence.Word16Type:
+		return cadence.NewWord16(uint16(u)), nil
+	case cadence.Word32Type:
+		return cadence.NewWord32(uint32(u)), nil
+	default:
+		return cadence.NewWord64(u), nil
+	}
+}
+
+// scalar formats a YAML or JSON scalar. Load keeps the numbers of a file as
+// they are written, the float64 of scenarios built in Go are formatted without
+// losing the digits of whole floats
+func scalar(raw any) string {
+	if f, ok := raw.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(raw)
+}
+
+// reference returns the name in a "$name" value
+func reference(raw any) (string, bool) {
+	s, ok := raw.(string)
+	if !ok || !strings.HasPrefix(s, "$") {
+		return "", false
+	}
+	return strings.TrimPrefix(s, "$"), true
+}
+
+// matches reports whether an actual value has the expected YAML or JSON representation.
+//
+// Composite values only have the expected fields compared,
+// and unordered arrays may list their elements in any order.
+func (s *state) matches(actual cadence.Value, expected any, unordered bool) (bool, error) {
+	if ref, ok := reference(expected); ok {
+		value, err := s.resolve(actual.Type(), ref)
+		if err != nil {
+			return false, err
+		}
+		return canonical(actual) == canonical(value), nil
+	}
+
+	switch actual := actual.(type) {
+	case cadence.Optional:
+		if actual.Value == nil || expected == nil {
+			return actual.Value == nil && expected == nil, nil
+		}
+		return s.matches(actual.Value, expected, unordered)
+
+	case cadence.Array:
+		list, ok := expected.([]any)
+		if !ok || len(list) != len(actual.Values) {
+			return false, nil
+		}
+		used := make([]bool, len(actual.Values))
+		for i, element := range list {
+			found := false
+			for j, value := range actual.Values {
+				if used[j] || (!unordered && i != j) {
+					continue
+				}
+				ok, err := s.matches(value, element, false)
+				if err != nil {
+					return false, err
+				}
+				if ok {
+					used[j], found = true, true
+					break
+				}
+			}
+			if !found {
+				return false, nil
+			}
+		}
+		return true, nil
+
+	case cadence.Dictionary:
+		entries, ok := expected.(map[string]any)
+		if !ok || len(entries) != len(actual.Pairs) {
+			return false, nil
+		}
+		for _, pair := range actual.Pairs {
+			value, ok := entries[keyString(pair.Key)]
+			if !ok {
+				return false, nil
+			}
+			if ok, err := s.matches(pair.Value, value, false); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+
+	case cadence.Composite:
+		fields, ok := expected.(map[string]any)
+		if !ok {
+			return false, nil
+		}
+		actualFields := cadence.FieldsMappedByName(actual)
+		for name, value := range fields {
+			field, ok := actualFields[name]
+			if !ok {
+				return false, nil
+			}
+			if ok, err := s.matches(field, value, false); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
+	value, err := newPrimitiveValue(actual.Type(), scalar(expected))
+	if err != nil {
+		return false, err
+	}
+	return canonical(actual) == canonical(value), nil
+}
+
+// canonical formats a value so that equal values format the same,
+// whatever order the pairs of their dictionaries are in
+func canonical(value cadence.Value) string {
+	switch value := value.(type) {
+	case cadence.Optional:
+		if value.Value == nil {
+			return "nil"
+		}
+		return canonical(value.Value)
+	case cadence.Array:
+		elements := make([]string, len(value.Values))
+		for i, element := range value.Values {
+			elements[i] = canonical(element)
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	case cadence.Dictionary:
+		pairs := make([]string, len(value.Pairs))
+		for i, pair := range value.Pairs {
+			pairs[i] = canonical(pair.Key) + ": " + canonical(pair.Value)
+		}
+		sort.Strings(pairs)
+		return "{" + strings.Join(pairs, ", ") + "}"
+	}
+	return value.String()
+}
+
+// keyString returns a dictionary key the way it is written in a scenario file
+func keyString(key cadence.Value) string {
+	if s, ok := key.(cadence.String); ok {
+		return string(s)
+	}
+	return key.String()
+}
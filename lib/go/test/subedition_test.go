@@ -552,6 +552,21 @@ func TestSubeditions(t *testing.T) {
 		assert.Equal(t, cadence.NewUInt32(5), result)
 	})
 
+	t.Run("Should be able to get the number of moments minted with a subedition", func(t *testing.T) {
+		result = executeScriptAndCheck(t, b, templates.GenerateGetNumMomentsInSubeditionScript(env), [][]byte{jsoncdc.MustEncode(cadence.UInt32(1)), jsoncdc.MustEncode(cadence.UInt32(1)), jsoncdc.MustEncode(cadence.UInt32(1))})
+		assert.Equal(t, cadence.NewUInt32(1), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetNumMomentsInSubeditionScript(env), [][]byte{jsoncdc.MustEncode(cadence.UInt32(1)), jsoncdc.MustEncode(cadence.UInt32(3)), jsoncdc.MustEncode(cadence.UInt32(3))})
+		assert.Equal(t, cadence.NewUInt32(5), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetNumMomentsInSubeditionScript(env), [][]byte{jsoncdc.MustEncode(cadence.UInt32(1)), jsoncdc.MustEncode(cadence.UInt32(3)), jsoncdc.MustEncode(cadence.UInt32(4))})
+		assert.Equal(t, cadence.NewUInt32(5), result)
+
+		// subeditions no moment was minted with are counted as zero
+		result = executeScriptAndCheck(t, b, templates.GenerateGetNumMomentsInSubeditionScript(env), [][]byte{jsoncdc.MustEncode(cadence.UInt32(1)), jsoncdc.MustEncode(cadence.UInt32(3)), jsoncdc.MustEncode(cadence.UInt32(1))})
+		assert.Equal(t, cadence.NewUInt32(0), result)
+	})
+
 	t.Run("Should be able to mint a batch of moments with subedition and fulfill a pack", func(t *testing.T) {
 		tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateBatchMintMomentWithSubeditionScript(env), topshotAddr)
 
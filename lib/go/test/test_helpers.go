@@ -3,11 +3,9 @@ package test
 import (
 	"testing"
 
-	"github.com/onflow/flow-emulator/convert"
 	"github.com/onflow/flow-go-sdk"
 	sdk "github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/crypto"
-	sdktemplates "github.com/onflow/flow-go-sdk/templates"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -16,6 +14,7 @@ import (
 	"github.com/onflow/flow-emulator/emulator"
 
 	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
 )
 
 // / Used to verify set metadata in tests
@@ -36,34 +35,13 @@ func verifyQuerySetMetadata(
 	env templates.Environment,
 	expectedMetadata SetMetadata) {
 
-	result := executeScriptAndCheck(t, b, templates.GenerateGetSetMetadataScript(env), [][]byte{jsoncdc.MustEncode(cadence.UInt32(expectedMetadata.setID))})
-	metadataStruct := result.(cadence.Struct)
-
-	//setID := metadataFields[0]
-	setID := cadence.SearchFieldByName(metadataStruct, "setID")
-	assertEqual(t, cadence.NewUInt32(expectedMetadata.setID), setID)
-
-	//name := metadataFields[1]
-	name := cadence.SearchFieldByName(metadataStruct, "name")
-	assertEqual(t, CadenceString(expectedMetadata.name), name)
-
-	//series := metadataFields[2]
-	series := cadence.SearchFieldByName(metadataStruct, "series")
-	assertEqual(t, cadence.NewUInt32(expectedMetadata.series), series)
-
-	if len(expectedMetadata.plays) != 0 {
-		plays := cadence.SearchFieldByName(metadataStruct, "plays").(cadence.Array).Values
-
-		for i, play := range plays {
-			expectedPlayID := cadence.NewUInt32(expectedMetadata.plays[i])
-			assertEqual(t, expectedPlayID, play)
-		}
-	}
-
-	//locked := metadataFields[5]
-	locked := cadence.SearchFieldByName(metadataStruct, "locked")
-	assertEqual(t, cadence.NewBool(expectedMetadata.locked), locked)
-
+	testkit.VerifyQuerySetMetadata(t, b, env, testkit.SetMetadata{
+		SetID:  expectedMetadata.setID,
+		Name:   expectedMetadata.name,
+		Series: expectedMetadata.series,
+		Plays:  expectedMetadata.plays,
+		Locked: expectedMetadata.locked,
+	})
 }
 
 type MetadataViewStruct struct {
@@ -102,53 +80,7 @@ func getTopShotMetadata(t *testing.T, b *emulator.Blockchain, env templates.Envi
 }
 
 func updateContract(b *emulator.Blockchain, address sdk.Address, signer crypto.Signer, name string, contractCode []byte) error {
-	tx := sdktemplates.UpdateAccountContract(
-		address,
-		sdktemplates.Contract{
-			Name:   name,
-			Source: string(contractCode),
-		},
-	)
-
-	tx.SetGasLimit(9999).
-		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
-		SetPayer(b.ServiceKey().Address)
-
-	err := tx.SignPayload(address, 0, signer)
-	if err != nil {
-		return err
-	}
-
-	serviceSigner, err := b.ServiceKey().Signer()
-	if err != nil {
-		return err
-	}
-
-	err = tx.SignEnvelope(b.ServiceKey().Address, b.ServiceKey().Index, serviceSigner)
-	if err != nil {
-		return err
-	}
-
-	flowTx := convert.SDKTransactionToFlow(*tx)
-	err = b.AddTransaction(*flowTx)
-	if err != nil {
-		return err
-	}
-
-	result, err := b.ExecuteNextTransaction()
-	if err != nil {
-		return err
-	}
-	if !result.Succeeded() {
-		return result.Error
-	}
-
-	_, err = b.CommitBlock()
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return testkit.UpdateContract(b, address, signer, name, contractCode)
 }
 
 // Transfer and start a v1 or v3 sale
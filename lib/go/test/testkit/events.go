@@ -0,0 +1,55 @@
+package testkit
+
+import (
+	"strings"
+	"testing"
+
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/stretchr/testify/require"
+)
+
+// EventPayloads returns the JSON-Cadence payloads of every event in the
+// result whose type ends with the qualified identifier, e.g.
+// events.EventMomentMinted ("TopShot.MomentMinted"), in emission order.
+//
+// The payloads can be handed straight to the lib/go/events decoders.
+func EventPayloads(t testing.TB, result *types.TransactionResult, qualifiedIdentifier string) [][]byte {
+	var payloads [][]byte
+	for _, event := range result.Events {
+		if !hasQualifiedIdentifier(event.Type, qualifiedIdentifier) {
+			continue
+		}
+		payload, err := jsoncdc.Encode(event.Value)
+		require.NoError(t, err)
+		payloads = append(payloads, payload)
+	}
+	return payloads
+}
+
+// DecodeEvents decodes every event of the qualified identifier in the result
+// with a lib/go/events decoder, failing the test if any of them cannot be decoded
+func DecodeEvents[T any](t testing.TB, result *types.TransactionResult, qualifiedIdentifier string, decode func([]byte) (T, error)) []T {
+	payloads := EventPayloads(t, result, qualifiedIdentifier)
+	decoded := make([]T, len(payloads))
+	for i, payload := range payloads {
+		event, err := decode(payload)
+		require.NoError(t, err)
+		decoded[i] = event
+	}
+	return decoded
+}
+
+// RequireEvent decodes the single event of the qualified identifier in the
+// result, failing the test unless exactly one was emitted
+func RequireEvent[T any](t testing.TB, result *types.TransactionResult, qualifiedIdentifier string, decode func([]byte) (T, error)) T {
+	decoded := DecodeEvents(t, result, qualifiedIdentifier, decode)
+	require.Len(t, decoded, 1, "expected exactly one %s event", qualifiedIdentifier)
+	return decoded[0]
+}
+
+// hasQualifiedIdentifier reports whether a fully qualified event type such as
+// "A.f8d6e0586b0a20c7.TopShot.MomentMinted" names the qualified identifier
+func hasQualifiedIdentifier(eventType string, qualifiedIdentifier string) bool {
+	return strings.HasSuffix(eventType, "."+qualifiedIdentifier)
+}
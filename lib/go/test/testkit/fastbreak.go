@@ -0,0 +1,87 @@
+package testkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+)
+
+const fastBreakGameTokenMinted = "FastBreakV1.FastBreakGameTokenMinted"
+
+// CreateFastBreakRun creates a Fast Break run spanning the window
+func (b *Blockchain) CreateFastBreakRun(t testing.TB, id string, name string, runStart time.Time, runEnd time.Time, fatigueModeOn bool) {
+	b.SendTx(t, templates.GenerateCreateRunScript(b.Env), []cadence.Value{
+		CadenceString(id),
+		CadenceString(name),
+		cadence.NewUInt64(uint64(runStart.Unix())),
+		cadence.NewUInt64(uint64(runEnd.Unix())),
+		cadence.NewBool(fatigueModeOn),
+	}, false, b.FastBreak)
+}
+
+// CreateFastBreakGame creates a Fast Break game in the run
+func (b *Blockchain) CreateFastBreakGame(t testing.TB, id string, name string, runID string, submissionDeadline time.Time, numPlayers uint64) {
+	b.SendTx(t, templates.GenerateCreateGameScript(b.Env), []cadence.Value{
+		CadenceString(id),
+		CadenceString(name),
+		CadenceString(runID),
+		cadence.NewUInt64(uint64(submissionDeadline.Unix())),
+		cadence.NewUInt64(numPlayers),
+	}, false, b.FastBreak)
+}
+
+// AddStatToFastBreakGame adds a statistical requirement to a Fast Break game
+func (b *Blockchain) AddStatToFastBreakGame(t testing.TB, gameID string, name string, rawType uint8, valueNeeded uint64) {
+	b.SendTx(t, templates.GenerateAddStatToGameScript(b.Env), []cadence.Value{
+		CadenceString(gameID),
+		CadenceString(name),
+		cadence.NewUInt8(rawType),
+		cadence.NewUInt64(valueNeeded),
+	}, false, b.FastBreak)
+}
+
+// UpdateFastBreakGame sets the status and winner of a Fast Break game
+func (b *Blockchain) UpdateFastBreakGame(t testing.TB, gameID string, rawStatus uint8, winner uint64) {
+	b.SendTx(t, templates.GenerateUpdateFastBreakGameScript(b.Env), []cadence.Value{
+		CadenceString(gameID),
+		cadence.NewUInt8(rawStatus),
+		cadence.NewUInt64(winner),
+	}, false, b.FastBreak)
+}
+
+// ScoreFastBreakSubmission records the points and win flag of a player's submission
+func (b *Blockchain) ScoreFastBreakSubmission(t testing.TB, gameID string, player Account, points uint64, win bool) *types.TransactionResult {
+	return b.SendTx(t, templates.GenerateScoreFastBreakSubmissionScript(b.Env), []cadence.Value{
+		CadenceString(gameID),
+		cadence.NewAddress(player.Address),
+		cadence.NewUInt64(points),
+		cadence.NewBool(win),
+	}, false, b.FastBreak)
+}
+
+// SetupFastBreakPlayer gives the account a Fast Break player and game token collection
+func (b *Blockchain) SetupFastBreakPlayer(t testing.TB, account Account, playerName string) {
+	b.SendTx(t, templates.GenerateFastBreakCreateAccountScript(b.Env), []cadence.Value{CadenceString(playerName)}, false, account)
+}
+
+// PlayFastBreak submits the moments to a Fast Break game and returns the ID of the minted game token.
+// The account must already have a Fast Break player, see SetupFastBreakPlayer.
+func (b *Blockchain) PlayFastBreak(t testing.TB, player Account, gameID string, momentIDs ...uint64) uint64 {
+	result := b.SendTx(t, templates.GeneratePlayFastBreakScript(b.Env), []cadence.Value{
+		CadenceString(gameID),
+		UInt64Array(momentIDs...),
+	}, false, player)
+
+	for _, event := range result.Events {
+		if hasQualifiedIdentifier(event.Type, fastBreakGameTokenMinted) {
+			return uint64(cadence.SearchFieldByName(event.Value, "id").(cadence.UInt64))
+		}
+	}
+	require.Fail(t, "expected a "+fastBreakGameTokenMinted+" event")
+	return 0
+}
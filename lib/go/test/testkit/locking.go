@@ -0,0 +1,28 @@
+package testkit
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+)
+
+// Lock locks a moment in the owner's collection for the duration, given in
+// seconds as a UFix64 string, and returns the emitted MomentLocked event
+func (b *Blockchain) Lock(t testing.TB, owner Account, momentID uint64, duration string) events.MomentLockedEvent {
+	result := b.SendTx(t, templates.GenerateTopShotLockingLockMomentScript(b.Env), []cadence.Value{
+		cadence.NewUInt64(momentID),
+		CadenceUFix64(duration),
+	}, false, owner)
+
+	return RequireEvent(t, result, events.MomentLocked, events.DecodeMomentLockedEvent)
+}
+
+// IsLocked returns whether the moment in the owner's collection is locked
+func (b *Blockchain) IsLocked(t testing.TB, owner Account, momentID uint64) bool {
+	result := b.ExecuteScript(t, templates.GenerateGetMomentIsLockedScript(b.Env), cadence.NewAddress(owner.Address), cadence.NewUInt64(momentID))
+
+	return bool(result.(cadence.Bool))
+}
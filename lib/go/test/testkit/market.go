@@ -0,0 +1,77 @@
+package testkit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/flow-emulator/types"
+	fungibleTokenTemplates "github.com/onflow/flow-ft/lib/go/templates"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+)
+
+// DUCReceiverPath is the public path of the DapperUtilityCoin receiver set up by the kit
+var DUCReceiverPath = cadence.Path{Domain: common.PathDomainPublic, Identifier: "dapperUtilityCoinReceiver"}
+
+func (b *Blockchain) tokenEnv() fungibleTokenTemplates.Environment {
+	return fungibleTokenTemplates.Environment{
+		FungibleTokenAddress:              b.Env.FungibleTokenAddress,
+		MetadataViewsAddress:              b.Env.MetadataViewsAddress,
+		ExampleTokenAddress:               b.Env.DUCAddress,
+		FungibleTokenMetadataViewsAddress: b.Env.FungibleTokenMetadataViewsAddress,
+		ViewResolverAddress:               b.Env.ViewResolverAddress,
+	}
+}
+
+// ducScript rewrites a flow-ft ExampleToken template to use DapperUtilityCoin
+func ducScript(script []byte) []byte {
+	return []byte(strings.ReplaceAll(string(script), "ExampleToken", "DapperUtilityCoin"))
+}
+
+// SetupDUCVault stores an empty DapperUtilityCoin vault in the account and publishes its receiver
+func (b *Blockchain) SetupDUCVault(t testing.TB, account Account) {
+	b.SendTx(t, ducScript(fungibleTokenTemplates.GenerateCreateTokenScript(b.tokenEnv())), nil, false, account)
+}
+
+// MintDUC mints DapperUtilityCoin into the account's vault
+func (b *Blockchain) MintDUC(t testing.TB, to Account, amount string) {
+	b.SendTx(t, ducScript(fungibleTokenTemplates.GenerateMintTokensScript(b.tokenEnv())), []cadence.Value{
+		cadence.NewAddress(to.Address),
+		CadenceUFix64(amount),
+	}, false, b.DUC)
+}
+
+// DUCBalanceScript returns a script that takes an address and returns its DapperUtilityCoin balance
+func (b *Blockchain) DUCBalanceScript() []byte {
+	return ducScript(fungibleTokenTemplates.GenerateInspectVaultScript(b.tokenEnv()))
+}
+
+// DUCBalance returns the account's DapperUtilityCoin balance
+func (b *Blockchain) DUCBalance(t testing.TB, account Account) cadence.UFix64 {
+	result := b.ExecuteScript(t, b.DUCBalanceScript(), cadence.NewAddress(account.Address))
+
+	return result.(cadence.UFix64)
+}
+
+// ListForSaleV3 lists a moment for sale in the seller's V3 sale collection,
+// creating the collection with MarketBeneficiary and DefaultCutPercentage if needed
+func (b *Blockchain) ListForSaleV3(t testing.TB, seller Account, momentID uint64, price string) *types.TransactionResult {
+	return b.SendTx(t, templates.GenerateCreateAndStartSaleV3Script(b.Env), []cadence.Value{
+		DUCReceiverPath,
+		cadence.NewAddress(b.MarketBeneficiary.Address),
+		CadenceUFix64(DefaultCutPercentage),
+		cadence.NewUInt64(momentID),
+		CadenceUFix64(price),
+	}, false, seller)
+}
+
+// PurchaseV3 buys a moment listed in the seller's V3 sale collection, paying with the buyer's DUC
+func (b *Blockchain) PurchaseV3(t testing.TB, buyer Account, seller Account, momentID uint64, price string) *types.TransactionResult {
+	return b.SendTx(t, templates.GenerateBuySaleV3Script(b.Env), []cadence.Value{
+		cadence.NewAddress(seller.Address),
+		cadence.NewUInt64(momentID),
+		CadenceUFix64(price),
+	}, false, buyer)
+}
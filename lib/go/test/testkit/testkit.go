@@ -0,0 +1,307 @@
+// Package testkit stands up a Flow emulator with the full Top Shot contract
+// suite deployed and exposes helpers for driving it from Go tests.
+//
+// It is the importable counterpart of the helpers used by the tests in
+// lib/go/test, so teams building on Top Shot can write emulator tests
+// against the same deployment:
+//
+//	b := testkit.NewBlockchain(t)
+//	playID := b.CreatePlay(t, map[string]string{"FullName": "Lebron"})
+//	setID := b.CreateSet(t, "Genesis")
+//	b.AddPlayToSet(t, setID, playID)
+//	user := b.SetupAccount(t)
+//	momentID := b.Mint(t, setID, playID, user)
+package testkit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-emulator/adapters"
+	"github.com/onflow/flow-emulator/emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	sdktemplates "github.com/onflow/flow-go-sdk/templates"
+	"github.com/onflow/flow-go-sdk/test"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/contracts"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+)
+
+const (
+	// EmulatorServiceAddress hosts the NFT standards, MetadataViews, ViewResolver and EVM on the emulator
+	EmulatorServiceAddress = "f8d6e0586b0a20c7"
+	// EmulatorFTAddress hosts FungibleToken, FungibleTokenMetadataViews and the switchboard on the emulator
+	EmulatorFTAddress = "ee82856bf20e2aa6"
+	// EmulatorFlowTokenAddress hosts FlowToken on the emulator
+	EmulatorFlowTokenAddress = "0ae53cb6e3f42a79"
+
+	Network             = `"mainnet"`
+	FlowEvmContractAddr = `"0x1234565789012345657890123456578901234565"`
+	EvmBaseURI          = `"https://base.uri/moment/"`
+
+	// DefaultGasLimit is the computation limit set on every transaction built by the kit
+	DefaultGasLimit = 9999
+
+	// DefaultCutPercentage is the marketplace cut used when the kit creates a V3 sale collection
+	DefaultCutPercentage = "0.15"
+)
+
+// Account is an emulator account together with the signer of its first key
+type Account struct {
+	Address flow.Address
+	Signer  crypto.Signer
+}
+
+// Blockchain is an emulator with the Top Shot contract suite deployed
+type Blockchain struct {
+	*emulator.Blockchain
+	Env templates.Environment
+
+	// TopShotAdmin holds the TopShot contract and its Admin resource
+	TopShotAdmin Account
+	// Locking holds the TopShotLocking contract
+	Locking Account
+	// DUC holds the DapperUtilityCoin contract and its minter
+	DUC Account
+	// Market holds both the Market and TopShotMarketV3 contracts
+	Market Account
+	// MarketBeneficiary receives the marketplace cut of sales listed through the kit
+	MarketBeneficiary Account
+	// FastBreak holds the FastBreakV1 contract and its oracle
+	FastBreak Account
+	// PackNFT holds the PackNFT stand-in and its Operator
+	PackNFT Account
+
+	ServiceKeySigner crypto.Signer
+	AccountKeys      *test.AccountKeys
+
+	adapter *adapters.SDKAdapter
+}
+
+// NewBlockchain returns an emulator with every Top Shot contract deployed:
+// those of NewTopShotBlockchain, then DapperUtilityCoin, Market,
+// TopShotMarketV3, FastBreakV1 and PackNFT.
+//
+// The standards come with the emulator and everything else is vendored in
+// the contracts package, so bootstrapping does not touch the network.
+func NewBlockchain(t testing.TB, opts ...emulator.Option) *Blockchain {
+	kit := NewTopShotBlockchain(t, opts...)
+	kit.Env.Network = "emulator"
+
+	kit.deployMarkets(t)
+	kit.deployFastBreak(t)
+	kit.deployPackNFT(t)
+
+	return kit
+}
+
+// NewTopShotBlockchain returns an emulator with only the TopShot contracts
+// deployed: CrossVMMetadataViews, TopShotLocking, TopShot and
+// TopShotShardedCollection. It is the deployment the tests of lib/go/test
+// run against, which deploy the other contracts they need themselves.
+func NewTopShotBlockchain(t testing.TB, opts ...emulator.Option) *Blockchain {
+	b, err := emulator.New(
+		append(
+			[]emulator.Option{
+				emulator.WithStorageLimitEnabled(false),
+			},
+			opts...,
+		)...,
+	)
+	require.NoError(t, err)
+
+	serviceKeySigner, err := b.ServiceKey().Signer()
+	require.NoError(t, err)
+
+	logger := zerolog.Nop()
+	kit := &Blockchain{
+		Blockchain:       b,
+		ServiceKeySigner: serviceKeySigner,
+		AccountKeys:      test.AccountKeyGenerator(),
+		adapter:          adapters.NewSDKAdapter(&logger, b),
+		Env: templates.Environment{
+			FungibleTokenAddress:              EmulatorFTAddress,
+			FlowTokenAddress:                  EmulatorFlowTokenAddress,
+			NFTAddress:                        EmulatorServiceAddress,
+			MetadataViewsAddress:              EmulatorServiceAddress,
+			ViewResolverAddress:               EmulatorServiceAddress,
+			EVMAddress:                        EmulatorServiceAddress,
+			FungibleTokenMetadataViewsAddress: EmulatorFTAddress,
+			FTSwitchboardAddress:              EmulatorFTAddress,
+		},
+	}
+
+	kit.deployTopShot(t)
+
+	return kit
+}
+
+// Service returns the emulator service account
+func (b *Blockchain) Service() Account {
+	return Account{Address: b.ServiceKey().Address, Signer: b.ServiceKeySigner}
+}
+
+// CreateAccount creates a new account with a single fresh key and, optionally, contracts
+func (b *Blockchain) CreateAccount(t testing.TB, contracts ...sdktemplates.Contract) Account {
+	accountKey, signer := b.AccountKeys.NewWithSigner()
+	address, err := b.adapter.CreateAccount(context.Background(), []*flow.AccountKey{accountKey}, contracts)
+	require.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	require.NoError(t, err)
+
+	return Account{Address: address, Signer: signer}
+}
+
+func (b *Blockchain) deployTopShot(t testing.TB) {
+	env := &b.Env
+
+	// Deploy CrossVMMetadataViews contract
+	crossVMMetadataViews := b.CreateAccount(t, sdktemplates.Contract{
+		Name:   "CrossVMMetadataViews",
+		Source: string(contracts.GenerateCrossVMMetadataViewsContract(env.EVMAddress, env.ViewResolverAddress)),
+	})
+	env.CrossVMMetadataViewsAddress = crossVMMetadataViews.Address.String()
+
+	// Deploy TopShot Locking contract
+	b.Locking = b.CreateAccount(t, sdktemplates.Contract{
+		Name:   "TopShotLocking",
+		Source: string(contracts.GenerateTopShotLockingContract(env.NFTAddress)),
+	})
+	env.TopShotLockingAddress = b.Locking.Address.String()
+
+	// Deploy the topshot contract
+	b.TopShotAdmin = b.CreateAccount(t, sdktemplates.Contract{
+		Name: "TopShot",
+		Source: string(contracts.GenerateTopShotContract(
+			env.FungibleTokenAddress,
+			env.NFTAddress,
+			env.MetadataViewsAddress,
+			env.ViewResolverAddress,
+			env.CrossVMMetadataViewsAddress,
+			env.EVMAddress,
+			env.TopShotLockingAddress,
+			env.FTSwitchboardAddress,
+			Network,
+			FlowEvmContractAddr,
+			EvmBaseURI,
+		)),
+	})
+	env.TopShotAddress = b.TopShotAdmin.Address.String()
+
+	// Update the locking contract with topshot address
+	err := UpdateContract(
+		b.Blockchain,
+		b.Locking.Address,
+		b.Locking.Signer,
+		"TopShotLocking",
+		contracts.GenerateTopShotLockingContractWithTopShotRuntimeAddr(env.NFTAddress, env.TopShotAddress),
+	)
+	require.NoError(t, err)
+
+	// Grant the TopShot account a TopShotLocking admin
+	// In testnet/mainnet the TopShotLocking contract is in the same account as TopShot contract
+	b.SendTx(t, templates.GenerateTopShotLockingAdminGrantAdminScript(*env), nil, false, b.Locking, b.TopShotAdmin)
+
+	// The royalty receiver lives in the FT account, which shares the service key on the emulator
+	b.SendTx(t, templates.GenerateSetupSwitchboardScript(*env), nil, false,
+		Account{Address: flow.HexToAddress(env.FTSwitchboardAddress), Signer: b.ServiceKeySigner},
+	)
+
+	// Check that that main contract fields were initialized correctly
+	result := ExecuteScriptAndCheck(t, b.Blockchain, templates.GenerateGetSeriesScript(*env), nil)
+	assert.Equal(t, cadence.NewUInt32(0), result)
+
+	result = ExecuteScriptAndCheck(t, b.Blockchain, templates.GenerateGetNextPlayIDScript(*env), nil)
+	assert.Equal(t, cadence.NewUInt32(1), result)
+
+	result = ExecuteScriptAndCheck(t, b.Blockchain, templates.GenerateGetNextSetIDScript(*env), nil)
+	assert.Equal(t, cadence.NewUInt32(1), result)
+
+	result = ExecuteScriptAndCheck(t, b.Blockchain, templates.GenerateGetSupplyScript(*env), nil)
+	assert.Equal(t, cadence.NewUInt64(0), result)
+
+	// Deploy the sharded collection contract
+	sharded := b.CreateAccount(t, sdktemplates.Contract{
+		Name:   "TopShotShardedCollection",
+		Source: string(contracts.GenerateTopShotShardedCollectionContract(env.NFTAddress, env.TopShotAddress, env.ViewResolverAddress)),
+	})
+	env.ShardedAddress = sharded.Address.String()
+}
+
+func (b *Blockchain) deployMarkets(t testing.TB) {
+	env := &b.Env
+
+	// Deploy the DapperUtilityCoin token contract
+	b.DUC = b.CreateAccount(t, sdktemplates.Contract{
+		Name: "DapperUtilityCoin",
+		Source: string(contracts.GenerateDapperUtilityCoinContract(
+			env.FungibleTokenAddress,
+			env.MetadataViewsAddress,
+			env.FungibleTokenMetadataViewsAddress,
+		)),
+	})
+	env.DUCAddress = b.DUC.Address.String()
+
+	// Deploy the first market contract
+	b.Market = b.CreateAccount(t, sdktemplates.Contract{
+		Name:   "Market",
+		Source: string(contracts.GenerateTopShotMarketContract(env.FungibleTokenAddress, env.NFTAddress, env.TopShotAddress, env.DUCAddress)),
+	})
+	env.TopShotMarketAddress = b.Market.Address.String()
+
+	// Add the third market contract to the same account, as on mainnet
+	tx := sdktemplates.AddAccountContract(
+		b.Market.Address,
+		sdktemplates.Contract{
+			Name: "TopShotMarketV3",
+			Source: string(contracts.GenerateTopShotMarketV3Contract(
+				env.FungibleTokenAddress,
+				env.NFTAddress,
+				env.TopShotAddress,
+				env.TopShotMarketAddress,
+				env.DUCAddress,
+				env.TopShotLockingAddress,
+				env.MetadataViewsAddress,
+			)),
+		},
+	)
+	tx.SetComputeLimit(DefaultGasLimit).
+		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+		SetPayer(b.ServiceKey().Address)
+
+	SignAndSubmit(
+		t, b.Blockchain, tx,
+		[]flow.Address{b.ServiceKey().Address, b.Market.Address},
+		[]crypto.Signer{b.ServiceKeySigner, b.Market.Signer},
+		false,
+	)
+	env.TopShotMarketV3Address = b.Market.Address.String()
+
+	b.MarketBeneficiary = b.CreateAccount(t)
+	b.SetupDUCVault(t, b.MarketBeneficiary)
+}
+
+func (b *Blockchain) deployFastBreak(t testing.TB) {
+	env := &b.Env
+
+	b.FastBreak = b.CreateAccount(t, sdktemplates.Contract{
+		Name:   "FastBreakV1",
+		Source: string(contracts.GenerateFastBreakContract(env.NFTAddress, env.TopShotAddress, env.MetadataViewsAddress, env.TopShotMarketV3Address)),
+	})
+	env.FastBreakAddress = b.FastBreak.Address.String()
+}
+
+func (b *Blockchain) deployPackNFT(t testing.TB) {
+	env := &b.Env
+
+	b.PackNFT = b.CreateAccount(t, sdktemplates.Contract{
+		Name:   "PackNFT",
+		Source: string(contracts.GeneratePackNFTContract(env.NFTAddress, env.MetadataViewsAddress, env.ViewResolverAddress)),
+	})
+}
@@ -0,0 +1,88 @@
+package testkit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
+)
+
+func TestKit(t *testing.T) {
+	b := testkit.NewBlockchain(t)
+	fixture := b.Bootstrap(t)
+
+	t.Run("Should bootstrap plays, a set and two moments", func(t *testing.T) {
+		assert.Equal(t, []uint32{1, 2, 3}, fixture.PlayIDs)
+		assert.Equal(t, uint32(1), fixture.SetID)
+		assert.Equal(t, []uint64{1, 2}, fixture.MomentIDs)
+
+		testkit.VerifyQuerySetMetadata(t, b.Blockchain, b.Env, testkit.SetMetadata{
+			SetID:  fixture.SetID,
+			Name:   "Genesis",
+			Series: 0,
+			Plays:  fixture.PlayIDs,
+			Locked: false,
+		})
+	})
+
+	t.Run("Should decode events emitted by a transfer", func(t *testing.T) {
+		recipient := b.SetupAccount(t)
+		result := b.Transfer(t, fixture.User, recipient, fixture.MomentIDs[0])
+
+		withdraw := testkit.RequireEvent(t, result, events.EventWithdraw, events.DecodeWithdrawEvent)
+		assert.Equal(t, fixture.MomentIDs[0], withdraw.Id())
+		assert.Equal(t, fixture.User.Address.Hex(), withdraw.From())
+
+		deposit := testkit.RequireEvent(t, result, events.TopShotEventDeposit, events.DecodeDepositEvent)
+		assert.Equal(t, recipient.Address.Hex(), deposit.To())
+
+		assert.True(t, b.IsInCollection(t, recipient, fixture.MomentIDs[0]))
+
+		// hand it back so later subtests see the bootstrapped state
+		b.Transfer(t, recipient, fixture.User, fixture.MomentIDs[0])
+	})
+
+	t.Run("Should list and sell a moment on the V3 market", func(t *testing.T) {
+		buyer := b.SetupAccount(t)
+		b.MintDUC(t, buyer, "100.0")
+
+		b.ListForSaleV3(t, fixture.User, fixture.MomentIDs[1], "50.0")
+		b.PurchaseV3(t, buyer, fixture.User, fixture.MomentIDs[1], "50.0")
+
+		assert.True(t, b.IsInCollection(t, buyer, fixture.MomentIDs[1]))
+		assert.Equal(t, testkit.CadenceUFix64("50.0"), b.DUCBalance(t, buyer))
+		assert.Equal(t, testkit.CadenceUFix64("42.5"), b.DUCBalance(t, fixture.User))
+		assert.Equal(t, testkit.CadenceUFix64("7.5"), b.DUCBalance(t, b.MarketBeneficiary))
+	})
+
+	t.Run("Should lock a moment", func(t *testing.T) {
+		locked := b.Lock(t, fixture.User, fixture.MomentIDs[0], "3600.0")
+		assert.Equal(t, fixture.MomentIDs[0], locked.FlowID())
+		assert.True(t, b.IsLocked(t, fixture.User, fixture.MomentIDs[0]))
+	})
+
+	t.Run("Should play a game of Fast Break", func(t *testing.T) {
+		b.CreateFastBreakRun(t, "run-1", "R0", time.Now().Add(-time.Hour), time.Now().Add(24*time.Hour), true)
+		b.CreateFastBreakGame(t, "game-1", "FB0", "run-1", time.Now().Add(time.Hour), 1)
+		b.AddStatToFastBreakGame(t, "game-1", "POINTS", 0, 30)
+
+		b.SetupFastBreakPlayer(t, fixture.User, "player-one")
+		tokenID := b.PlayFastBreak(t, fixture.User, "game-1", fixture.MomentIDs[0])
+		assert.NotZero(t, tokenID)
+
+		result := b.ExecuteScript(t, templates.GenerateGetFastBreakTokenCountScript(b.Env))
+		assert.Equal(t, cadence.NewUInt64(1), result)
+
+		b.UpdateFastBreakGame(t, "game-1", 2, 0)
+		b.ScoreFastBreakSubmission(t, "game-1", fixture.User, 42, true)
+
+		result = b.ExecuteScript(t, templates.GenerateGetPlayerScoreScript(b.Env), testkit.CadenceString("game-1"), cadence.NewAddress(fixture.User.Address))
+		require.Equal(t, cadence.NewUInt64(42), result)
+	})
+}
@@ -0,0 +1,186 @@
+package testkit
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-emulator/emulator"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+)
+
+// CreatePlay creates a play with the metadata and returns its ID
+func (b *Blockchain) CreatePlay(t testing.TB, metadata map[string]string) uint32 {
+	result := b.SendTx(t, templates.GenerateMintPlayScript(b.Env), []cadence.Value{CadenceStringDictionary(metadata)}, false, b.TopShotAdmin)
+
+	return RequireEvent(t, result, events.EventPlayCreated, events.DecodePlayCreatedEvent).Id()
+}
+
+// CreateSet creates a set in the current series and returns its ID
+func (b *Blockchain) CreateSet(t testing.TB, setName string) uint32 {
+	result := b.SendTx(t, templates.GenerateMintSetScript(b.Env), []cadence.Value{CadenceString(setName)}, false, b.TopShotAdmin)
+
+	return RequireEvent(t, result, events.EventSetCreated, events.DecodeSetCreatedEvent).SetID()
+}
+
+// AddPlayToSet adds a play to a set so moments of that edition can be minted
+func (b *Blockchain) AddPlayToSet(t testing.TB, setID uint32, playID uint32) {
+	result := b.SendTx(t, templates.GenerateAddPlayToSetScript(b.Env), []cadence.Value{cadence.NewUInt32(setID), cadence.NewUInt32(playID)}, false, b.TopShotAdmin)
+
+	RequireEvent(t, result, events.EventPlayAddedToSet, events.DecodePlayAddedToSetEvent)
+}
+
+// AddPlaysToSet adds several plays to a set in a single transaction
+func (b *Blockchain) AddPlaysToSet(t testing.TB, setID uint32, playIDs ...uint32) {
+	result := b.SendTx(t, templates.GenerateAddPlaysToSetScript(b.Env), []cadence.Value{cadence.NewUInt32(setID), UInt32Array(playIDs...)}, false, b.TopShotAdmin)
+
+	added := DecodeEvents(t, result, events.EventPlayAddedToSet, events.DecodePlayAddedToSetEvent)
+	assert.Len(t, added, len(playIDs))
+}
+
+// Mint mints a moment of the edition into the recipient's collection and returns its ID
+func (b *Blockchain) Mint(t testing.TB, setID uint32, playID uint32, recipient Account) uint64 {
+	result := b.SendTx(t, templates.GenerateMintMomentScript(b.Env), []cadence.Value{
+		cadence.NewUInt32(setID),
+		cadence.NewUInt32(playID),
+		cadence.NewAddress(recipient.Address),
+	}, false, b.TopShotAdmin)
+
+	minted := RequireEvent(t, result, events.EventMomentMinted, events.DecodeMomentMintedEvent)
+	assert.Equal(t, setID, minted.SetId())
+	assert.Equal(t, playID, minted.PlayId())
+
+	return minted.MomentId()
+}
+
+// BatchMint mints a number of moments of the edition into the recipient's collection and returns their IDs
+func (b *Blockchain) BatchMint(t testing.TB, setID uint32, playID uint32, quantity uint64, recipient Account) []uint64 {
+	result := b.SendTx(t, templates.GenerateBatchMintMomentScript(b.Env), []cadence.Value{
+		cadence.NewUInt32(setID),
+		cadence.NewUInt32(playID),
+		cadence.NewUInt64(quantity),
+		cadence.NewAddress(recipient.Address),
+	}, false, b.TopShotAdmin)
+
+	minted := DecodeEvents(t, result, events.EventMomentMinted, events.DecodeMomentMintedEvent)
+	assert.Len(t, minted, int(quantity))
+
+	momentIDs := make([]uint64, len(minted))
+	for i, moment := range minted {
+		momentIDs[i] = moment.MomentId()
+	}
+
+	return momentIDs
+}
+
+// Transfer moves a moment from one collection to another
+func (b *Blockchain) Transfer(t testing.TB, from Account, to Account, momentID uint64) *types.TransactionResult {
+	return b.SendTx(t, templates.GenerateTransferMomentScript(b.Env), []cadence.Value{
+		cadence.NewAddress(to.Address),
+		cadence.NewUInt64(momentID),
+	}, false, from)
+}
+
+// SetupAccount creates an account with an empty moment collection and,
+// when the markets are deployed, a DUC vault
+func (b *Blockchain) SetupAccount(t testing.TB) Account {
+	account := b.CreateAccount(t)
+
+	b.SendTx(t, templates.GenerateSetupAccountScript(b.Env), nil, false, account)
+	if b.Env.DUCAddress != "" {
+		b.SetupDUCVault(t, account)
+	}
+
+	return account
+}
+
+// IsInCollection returns whether the moment is in the account's collection
+func (b *Blockchain) IsInCollection(t testing.TB, account Account, momentID uint64) bool {
+	result := b.ExecuteScript(t, templates.GenerateIsIDInCollectionScript(b.Env), cadence.NewAddress(account.Address), cadence.NewUInt64(momentID))
+
+	return bool(result.(cadence.Bool))
+}
+
+// Fixture is the state left behind by Bootstrap
+type Fixture struct {
+	User      Account
+	SetID     uint32
+	PlayIDs   []uint32
+	MomentIDs []uint64
+}
+
+// Bootstrap gets the blockchain in a state where we can run interesting tests against it:
+// a user with a moment collection, three plays in a Genesis set and
+// two moments (of the first two plays) minted to the user
+func (b *Blockchain) Bootstrap(t testing.TB) Fixture {
+	user := b.SetupAccount(t)
+
+	var playIDs []uint32
+	for _, name := range []string{"Lebron", "Hayward", "Antetokounmpo"} {
+		playIDs = append(playIDs, b.CreatePlay(t, map[string]string{"FullName": name}))
+	}
+
+	setID := b.CreateSet(t, "Genesis")
+	b.AddPlaysToSet(t, setID, playIDs...)
+
+	momentIDs := []uint64{
+		b.Mint(t, setID, playIDs[0], user),
+		b.Mint(t, setID, playIDs[1], user),
+	}
+
+	for _, momentID := range momentIDs {
+		assert.True(t, b.IsInCollection(t, user, momentID))
+	}
+
+	return Fixture{
+		User:      user,
+		SetID:     setID,
+		PlayIDs:   playIDs,
+		MomentIDs: momentIDs,
+	}
+}
+
+// SetMetadata is used to verify set metadata in tests
+type SetMetadata struct {
+	SetID  uint32
+	Name   string
+	Series uint32
+	Plays  []uint32
+	Locked bool
+}
+
+// VerifyQuerySetMetadata verifies that the set metadata is equal to the provided expected values
+func VerifyQuerySetMetadata(
+	t testing.TB,
+	b *emulator.Blockchain,
+	env templates.Environment,
+	expectedMetadata SetMetadata) {
+
+	result := ExecuteScriptAndCheck(t, b, templates.GenerateGetSetMetadataScript(env), [][]byte{jsoncdc.MustEncode(cadence.UInt32(expectedMetadata.SetID))})
+	metadataStruct := result.(cadence.Struct)
+
+	setID := cadence.SearchFieldByName(metadataStruct, "setID")
+	assert.Equal(t, cadence.NewUInt32(expectedMetadata.SetID), setID)
+
+	name := cadence.SearchFieldByName(metadataStruct, "name")
+	assert.Equal(t, CadenceString(expectedMetadata.Name), name)
+
+	series := cadence.SearchFieldByName(metadataStruct, "series")
+	assert.Equal(t, cadence.NewUInt32(expectedMetadata.Series), series)
+
+	if len(expectedMetadata.Plays) != 0 {
+		plays := cadence.SearchFieldByName(metadataStruct, "plays").(cadence.Array).Values
+
+		for i, play := range plays {
+			expectedPlayID := cadence.NewUInt32(expectedMetadata.Plays[i])
+			assert.Equal(t, expectedPlayID, play)
+		}
+	}
+
+	locked := cadence.SearchFieldByName(metadataStruct, "locked")
+	assert.Equal(t, cadence.NewBool(expectedMetadata.Locked), locked)
+}
@@ -0,0 +1,268 @@
+package testkit
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-emulator/convert"
+	"github.com/onflow/flow-emulator/emulator"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	sdktemplates "github.com/onflow/flow-go-sdk/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NewTx returns a transaction for the script that is proposed and paid for
+// by the service account and authorized by the given addresses
+func NewTx(b *emulator.Blockchain, script []byte, authorizers ...flow.Address) *flow.Transaction {
+	tx := flow.NewTransaction().
+		SetScript(script).
+		SetComputeLimit(DefaultGasLimit).
+		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+		SetPayer(b.ServiceKey().Address)
+
+	for _, authorizer := range authorizers {
+		tx.AddAuthorizer(authorizer)
+	}
+
+	return tx
+}
+
+// SendTx builds a transaction for the script with the arguments, has every
+// signer authorize it and submits it, returning the result.
+//
+// The shouldRevert parameter indicates whether the transaction should fail or not.
+func (b *Blockchain) SendTx(t testing.TB, script []byte, args []cadence.Value, shouldRevert bool, signers ...Account) *types.TransactionResult {
+	addresses := []flow.Address{b.ServiceKey().Address}
+	cryptoSigners := []crypto.Signer{b.ServiceKeySigner}
+
+	tx := NewTx(b.Blockchain, script)
+	for _, signer := range signers {
+		tx.AddAuthorizer(signer.Address)
+		addresses = append(addresses, signer.Address)
+		cryptoSigners = append(cryptoSigners, signer.Signer)
+	}
+
+	for _, arg := range args {
+		require.NoError(t, tx.AddArgument(arg))
+	}
+
+	return SignAndSubmit(t, b.Blockchain, tx, addresses, cryptoSigners, shouldRevert)
+}
+
+// TrySendTx is SendTx for transactions that may or may not succeed: it
+// submits the transaction and commits its block without checking the
+// result, leaving it to the caller to decide whether it should have reverted
+func (b *Blockchain) TrySendTx(t testing.TB, script []byte, args []cadence.Value, signers ...Account) *types.TransactionResult {
+	tx := NewTx(b.Blockchain, script)
+	for _, signer := range signers {
+		tx.AddAuthorizer(signer.Address)
+	}
+
+	for _, arg := range args {
+		require.NoError(t, tx.AddArgument(arg))
+	}
+
+	for i := len(signers) - 1; i >= 0; i-- {
+		require.NoError(t, tx.SignPayload(signers[i].Address, 0, signers[i].Signer))
+	}
+	require.NoError(t, tx.SignEnvelope(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKeySigner))
+
+	require.NoError(t, b.AddTransaction(*convert.SDKTransactionToFlow(*tx)))
+	result, err := b.ExecuteNextTransaction()
+	require.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	require.NoError(t, err)
+
+	return result
+}
+
+// ExecuteScript runs a script with the arguments and returns its value,
+// failing the test if the script does not succeed
+func (b *Blockchain) ExecuteScript(t testing.TB, script []byte, args ...cadence.Value) cadence.Value {
+	arguments := make([][]byte, len(args))
+	for i, arg := range args {
+		arguments[i] = jsoncdc.MustEncode(arg)
+	}
+
+	return ExecuteScriptAndCheck(t, b.Blockchain, script, arguments)
+}
+
+// SignAndSubmit signs a transaction with an array of signers and adds their signatures to the transaction
+// before submitting it to the emulator.
+//
+// If the private keys do not match up with the addresses, the transaction will not succeed.
+//
+// The shouldRevert parameter indicates whether the transaction should fail or not.
+//
+// This function asserts the correct result and commits the block if it passed.
+func SignAndSubmit(
+	t testing.TB,
+	b *emulator.Blockchain,
+	tx *flow.Transaction,
+	signerAddresses []flow.Address,
+	signers []crypto.Signer,
+	shouldRevert bool,
+) *types.TransactionResult {
+	// sign transaction with each signer
+	for i := len(signerAddresses) - 1; i >= 0; i-- {
+		signerAddress := signerAddresses[i]
+		signer := signers[i]
+
+		if i == 0 {
+			err := tx.SignEnvelope(signerAddress, 0, signer)
+			assert.NoError(t, err)
+		} else {
+			err := tx.SignPayload(signerAddress, 0, signer)
+			assert.NoError(t, err)
+		}
+	}
+
+	return Submit(t, b, tx, shouldRevert)
+}
+
+// Submit submits a transaction and checks if it fails or not.
+func Submit(
+	t testing.TB,
+	b *emulator.Blockchain,
+	tx *flow.Transaction,
+	shouldRevert bool,
+) *types.TransactionResult {
+	// submit the signed transaction
+	flowTx := convert.SDKTransactionToFlow(*tx)
+	err := b.AddTransaction(*flowTx)
+	require.NoError(t, err)
+
+	result, err := b.ExecuteNextTransaction()
+	require.NoError(t, err)
+
+	if shouldRevert {
+		assert.True(t, result.Reverted())
+	} else {
+		if !assert.True(t, result.Succeeded()) {
+			t.Log(result.Error.Error())
+		}
+	}
+
+	_, err = b.CommitBlock()
+	assert.NoError(t, err)
+
+	return result
+}
+
+// ExecuteScriptAndCheck executes a script and checks to make sure that it succeeded.
+func ExecuteScriptAndCheck(t testing.TB, b *emulator.Blockchain, script []byte, arguments [][]byte) cadence.Value {
+	result, err := b.ExecuteScript(script, arguments)
+	require.NoError(t, err)
+	if !assert.True(t, result.Succeeded()) {
+		t.Log(result.Error.Error())
+	}
+
+	return result.Value
+}
+
+// UpdateContract replaces the code of a contract already deployed to the address
+func UpdateContract(b *emulator.Blockchain, address flow.Address, signer crypto.Signer, name string, contractCode []byte) error {
+	tx := sdktemplates.UpdateAccountContract(
+		address,
+		sdktemplates.Contract{
+			Name:   name,
+			Source: string(contractCode),
+		},
+	)
+
+	tx.SetComputeLimit(DefaultGasLimit).
+		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+		SetPayer(b.ServiceKey().Address)
+
+	err := tx.SignPayload(address, 0, signer)
+	if err != nil {
+		return err
+	}
+
+	serviceSigner, err := b.ServiceKey().Signer()
+	if err != nil {
+		return err
+	}
+
+	err = tx.SignEnvelope(b.ServiceKey().Address, b.ServiceKey().Index, serviceSigner)
+	if err != nil {
+		return err
+	}
+
+	flowTx := convert.SDKTransactionToFlow(*tx)
+	err = b.AddTransaction(*flowTx)
+	if err != nil {
+		return err
+	}
+
+	result, err := b.ExecuteNextTransaction()
+	if err != nil {
+		return err
+	}
+	if !result.Succeeded() {
+		return result.Error
+	}
+
+	_, err = b.CommitBlock()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CadenceUFix64 returns a UFix64 value
+func CadenceUFix64(value string) cadence.Value {
+	newValue, err := cadence.NewUFix64(value)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return newValue
+}
+
+// CadenceString returns a string value from a string representation
+func CadenceString(value string) cadence.Value {
+	newValue, err := cadence.NewString(value)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return newValue
+}
+
+// CadenceStringDictionary returns a {String: String} dictionary of the metadata
+func CadenceStringDictionary(metadata map[string]string) cadence.Dictionary {
+	pairs := make([]cadence.KeyValuePair, 0, len(metadata))
+	for key, value := range metadata {
+		pairs = append(pairs, cadence.KeyValuePair{Key: CadenceString(key), Value: CadenceString(value)})
+	}
+
+	return cadence.NewDictionary(pairs).
+		WithType(cadence.NewDictionaryType(cadence.StringType, cadence.StringType))
+}
+
+// UInt32Array returns a [UInt32] array of the values
+func UInt32Array(values ...uint32) cadence.Array {
+	mapped := make([]cadence.Value, len(values))
+	for i, v := range values {
+		mapped[i] = cadence.NewUInt32(v)
+	}
+	return cadence.NewArray(mapped).WithType(cadence.NewVariableSizedArrayType(cadence.UInt32Type))
+}
+
+// UInt64Array returns a [UInt64] array of the values
+func UInt64Array(values ...uint64) cadence.Array {
+	mapped := make([]cadence.Value, len(values))
+	for i, v := range values {
+		mapped[i] = cadence.NewUInt64(v)
+	}
+	return cadence.NewArray(mapped).WithType(cadence.NewVariableSizedArrayType(cadence.UInt64Type))
+}
@@ -0,0 +1,43 @@
+package testkit_test
+
+import (
+	"testing"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/contracts"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
+)
+
+// The standards vendored in contracts/imports should stay in step with the
+// ones the emulator deploys, otherwise tests and deployments drift apart
+func TestVendoredStandardsMatchEmulator(t *testing.T) {
+	b := testkit.NewBlockchain(t)
+
+	service := testkit.EmulatorServiceAddress
+	ft := testkit.EmulatorFTAddress
+
+	for _, tc := range []struct {
+		address string
+		name    string
+		code    []byte
+	}{
+		{service, "ViewResolver", contracts.GenerateViewResolverContract()},
+		{service, "NonFungibleToken", contracts.GenerateNonFungibleTokenContract(service)},
+		{service, "MetadataViews", contracts.GenerateMetadataViewsContract(ft, service, service)},
+		{ft, "FungibleToken", contracts.GenerateFungibleTokenContract(service, service)},
+		{ft, "FungibleTokenMetadataViews", contracts.GenerateFungibleTokenMetadataViewsContract(ft, service, service)},
+		{ft, "FungibleTokenSwitchboard", contracts.GenerateFungibleTokenSwitchboardContract(ft)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			account, err := b.GetAccount(flow.HexToAddress(tc.address))
+			require.NoError(t, err)
+
+			deployed, ok := account.Contracts[tc.name]
+			require.True(t, ok, "%s is not deployed to %s", tc.name, tc.address)
+			assert.Equal(t, string(deployed), string(tc.code))
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/dapperlabs/nba-smart-contracts/lib/go/contracts"
 	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/test/testkit"
 
 	"github.com/onflow/flow-go-sdk/crypto"
 	sdktemplates "github.com/onflow/flow-go-sdk/templates"
@@ -27,35 +28,17 @@ import (
 )
 
 const (
-	NonFungibleTokenContractsBaseURL = "https://raw.githubusercontent.com/onflow/flow-nft/0ceed9bbdaba549328a73c4fca9f30a9e56c49bc/contracts/"
-	NonFungibleTokenInterfaceFile    = "NonFungibleToken.cdc"
-
-	MetadataViewsContractsBaseURL = "https://raw.githubusercontent.com/onflow/flow-nft/0ceed9bbdaba549328a73c4fca9f30a9e56c49bc/contracts/"
-	MetadataViewsInterfaceFile    = "MetadataViews.cdc"
-
-	FungibleTokenMetadataViewsContractsBaseURL = "https://raw.githubusercontent.com/onflow/flow-ft/9a9073c713583061cf614efc4aed0a1572ca0f09/contracts/"
-	FungibleTokenMetadataViewsInterfaceFile    = "FungibleTokenMetadataViews.cdc"
-
-	FungibleTokenSwitchboardContractsBaseURL = "https://raw.githubusercontent.com/onflow/flow-ft/9a9073c713583061cf614efc4aed0a1572ca0f09/contracts/"
-	FungibleTokenSwitchboardInterfaceFile    = "FungibleTokenSwitchboard.cdc"
-
-	ViewResolverContractsBaseURL = "https://raw.githubusercontent.com/onflow/flow-nft/0ceed9bbdaba549328a73c4fca9f30a9e56c49bc/contracts/"
-	ViewResolverInterfaceFile    = "ViewResolver.cdc"
-
-	emulatorFTAddress           = "ee82856bf20e2aa6"
-	emulatorFlowTokenAddress    = "0ae53cb6e3f42a79"
-	MetadataFTReplaceAddress    = `"FungibleToken"`
-	MetadataNFTReplaceAddress   = `"NonFungibleToken"`
-	MetadataViewsReplaceAddress = `"MetadataViews"`
-	ViewResolverReplaceAddress  = `"ViewResolver"`
-	Network                     = `"mainnet"`
-	FlowEvmContractAddr         = `"0x1234565789012345657890123456578901234565"`
-	EvmBaseURI                  = `"https://base.uri/moment/"`
+	emulatorFTAddress        = "ee82856bf20e2aa6"
+	emulatorFlowTokenAddress = "0ae53cb6e3f42a79"
+	Network                  = `"mainnet"`
+	FlowEvmContractAddr      = `"0x1234565789012345657890123456578901234565"`
+	EvmBaseURI               = `"https://base.uri/moment/"`
 )
 
 // topshotTestContext will expose sugar for common actions needed to bootstrap testing
 type topshotTestBlockchain struct {
 	*emulator.Blockchain
+	kit                            *testkit.Blockchain
 	env                            templates.Environment
 	topshotAdminAddr               flow.Address
 	evmAddr                        flow.Address
@@ -73,159 +56,23 @@ type topshotTestBlockchain struct {
 }
 
 func NewTopShotTestBlockchain(t *testing.T) topshotTestBlockchain {
-	b := newBlockchain()
-
-	serviceKeySigner, err := b.ServiceKey().Signer()
-	require.NoError(t, err)
-
-	accountKeys := test.AccountKeyGenerator()
-
-	env := templates.Environment{
-		FungibleTokenAddress: emulatorFTAddress,
-		FlowTokenAddress:     emulatorFlowTokenAddress,
-	}
-
-	logger := zerolog.Nop()
-	adapter := adapters.NewSDKAdapter(&logger, b)
-
-	viewResolverAddr := flow.HexToAddress("f8d6e0586b0a20c7")
-	env.ViewResolverAddress = viewResolverAddr.String()
-
-	nftAddr := flow.HexToAddress("f8d6e0586b0a20c7")
-	env.NFTAddress = nftAddr.String()
-
-	metadataViewsAddr := flow.HexToAddress("f8d6e0586b0a20c7")
-	env.MetadataViewsAddress = metadataViewsAddr.String()
-
-	fungibleTokenMetadataViewsAddr := flow.HexToAddress("ee82856bf20e2aa6")
-	env.FungibleTokenMetadataViewsAddress = fungibleTokenMetadataViewsAddr.String()
-
-	evmAddr := flow.HexToAddress("f8d6e0586b0a20c7")
-	env.EVMAddress = evmAddr.String()
-
-	// Deploy CrossVMMetadataViews contract
-	crossVMMetadataViewsCode := contracts.GenerateCrossVMMetadataViewsContract(evmAddr.String(), viewResolverAddr.String())
-	crossVMMetadataViewsAddr, err := adapter.CreateAccount(context.Background(), nil, []sdktemplates.Contract{
-		{
-			Name:   "CrossVMMetadataViews",
-			Source: string(crossVMMetadataViewsCode),
-		},
-	})
-	require.NoError(t, err)
-	env.CrossVMMetadataViewsAddress = crossVMMetadataViewsAddr.String()
-
-	// Deploy TopShot Locking contract
-	lockingKey, lockingSigner := test.AccountKeyGenerator().NewWithSigner()
-	topshotLockingCode := contracts.GenerateTopShotLockingContract(nftAddr.String())
-	topShotLockingAddr, err := adapter.CreateAccount(context.Background(), []*flow.AccountKey{lockingKey}, []sdktemplates.Contract{
-		{
-			Name:   "TopShotLocking",
-			Source: string(topshotLockingCode),
-		},
-	})
-	assert.Nil(t, err)
-
-	env.TopShotLockingAddress = topShotLockingAddr.String()
-
-	royaltyAddr := flow.HexToAddress("ee82856bf20e2aa6")
-	env.FTSwitchboardAddress = royaltyAddr.String()
-	_, err = b.CommitBlock()
-	require.NoError(t, err)
-
-	// Deploy the topshot contract
-	topshotCode := contracts.GenerateTopShotContract(
-		emulatorFTAddress,
-		nftAddr.String(),
-		metadataViewsAddr.String(),
-		viewResolverAddr.String(),
-		crossVMMetadataViewsAddr.String(),
-		evmAddr.String(),
-		topShotLockingAddr.String(),
-		royaltyAddr.String(),
-		Network,
-		FlowEvmContractAddr,
-		EvmBaseURI,
-	)
-	topshotAccountKey, topshotSigner := accountKeys.NewWithSigner()
-	topshotAddr, err := adapter.CreateAccount(context.Background(), []*flow.AccountKey{topshotAccountKey}, []sdktemplates.Contract{
-		{
-			Name:   "TopShot",
-			Source: string(topshotCode),
-		},
-	})
-	require.NoError(t, err)
-
-	env.TopShotAddress = topshotAddr.String()
-
-	// Update the locking contract with topshot address
-	topShotLockingCodeWithRuntimeAddr := contracts.GenerateTopShotLockingContractWithTopShotRuntimeAddr(nftAddr.String(), topshotAddr.String())
-	err = updateContract(b, topShotLockingAddr, lockingSigner, "TopShotLocking", topShotLockingCodeWithRuntimeAddr)
-	require.NoError(t, err)
-
-	// Grant the TopShot account a TopShotLocking admin
-	// In testnet/mainnet the TopShotLocking contract is in the same account as TopShot contract
-	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTopShotLockingAdminGrantAdminScript(env), topShotLockingAddr)
-	tx.AddAuthorizer(topshotAddr)
-
-	signAndSubmit(
-		t, b, tx,
-		[]flow.Address{b.ServiceKey().Address, topShotLockingAddr, topshotAddr},
-		[]crypto.Signer{serviceKeySigner, lockingSigner, topshotSigner},
-		false,
-	)
-
-	tx = createTxWithTemplateAndAuthorizer(b, templates.GenerateSetupSwitchboardScript(env), royaltyAddr)
-
-	signAndSubmit(
-		t, b, tx,
-		[]flow.Address{b.ServiceKey().Address, royaltyAddr},
-		[]crypto.Signer{serviceKeySigner, serviceKeySigner},
-		false,
-	)
-
-	// Check that that main contract fields were initialized correctly
-	result := executeScriptAndCheck(t, b, templates.GenerateGetSeriesScript(env), nil)
-	assert.Equal(t, cadence.NewUInt32(0), result)
-
-	result = executeScriptAndCheck(t, b, templates.GenerateGetNextPlayIDScript(env), nil)
-	assert.Equal(t, cadence.NewUInt32(1), result)
-
-	result = executeScriptAndCheck(t, b, templates.GenerateGetNextSetIDScript(env), nil)
-	assert.Equal(t, cadence.NewUInt32(1), result)
-
-	result = executeScriptAndCheck(t, b, templates.GenerateGetSupplyScript(env), nil)
-	assert.Equal(t, cadence.NewUInt64(0), result)
-
-	// Deploy the sharded collection contract
-	shardedCollectionCode := contracts.GenerateTopShotShardedCollectionContract(nftAddr.String(), topshotAddr.String(), viewResolverAddr.String())
-	shardedAddr, err := adapter.CreateAccount(context.Background(), nil, []sdktemplates.Contract{
-		{
-			Name:   "TopShotShardedCollection",
-			Source: string(shardedCollectionCode),
-		},
-	})
-	require.NoError(t, err)
-
-	_, err = b.CommitBlock()
-
-	require.NoError(t, err)
-
-	env.ShardedAddress = shardedAddr.String()
+	kit := testkit.NewTopShotBlockchain(t)
 
 	return topshotTestBlockchain{
-		Blockchain:                     b,
-		env:                            env,
-		topshotAdminAddr:               topshotAddr,
-		evmAddr:                        evmAddr,
-		crossVMMetadataViewsAddr:       crossVMMetadataViewsAddr,
-		metadataViewsAddr:              metadataViewsAddr,
-		topshotLockingAddr:             topShotLockingAddr,
-		fungibleTokenMetadataViewsAddr: fungibleTokenMetadataViewsAddr,
-		viewResolverAddr:               viewResolverAddr,
-		serviceKeySigner:               serviceKeySigner,
-		topshotAdminSigner:             topshotSigner,
-		lockingSigner:                  lockingSigner,
-		accountKeys:                    accountKeys}
+		Blockchain:                     kit.Blockchain,
+		kit:                            kit,
+		env:                            kit.Env,
+		topshotAdminAddr:               kit.TopShotAdmin.Address,
+		evmAddr:                        flow.HexToAddress(kit.Env.EVMAddress),
+		crossVMMetadataViewsAddr:       flow.HexToAddress(kit.Env.CrossVMMetadataViewsAddress),
+		metadataViewsAddr:              flow.HexToAddress(kit.Env.MetadataViewsAddress),
+		topshotLockingAddr:             kit.Locking.Address,
+		fungibleTokenMetadataViewsAddr: flow.HexToAddress(kit.Env.FungibleTokenMetadataViewsAddress),
+		viewResolverAddr:               flow.HexToAddress(kit.Env.ViewResolverAddress),
+		serviceKeySigner:               kit.ServiceKeySigner,
+		topshotAdminSigner:             kit.TopShotAdmin.Signer,
+		lockingSigner:                  kit.Locking.Signer,
+		accountKeys:                    kit.AccountKeys}
 }
 
 // This test is for testing the deployment the topshot smart contracts
@@ -0,0 +1,126 @@
+// Command fastbreak-leaderboard serves the standings of Fast Break runs and
+// games, built from a file of FastBreakV1 events.
+//
+//	fastbreak-leaderboard -events fastbreak.jsonl -addr :8080 \
+//		-access access.mainnet.nodes.onflow.org:9000 -fastbreak 0xb6f2481eba4df97b
+//
+// The runs are served at /runs, the standings of a run at /runs/{id}, of a
+// game at /games/{id} and the record of a player at /players/{id}. The
+// events carry no points, so with -fastbreak the closed games are read with
+// get_fast_break for their scores; without it every player has 0 points. With
+// -reload, the events are read again whenever the file changes.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak/leaderboard"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak/validate"
+)
+
+func main() {
+	eventsPath := flag.String("events", "", "file of FastBreakV1 JSON-CDC events, one per line in chain order")
+	accessNode := flag.String("access", "access.mainnet.nodes.onflow.org:9000", "gRPC address of the access node")
+	fastBreak := flag.String("fastbreak", "", "account FastBreakV1 is deployed to, to read the points of closed games")
+	addr := flag.String("addr", ":8080", "address to listen on")
+	reload := flag.Duration("reload", 0, "interval to check the events file for changes at, never when 0")
+	flag.Parse()
+
+	if *eventsPath == "" {
+		log.Fatal("-events is required")
+	}
+
+	var state *validate.FlowState
+	if *fastBreak != "" {
+		client, err := grpc.NewClient(*accessNode)
+		if err != nil {
+			log.Fatal(err)
+		}
+		state = &validate.FlowState{Client: client, Env: templates.Environment{FastBreakAddress: flow.HexToAddress(*fastBreak).Hex()}}
+	}
+
+	l, modified, err := load(*eventsPath, state)
+	if err != nil {
+		log.Fatal(err)
+	}
+	server := leaderboard.NewServer(l)
+
+	if *reload > 0 {
+		go func() {
+			for range time.Tick(*reload) {
+				info, err := os.Stat(*eventsPath)
+				if err != nil || !info.ModTime().After(modified) {
+					continue
+				}
+				l, changed, err := load(*eventsPath, state)
+				if err != nil {
+					log.Printf("Keeping the previous events: %v", err)
+					continue
+				}
+				server.Update(l)
+				modified = changed
+				log.Printf("Reloaded %s with %d games", *eventsPath, len(l.GameIDs()))
+			}
+		}()
+	}
+
+	log.Printf("Serving the standings of %d runs on %s", len(l.RunIDs()), *addr)
+	log.Fatal(http.ListenAndServe(*addr, server.Handler()))
+}
+
+// load reads the leaderboard of the events, scoring its closed games when
+// state is set, and returns the modification time of the events file
+func load(eventsPath string, state *validate.FlowState) (*leaderboard.Leaderboard, time.Time, error) {
+	file, err := os.Open(eventsPath)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	l, err := leaderboard.ReadEvents(file)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("%s: %w", eventsPath, err)
+	}
+	if state != nil {
+		if err := score(l, state); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+	return l, info.ModTime(), nil
+}
+
+// score reads the scores of the closed games, again on every load since the
+// oracle may correct them
+func score(l *leaderboard.Leaderboard, state *validate.FlowState) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	for _, id := range l.GameIDs() {
+		game, _ := l.Game(id)
+		if game.Status != fastbreak.GameClosed {
+			continue
+		}
+		scored, err := state.Game(ctx, id)
+		if err != nil {
+			return fmt.Errorf("game %s: %w", id, err)
+		}
+		if scored != nil {
+			l.Score(scored)
+		}
+	}
+	return nil
+}
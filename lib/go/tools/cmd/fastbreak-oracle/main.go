@@ -0,0 +1,207 @@
+// Command fastbreak-oracle runs the Fast Break oracle against an access
+// node, from a feed directory holding schedule.json and the box scores of
+// games in boxscores/<game id>.json.
+//
+//	fastbreak-oracle -access access.mainnet.nodes.onflow.org:9000 -fastbreak 0xb6f2481eba4df97b \
+//		-key-file oracle.key -feed feed -events events.jsonl -journal oracle.json
+//
+// The top shots of submissions are matched to the box scores by the FullName
+// of their play, read from a file of TopShot events that is read again
+// whenever it changes. Runs in fatigue mode limit the plays of top shots by
+// their tier, given by set id in the JSON object of -set-tiers:
+//
+//	{"1": "COMMON", "2": "RARE", "3": "LEGENDARY"}
+//
+// Top shots of sets missing from it have the limit of common ones.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/onflow/flow-go-sdk/crypto"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak/oracle"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+func main() {
+	accessNode := flag.String("access", "access.mainnet.nodes.onflow.org:9000", "gRPC address of the access node")
+	fastBreak := flag.String("fastbreak", "", "account FastBreakV1 is deployed to")
+	oracleAccount := flag.String("oracle", "", "account the oracle resource is stored in, the FastBreakV1 account by default")
+	keyFile := flag.String("key-file", "", "file of the hex encoded private key of the oracle account")
+	keyIndex := flag.Uint("key-index", 0, "index of the key on the oracle account")
+	sigAlgo := flag.String("sig-algo", "ECDSA_P256", "signature algorithm of the key")
+	hashAlgo := flag.String("hash-algo", "SHA3_256", "hash algorithm of the key")
+	feedDir := flag.String("feed", "", "directory of schedule.json and boxscores/<game id>.json")
+	eventsPath := flag.String("events", "", "file of TopShot JSON-CDC events, one per line in chain order, to find the players of top shots")
+	setTiersPath := flag.String("set-tiers", "", "JSON file of the tier of each set id, for runs in fatigue mode")
+	journalPath := flag.String("journal", "fastbreak-oracle.json", "file the sent transactions are recorded in")
+	interval := flag.Duration("interval", 30*time.Second, "interval between the steps of the oracle")
+	once := flag.Bool("once", false, "step once and exit")
+	flag.Parse()
+
+	if *fastBreak == "" || *keyFile == "" || *feedDir == "" || *eventsPath == "" {
+		log.Fatal("-fastbreak, -key-file, -feed and -events are required")
+	}
+	if *oracleAccount == "" {
+		*oracleAccount = *fastBreak
+	}
+	oracleAddr := flow.HexToAddress(*oracleAccount)
+
+	signer, err := readSigner(*keyFile, *sigAlgo, *hashAlgo)
+	if err != nil {
+		log.Fatal(err)
+	}
+	client, err := grpc.NewClient(*accessNode)
+	if err != nil {
+		log.Fatal(err)
+	}
+	journal, err := oracle.LoadJournal(*journalPath, oracleAddr.HexWithPrefix())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var setTiers map[uint32]fastbreak.Tier
+	if *setTiersPath != "" {
+		if setTiers, err = readSetTiers(*setTiersPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	env := templates.Environment{FastBreakAddress: flow.HexToAddress(*fastBreak).Hex()}
+	logger := log.New(os.Stderr, "", log.LstdFlags)
+	events := &events{path: *eventsPath, setTiers: setTiers, log: logger}
+	o := &oracle.Oracle{
+		Feed: oracle.FileFeed{Dir: *feedDir},
+		Chain: &oracle.FlowChain{
+			Client:   client,
+			Env:      env,
+			Oracle:   oracleAddr,
+			KeyIndex: uint32(*keyIndex),
+			Signer:   signer,
+		},
+		Engine:      events.engine(),
+		Env:         env,
+		Journal:     journal,
+		JournalPath: *journalPath,
+		Log:         logger,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *once {
+		if err := o.Step(ctx); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if err := o.Run(ctx, *interval); err != nil && ctx.Err() == nil {
+		log.Fatal(err)
+	}
+}
+
+func readSigner(path, sigAlgo, hashAlgo string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.DecodePrivateKeyHex(crypto.StringToSignatureAlgorithm(sigAlgo), strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key in %s: %w", path, err)
+	}
+	return crypto.NewInMemorySigner(key, crypto.StringToHashAlgorithm(hashAlgo))
+}
+
+func readSetTiers(path string) (map[uint32]fastbreak.Tier, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var setTiers map[uint32]fastbreak.Tier
+	if err := json.Unmarshal(data, &setTiers); err != nil {
+		return nil, fmt.Errorf("invalid set tiers %s: %w", path, err)
+	}
+	for setID, tier := range setTiers {
+		switch tier {
+		case fastbreak.Common, fastbreak.Fandom, fastbreak.Rare, fastbreak.Legendary, fastbreak.Ultimate:
+		default:
+			return nil, fmt.Errorf("invalid set tiers %s: unknown tier %q of set %d", path, tier, setID)
+		}
+	}
+	return setTiers, nil
+}
+
+// events looks up the players and tiers of top shots in the projection of
+// the events file, read again when the file changed since the last lookup
+type events struct {
+	path     string
+	setTiers map[uint32]fastbreak.Tier
+	log      *log.Logger
+
+	mu       sync.Mutex
+	modified time.Time
+	players  fastbreak.Players
+	tiers    fastbreak.Tiers
+}
+
+func (e *events) engine() fastbreak.Engine {
+	return fastbreak.Engine{
+		Players: func(momentID uint64) (string, bool) {
+			e.refresh()
+			if e.players == nil {
+				return "", false
+			}
+			return e.players(momentID)
+		},
+		Tiers: func(momentID uint64) (fastbreak.Tier, bool) {
+			e.refresh()
+			if e.tiers == nil {
+				return "", false
+			}
+			return e.tiers(momentID)
+		},
+	}
+}
+
+func (e *events) refresh() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	info, err := os.Stat(e.path)
+	if err == nil && info.ModTime().After(e.modified) {
+		if err = e.load(); err == nil {
+			e.modified = info.ModTime()
+		}
+	}
+	if err != nil {
+		e.log.Printf("Keeping the previous events of %s: %v", e.path, err)
+	}
+}
+
+func (e *events) load() error {
+	file, err := os.Open(e.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	projected, err := projection.ReadEvents(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", e.path, err)
+	}
+	e.players = fastbreak.ProjectionPlayers(projected)
+	e.tiers = fastbreak.ProjectionTiers(projected, e.setTiers)
+	return nil
+}
@@ -0,0 +1,208 @@
+// Command lock-admin manages the locks of TopShotLocking for support staff.
+//
+//	lock-admin status -moments moments.txt
+//	lock-admin set-expiry -moments moments.txt -expiry 2025-06-01T00:00:00Z -apply -admin 0x... -key-file admin.key
+//	lock-admin mark-unlockable -owner 0x... -ids 42,43 -apply -admin 0x... -key-file admin.key
+//	lock-admin unlock-all -apply -admin 0x... -key-file admin.key
+//
+// Moments are given by -owner and -ids, or by -moments, a file of the owner
+// and id of a moment per line. Every action prints a preview of the moments
+// it affects and skips, and only sends its transactions with -apply, once
+// confirmed. Each transaction is appended to the -audit log when sent and
+// once sealed or failed, and the log can be passed to lock-expiry as its
+// -overrides.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/onflow/flow-go-sdk/crypto"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/locking/admin"
+)
+
+const usage = "usage: lock-admin status|set-expiry|mark-unlockable|unlock-all [flags]"
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+	command := os.Args[1]
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	accessNode := flags.String("access", "access.mainnet.nodes.onflow.org:9000", "gRPC address of the access node")
+	topShot := flags.String("topshot", "0b2a3299cc857e29", "address of TopShot")
+	topShotLocking := flags.String("locking", "0b2a3299cc857e29", "address of TopShotLocking")
+	owner := flags.String("owner", "", "owner of the moments of -ids")
+	ids := flags.String("ids", "", "comma separated ids of moments of -owner")
+	momentsPath := flags.String("moments", "", "file of moments, the owner and id of a moment per line")
+	expiry := flags.String("expiry", "", "expiry set-expiry sets, as a unix timestamp or an RFC 3339 time")
+	apply := flags.Bool("apply", false, "send the transactions after the preview, once confirmed")
+	yes := flags.Bool("yes", false, "send the transactions of -apply without asking to confirm")
+	adminAccount := flags.String("admin", "", "account holding the TopShotLocking admin, to sign with")
+	keyFile := flags.String("key-file", "", "file of the hex encoded private key of the admin account")
+	keyIndex := flags.Uint("key-index", 0, "index of the key on the admin account")
+	sigAlgo := flags.String("sig-algo", "ECDSA_P256", "signature algorithm of the key")
+	hashAlgo := flags.String("hash-algo", "SHA3_256", "hash algorithm of the key")
+	batchSize := flags.Int("batch-size", admin.DefaultBatchSize, "maximum number of moments changed by a transaction")
+	auditPath := flags.String("audit", "lock-admin.jsonl", "file the admin transactions are appended to")
+	if err := flags.Parse(os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := grpc.NewClient(*accessNode)
+	if err != nil {
+		log.Fatal(err)
+	}
+	chain := &admin.FlowChain{
+		Client: client,
+		Env: templates.Environment{
+			TopShotAddress:        flow.HexToAddress(*topShot).Hex(),
+			TopShotLockingAddress: flow.HexToAddress(*topShotLocking).Hex(),
+		},
+	}
+	console := &admin.Console{Chain: chain, Env: chain.Env, BatchSize: *batchSize}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var plan *admin.Plan
+	switch command {
+	case "status":
+		moments := readMoments(*owner, *ids, *momentsPath)
+		locks, err := console.Locks(ctx, moments)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := admin.WriteLocks(os.Stdout, locks); err != nil {
+			log.Fatal(err)
+		}
+		return
+	case "set-expiry":
+		at, err := parseTime(*expiry)
+		if err != nil {
+			log.Fatal(err)
+		}
+		plan, err = console.PlanSetLockExpiry(ctx, readMoments(*owner, *ids, *momentsPath), at)
+		if err != nil {
+			log.Fatal(err)
+		}
+	case "mark-unlockable":
+		plan, err = console.PlanMarkUnlockable(ctx, readMoments(*owner, *ids, *momentsPath))
+		if err != nil {
+			log.Fatal(err)
+		}
+	case "unlock-all":
+		plan, err = console.PlanUnlockAll(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatal(usage)
+	}
+
+	if err := plan.WritePreview(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	if !*apply || len(plan.Transactions) == 0 {
+		return
+	}
+	if *adminAccount == "" || *keyFile == "" {
+		log.Fatal("-apply requires -admin and -key-file")
+	}
+	if !*yes && !confirm(fmt.Sprintf("Send %d transactions as %s?", len(plan.Transactions), flow.HexToAddress(*adminAccount).HexWithPrefix())) {
+		log.Print("Nothing sent")
+		return
+	}
+
+	chain.Admin = flow.HexToAddress(*adminAccount)
+	chain.KeyIndex = uint32(*keyIndex)
+	if chain.Signer, err = readSigner(*keyFile, *sigAlgo, *hashAlgo); err != nil {
+		log.Fatal(err)
+	}
+	audit, err := os.OpenFile(*auditPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer audit.Close()
+	console.Admin = chain.Admin.HexWithPrefix()
+	console.Audit = audit
+
+	if err := console.Apply(ctx, plan); err != nil {
+		log.Fatalf("%v; the transactions sent are in %s", err, *auditPath)
+	}
+	log.Printf("Sent %d transactions, recorded in %s", len(plan.Transactions), *auditPath)
+}
+
+func readMoments(owner, ids, path string) []admin.Moment {
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer file.Close()
+		moments, err := admin.ReadMoments(file)
+		if err != nil {
+			log.Fatalf("%s: %v", path, err)
+		}
+		return moments
+	}
+
+	if owner == "" || ids == "" {
+		log.Fatal("-moments, or -owner and -ids, are required")
+	}
+	var moments []admin.Moment
+	for _, id := range strings.Split(ids, ",") {
+		parsed, err := strconv.ParseUint(strings.TrimSpace(id), 10, 64)
+		if err != nil {
+			log.Fatalf("invalid moment id %q", id)
+		}
+		moments = append(moments, admin.Moment{Owner: flow.HexToAddress(owner).HexWithPrefix(), ID: parsed})
+	}
+	return moments
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("-expiry is required")
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid -expiry %q, expected a unix timestamp or an RFC 3339 time", value)
+	}
+	return at, nil
+}
+
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func readSigner(path, sigAlgo, hashAlgo string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.DecodePrivateKeyHex(crypto.StringToSignatureAlgorithm(sigAlgo), strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key in %s: %w", path, err)
+	}
+	return crypto.NewInMemorySigner(key, crypto.StringToHashAlgorithm(hashAlgo))
+}
@@ -0,0 +1,193 @@
+// Command lock-expiry notifies the TopShot moments whose locks expire, are
+// marked unlockable or are cleared by unlockAll, built from a file of
+// TopShot and TopShotLocking events and a file of the admin overrides that
+// emit no event, both read again at every step.
+//
+//	lock-expiry -events events.jsonl -overrides overrides.jsonl -sink webhook:http://localhost:8080/locks
+//
+// Notifications go to stdout, to a file with -sink file:<path> or are
+// posted to a webhook with -sink webhook:<url>. The notifications delivered
+// are recorded in -state, so a restarted scheduler does not deliver them
+// again. With -unlock, the moments of the owners listed in -opt-in, a JSON
+// array of addresses, are batched into batch_unlock_moments transactions
+// for them to sign, written to the directory as batch_unlock_moments.cdc
+// and a line of arguments per batch appended to unlock.jsonl, to send with
+//
+//	flow transactions send batch_unlock_moments.cdc --args-json '[{"type":"Array","value":[...]}]' --signer owner
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/locking"
+)
+
+func main() {
+	eventsPath := flag.String("events", "", "file of TopShot and TopShotLocking JSON-CDC events, one per line in chain order")
+	overridesPath := flag.String("overrides", "", "file of the markUnlockable and unlockAll overrides, one per line")
+	sinkSpec := flag.String("sink", "stdout", "where to notify: stdout, file:<path> or webhook:<url>")
+	statePath := flag.String("state", "lock-expiry.json", "file the notifications delivered are recorded in")
+	optInPath := flag.String("opt-in", "", "JSON file of the addresses of the owners to batch unlock transactions for")
+	unlockDir := flag.String("unlock", "", "directory to write the unlock transactions of the owners who opted in to")
+	batchSize := flag.Int("batch-size", locking.DefaultBatchSize, "maximum number of moments unlocked by a transaction")
+	nft := flag.String("nft", "1d7e57aa55817448", "address of NonFungibleToken")
+	topShot := flag.String("topshot", "0b2a3299cc857e29", "address of TopShot")
+	interval := flag.Duration("interval", time.Minute, "interval between the steps of the scheduler")
+	once := flag.Bool("once", false, "step once and exit")
+	flag.Parse()
+
+	if *eventsPath == "" {
+		log.Fatal("-events is required")
+	}
+	if (*unlockDir == "") != (*optInPath == "") {
+		log.Fatal("-unlock and -opt-in go together")
+	}
+
+	sink, err := parseSink(*sinkSpec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	state, err := locking.LoadState(*statePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	scheduler := &locking.Scheduler{
+		Index:     func() (*locking.Index, error) { return readIndex(*eventsPath, *overridesPath) },
+		Sink:      sink,
+		BatchSize: *batchSize,
+		State:     state,
+		StatePath: *statePath,
+		Log:       log.New(os.Stderr, "", log.LstdFlags),
+	}
+	if *unlockDir != "" {
+		if scheduler.OptIn, err = readOptIn(*optInPath); err != nil {
+			log.Fatal(err)
+		}
+		env := templates.Environment{
+			NFTAddress:     flow.HexToAddress(*nft).Hex(),
+			TopShotAddress: flow.HexToAddress(*topShot).Hex(),
+		}
+		scheduler.Unlocks = func(batches []locking.UnlockBatch) error {
+			return writeUnlocks(*unlockDir, env, batches)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *once {
+		if err := scheduler.Step(ctx); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if err := scheduler.Run(ctx, *interval); err != nil && ctx.Err() == nil {
+		log.Fatal(err)
+	}
+}
+
+func parseSink(spec string) (locking.Sink, error) {
+	kind, target, _ := strings.Cut(spec, ":")
+	switch {
+	case kind == "stdout" && target == "":
+		return locking.WriterSink{W: os.Stdout}, nil
+	case kind == "file" && target != "":
+		return locking.FileSink{Path: target}, nil
+	case kind == "webhook" && target != "":
+		return locking.WebhookSink{URL: target, Client: &http.Client{Timeout: 30 * time.Second}}, nil
+	}
+	return nil, fmt.Errorf("invalid sink %q, expected stdout, file:<path> or webhook:<url>", spec)
+}
+
+func readIndex(eventsPath, overridesPath string) (*locking.Index, error) {
+	var overrides []locking.Override
+	if overridesPath != "" {
+		file, err := os.Open(overridesPath)
+		if err != nil {
+			return nil, err
+		}
+		overrides, err = locking.ReadOverrides(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", overridesPath, err)
+		}
+	}
+
+	file, err := os.Open(eventsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	index, err := locking.ReadEvents(file, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", eventsPath, err)
+	}
+	return index, nil
+}
+
+func readOptIn(path string) (map[string]bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var addresses []string
+	if err := json.Unmarshal(data, &addresses); err != nil {
+		return nil, fmt.Errorf("invalid opt-in %s: %w", path, err)
+	}
+	optIn := map[string]bool{}
+	for _, address := range addresses {
+		optIn[flow.HexToAddress(address).HexWithPrefix()] = true
+	}
+	return optIn, nil
+}
+
+// unlock is the batch_unlock_moments transaction of a batch
+type unlock struct {
+	Owner     string            `json:"owner"`
+	MomentIDs []uint64          `json:"momentIds"`
+	Arguments []json.RawMessage `json:"arguments"`
+}
+
+func writeUnlocks(dir string, env templates.Environment, batches []locking.UnlockBatch) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "batch_unlock_moments.cdc"), templates.GenerateBatchUnlockMomentScript(env), 0o644); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, "unlock.jsonl")
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	for _, batch := range batches {
+		tx, err := batch.Transaction(env)
+		if err != nil {
+			file.Close()
+			return fmt.Errorf("owner %s: %w", batch.Owner, err)
+		}
+		arguments := make([]json.RawMessage, len(tx.Arguments))
+		for i, argument := range tx.Arguments {
+			arguments[i] = json.RawMessage(argument)
+		}
+		if err := encoder.Encode(unlock{Owner: batch.Owner, MomentIDs: batch.MomentIDs, Arguments: arguments}); err != nil {
+			file.Close()
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return file.Close()
+}
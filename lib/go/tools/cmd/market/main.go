@@ -0,0 +1,79 @@
+// Command market exports the price analytics of TopShotMarketV3 editions
+// and sellers, built from a file of TopShot and TopShotMarketV3 events.
+//
+//	market -events events.jsonl -bucket 24h -out analytics
+//
+// It writes editions.csv, series.csv, sellers.csv and all of them together
+// in report.json to the output directory. The events are JSON-CDC lines in
+// chain order, bare or with the timestamp of their block:
+//
+//	{"blockHeight": 72000000, "blockTimestamp": "2024-03-01T12:00:00Z", "event": {"type": "Event", ...}}
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/market"
+)
+
+func main() {
+	eventsPath := flag.String("events", "", "file of TopShot and TopShotMarketV3 JSON-CDC events, one per line in chain order")
+	bucket := flag.Duration("bucket", 24*time.Hour, "duration of the buckets of the OHLC series")
+	out := flag.String("out", ".", "directory to write the CSV files and report.json to")
+	flag.Parse()
+
+	if *eventsPath == "" {
+		log.Fatal("-events is required")
+	}
+	if *bucket <= 0 {
+		log.Fatal("-bucket must be positive")
+	}
+
+	file, err := os.Open(*eventsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, err := market.ReadEvents(file)
+	file.Close()
+	if err != nil {
+		log.Fatalf("%s: %v", *eventsPath, err)
+	}
+
+	report := m.Report(*bucket)
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal(err)
+	}
+	for name, write := range map[string]func(io.Writer) error{
+		"editions.csv": report.WriteEditionsCSV,
+		"series.csv":   report.WriteSeriesCSV,
+		"sellers.csv":  report.WriteSellersCSV,
+		"report.json":  report.WriteJSON,
+	} {
+		if err := writeFile(filepath.Join(*out, name), write); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	log.Printf("Exported %d editions, %d candles and %d sellers to %s", len(report.Editions), len(report.Series), len(report.Sellers), *out)
+	if report.Unknown > 0 {
+		log.Printf("%d sales are of moments minted before the events begin and are left out of the editions", report.Unknown)
+	}
+}
+
+func writeFile(path string, write func(io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return file.Close()
+}
@@ -0,0 +1,121 @@
+// Command metadata serves the ERC721 metadata of moments and the collection
+// metadata, built from a file of TopShot events, so that the base token URI
+// of BridgedTopShotMoments can point at it on any network.
+//
+//	metadata -events events.jsonl -set-names sets.json -network testnet -addr :8080
+//
+// A token is served at /{id} and the collection at /contract. With -reload,
+// the events are read again whenever the file changes.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/metadata"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+func main() {
+	eventsPath := flag.String("events", "", "file of TopShot and TopShotLocking JSON-CDC events, one per line in chain order")
+	setNames := flag.String("set-names", "", `JSON file of the set names, {"<setID>": "<name>"}, which the events do not carry`)
+	contractPath := flag.String("contract", "", "JSON file of the collection metadata, the NFTCollectionDisplay view of TopShot by default")
+	network := flag.String("network", "mainnet", "network TopShot is deployed on")
+	assetBaseURL := flag.String("asset-base-url", "", "url the media of moments are served from, the TopShot asset host by default")
+	addr := flag.String("addr", ":8080", "address to listen on")
+	reload := flag.Duration("reload", 0, "interval to check the events file for changes at, never when 0")
+	flag.Parse()
+
+	if *eventsPath == "" {
+		log.Fatal("-events is required")
+	}
+
+	contract := metadata.DefaultContract
+	if *contractPath != "" {
+		data, err := os.ReadFile(*contractPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &contract); err != nil {
+			log.Fatalf("invalid collection metadata %s: %v", *contractPath, err)
+		}
+	}
+
+	p, modified, err := load(*eventsPath, *setNames)
+	if err != nil {
+		log.Fatal(err)
+	}
+	server := metadata.NewServer(p, metadata.Builder{Network: *network, AssetBaseURL: *assetBaseURL}, contract)
+
+	if *reload > 0 {
+		go func() {
+			for range time.Tick(*reload) {
+				info, err := os.Stat(*eventsPath)
+				if err != nil || !info.ModTime().After(modified) {
+					continue
+				}
+				p, changed, err := load(*eventsPath, *setNames)
+				if err != nil {
+					log.Printf("Keeping the previous events: %v", err)
+					continue
+				}
+				server.Update(p)
+				modified = changed
+				log.Printf("Reloaded %s with %d moments", *eventsPath, len(p.Moments()))
+			}
+		}()
+	}
+
+	log.Printf("Serving the metadata of %d moments on %s", len(p.Moments()), *addr)
+	log.Fatal(http.ListenAndServe(*addr, server.Handler()))
+}
+
+// load reads the projection of the events, naming its sets, and returns
+// the modification time of the events file
+func load(eventsPath, setNamesPath string) (*projection.Projection, time.Time, error) {
+	file, err := os.Open(eventsPath)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	p, err := projection.ReadEvents(file)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("%s: %w", eventsPath, err)
+	}
+	if setNamesPath != "" {
+		if err := nameSets(p, setNamesPath); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+	return p, info.ModTime(), nil
+}
+
+func nameSets(p *projection.Projection, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var names map[string]string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return fmt.Errorf("invalid set names %s: %w", path, err)
+	}
+	for id, name := range names {
+		setID, err := strconv.ParseUint(id, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid set ID %q in %s", id, path)
+		}
+		p.NameSet(uint32(setID), name)
+	}
+	return nil
+}
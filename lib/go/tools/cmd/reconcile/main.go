@@ -0,0 +1,189 @@
+// Command reconcile reports the moments that are double-counted, missing or
+// stuck wrapped across Cadence and Flow EVM, per owner and per COA. It exits
+// with status 1 when any moment has an issue.
+//
+//	reconcile -cadence-events events.jsonl -evm-logs logs.json \
+//		-legacy 0x... -wrapper 0x... -cadence-escrow 0x... -evm-escrow 0x... -coas coas.json
+//
+// The EVM logs are fetched over JSON-RPC instead when -rpc is given, and can be
+// recorded with -save-logs to replay the reconciliation later.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/reconcile"
+)
+
+func main() {
+	cadenceEvents := flag.String("cadence-events", "", "file of TopShot JSON-CDC events, one per line in chain order")
+	evmLogs := flag.String("evm-logs", "", "recorded JSON array of the Transfer logs of both ERC721 contracts")
+	rpcURL := flag.String("rpc", "", "Flow EVM JSON-RPC endpoint to fetch the logs from instead")
+	fromBlock := flag.Uint64("from-block", 0, "first block to fetch logs from")
+	toBlock := flag.Uint64("to-block", 0, "last block to fetch logs from, the latest when 0")
+	blockRange := flag.Uint64("block-range", 5000, "blocks fetched per query")
+	saveLogs := flag.String("save-logs", "", "file to record the fetched logs to")
+	legacy := flag.String("legacy", "", "address of the bridge-deployed ERC721")
+	wrapper := flag.String("wrapper", "", "address of the BridgedTopShotMoments proxy")
+	cadenceEscrows := flag.String("cadence-escrow", "", "comma separated Cadence accounts of the bridge")
+	evmEscrows := flag.String("evm-escrow", "", "comma separated EVM addresses of the bridge")
+	coas := flag.String("coas", "", `JSON file of the COAs of Cadence accounts, {"0x<account>": "0x<coa>"}`)
+	asJSON := flag.Bool("json", false, "write the report as JSON")
+	flag.Parse()
+
+	if *cadenceEvents == "" || (*evmLogs == "") == (*rpcURL == "") {
+		log.Fatal("-cadence-events and one of -evm-logs or -rpc are required")
+	}
+	legacyAddr, err := parseAddress(*legacy)
+	if err != nil {
+		log.Fatalf("-legacy: %v", err)
+	}
+	wrapperAddr, err := parseAddress(*wrapper)
+	if err != nil {
+		log.Fatalf("-wrapper: %v", err)
+	}
+
+	config := reconcile.Config{CadenceEscrows: splitList(*cadenceEscrows)}
+	for _, escrow := range splitList(*evmEscrows) {
+		addr, err := parseAddress(escrow)
+		if err != nil {
+			log.Fatalf("-evm-escrow: %v", err)
+		}
+		config.EVMEscrows = append(config.EVMEscrows, addr)
+	}
+	if *coas != "" {
+		if config.COAs, err = readCOAs(*coas); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	events, err := os.Open(*cadenceEvents)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer events.Close()
+	cadence, err := reconcile.ReadCadenceEvents(events)
+	if err != nil {
+		log.Fatalf("%s: %v", *cadenceEvents, err)
+	}
+
+	var logs []types.Log
+	if *rpcURL != "" {
+		logs, err = fetchLogs(*rpcURL, []common.Address{legacyAddr, wrapperAddr}, *fromBlock, *toBlock, *blockRange)
+	} else {
+		logs, err = readLogs(*evmLogs)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *saveLogs != "" {
+		if err := writeLogs(*saveLogs, logs); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	evm := reconcile.NewEVMSnapshot(legacyAddr, wrapperAddr)
+	if err := evm.Apply(logs); err != nil {
+		log.Fatal(err)
+	}
+
+	report := reconcile.Reconcile(cadence, evm, config)
+	if *asJSON {
+		err = report.WriteJSON(os.Stdout)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(report.Issues) > 0 {
+		os.Exit(1)
+	}
+}
+
+func fetchLogs(url string, contracts []common.Address, fromBlock, toBlock, blockRange uint64) ([]types.Log, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", url, err)
+	}
+	defer client.Close()
+
+	if toBlock == 0 {
+		if toBlock, err = client.BlockNumber(ctx); err != nil {
+			return nil, fmt.Errorf("failed to get the latest block: %w", err)
+		}
+	}
+	log.Printf("Fetching the logs of blocks %d to %d", fromBlock, toBlock)
+	return reconcile.FetchLogs(ctx, client, contracts, fromBlock, toBlock, blockRange)
+}
+
+func readLogs(path string) ([]types.Log, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return reconcile.ReadLogs(file)
+}
+
+func writeLogs(path string, logs []types.Log) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := reconcile.WriteLogs(file, logs); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func readCOAs(path string) (map[string]common.Address, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var addrs map[string]string
+	if err := json.Unmarshal(data, &addrs); err != nil {
+		return nil, fmt.Errorf("invalid COA file %s: %w", path, err)
+	}
+
+	coas := map[string]common.Address{}
+	for account, coa := range addrs {
+		if coas[account], err = parseAddress(coa); err != nil {
+			return nil, fmt.Errorf("COA of %s: %w", account, err)
+		}
+	}
+	return coas, nil
+}
+
+func parseAddress(addr string) (common.Address, error) {
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, fmt.Errorf("invalid EVM address %q", addr)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,135 @@
+// Command stale-listings reports the TopShotMarketV3 listings whose purchase
+// reverts, per seller: listings of moments destroyed, moved out of the
+// collection of the seller or locked, built from a file of TopShot,
+// TopShotLocking and TopShotMarketV3 events.
+//
+//	stale-listings -events events.jsonl -json
+//
+// The contract addresses default to mainnet. With -cancel, it also writes the cancel sale transaction of each dead
+// listing, for its seller to sign, to a directory: cancel_sale.cdc and the
+// arguments of each listing in cancel.json, to send with
+//
+//	flow transactions send cancel_sale.cdc --args-json '[{"type":"UInt64","value":"42"}]' --signer seller
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/market"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+// cancel is the cancel sale transaction of a dead listing
+type cancel struct {
+	Seller    string            `json:"seller"`
+	MomentID  uint64            `json:"momentId"`
+	Reason    market.DeadReason `json:"reason"`
+	Arguments []json.RawMessage `json:"arguments"`
+}
+
+func main() {
+	eventsPath := flag.String("events", "", "file of TopShot, TopShotLocking and TopShotMarketV3 JSON-CDC events, one per line in chain order")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	cancelDir := flag.String("cancel", "", "directory to write the cancel sale transactions of the dead listings to")
+	nft := flag.String("nft", "1d7e57aa55817448", "address of NonFungibleToken")
+	topShot := flag.String("topshot", "0b2a3299cc857e29", "address of TopShot")
+	marketV1 := flag.String("market", "c1e4f4f4c4257510", "address of Market")
+	marketV3 := flag.String("market-v3", "c1e4f4f4c4257510", "address of TopShotMarketV3")
+	flag.Parse()
+
+	if *eventsPath == "" {
+		log.Fatal("-events is required")
+	}
+
+	file, err := os.Open(*eventsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	m, p := market.New(), projection.New()
+	err = m.ReadWith(file, p)
+	file.Close()
+	if err != nil {
+		log.Fatalf("%s: %v", *eventsPath, err)
+	}
+	report := m.DeadListings(p)
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		for _, seller := range report.Sellers {
+			fmt.Printf("%s: %d dead listings\n", seller.Seller, len(seller.Listings))
+			for _, listing := range seller.Listings {
+				fmt.Printf("  moment %d at %s: %s%s\n", listing.MomentID, listing.Price, listing.Reason, detail(listing))
+			}
+		}
+	}
+	log.Printf("%d of %d listings are dead across %d sellers", report.Dead, report.Listings, len(report.Sellers))
+	if report.Unchecked > 0 {
+		log.Printf("%d listings are of moments minted before the events begin and are left unchecked", report.Unchecked)
+	}
+
+	if *cancelDir == "" {
+		return
+	}
+	env := templates.Environment{
+		NFTAddress:             flow.HexToAddress(*nft).Hex(),
+		TopShotAddress:         flow.HexToAddress(*topShot).Hex(),
+		TopShotMarketAddress:   flow.HexToAddress(*marketV1).Hex(),
+		TopShotMarketV3Address: flow.HexToAddress(*marketV3).Hex(),
+	}
+	if err := writeCancels(*cancelDir, env, report); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Wrote %d cancel sale transactions to %s", report.Dead, *cancelDir)
+}
+
+func detail(listing market.DeadListing) string {
+	switch {
+	case listing.Reason == market.DeadMoved && listing.Owner != "":
+		return " to " + listing.Owner
+	case listing.Reason == market.DeadLocked:
+		return fmt.Sprintf(" until %d", listing.LockExpiry/1e8)
+	}
+	return ""
+}
+
+func writeCancels(dir string, env templates.Environment, report *market.DeadListingsReport) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cancel_sale.cdc"), templates.GenerateCancelSaleV3Script(env), 0o644); err != nil {
+		return err
+	}
+
+	cancels := []cancel{}
+	for _, seller := range report.Sellers {
+		for _, listing := range seller.Listings {
+			tx, err := market.CancelTransaction(env, listing)
+			if err != nil {
+				return fmt.Errorf("moment %d: %w", listing.MomentID, err)
+			}
+			arguments := make([]json.RawMessage, len(tx.Arguments))
+			for i, argument := range tx.Arguments {
+				arguments[i] = json.RawMessage(argument)
+			}
+			cancels = append(cancels, cancel{Seller: listing.Seller, MomentID: listing.MomentID, Reason: listing.Reason, Arguments: arguments})
+		}
+	}
+	data, err := json.MarshalIndent(cancels, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "cancel.json"), append(data, '\n'), 0o644)
+}
@@ -0,0 +1,137 @@
+// Command supply-audit checks the supply of every TopShot edition against
+// its history: the gaps and duplicates of serials, the moments minted after
+// their edition was retired, and getNumMomentsInEdition, the number minted
+// per subedition and totalSupply against the moments minted. It exits with
+// status 1 when there is any finding.
+//
+//	supply-audit -events events.jsonl -access access.mainnet.nodes.onflow.org:9000
+//	supply-audit -fetch -access 127.0.0.1:3569 -topshot f8d6e0586b0a20c7
+//
+// The events, read from the deployment of TopShot in chain order, are
+// fetched from the access node or emulator instead with -fetch, and can be
+// recorded with -save-events to replay the audit later. -offline audits
+// the history alone, without reading the counters.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/supply"
+)
+
+func main() {
+	eventsPath := flag.String("events", "", "file of TopShot JSON-CDC events, one per line in chain order")
+	accessNode := flag.String("access", "access.mainnet.nodes.onflow.org:9000", "gRPC address of the access node or emulator")
+	topShot := flag.String("topshot", "0b2a3299cc857e29", "address of TopShot")
+	fetch := flag.Bool("fetch", false, "fetch the events from the access node instead of -events")
+	fromHeight := flag.Uint64("from-height", 0, "first block to fetch events from, that of the deployment of TopShot")
+	toHeight := flag.Uint64("to-height", 0, "last block to fetch events from, the latest sealed when 0")
+	blockRange := flag.Uint64("block-range", 250, "blocks fetched per query")
+	saveEvents := flag.String("save-events", "", "file to record the fetched events to")
+	offline := flag.Bool("offline", false, "audit the history without reading the counters of the access node")
+	lockedSetsClosed := flag.Bool("locked-sets-closed", false, "report the moments minted after their set was locked")
+	asJSON := flag.Bool("json", false, "write the report as JSON")
+	flag.Parse()
+
+	if (*eventsPath == "") == !*fetch {
+		log.Fatal("one of -events or -fetch is required")
+	}
+	if *fetch && *offline {
+		log.Fatal("-fetch reads the access node, which -offline does not")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var client *grpc.Client
+	if !*offline {
+		var err error
+		if client, err = grpc.NewClient(*accessNode); err != nil {
+			log.Fatal(err)
+		}
+		defer client.Close()
+	}
+
+	var history *supply.History
+	if *fetch {
+		payloads, err := fetchEvents(ctx, client, flow.HexToAddress(*topShot), *fromHeight, *toHeight, *blockRange)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if *saveEvents != "" {
+			if err := writeEvents(*saveEvents, payloads); err != nil {
+				log.Fatal(err)
+			}
+		}
+		history = supply.NewHistory()
+		for _, payload := range payloads {
+			if err := history.Apply(payload); err != nil {
+				log.Fatal(err)
+			}
+		}
+	} else {
+		file, err := os.Open(*eventsPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		history, err = supply.ReadEvents(file)
+		file.Close()
+		if err != nil {
+			log.Fatalf("%s: %v", *eventsPath, err)
+		}
+	}
+
+	var chain supply.Chain
+	if client != nil {
+		chain = &supply.FlowChain{
+			Client: client,
+			Env:    templates.Environment{TopShotAddress: flow.HexToAddress(*topShot).Hex()},
+		}
+	}
+	report, err := supply.Audit(ctx, history, chain, supply.Options{LockedSetsClosed: *lockedSetsClosed})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *asJSON {
+		err = report.WriteJSON(os.Stdout)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(report.Findings) > 0 {
+		os.Exit(1)
+	}
+}
+
+func fetchEvents(ctx context.Context, client *grpc.Client, topShot flow.Address, fromHeight, toHeight, blockRange uint64) ([][]byte, error) {
+	if toHeight == 0 {
+		header, err := client.GetLatestBlockHeader(ctx, true)
+		if err != nil {
+			return nil, err
+		}
+		toHeight = header.Height
+	}
+	log.Printf("Fetching the events of blocks %d to %d", fromHeight, toHeight)
+	return supply.FetchEvents(ctx, client, topShot, fromHeight, toHeight, blockRange)
+}
+
+func writeEvents(path string, payloads [][]byte) error {
+	var lines bytes.Buffer
+	for _, payload := range payloads {
+		lines.Write(bytes.TrimSpace(payload))
+		lines.WriteByte('\n')
+	}
+	return os.WriteFile(path, lines.Bytes(), 0o644)
+}
@@ -0,0 +1,156 @@
+package fastbreak
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+)
+
+// DecodeRun decodes a FastBreakV1.FastBreakRun, as get_fast_break_run returns it
+func DecodeRun(value cadence.Value) (*Run, error) {
+	run, ok := value.(cadence.Struct)
+	if !ok {
+		return nil, fmt.Errorf("unexpected fast break run %s", value)
+	}
+	fields := cadence.FieldsMappedByName(run)
+	id, okID := fields["id"].(cadence.String)
+	name, okName := fields["name"].(cadence.String)
+	runStart, okStart := fields["runStart"].(cadence.UInt64)
+	runEnd, okEnd := fields["runEnd"].(cadence.UInt64)
+	winCounts, okWinCounts := fields["runWinCount"].(cadence.Dictionary)
+	fatigueModeOn, okFatigue := fields["fatigueModeOn"].(cadence.Bool)
+	status, errStatus := rawValue(fields["status"])
+	if !okID || !okName || !okStart || !okEnd || !okWinCounts || !okFatigue || errStatus != nil {
+		return nil, fmt.Errorf("unexpected fast break run %s", value)
+	}
+
+	decoded := &Run{
+		ID:            string(id),
+		Name:          string(name),
+		Status:        RunStatus(status),
+		RunStart:      uint64(runStart),
+		RunEnd:        uint64(runEnd),
+		FatigueModeOn: bool(fatigueModeOn),
+	}
+	for _, pair := range winCounts.Pairs {
+		playerID, okPlayer := pair.Key.(cadence.UInt64)
+		count, okCount := pair.Value.(cadence.UInt64)
+		if !okPlayer || !okCount {
+			return nil, fmt.Errorf("unexpected win count of fast break run %s", id)
+		}
+		if decoded.RunWinCount == nil {
+			decoded.RunWinCount = map[uint64]uint64{}
+		}
+		decoded.RunWinCount[uint64(playerID)] = uint64(count)
+	}
+	return decoded, nil
+}
+
+// DecodeGame decodes a FastBreakV1.FastBreakGame, as get_fast_break returns it
+func DecodeGame(value cadence.Value) (*Game, error) {
+	game, ok := value.(cadence.Struct)
+	if !ok {
+		return nil, fmt.Errorf("unexpected fast break game %s", value)
+	}
+	fields := cadence.FieldsMappedByName(game)
+	id, okID := fields["id"].(cadence.String)
+	name, okName := fields["name"].(cadence.String)
+	runID, okRun := fields["fastBreakRunID"].(cadence.String)
+	deadline, okDeadline := fields["submissionDeadline"].(cadence.UInt64)
+	numPlayers, okNumPlayers := fields["numPlayers"].(cadence.UInt64)
+	winner, okWinner := fields["winner"].(cadence.UInt64)
+	stats, okStats := fields["stats"].(cadence.Array)
+	submissions, okSubmissions := fields["submissions"].(cadence.Dictionary)
+	status, errStatus := rawValue(fields["status"])
+	if !okID || !okName || !okRun || !okDeadline || !okNumPlayers || !okWinner || !okStats || !okSubmissions || errStatus != nil {
+		return nil, fmt.Errorf("unexpected fast break game %s", value)
+	}
+
+	decoded := &Game{
+		ID:                 string(id),
+		Name:               string(name),
+		FastBreakRunID:     string(runID),
+		SubmissionDeadline: uint64(deadline),
+		NumPlayers:         uint64(numPlayers),
+		Status:             GameStatus(status),
+		Winner:             uint64(winner),
+		Submissions:        map[uint64]*Submission{},
+	}
+	for _, value := range stats.Values {
+		stat, err := decodeStat(value)
+		if err != nil {
+			return nil, fmt.Errorf("fast break game %s: %w", id, err)
+		}
+		decoded.Stats = append(decoded.Stats, stat)
+	}
+	for _, pair := range submissions.Pairs {
+		submission, err := DecodeSubmission(pair.Value)
+		if err != nil {
+			return nil, fmt.Errorf("fast break game %s: %w", id, err)
+		}
+		decoded.Submissions[submission.PlayerID] = &submission
+	}
+	return decoded, nil
+}
+
+func decodeStat(value cadence.Value) (Stat, error) {
+	stat, ok := value.(cadence.Struct)
+	if !ok {
+		return Stat{}, fmt.Errorf("unexpected stat %s", value)
+	}
+	fields := cadence.FieldsMappedByName(stat)
+	name, okName := fields["name"].(cadence.String)
+	valueNeeded, okValue := fields["valueNeeded"].(cadence.UInt64)
+	statType, errType := rawValue(fields["type"])
+	if !okName || !okValue || errType != nil {
+		return Stat{}, fmt.Errorf("unexpected stat %s", value)
+	}
+	return Stat{Name: string(name), Type: StatisticType(statType), ValueNeeded: uint64(valueNeeded)}, nil
+}
+
+// DecodeSubmission decodes a FastBreakV1.FastBreakSubmission
+func DecodeSubmission(value cadence.Value) (Submission, error) {
+	submission, ok := value.(cadence.Struct)
+	if !ok {
+		return Submission{}, fmt.Errorf("unexpected submission %s", value)
+	}
+	fields := cadence.FieldsMappedByName(submission)
+	playerID, okPlayer := fields["playerId"].(cadence.UInt64)
+	submittedAt, okSubmitted := fields["submittedAt"].(cadence.UInt64)
+	gameID, okGame := fields["fastBreakGameID"].(cadence.String)
+	topShots, okTopShots := fields["topShots"].(cadence.Array)
+	points, okPoints := fields["points"].(cadence.UInt64)
+	win, okWin := fields["win"].(cadence.Bool)
+	if !okPlayer || !okSubmitted || !okGame || !okTopShots || !okPoints || !okWin {
+		return Submission{}, fmt.Errorf("unexpected submission %s", value)
+	}
+
+	decoded := Submission{
+		PlayerID:        uint64(playerID),
+		SubmittedAt:     uint64(submittedAt),
+		FastBreakGameID: string(gameID),
+		Points:          uint64(points),
+		Win:             bool(win),
+	}
+	for _, topShot := range topShots.Values {
+		id, ok := topShot.(cadence.UInt64)
+		if !ok {
+			return Submission{}, fmt.Errorf("unexpected top shot %s of player %d", topShot, playerID)
+		}
+		decoded.TopShots = append(decoded.TopShots, uint64(id))
+	}
+	return decoded, nil
+}
+
+// rawValue reads the raw value of a FastBreakV1 enum
+func rawValue(value cadence.Value) (uint8, error) {
+	enum, ok := value.(cadence.Enum)
+	if !ok {
+		return 0, fmt.Errorf("unexpected enum %s", value)
+	}
+	raw, ok := cadence.SearchFieldByName(enum, "rawValue").(cadence.UInt8)
+	if !ok {
+		return 0, fmt.Errorf("unexpected enum %s", value)
+	}
+	return uint8(raw), nil
+}
@@ -0,0 +1,190 @@
+// Package fastbreak models the runs, games, stats and submissions of the
+// FastBreakV1 contract and scores submissions from the box scores of NBA
+// players, the way the oracle submits them with updateFastBreakScore.
+//
+// The contract only records the points and win flag the oracle gives it;
+// the rules that produce them live here, so that the oracle, the backend
+// and tests score a game the same way.
+package fastbreak
+
+import (
+	"fmt"
+)
+
+// GameStatus is the raw value of FastBreakV1.GameStatus
+type GameStatus uint8
+
+const (
+	GameScheduled GameStatus = iota
+	GameOpen
+	GameStarted
+	GameClosed
+)
+
+func (s GameStatus) String() string {
+	switch s {
+	case GameScheduled:
+		return "SCHEDULED"
+	case GameOpen:
+		return "OPEN"
+	case GameStarted:
+		return "STARTED"
+	case GameClosed:
+		return "CLOSED"
+	}
+	return fmt.Sprintf("GameStatus(%d)", uint8(s))
+}
+
+// RunStatus is the raw value of FastBreakV1.RunStatus
+type RunStatus uint8
+
+const (
+	RunScheduled RunStatus = iota
+	RunRunning
+	RunClosed
+)
+
+func (s RunStatus) String() string {
+	switch s {
+	case RunScheduled:
+		return "SCHEDULED"
+	case RunRunning:
+		return "RUNNING"
+	case RunClosed:
+		return "CLOSED"
+	}
+	return fmt.Sprintf("RunStatus(%d)", uint8(s))
+}
+
+// StatisticType is the raw value of FastBreakV1.StatisticType
+type StatisticType uint8
+
+const (
+	// Individual stats must be met by each top shot of a submission
+	Individual StatisticType = iota
+	// Cumulative stats must be met by the top shots of a submission together
+	Cumulative
+)
+
+// MarshalText names the type the way the contract does, CUMMULATIVE included
+func (t StatisticType) MarshalText() ([]byte, error) {
+	switch t {
+	case Individual:
+		return []byte("INDIVIDUAL"), nil
+	case Cumulative:
+		return []byte("CUMMULATIVE"), nil
+	}
+	return nil, fmt.Errorf("unknown statistic type %d", uint8(t))
+}
+
+// UnmarshalText reads the name of the type, accepting CUMULATIVE as well
+func (t *StatisticType) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "INDIVIDUAL":
+		*t = Individual
+	case "CUMMULATIVE", "CUMULATIVE":
+		*t = Cumulative
+	default:
+		return fmt.Errorf("unknown statistic type %q", text)
+	}
+	return nil
+}
+
+// Run is a FastBreakRun, a mini-season of games. In fatigue mode, top
+// shots can only be played a few times over the games of the run, see
+// FatigueLimit.
+type Run struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Status        RunStatus `json:"status"`
+	RunStart      uint64    `json:"runStart"`
+	RunEnd        uint64    `json:"runEnd"`
+	FatigueModeOn bool      `json:"fatigueModeOn"`
+	// RunWinCount is the number of games each player won, by player id
+	RunWinCount map[uint64]uint64 `json:"runWinCount,omitempty"`
+}
+
+// IncrementRunWinCount records a new win of the player, as FastBreakRun.incrementRunWinCount
+func (r *Run) IncrementRunWinCount(playerID uint64) {
+	if r.RunWinCount == nil {
+		r.RunWinCount = map[uint64]uint64{}
+	}
+	r.RunWinCount[playerID]++
+}
+
+// Game is a FastBreakGame of a run
+type Game struct {
+	ID                 string     `json:"id"`
+	Name               string     `json:"name"`
+	FastBreakRunID     string     `json:"fastBreakRunID"`
+	SubmissionDeadline uint64     `json:"submissionDeadline"`
+	NumPlayers         uint64     `json:"numPlayers"`
+	Status             GameStatus `json:"status"`
+	Winner             uint64     `json:"winner"`
+	Stats              []Stat     `json:"stats"`
+	// Submissions are by player id
+	Submissions map[uint64]*Submission `json:"submissions,omitempty"`
+}
+
+// Stat is a FastBreakStat, the NBA statistic top shots must meet or exceed,
+// e.g. 30 POINTS
+type Stat struct {
+	Name        string        `json:"name"`
+	Type        StatisticType `json:"type"`
+	ValueNeeded uint64        `json:"valueNeeded"`
+}
+
+// Submission is a FastBreakSubmission, the top shots a player plays in a game
+type Submission struct {
+	PlayerID        uint64   `json:"playerId"`
+	SubmittedAt     uint64   `json:"submittedAt"`
+	FastBreakGameID string   `json:"fastBreakGameID"`
+	TopShots        []uint64 `json:"topShots"`
+	Points          uint64   `json:"points"`
+	Win             bool     `json:"win"`
+}
+
+// UpdateScore sets the points and win flag of the player's submission, as
+// FastBreakGame.updateScore, and reports whether it is a new win
+func (g *Game) UpdateScore(playerID, points uint64, win bool) (bool, error) {
+	submission, ok := g.Submissions[playerID]
+	if !ok {
+		return false, fmt.Errorf("unable to find fast break submission for playerId: %d", playerID)
+	}
+	isPrevSubmissionWin := submission.Win
+	submission.Points, submission.Win = points, win
+	return win && !isPrevSubmissionWin, nil
+}
+
+// UpdateFastBreakScore records a score as FastBreakDaemon.updateFastBreakScore
+// does, counting a win for the run only when the submission was not
+// already a win. A win the oracle takes back is not uncounted.
+func UpdateFastBreakScore(run *Run, game *Game, playerID, points uint64, win bool) error {
+	if game.FastBreakRunID != run.ID {
+		return fmt.Errorf("fast break game %s is not in run %s", game.ID, run.ID)
+	}
+	isNewWin, err := game.UpdateScore(playerID, points, win)
+	if err != nil {
+		return err
+	}
+	if isNewWin {
+		run.IncrementRunWinCount(playerID)
+	}
+	return nil
+}
+
+// SubmissionBuffer is the number of seconds before its deadline a game
+// stops taking submissions
+const SubmissionBuffer = 60
+
+// IsValidSubmission applies FastBreakV1.isValidSubmission: a game takes
+// submissions until SubmissionBuffer seconds before its deadline
+func IsValidSubmission(submissionDeadline, now uint64) bool {
+	return submissionDeadline > now+SubmissionBuffer
+}
+
+// ValidPlaySubmission applies FastBreakV1.validatePlaySubmission: a
+// submission plays at least one and at most numPlayers top shots
+func ValidPlaySubmission(game *Game, topShots []uint64) bool {
+	return len(topShots) >= 1 && uint64(len(topShots)) <= game.NumPlayers
+}
@@ -0,0 +1,233 @@
+package fastbreak
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the expected results of testdata/*.json")
+
+// golden is a run replayed by the engine, and by TestFastBreakGoldens of
+// lib/go/test on the emulator. Players have ids 1 to n, in the order the
+// emulator creates them, and each moment is owned by the first player to
+// play it.
+type golden struct {
+	Run     Run                     `json:"run"`
+	Moments map[uint64]goldenMoment `json:"moments"`
+	Games   []goldenGame            `json:"games"`
+	// Expected is what the engine scored, rewritten by -update
+	Expected goldenExpected `json:"expected"`
+}
+
+type goldenMoment struct {
+	Player string `json:"player"`
+	Tier   Tier   `json:"tier,omitempty"`
+}
+
+type goldenGame struct {
+	Game      Game      `json:"game"`
+	BoxScores BoxScores `json:"boxScores"`
+	// Corrections are box scores the closed game is scored again with
+	Corrections []BoxScores `json:"corrections,omitempty"`
+}
+
+type goldenExpected struct {
+	Games       map[string]goldenResult `json:"games"`
+	RunWinCount map[uint64]uint64       `json:"runWinCount"`
+}
+
+type goldenResult struct {
+	// Scores are the scores of each scoring of the game, the box scores
+	// and then every correction
+	Scores [][]Score `json:"scores"`
+	Winner uint64    `json:"winner"`
+}
+
+func (g *golden) replay() (goldenExpected, error) {
+	engine := Engine{
+		Players: func(momentID uint64) (string, bool) {
+			moment, ok := g.Moments[momentID]
+			return moment.Player, ok
+		},
+		Tiers: func(momentID uint64) (Tier, bool) {
+			moment, ok := g.Moments[momentID]
+			return moment.Tier, ok && moment.Tier != ""
+		},
+	}
+
+	run := g.Run
+	expected := goldenExpected{Games: map[string]goldenResult{}}
+	var previous []*Game
+	for _, played := range g.Games {
+		game := played.Game
+		game.Submissions = map[uint64]*Submission{}
+		for playerID, submission := range played.Game.Submissions {
+			copied := *submission
+			game.Submissions[playerID] = &copied
+		}
+
+		var result goldenResult
+		for _, boxScores := range append([]BoxScores{played.BoxScores}, played.Corrections...) {
+			scores, err := engine.ScoreGame(&run, previous, &game, &boxScores)
+			if err != nil {
+				return goldenExpected{}, err
+			}
+			result.Scores = append(result.Scores, scores)
+		}
+		result.Winner = game.Winner
+		expected.Games[game.ID] = result
+		previous = append(previous, &game)
+	}
+	expected.RunWinCount = run.RunWinCount
+	return expected, nil
+}
+
+func TestGoldens(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			var g golden
+			require.NoError(t, json.Unmarshal(data, &g))
+
+			expected, err := g.replay()
+			require.NoError(t, err)
+
+			if *update {
+				g.Expected = expected
+				data, err := json.MarshalIndent(g, "", "  ")
+				require.NoError(t, err)
+				require.NoError(t, os.WriteFile(path, append(data, '\n'), 0o644))
+				return
+			}
+			assert.Equal(t, g.Expected, expected)
+		})
+	}
+}
+
+func TestScoreSubmission(t *testing.T) {
+	players := func(momentID uint64) (string, bool) {
+		players := map[uint64]string{1: "Lebron", 2: "Ja Morant", 3: "Stephen Curry"}
+		player, ok := players[momentID]
+		return player, ok
+	}
+	boxScores := &BoxScores{Final: true, Players: map[string]map[string]uint64{
+		"Lebron":        {"POINTS": 31, "REBOUNDS": 8},
+		"Ja Morant":     {"POINTS": 22, "REBOUNDS": 4},
+		"Stephen Curry": {"POINTS": 40},
+	}}
+	game := &Game{NumPlayers: 2, Stats: []Stat{
+		{Name: "POINTS", Type: Individual, ValueNeeded: 20},
+		{Name: "REBOUNDS", Type: Cumulative, ValueNeeded: 12},
+	}}
+
+	for _, test := range []struct {
+		name     string
+		topShots []uint64
+		points   uint64
+		win      bool
+	}{
+		{"meets both stats", []uint64{1, 2}, 65, true},
+		{"misses the cumulative stat", []uint64{1, 3}, 79, false},
+		{"unknown moments count nothing", []uint64{1, 99}, 39, false},
+		{"more top shots than numPlayers", []uint64{1, 2, 3}, 0, false},
+		{"no top shots", nil, 0, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			points, win := ScoreSubmission(game, test.topShots, boxScores, players)
+			assert.Equal(t, test.points, points)
+			assert.Equal(t, test.win, win)
+		})
+	}
+}
+
+func TestWinner(t *testing.T) {
+	game := &Game{Submissions: map[uint64]*Submission{
+		3: {PlayerID: 3, SubmittedAt: 20, Points: 50, Win: true},
+		2: {PlayerID: 2, SubmittedAt: 10, Points: 50, Win: true},
+		1: {PlayerID: 1, SubmittedAt: 10, Points: 50, Win: true},
+		4: {PlayerID: 4, SubmittedAt: 5, Points: 90},
+	}}
+	assert.Equal(t, uint64(1), Winner(game))
+
+	delete(game.Submissions, 1)
+	delete(game.Submissions, 2)
+	delete(game.Submissions, 3)
+	assert.Equal(t, uint64(0), Winner(game))
+}
+
+func TestFatigued(t *testing.T) {
+	engine := Engine{Tiers: func(momentID uint64) (Tier, bool) {
+		tiers := map[uint64]Tier{1: Common, 2: Rare, 3: Legendary}
+		tier, ok := tiers[momentID]
+		return tier, ok
+	}}
+
+	assert.Empty(t, engine.Fatigued([]uint64{1, 2, 3}, nil))
+	assert.Equal(t, []uint64{1}, engine.Fatigued([]uint64{1, 2, 3}, map[uint64]int{1: 1, 2: 1, 3: 3}))
+	assert.Equal(t, []uint64{2, 4}, engine.Fatigued([]uint64{2, 4, 2}, map[uint64]int{2: 2, 4: 1}))
+	// a top shot played twice in one submission is reported once
+	assert.Equal(t, []uint64{1}, engine.Fatigued([]uint64{1, 1, 1}, nil))
+}
+
+func TestUpdateFastBreakScore(t *testing.T) {
+	run := &Run{ID: "abc-123"}
+	game := &Game{ID: "def-456", FastBreakRunID: "abc-123", Submissions: map[uint64]*Submission{1: {PlayerID: 1}}}
+
+	require.NoError(t, UpdateFastBreakScore(run, game, 1, 100, true))
+	require.NoError(t, UpdateFastBreakScore(run, game, 1, 120, true))
+	assert.Equal(t, map[uint64]uint64{1: 1}, run.RunWinCount)
+
+	// a win taken back is not uncounted, and counts again when restored
+	require.NoError(t, UpdateFastBreakScore(run, game, 1, 10, false))
+	require.NoError(t, UpdateFastBreakScore(run, game, 1, 100, true))
+	assert.Equal(t, map[uint64]uint64{1: 2}, run.RunWinCount)
+
+	assert.ErrorContains(t, UpdateFastBreakScore(run, game, 2, 100, true), "unable to find fast break submission for playerId: 2")
+	assert.ErrorContains(t, UpdateFastBreakScore(&Run{ID: "ghi-789"}, game, 1, 100, true), "not in run ghi-789")
+}
+
+// testdata/cadence holds the run and game of fast_break_test.go, as the
+// get_fast_break_run and get_fast_break scripts return them
+func TestDecode(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "cadence", "run.json"))
+	require.NoError(t, err)
+	value, err := jsoncdc.Decode(nil, data)
+	require.NoError(t, err)
+	run, err := DecodeRun(value)
+	require.NoError(t, err)
+	assert.Equal(t, &Run{
+		ID: "abc-123", Name: "R0", Status: RunRunning, RunStart: 1791819609, RunEnd: 1793029209,
+		FatigueModeOn: true, RunWinCount: map[uint64]uint64{1: 1},
+	}, run)
+
+	data, err = os.ReadFile(filepath.Join("testdata", "cadence", "game.json"))
+	require.NoError(t, err)
+	value, err = jsoncdc.Decode(nil, data)
+	require.NoError(t, err)
+	game, err := DecodeGame(value)
+	require.NoError(t, err)
+	assert.Equal(t, &Game{
+		ID: "def-456", Name: "fb0", FastBreakRunID: "abc-123", SubmissionDeadline: 1792510809, NumPlayers: 1,
+		Status: GameOpen, Winner: 1,
+		Stats: []Stat{{Name: "POINTS", Type: Individual, ValueNeeded: 30}},
+		Submissions: map[uint64]*Submission{
+			1: {PlayerID: 1, SubmittedAt: 1792424411, FastBreakGameID: "def-456", TopShots: []uint64{1}, Points: 100, Win: true},
+		},
+	}, game)
+
+	_, err = DecodeGame(cadence.String("def-456"))
+	assert.ErrorContains(t, err, "unexpected fast break game")
+}
@@ -0,0 +1,85 @@
+package fastbreak
+
+import (
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+// Tier is the rarity tier of a moment
+type Tier string
+
+const (
+	Common    Tier = "COMMON"
+	Fandom    Tier = "FANDOM"
+	Rare      Tier = "RARE"
+	Legendary Tier = "LEGENDARY"
+	Ultimate  Tier = "ULTIMATE"
+)
+
+// Tiers returns the tier of a moment. The contract does not record tiers,
+// which come from the sets of moments off chain.
+type Tiers func(momentID uint64) (Tier, bool)
+
+// ProjectionTiers looks the tiers of moments up by the set they were minted in
+func ProjectionTiers(p *projection.Projection, setTiers map[uint32]Tier) Tiers {
+	return func(momentID uint64) (Tier, bool) {
+		moment, ok := p.Moment(momentID)
+		if !ok {
+			return "", false
+		}
+		tier, ok := setTiers[moment.SetID]
+		return tier, ok
+	}
+}
+
+// FatigueLimit is the number of games of a run in fatigue mode a top shot
+// of the tier can be played in: 4 for legendary, 2 for rare and 1 for any
+// other tier, as FastBreakV1.FastBreakRun documents
+func FatigueLimit(tier Tier) int {
+	switch tier {
+	case Legendary:
+		return 4
+	case Rare:
+		return 2
+	}
+	return 1
+}
+
+// Uses counts the plays of each top shot in the submissions of the games
+func Uses(games []*Game) map[uint64]int {
+	uses := map[uint64]int{}
+	for _, game := range games {
+		for _, submission := range game.Submissions {
+			for _, momentID := range submission.TopShots {
+				uses[momentID]++
+			}
+		}
+	}
+	return uses
+}
+
+// Tier returns the tier of a moment, Common when it is unknown
+func (e Engine) Tier(momentID uint64) Tier {
+	if e.Tiers != nil {
+		if tier, ok := e.Tiers(momentID); ok {
+			return tier
+		}
+	}
+	return Common
+}
+
+// Fatigued returns the top shots that playing would take beyond the
+// fatigue limit of their tier, given their uses in earlier games. A top
+// shot of an unknown tier has the limit of a common one.
+func (e Engine) Fatigued(topShots []uint64, uses map[uint64]int) []uint64 {
+	var fatigued []uint64
+	played := map[uint64]int{}
+	for _, momentID := range topShots {
+		played[momentID]++
+		// reported once, at the first play beyond the limit
+		if limit := FatigueLimit(e.Tier(momentID)); uses[momentID]+played[momentID] > limit &&
+			(played[momentID] == 1 || uses[momentID]+played[momentID]-1 == limit) {
+			fatigued = append(fatigued, momentID)
+		}
+	}
+	return fatigued
+}
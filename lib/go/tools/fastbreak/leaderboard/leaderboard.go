@@ -0,0 +1,261 @@
+// Package leaderboard folds the events of FastBreakV1 into the standings of
+// runs and games: the wins, points and win streaks of players, and the game
+// tokens they minted.
+//
+// Wins are counted from FastBreakGameWinner, which the contract emits
+// whenever it increments the run win count of a player, so they match
+// get_player_win_count_for_run, a win the oracle takes back included. No
+// event carries points, which are read from the games once they are scored,
+// see Score.
+package leaderboard
+
+import (
+	"io"
+	"sort"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events/decoder"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/eventfile"
+)
+
+// Run is a FastBreakRun and the ids of its games in the order they were created
+type Run struct {
+	ID            string
+	Name          string
+	Status        fastbreak.RunStatus
+	RunStart      uint64
+	RunEnd        uint64
+	FatigueModeOn bool
+	Games         []string
+}
+
+// Game is a FastBreakGame and its submissions by player id
+type Game struct {
+	ID                 string
+	Name               string
+	RunID              string
+	SubmissionDeadline uint64
+	NumPlayers         uint64
+	Status             fastbreak.GameStatus
+	// Winner is the winner the game was closed with, known once it is scored
+	Winner      uint64
+	Scored      bool
+	Submissions map[uint64]*Submission
+
+	// created orders the games of a run with the same deadline
+	created int
+}
+
+// Submission is the game token a player minted and its score
+type Submission struct {
+	PlayerID    uint64
+	TokenID     uint64
+	SubmittedAt uint64
+	TopShots    []uint64
+	// Points and Win are the score the oracle recorded, known once the game is scored
+	Points uint64
+	Win    bool
+	// Wins is the number of FastBreakGameWinner events of the submission
+	Wins uint64
+}
+
+// Player is a Fast Break player
+type Player struct {
+	ID   uint64
+	Name string
+	// Tokens is the number of game tokens the player minted
+	Tokens uint64
+}
+
+// Leaderboard is the projection of FastBreakV1 events
+type Leaderboard struct {
+	runs    map[string]*Run
+	games   map[string]*Game
+	players map[uint64]*Player
+}
+
+func New() *Leaderboard {
+	return &Leaderboard{
+		runs:    map[string]*Run{},
+		games:   map[string]*Game{},
+		players: map[uint64]*Player{},
+	}
+}
+
+// Apply folds a JSON-CDC or CCF encoded event into the leaderboard,
+// ignoring the events of other contracts
+func (l *Leaderboard) Apply(payload []byte) error {
+	event, err := decoder.GetCadenceEvent(payload)
+	if err != nil {
+		return err
+	}
+
+	switch event.EventType.QualifiedIdentifier {
+	case events.EventFastBreakRunCreated:
+		created, err := events.DecodeFastBreakRunCreatedEvent(payload)
+		if err != nil {
+			return err
+		}
+		run := l.run(created.ID())
+		run.Name, run.RunStart, run.RunEnd, run.FatigueModeOn = created.Name(), created.RunStart(), created.RunEnd(), created.FatigueModeOn()
+	case events.EventFastBreakRunStatusChange:
+		changed, err := events.DecodeFastBreakRunStatusChangeEvent(payload)
+		if err != nil {
+			return err
+		}
+		l.run(changed.ID()).Status = fastbreak.RunStatus(changed.NewRawStatus())
+	case events.EventFastBreakGameCreated:
+		created, err := events.DecodeFastBreakGameCreatedEvent(payload)
+		if err != nil {
+			return err
+		}
+		game := l.game(created.ID())
+		game.Name, game.SubmissionDeadline, game.NumPlayers = created.Name(), created.SubmissionDeadline(), created.NumPlayers()
+		if game.RunID == "" {
+			game.RunID = created.FastBreakRunID()
+			run := l.run(game.RunID)
+			run.Games = append(run.Games, game.ID)
+		}
+	case events.EventFastBreakGameStatusChange:
+		changed, err := events.DecodeFastBreakGameStatusChangeEvent(payload)
+		if err != nil {
+			return err
+		}
+		l.game(changed.ID()).Status = fastbreak.GameStatus(changed.NewRawStatus())
+	case events.EventFastBreakPlayerCreated:
+		created, err := events.DecodeFastBreakPlayerCreatedEvent(payload)
+		if err != nil {
+			return err
+		}
+		l.player(created.ID()).Name = created.PlayerName()
+	case events.EventFastBreakGameTokenMinted:
+		minted, err := events.DecodeFastBreakGameTokenMintedEvent(payload)
+		if err != nil {
+			return err
+		}
+		submission := l.submission(minted.FastBreakGameID(), minted.MintedTo())
+		submission.TokenID, submission.SubmittedAt, submission.TopShots = minted.ID(), minted.MintingDate(), minted.TopShots()
+		l.player(minted.MintedTo()).Tokens++
+	case events.EventFastBreakGameSubmissionUpdated:
+		updated, err := events.DecodeFastBreakGameSubmissionUpdatedEvent(payload)
+		if err != nil {
+			return err
+		}
+		l.submission(updated.FastBreakGameID(), updated.PlayerID()).TopShots = updated.TopShots()
+	case events.EventFastBreakGameWinner:
+		winner, err := events.DecodeFastBreakGameWinnerEvent(payload)
+		if err != nil {
+			return err
+		}
+		submission := l.submission(winner.FastBreakGameID(), winner.PlayerID())
+		submission.Wins++
+		submission.Win = true
+	}
+	return nil
+}
+
+// Read folds the events of an event file into the leaderboard
+func (l *Leaderboard) Read(r io.Reader) error {
+	return eventfile.Read(r, l.Apply)
+}
+
+// ReadEvents builds a leaderboard from an event file
+func ReadEvents(r io.Reader) (*Leaderboard, error) {
+	l := New()
+	if err := l.Read(r); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Score records the points and winner of a game as get_fast_break returns
+// it, which no event carries
+func (l *Leaderboard) Score(scored *fastbreak.Game) {
+	game := l.game(scored.ID)
+	game.Winner, game.Scored = scored.Winner, true
+	for playerID, score := range scored.Submissions {
+		submission := l.submission(scored.ID, playerID)
+		submission.Points, submission.Win = score.Points, score.Win
+		if submission.SubmittedAt == 0 {
+			submission.SubmittedAt, submission.TopShots = score.SubmittedAt, score.TopShots
+		}
+	}
+}
+
+// Run returns the run with the id
+func (l *Leaderboard) Run(id string) (*Run, bool) {
+	run, ok := l.runs[id]
+	return run, ok
+}
+
+// Game returns the game with the id
+func (l *Leaderboard) Game(id string) (*Game, bool) {
+	game, ok := l.games[id]
+	return game, ok
+}
+
+// Player returns the player with the id
+func (l *Leaderboard) Player(id uint64) (*Player, bool) {
+	player, ok := l.players[id]
+	return player, ok
+}
+
+// RunIDs returns the ids of the runs in sorted order
+func (l *Leaderboard) RunIDs() []string {
+	ids := make([]string, 0, len(l.runs))
+	for id := range l.runs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// GameIDs returns the ids of the games in sorted order
+func (l *Leaderboard) GameIDs() []string {
+	ids := make([]string, 0, len(l.games))
+	for id := range l.games {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// run returns the run with the id, added when an event comes before its FastBreakRunCreated
+func (l *Leaderboard) run(id string) *Run {
+	run, ok := l.runs[id]
+	if !ok {
+		run = &Run{ID: id}
+		l.runs[id] = run
+	}
+	return run
+}
+
+// game returns the game with the id, added when an event comes before its FastBreakGameCreated
+func (l *Leaderboard) game(id string) *Game {
+	game, ok := l.games[id]
+	if !ok {
+		game = &Game{ID: id, Submissions: map[uint64]*Submission{}, created: len(l.games)}
+		l.games[id] = game
+	}
+	return game
+}
+
+func (l *Leaderboard) submission(gameID string, playerID uint64) *Submission {
+	game := l.game(gameID)
+	submission, ok := game.Submissions[playerID]
+	if !ok {
+		submission = &Submission{PlayerID: playerID}
+		game.Submissions[playerID] = submission
+	}
+	return submission
+}
+
+func (l *Leaderboard) player(id uint64) *Player {
+	player, ok := l.players[id]
+	if !ok {
+		player = &Player{ID: id}
+		l.players[id] = player
+	}
+	return player
+}
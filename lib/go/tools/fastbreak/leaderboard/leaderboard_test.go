@@ -0,0 +1,250 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/cdctest"
+)
+
+func topShots(ids ...uint64) string {
+	values := []string{}
+	for _, id := range ids {
+		values = append(values, cdctest.Value("UInt64", id))
+	}
+	return cdctest.FieldOf("topShots", fmt.Sprintf(`{"type":"Array","value":[%s]}`, strings.Join(values, ",")))
+}
+
+func gameCreated(id string, deadline uint64) string {
+	return cdctest.Event("FastBreakV1", "FastBreakGameCreated",
+		cdctest.Field("id", "String", id),
+		cdctest.Field("name", "String", "fb-"+id),
+		cdctest.Field("fastBreakRunID", "String", "abc-123"),
+		cdctest.Field("submissionDeadline", "UInt64", deadline),
+		cdctest.Field("numPlayers", "UInt64", 3),
+	)
+}
+
+func statusChange(name, id string, status uint8) string {
+	return cdctest.Event("FastBreakV1", name, cdctest.Field("id", "String", id), cdctest.Field("newRawStatus", "UInt8", status))
+}
+
+func playerCreated(id uint64, name string) string {
+	return cdctest.Event("FastBreakV1", "FastBreakPlayerCreated", cdctest.Field("id", "UInt64", id), cdctest.Field("playerName", "String", name))
+}
+
+func tokenMinted(id uint64, gameID string, playerID, mintingDate uint64, ids ...uint64) string {
+	return cdctest.Event("FastBreakV1", "FastBreakGameTokenMinted",
+		cdctest.Field("id", "UInt64", id),
+		cdctest.Field("fastBreakGameID", "String", gameID),
+		cdctest.Field("serialNumber", "UInt64", 1),
+		cdctest.Field("mintingDate", "UInt64", mintingDate),
+		topShots(ids...),
+		cdctest.Field("mintedTo", "UInt64", playerID),
+	)
+}
+
+func gameWinner(gameID string, playerID uint64) string {
+	return cdctest.Event("FastBreakV1", "FastBreakGameWinner",
+		cdctest.Field("playerId", "UInt64", playerID),
+		cdctest.Field("submittedAt", "UInt64", 0),
+		cdctest.Field("fastBreakGameID", "String", gameID),
+		topShots(),
+	)
+}
+
+// testLeaderboard is run abc-123 of four games, the second created first.
+// Alice wins g1 and g2 and bob g3, which are closed, and alice and carol
+// play g4, which is open. Only g1 is scored.
+func testLeaderboard(t *testing.T) *Leaderboard {
+	t.Helper()
+	history := []string{
+		cdctest.Event("FastBreakV1", "FastBreakRunCreated",
+			cdctest.Field("id", "String", "abc-123"),
+			cdctest.Field("name", "String", "R0"),
+			cdctest.Field("runStart", "UInt64", 0),
+			cdctest.Field("runEnd", "UInt64", 1000),
+			cdctest.FieldOf("fatigueModeOn", `{"type":"Bool","value":true}`),
+		),
+		statusChange("FastBreakRunStatusChange", "abc-123", uint8(fastbreak.RunRunning)),
+		gameCreated("g2", 200),
+		gameCreated("g1", 100),
+		gameCreated("g3", 300),
+		gameCreated("g4", 400),
+		playerCreated(1, "alice"),
+		playerCreated(2, "bob"),
+		playerCreated(3, "carol"),
+		tokenMinted(1, "g1", 1, 50, 10),
+		tokenMinted(2, "g1", 2, 40, 20),
+		gameWinner("g1", 1),
+		statusChange("FastBreakGameStatusChange", "g1", uint8(fastbreak.GameClosed)),
+		tokenMinted(3, "g2", 1, 150, 10),
+		tokenMinted(4, "g2", 2, 140, 20),
+		gameWinner("g2", 1),
+		statusChange("FastBreakGameStatusChange", "g2", uint8(fastbreak.GameClosed)),
+		tokenMinted(5, "g3", 1, 250, 11),
+		tokenMinted(6, "g3", 2, 240, 20),
+		gameWinner("g3", 2),
+		statusChange("FastBreakGameStatusChange", "g3", uint8(fastbreak.GameClosed)),
+		tokenMinted(7, "g4", 1, 350, 10),
+		tokenMinted(8, "g4", 3, 340, 30),
+		cdctest.Event("FastBreakV1", "FastBreakGameSubmissionUpdated", cdctest.Field("playerId", "UInt64", 1), cdctest.Field("fastBreakGameID", "String", "g4"), topShots(12)),
+		statusChange("FastBreakGameStatusChange", "g4", uint8(fastbreak.GameOpen)),
+		cdctest.Event("TopShot", "MomentMinted", cdctest.Field("momentID", "UInt64", 1)),
+	}
+	l, err := ReadEvents(strings.NewReader(strings.Join(history, "\n")))
+	require.NoError(t, err)
+
+	l.Score(&fastbreak.Game{ID: "g1", Winner: 1, Submissions: map[uint64]*fastbreak.Submission{
+		1: {PlayerID: 1, Points: 30, Win: true},
+		2: {PlayerID: 2, Points: 20},
+	}})
+	return l
+}
+
+func TestApply(t *testing.T) {
+	l := testLeaderboard(t)
+
+	run, ok := l.Run("abc-123")
+	require.True(t, ok)
+	assert.Equal(t, "R0", run.Name)
+	assert.Equal(t, fastbreak.RunRunning, run.Status)
+	assert.True(t, run.FatigueModeOn)
+	assert.Equal(t, []string{"g2", "g1", "g3", "g4"}, run.Games)
+
+	game, ok := l.Game("g4")
+	require.True(t, ok)
+	assert.Equal(t, fastbreak.GameOpen, game.Status)
+	assert.Equal(t, []uint64{12}, game.Submissions[1].TopShots)
+	assert.Equal(t, uint64(340), game.Submissions[3].SubmittedAt)
+
+	alice, ok := l.Player(1)
+	require.True(t, ok)
+	assert.Equal(t, &Player{ID: 1, Name: "alice", Tokens: 4}, alice)
+
+	game, _ = l.Game("g1")
+	assert.True(t, game.Scored)
+	assert.Equal(t, uint64(1), game.Winner)
+	assert.Equal(t, uint64(30), game.Submissions[1].Points)
+}
+
+func TestRunStandings(t *testing.T) {
+	standings, ok := testLeaderboard(t).RunStandings("abc-123")
+	require.True(t, ok)
+
+	assert.Equal(t, RunSummary{ID: "abc-123", Name: "R0", Status: "RUNNING", RunEnd: 1000, FatigueModeOn: true, Games: 4, Players: 3}, standings.RunSummary)
+	assert.Equal(t, []Standing{
+		{Rank: 1, PlayerID: 1, PlayerName: "alice", Wins: 2, Points: 30, GamesPlayed: 4, CurrentStreak: 0, LongestStreak: 2},
+		{Rank: 2, PlayerID: 2, PlayerName: "bob", Wins: 1, Points: 20, GamesPlayed: 3, CurrentStreak: 1, LongestStreak: 1},
+		{Rank: 3, PlayerID: 3, PlayerName: "carol", GamesPlayed: 1},
+	}, standings.Standings)
+
+	_, ok = testLeaderboard(t).RunStandings("xyz")
+	assert.False(t, ok)
+}
+
+func TestRunStandingsTies(t *testing.T) {
+	l := testLeaderboard(t)
+	l.Score(&fastbreak.Game{ID: "g1", Winner: 1, Submissions: map[uint64]*fastbreak.Submission{
+		1: {PlayerID: 1, Points: 20, Win: true},
+		2: {PlayerID: 2, Points: 20},
+	}})
+	l.submission("g4", 3).Wins = 1
+
+	standings, _ := l.RunStandings("abc-123")
+	var ranks []int
+	for _, standing := range standings.Standings {
+		ranks = append(ranks, standing.Rank)
+	}
+	assert.Equal(t, []int{1, 2, 3}, ranks)
+
+	l.submission("g4", 3).Points = 20
+	standings, _ = l.RunStandings("abc-123")
+	assert.Equal(t, uint64(2), standings.Standings[1].PlayerID)
+	assert.Equal(t, 2, standings.Standings[1].Rank)
+	assert.Equal(t, uint64(3), standings.Standings[2].PlayerID)
+	assert.Equal(t, 2, standings.Standings[2].Rank)
+}
+
+func TestGameStandings(t *testing.T) {
+	l := testLeaderboard(t)
+
+	standings, ok := l.GameStandings("g1")
+	require.True(t, ok)
+	assert.Equal(t, &GameStandings{
+		ID: "g1", Name: "fb-g1", RunID: "abc-123", Status: "CLOSED", SubmissionDeadline: 100, NumPlayers: 3,
+		Winner: 1, Scored: true, Tokens: 2,
+		Entries: []Entry{
+			{Rank: 1, PlayerID: 1, PlayerName: "alice", TokenID: 1, SubmittedAt: 50, TopShots: []uint64{10}, Points: 30, Win: true},
+			{Rank: 2, PlayerID: 2, PlayerName: "bob", TokenID: 2, SubmittedAt: 40, TopShots: []uint64{20}, Points: 20},
+		},
+	}, standings)
+
+	// g4 is not scored, so its submissions tie and the earliest comes first
+	standings, ok = l.GameStandings("g4")
+	require.True(t, ok)
+	assert.False(t, standings.Scored)
+	require.Len(t, standings.Entries, 2)
+	assert.Equal(t, uint64(3), standings.Entries[0].PlayerID)
+	assert.Equal(t, 1, standings.Entries[0].Rank)
+	assert.Equal(t, uint64(1), standings.Entries[1].PlayerID)
+	assert.Equal(t, 1, standings.Entries[1].Rank)
+}
+
+func TestPlayerStats(t *testing.T) {
+	stats, ok := testLeaderboard(t).PlayerStats(2)
+	require.True(t, ok)
+	assert.Equal(t, &PlayerStats{ID: 2, Name: "bob", Tokens: 3, Runs: []PlayerRecord{
+		{RunID: "abc-123", Standing: Standing{PlayerID: 2, PlayerName: "bob", Wins: 1, Points: 20, GamesPlayed: 3, CurrentStreak: 1, LongestStreak: 1}},
+	}}, stats)
+
+	_, ok = testLeaderboard(t).PlayerStats(9)
+	assert.False(t, ok)
+}
+
+func TestServer(t *testing.T) {
+	server := httptest.NewServer(NewServer(testLeaderboard(t)).Handler())
+	defer server.Close()
+
+	get := func(path string) (int, map[string]any) {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+
+	status, body := get("/runs/abc-123")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "R0", body["name"])
+	assert.Len(t, body["standings"], 3)
+
+	status, body = get("/games/g1")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "CLOSED", body["status"])
+
+	status, body = get("/players/1")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "alice", body["name"])
+
+	status, _ = get("/players/alice")
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _ = get("/games/xyz")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	resp, err := http.Get(server.URL + "/runs")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var runs []RunSummary
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&runs))
+	assert.Equal(t, []RunSummary{{ID: "abc-123", Name: "R0", Status: "RUNNING", RunEnd: 1000, FatigueModeOn: true, Games: 4, Players: 3}}, runs)
+}
@@ -0,0 +1,89 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// Server serves the leaderboard as JSON: the runs at /runs, the standings
+// of a run at /runs/{id}, of a game at /games/{id} and the record of a
+// player at /players/{id}
+type Server struct {
+	mu          sync.RWMutex
+	leaderboard *Leaderboard
+}
+
+func NewServer(l *Leaderboard) *Server {
+	return &Server{leaderboard: l}
+}
+
+// Update replaces the leaderboard served, e.g. with one read from newer events
+func (s *Server) Update(l *Leaderboard) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leaderboard = l
+}
+
+// Handler returns the handler of the leaderboard routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /runs", s.serveRuns)
+	mux.HandleFunc("GET /runs/{id}", s.serveRun)
+	mux.HandleFunc("GET /games/{id}", s.serveGame)
+	mux.HandleFunc("GET /players/{id}", s.servePlayer)
+	return mux
+}
+
+func (s *Server) serveRuns(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	runs := s.leaderboard.Runs()
+	s.mu.RUnlock()
+	writeJSON(w, http.StatusOK, runs)
+}
+
+func (s *Server) serveRun(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	standings, ok := s.leaderboard.RunStandings(r.PathValue("id"))
+	s.mu.RUnlock()
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "run not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, standings)
+}
+
+func (s *Server) serveGame(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	standings, ok := s.leaderboard.GameStandings(r.PathValue("id"))
+	s.mu.RUnlock()
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "game not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, standings)
+}
+
+func (s *Server) servePlayer(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid player ID"})
+		return
+	}
+
+	s.mu.RLock()
+	stats, ok := s.leaderboard.PlayerStats(id)
+	s.mu.RUnlock()
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "player not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, stats)
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,268 @@
+package leaderboard
+
+import (
+	"sort"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+// RunSummary is a run in the list of runs
+type RunSummary struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	RunStart      uint64 `json:"runStart"`
+	RunEnd        uint64 `json:"runEnd"`
+	FatigueModeOn bool   `json:"fatigueModeOn"`
+	Games         int    `json:"games"`
+	Players       int    `json:"players"`
+}
+
+// Standing is the record of a player over the games of a run
+type Standing struct {
+	Rank       int    `json:"rank"`
+	PlayerID   uint64 `json:"playerId"`
+	PlayerName string `json:"playerName"`
+	// Wins is the run win count of the player
+	Wins        uint64 `json:"wins"`
+	Points      uint64 `json:"points"`
+	GamesPlayed int    `json:"gamesPlayed"`
+	// CurrentStreak is the number of games of the run the player won in a
+	// row up to the last closed one, LongestStreak the most in any row
+	CurrentStreak int `json:"currentStreak"`
+	LongestStreak int `json:"longestStreak"`
+}
+
+// RunStandings are the standings of a run, ranked by wins then points
+type RunStandings struct {
+	RunSummary
+	Standings []Standing `json:"standings"`
+}
+
+// Entry is the submission of a player to a game
+type Entry struct {
+	Rank        int      `json:"rank"`
+	PlayerID    uint64   `json:"playerId"`
+	PlayerName  string   `json:"playerName"`
+	TokenID     uint64   `json:"tokenId"`
+	SubmittedAt uint64   `json:"submittedAt"`
+	TopShots    []uint64 `json:"topShots"`
+	Points      uint64   `json:"points"`
+	Win         bool     `json:"win"`
+}
+
+// GameStandings are the submissions to a game, ranked by points then wins,
+// the earliest first among equals
+type GameStandings struct {
+	ID                 string  `json:"id"`
+	Name               string  `json:"name"`
+	RunID              string  `json:"runId"`
+	Status             string  `json:"status"`
+	SubmissionDeadline uint64  `json:"submissionDeadline"`
+	NumPlayers         uint64  `json:"numPlayers"`
+	Winner             uint64  `json:"winner"`
+	Scored             bool    `json:"scored"`
+	Tokens             int     `json:"tokens"`
+	Entries            []Entry `json:"entries"`
+}
+
+// PlayerStats is a player with their standing in each run they played
+type PlayerStats struct {
+	ID     uint64         `json:"id"`
+	Name   string         `json:"name"`
+	Tokens uint64         `json:"tokens"`
+	Runs   []PlayerRecord `json:"runs"`
+}
+
+// PlayerRecord is the standing of a player in a run
+type PlayerRecord struct {
+	RunID string `json:"runId"`
+	Standing
+}
+
+// Runs returns the summaries of every run, by id
+func (l *Leaderboard) Runs() []RunSummary {
+	summaries := make([]RunSummary, 0, len(l.runs))
+	for _, id := range l.RunIDs() {
+		summaries = append(summaries, l.summary(l.runs[id]))
+	}
+	return summaries
+}
+
+// RunStandings returns the standings of the players of the run
+func (l *Leaderboard) RunStandings(runID string) (*RunStandings, bool) {
+	run, ok := l.runs[runID]
+	if !ok {
+		return nil, false
+	}
+
+	games := l.orderedGames(run)
+	standings := []Standing{}
+	for _, playerID := range playersOf(games) {
+		standings = append(standings, l.standing(games, playerID))
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		return a.PlayerID < b.PlayerID
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+		if i > 0 && standings[i].Wins == standings[i-1].Wins && standings[i].Points == standings[i-1].Points {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+	return &RunStandings{RunSummary: l.summary(run), Standings: standings}, true
+}
+
+// GameStandings returns the submissions to the game
+func (l *Leaderboard) GameStandings(gameID string) (*GameStandings, bool) {
+	game, ok := l.games[gameID]
+	if !ok {
+		return nil, false
+	}
+
+	entries := []Entry{}
+	for _, submission := range game.Submissions {
+		entries = append(entries, Entry{
+			PlayerID:    submission.PlayerID,
+			PlayerName:  l.playerName(submission.PlayerID),
+			TokenID:     submission.TokenID,
+			SubmittedAt: submission.SubmittedAt,
+			TopShots:    submission.TopShots,
+			Points:      submission.Points,
+			Win:         submission.Win,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Win != b.Win {
+			return a.Win
+		}
+		if a.SubmittedAt != b.SubmittedAt {
+			return a.SubmittedAt < b.SubmittedAt
+		}
+		return a.PlayerID < b.PlayerID
+	})
+	for i := range entries {
+		entries[i].Rank = i + 1
+		if i > 0 && entries[i].Points == entries[i-1].Points && entries[i].Win == entries[i-1].Win {
+			entries[i].Rank = entries[i-1].Rank
+		}
+	}
+
+	return &GameStandings{
+		ID:                 game.ID,
+		Name:               game.Name,
+		RunID:              game.RunID,
+		Status:             game.Status.String(),
+		SubmissionDeadline: game.SubmissionDeadline,
+		NumPlayers:         game.NumPlayers,
+		Winner:             game.Winner,
+		Scored:             game.Scored,
+		Tokens:             len(game.Submissions),
+		Entries:            entries,
+	}, true
+}
+
+// PlayerStats returns the player with their standing in each run they played
+func (l *Leaderboard) PlayerStats(playerID uint64) (*PlayerStats, bool) {
+	player, ok := l.players[playerID]
+	if !ok {
+		return nil, false
+	}
+
+	stats := &PlayerStats{ID: player.ID, Name: player.Name, Tokens: player.Tokens, Runs: []PlayerRecord{}}
+	for _, runID := range l.RunIDs() {
+		games := l.orderedGames(l.runs[runID])
+		for _, game := range games {
+			if _, played := game.Submissions[playerID]; played {
+				stats.Runs = append(stats.Runs, PlayerRecord{RunID: runID, Standing: l.standing(games, playerID)})
+				break
+			}
+		}
+	}
+	return stats, true
+}
+
+// standing is the record of the player over the games of a run
+func (l *Leaderboard) standing(games []*Game, playerID uint64) Standing {
+	standing := Standing{PlayerID: playerID, PlayerName: l.playerName(playerID)}
+	for _, game := range games {
+		submission, played := game.Submissions[playerID]
+		if played {
+			standing.GamesPlayed++
+			standing.Wins += submission.Wins
+			standing.Points += submission.Points
+		}
+
+		switch {
+		case played && submission.Wins > 0:
+			standing.CurrentStreak++
+			standing.LongestStreak = max(standing.LongestStreak, standing.CurrentStreak)
+		case game.Status == fastbreak.GameClosed:
+			standing.CurrentStreak = 0
+		}
+	}
+	return standing
+}
+
+func (l *Leaderboard) summary(run *Run) RunSummary {
+	return RunSummary{
+		ID:            run.ID,
+		Name:          run.Name,
+		Status:        run.Status.String(),
+		RunStart:      run.RunStart,
+		RunEnd:        run.RunEnd,
+		FatigueModeOn: run.FatigueModeOn,
+		Games:         len(run.Games),
+		Players:       len(playersOf(l.orderedGames(run))),
+	}
+}
+
+// orderedGames returns the games of the run by submission deadline, then
+// in the order they were created
+func (l *Leaderboard) orderedGames(run *Run) []*Game {
+	games := make([]*Game, 0, len(run.Games))
+	for _, id := range run.Games {
+		games = append(games, l.games[id])
+	}
+	sort.SliceStable(games, func(i, j int) bool {
+		if games[i].SubmissionDeadline != games[j].SubmissionDeadline {
+			return games[i].SubmissionDeadline < games[j].SubmissionDeadline
+		}
+		return games[i].created < games[j].created
+	})
+	return games
+}
+
+func (l *Leaderboard) playerName(playerID uint64) string {
+	if player, ok := l.players[playerID]; ok {
+		return player.Name
+	}
+	return ""
+}
+
+// playersOf returns the ids of the players who submitted to any of the games, in order
+func playersOf(games []*Game) []uint64 {
+	seen := map[uint64]bool{}
+	var ids []uint64
+	for _, game := range games {
+		for playerID := range game.Submissions {
+			if !seen[playerID] {
+				seen[playerID] = true
+				ids = append(ids, playerID)
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
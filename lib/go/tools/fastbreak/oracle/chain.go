@@ -0,0 +1,49 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+// Transaction is an oracle transaction of the templates package
+type Transaction struct {
+	// Name is the name of the template in templates.Catalog
+	Name   string
+	Script []byte
+	Args   []cadence.Value
+}
+
+// Chain reads the runs and games on chain and sends the oracle transactions
+type Chain interface {
+	// Run returns the run, nil when it does not exist
+	Run(ctx context.Context, runID string) (*fastbreak.Run, error)
+	// Game returns the game, nil when it does not exist
+	Game(ctx context.Context, gameID string) (*fastbreak.Game, error)
+	// Submissions returns the submissions to the game
+	Submissions(ctx context.Context, gameID string) ([]fastbreak.Submission, error)
+	// PlayerAddress returns the account of a player, which scores are submitted for
+	PlayerAddress(ctx context.Context, playerID uint64) (string, error)
+	// Send signs and sends the transaction and returns its id
+	Send(ctx context.Context, tx Transaction) (string, error)
+	// Wait waits for the transaction to be sealed. It returns a *TxFailedError
+	// when the transaction failed, and other errors when it could not tell.
+	Wait(ctx context.Context, txID string) error
+}
+
+// TxFailedError is a transaction that was sealed with an error
+type TxFailedError struct {
+	TxID string
+	Err  error
+}
+
+func (e *TxFailedError) Error() string {
+	return fmt.Sprintf("transaction %s failed: %v", e.TxID, e.Err)
+}
+
+func (e *TxFailedError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,67 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+// Feed provides the schedule of the games and the box scores they are scored with
+type Feed interface {
+	Schedule(ctx context.Context) (*Schedule, error)
+	// BoxScores returns nil when no stats were recorded for the game yet
+	BoxScores(ctx context.Context, gameID string) (*fastbreak.BoxScores, error)
+}
+
+// FileFeed reads the schedule from schedule.json in its directory and the
+// box scores of each game from boxscores/<game id>.json. Both are read
+// again on every call, so they can be updated while the oracle runs.
+type FileFeed struct {
+	Dir string
+}
+
+// Schedule reads and validates schedule.json
+func (f FileFeed) Schedule(_ context.Context) (*Schedule, error) {
+	path := filepath.Join(f.Dir, "schedule.json")
+	schedule := &Schedule{}
+	if err := readJSON(path, schedule); err != nil {
+		return nil, err
+	}
+	if err := schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid schedule %s: %w", path, err)
+	}
+	return schedule, nil
+}
+
+// BoxScores reads boxscores/<game id>.json, nil when it does not exist yet
+func (f FileFeed) BoxScores(_ context.Context, gameID string) (*fastbreak.BoxScores, error) {
+	if filepath.Base(gameID) != gameID {
+		return nil, fmt.Errorf("invalid game id %q", gameID)
+	}
+	boxScores := &fastbreak.BoxScores{}
+	err := readJSON(filepath.Join(f.Dir, "boxscores", gameID+".json"), boxScores)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return boxScores, nil
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("invalid %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/onflow/flow-go-sdk/crypto"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+// FlowChain is the Chain of an access node, signing with a key of the
+// account the FastBreakV1 oracle resource is stored in
+type FlowChain struct {
+	Client   *grpc.Client
+	Env      templates.Environment
+	Oracle   flow.Address
+	KeyIndex uint32
+	Signer   crypto.Signer
+	// ComputeLimit of the transactions, 9999 when zero
+	ComputeLimit uint64
+	// PollInterval between the status checks of Wait, a second when zero
+	PollInterval time.Duration
+}
+
+// Run reads the run with get_fast_break_run
+func (c *FlowChain) Run(ctx context.Context, runID string) (*fastbreak.Run, error) {
+	id, err := cadence.NewString(runID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetFastBreakRunScript(c.Env), []cadence.Value{id})
+	if err != nil {
+		return nil, fmt.Errorf("get_fast_break_run %s: %w", runID, err)
+	}
+	optional, ok := result.(cadence.Optional)
+	if !ok || optional.Value == nil {
+		return nil, nil
+	}
+	return fastbreak.DecodeRun(optional.Value)
+}
+
+// Game reads the game with get_fast_break
+func (c *FlowChain) Game(ctx context.Context, gameID string) (*fastbreak.Game, error) {
+	id, err := cadence.NewString(gameID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetFastBreakScript(c.Env), []cadence.Value{id})
+	if err != nil {
+		return nil, fmt.Errorf("get_fast_break %s: %w", gameID, err)
+	}
+	optional, ok := result.(cadence.Optional)
+	if !ok || optional.Value == nil {
+		return nil, nil
+	}
+	return fastbreak.DecodeGame(optional.Value)
+}
+
+// Submissions reads the submissions of the game with get_fast_break
+func (c *FlowChain) Submissions(ctx context.Context, gameID string) ([]fastbreak.Submission, error) {
+	game, err := c.Game(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if game == nil {
+		return nil, fmt.Errorf("fast break game %s does not exist", gameID)
+	}
+
+	submissions := make([]fastbreak.Submission, 0, len(game.Submissions))
+	for _, submission := range game.Submissions {
+		submissions = append(submissions, *submission)
+	}
+	sort.Slice(submissions, func(i, j int) bool { return submissions[i].PlayerID < submissions[j].PlayerID })
+	return submissions, nil
+}
+
+// PlayerAddress reads the account of the player with get_player_address
+func (c *FlowChain) PlayerAddress(ctx context.Context, playerID uint64) (string, error) {
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetFastBreakPlayerAddressScript(c.Env), []cadence.Value{cadence.NewUInt64(playerID)})
+	if err != nil {
+		return "", fmt.Errorf("get_player_address %d: %w", playerID, err)
+	}
+	optional, ok := result.(cadence.Optional)
+	if !ok || optional.Value == nil {
+		return "", fmt.Errorf("player %d has no account", playerID)
+	}
+	address, ok := optional.Value.(cadence.Address)
+	if !ok {
+		return "", fmt.Errorf("unexpected address %s of player %d", optional.Value, playerID)
+	}
+	return address.String(), nil
+}
+
+// Send signs the transaction as proposer, payer and authorizer with the oracle key
+func (c *FlowChain) Send(ctx context.Context, tx Transaction) (string, error) {
+	account, err := c.Client.GetAccount(ctx, c.Oracle)
+	if err != nil {
+		return "", err
+	}
+	if int(c.KeyIndex) >= len(account.Keys) {
+		return "", fmt.Errorf("oracle account %s has no key %d", c.Oracle, c.KeyIndex)
+	}
+	block, err := c.Client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return "", err
+	}
+
+	computeLimit := c.ComputeLimit
+	if computeLimit == 0 {
+		computeLimit = 9999
+	}
+	flowTx := flow.NewTransaction().
+		SetScript(tx.Script).
+		SetComputeLimit(computeLimit).
+		SetReferenceBlockID(block.ID).
+		SetProposalKey(c.Oracle, c.KeyIndex, account.Keys[c.KeyIndex].SequenceNumber).
+		SetPayer(c.Oracle).
+		AddAuthorizer(c.Oracle)
+	for _, arg := range tx.Args {
+		if err := flowTx.AddArgument(arg); err != nil {
+			return "", fmt.Errorf("%s: %w", tx.Name, err)
+		}
+	}
+	if err := flowTx.SignEnvelope(c.Oracle, c.KeyIndex, c.Signer); err != nil {
+		return "", err
+	}
+
+	if err := c.Client.SendTransaction(ctx, *flowTx); err != nil {
+		return "", fmt.Errorf("%s: %w", tx.Name, err)
+	}
+	return flowTx.ID().String(), nil
+}
+
+// Wait polls the result of the transaction until it is sealed or expired
+func (c *FlowChain) Wait(ctx context.Context, txID string) error {
+	interval := c.PollInterval
+	if interval == 0 {
+		interval = time.Second
+	}
+	for {
+		result, err := c.Client.GetTransactionResult(ctx, flow.HexToID(txID))
+		if err != nil {
+			return err
+		}
+		switch result.Status {
+		case flow.TransactionStatusSealed:
+			if result.Error != nil {
+				return &TxFailedError{TxID: txID, Err: result.Error}
+			}
+			return nil
+		case flow.TransactionStatusExpired:
+			return &TxFailedError{TxID: txID, Err: errors.New("expired")}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
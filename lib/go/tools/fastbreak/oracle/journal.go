@@ -0,0 +1,69 @@
+package oracle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/jsonfile"
+)
+
+// Step statuses
+const (
+	Pending = "pending"
+	Done    = "done"
+	Failed  = "failed"
+)
+
+// Step is a transaction the oracle sent, recorded before it waits for it so
+// that a restarted oracle waits for the same transaction rather than
+// sending it again
+type Step struct {
+	Status string `json:"status"`
+	TxID   string `json:"txId,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Journal records the transactions of an oracle account by step, e.g.
+// game/<id>/create, game/<id>/status/OPEN or game/<id>/score/<player id>
+type Journal struct {
+	Oracle string           `json:"oracle"`
+	Steps  map[string]*Step `json:"steps"`
+}
+
+// LoadJournal reads the journal of the oracle account, which is new when
+// the file does not exist. The journal of another account is rejected.
+func LoadJournal(path, oracle string) (*Journal, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Journal{Oracle: oracle, Steps: map[string]*Step{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	journal := &Journal{}
+	if err := json.Unmarshal(data, journal); err != nil {
+		return nil, fmt.Errorf("invalid journal %s: %w", path, err)
+	}
+	if journal.Oracle != oracle {
+		return nil, fmt.Errorf("%s is the journal of oracle %s, not %s", path, journal.Oracle, oracle)
+	}
+	if journal.Steps == nil {
+		journal.Steps = map[string]*Step{}
+	}
+	return journal, nil
+}
+
+// Save writes the journal, replacing the file only once it is fully written
+func (j *Journal) Save(path string) error {
+	return jsonfile.Write(path, j)
+}
+
+// Done reports whether the transaction of the step was sealed
+func (j *Journal) Done(key string) bool {
+	step, ok := j.Steps[key]
+	return ok && step.Status == Done
+}
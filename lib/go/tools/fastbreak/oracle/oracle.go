@@ -0,0 +1,363 @@
+// Package oracle drives Fast Break runs and games on chain from a feed of
+// schedules and box scores. It creates the runs and games of the schedule,
+// moves each game through SCHEDULED, OPEN, STARTED and CLOSED as its times
+// pass, and once the box scores of a started game are final scores every
+// submission and closes the game with its winner.
+//
+// Every transaction is recorded in a journal before the oracle waits for
+// it, so an oracle restarted with the same journal neither skips nor
+// repeats a transaction. The runs and games are read on chain before they
+// are created or their status updated, so that an oracle without its
+// journal does not overwrite them, as FastBreakV1 replaces a run or game
+// created again.
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+// Oracle runs the oracle transactions of a schedule
+type Oracle struct {
+	Feed  Feed
+	Chain Chain
+	// Engine scores the submissions, with the tiers of top shots for runs in fatigue mode
+	Engine fastbreak.Engine
+	Env    templates.Environment
+
+	Journal     *Journal
+	JournalPath string
+
+	// Now is the clock the times of the schedule are compared to, time.Now when nil
+	Now func() time.Time
+	Log *log.Logger
+}
+
+// Run steps the oracle at the interval until the context is cancelled.
+// Errors are logged and the step retried at the next interval.
+func (o *Oracle) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := o.Step(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			o.Log.Printf("Step failed, retrying in %s: %v", interval, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step sends the transactions the schedule calls for at the current time.
+// A game waits on its failed transactions, which are sent again at the
+// next step, while the other games go on.
+func (o *Oracle) Step(ctx context.Context) error {
+	schedule, err := o.Feed.Schedule(ctx)
+	if err != nil {
+		return err
+	}
+	if err := schedule.Validate(); err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+	now := uint64(o.now().Unix())
+
+	var errs []error
+	for _, run := range schedule.Runs {
+		if err := o.run(ctx, schedule, run, now); err != nil {
+			errs = append(errs, fmt.Errorf("run %s: %w", run.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (o *Oracle) run(ctx context.Context, schedule *Schedule, run Run, now uint64) error {
+	created, err := o.sendUnless(ctx, "run/"+run.ID+"/create", o.runReached(run.ID, fastbreak.RunScheduled), Transaction{
+		Name:   "fastbreak/oracle/create_run",
+		Script: templates.GenerateCreateRunScript(o.Env),
+		Args: []cadence.Value{
+			cadence.String(run.ID), cadence.String(run.Name),
+			cadence.NewUInt64(run.RunStart), cadence.NewUInt64(run.RunEnd),
+			cadence.NewBool(run.FatigueModeOn),
+		},
+	})
+	if !created {
+		return err
+	}
+
+	var errs []error
+	games := schedule.gamesOf(run.ID)
+	started, closed := false, len(games) > 0
+	for _, game := range games {
+		if err := o.game(ctx, run, games, game, now); err != nil {
+			errs = append(errs, fmt.Errorf("game %s: %w", game.ID, err))
+		}
+		started = started || o.Journal.Done(statusKey(game.ID, fastbreak.GameStarted))
+		closed = closed && o.Journal.Done(statusKey(game.ID, fastbreak.GameClosed))
+	}
+
+	if started {
+		done, err := o.updateRunStatus(ctx, run, fastbreak.RunRunning)
+		if done && closed {
+			_, err = o.updateRunStatus(ctx, run, fastbreak.RunClosed)
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// game sends the next transactions of the game, stopping at the first one
+// that is not done
+func (o *Oracle) game(ctx context.Context, run Run, games []Game, game Game, now uint64) error {
+	if done, err := o.create(ctx, game); !done {
+		return err
+	}
+
+	if now < game.OpensAt {
+		return nil
+	}
+	if done, err := o.updateStatus(ctx, game, fastbreak.GameOpen, 0); !done {
+		return err
+	}
+	if now < game.SubmissionDeadline {
+		return nil
+	}
+	if done, err := o.updateStatus(ctx, game, fastbreak.GameStarted, 0); !done {
+		return err
+	}
+	if closed, err := o.recorded(ctx, statusKey(game.ID, fastbreak.GameClosed), o.gameReached(game.ID, fastbreak.GameClosed)); closed || err != nil {
+		return err
+	}
+
+	boxScores, err := o.Feed.BoxScores(ctx, game.ID)
+	if err != nil || boxScores == nil || !boxScores.Final {
+		return err
+	}
+	scored, scores, err := o.score(ctx, run, games, game, boxScores)
+	if err != nil {
+		return err
+	}
+	for _, score := range scores {
+		key := "game/" + game.ID + "/score/" + strconv.FormatUint(score.PlayerID, 10)
+		if o.Journal.Done(key) {
+			continue
+		}
+		address, err := o.Chain.PlayerAddress(ctx, score.PlayerID)
+		if err != nil {
+			return err
+		}
+		done, err := o.send(ctx, key, Transaction{
+			Name:   "fastbreak/oracle/score_fast_break_submission",
+			Script: templates.GenerateScoreFastBreakSubmissionScript(o.Env),
+			Args: []cadence.Value{
+				cadence.String(game.ID), cadence.NewAddress(flow.HexToAddress(address)),
+				cadence.NewUInt64(score.Points), cadence.NewBool(score.Win),
+			},
+		})
+		if !done {
+			return err
+		}
+	}
+
+	_, err = o.updateStatus(ctx, game, fastbreak.GameClosed, scored.Winner)
+	return err
+}
+
+// score scores the submissions of the game with the engine. In fatigue
+// mode, the games of the run before it use up the top shots they played.
+func (o *Oracle) score(ctx context.Context, run Run, games []Game, game Game, boxScores *fastbreak.BoxScores) (*fastbreak.Game, []fastbreak.Score, error) {
+	var previous []*fastbreak.Game
+	for _, earlier := range games {
+		if earlier.ID == game.ID || !run.FatigueModeOn {
+			break
+		}
+		submissions, err := o.Chain.Submissions(ctx, earlier.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		previous = append(previous, earlier.model(submissions))
+	}
+
+	submissions, err := o.Chain.Submissions(ctx, game.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	scored := game.model(submissions)
+	scores, err := o.Engine.ScoreGame(run.model(), previous, scored, boxScores)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scored, scores, nil
+}
+
+// create creates the game and adds its stats
+func (o *Oracle) create(ctx context.Context, game Game) (bool, error) {
+	done, err := o.sendUnless(ctx, "game/"+game.ID+"/create", o.gameReached(game.ID, fastbreak.GameScheduled), Transaction{
+		Name:   "fastbreak/oracle/create_game",
+		Script: templates.GenerateCreateGameScript(o.Env),
+		Args: []cadence.Value{
+			cadence.String(game.ID), cadence.String(game.Name), cadence.String(game.RunID),
+			cadence.NewUInt64(game.SubmissionDeadline), cadence.NewUInt64(game.NumPlayers),
+		},
+	})
+	if !done {
+		return false, err
+	}
+
+	for i, stat := range game.Stats {
+		// addStatToFastBreakGame appends the stat, the game on chain has the ones added before it
+		done, err := o.sendUnless(ctx, fmt.Sprintf("game/%s/stat/%d", game.ID, i), func(ctx context.Context) (bool, error) {
+			onChain, err := o.Chain.Game(ctx, game.ID)
+			return onChain != nil && len(onChain.Stats) > i, err
+		}, Transaction{
+			Name:   "fastbreak/oracle/add_stat_to_game",
+			Script: templates.GenerateAddStatToGameScript(o.Env),
+			Args: []cadence.Value{
+				cadence.String(game.ID), cadence.String(stat.Name),
+				cadence.NewUInt8(uint8(stat.Type)), cadence.NewUInt64(stat.ValueNeeded),
+			},
+		})
+		if !done {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (o *Oracle) updateRunStatus(ctx context.Context, run Run, status fastbreak.RunStatus) (bool, error) {
+	return o.sendUnless(ctx, "run/"+run.ID+"/status/"+status.String(), o.runReached(run.ID, status), Transaction{
+		Name:   "fastbreak/oracle/update_run_status",
+		Script: templates.GenerateUpdateFastBreakRunStatusScript(o.Env),
+		Args:   []cadence.Value{cadence.String(run.ID), cadence.NewUInt8(uint8(status))},
+	})
+}
+
+func (o *Oracle) updateStatus(ctx context.Context, game Game, status fastbreak.GameStatus, winner uint64) (bool, error) {
+	return o.sendUnless(ctx, statusKey(game.ID, status), o.gameReached(game.ID, status), Transaction{
+		Name:   "fastbreak/oracle/update_fast_break_game",
+		Script: templates.GenerateUpdateFastBreakGameScript(o.Env),
+		Args:   []cadence.Value{cadence.String(game.ID), cadence.NewUInt8(uint8(status)), cadence.NewUInt64(winner)},
+	})
+}
+
+// runReached reads whether the run exists on chain with the status or a later one
+func (o *Oracle) runReached(runID string, status fastbreak.RunStatus) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		onChain, err := o.Chain.Run(ctx, runID)
+		return onChain != nil && onChain.Status >= status, err
+	}
+}
+
+// gameReached reads whether the game exists on chain with the status or a later one
+func (o *Oracle) gameReached(gameID string, status fastbreak.GameStatus) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		onChain, err := o.Chain.Game(ctx, gameID)
+		return onChain != nil && onChain.Status >= status, err
+	}
+}
+
+func statusKey(gameID string, status fastbreak.GameStatus) string {
+	return "game/" + gameID + "/status/" + status.String()
+}
+
+// sendUnless runs the transaction of the step like send, unless the chain
+// shows the step done already
+func (o *Oracle) sendUnless(ctx context.Context, key string, onChain func(context.Context) (bool, error), tx Transaction) (bool, error) {
+	if done, err := o.recorded(ctx, key, onChain); done || err != nil {
+		return done, err
+	}
+	return o.send(ctx, key, tx)
+}
+
+// recorded reports whether the step is done. A step that was not sent yet,
+// or failed, is read on chain and recorded as done without a transaction
+// when the chain shows it done.
+func (o *Oracle) recorded(ctx context.Context, key string, onChain func(context.Context) (bool, error)) (bool, error) {
+	step := o.Journal.Steps[key]
+	if step != nil && step.Status != Failed {
+		return step.Status == Done, nil
+	}
+
+	done, err := onChain(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", key, err)
+	}
+	if !done {
+		return false, nil
+	}
+	o.Journal.Steps[key] = &Step{Status: Done}
+	if err := o.save(); err != nil {
+		return false, err
+	}
+	o.Log.Printf("%s already done on chain", key)
+	return true, nil
+}
+
+// send runs the transaction of the step unless it is done, and reports
+// whether it is done. A step whose transaction is pending from an earlier
+// step is waited for instead of being sent again.
+func (o *Oracle) send(ctx context.Context, key string, tx Transaction) (bool, error) {
+	step := o.Journal.Steps[key]
+	if step != nil && step.Status == Done {
+		return true, nil
+	}
+
+	if step == nil || step.Status == Failed {
+		txID, err := o.Chain.Send(ctx, tx)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", key, err)
+		}
+		step = &Step{Status: Pending, TxID: txID}
+		o.Journal.Steps[key] = step
+		if err := o.save(); err != nil {
+			return false, err
+		}
+	}
+
+	err := o.Chain.Wait(ctx, step.TxID)
+	var failed *TxFailedError
+	switch {
+	case errors.As(err, &failed):
+		step.Status, step.Error = Failed, err.Error()
+	case err != nil:
+		return false, fmt.Errorf("%s: %w", key, err)
+	default:
+		step.Status, step.Error = Done, ""
+		o.Log.Printf("%s done in %s", key, step.TxID)
+	}
+	if err := o.save(); err != nil {
+		return false, err
+	}
+	if step.Status == Failed {
+		return false, fmt.Errorf("%s: %w", key, err)
+	}
+	return true, nil
+}
+
+func (o *Oracle) save() error {
+	if o.JournalPath == "" {
+		return nil
+	}
+	return o.Journal.Save(o.JournalPath)
+}
+
+func (o *Oracle) now() time.Time {
+	if o.Now == nil {
+		return time.Now()
+	}
+	return o.Now()
+}
@@ -0,0 +1,294 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+type memoryFeed struct {
+	schedule  *Schedule
+	boxScores map[string]*fastbreak.BoxScores
+}
+
+func (f *memoryFeed) Schedule(context.Context) (*Schedule, error) {
+	return f.schedule, nil
+}
+
+func (f *memoryFeed) BoxScores(_ context.Context, gameID string) (*fastbreak.BoxScores, error) {
+	return f.boxScores[gameID], nil
+}
+
+// fakeChain seals every transaction it is sent, unless told to fail it or
+// to lose track of it. Its runs and games are the ones a test gives it.
+type fakeChain struct {
+	runs        map[string]*fastbreak.Run
+	games       map[string]*fastbreak.Game
+	submissions map[string][]fastbreak.Submission
+	addresses   map[uint64]string
+	sent        []string
+	failNext    bool
+	unreachable bool
+	failed      map[string]bool
+}
+
+func (c *fakeChain) Run(_ context.Context, runID string) (*fastbreak.Run, error) {
+	return c.runs[runID], nil
+}
+
+func (c *fakeChain) Game(_ context.Context, gameID string) (*fastbreak.Game, error) {
+	return c.games[gameID], nil
+}
+
+func (c *fakeChain) Submissions(_ context.Context, gameID string) ([]fastbreak.Submission, error) {
+	return c.submissions[gameID], nil
+}
+
+func (c *fakeChain) PlayerAddress(_ context.Context, playerID uint64) (string, error) {
+	address, ok := c.addresses[playerID]
+	if !ok {
+		return "", fmt.Errorf("player %d has no account", playerID)
+	}
+	return address, nil
+}
+
+func (c *fakeChain) Send(_ context.Context, tx Transaction) (string, error) {
+	args := make([]string, len(tx.Args))
+	for i, arg := range tx.Args {
+		args[i] = arg.String()
+	}
+	c.sent = append(c.sent, tx.Name+"("+strings.Join(args, ", ")+")")
+	txID := fmt.Sprintf("tx%d", len(c.sent))
+	if c.failNext {
+		c.failNext = false
+		if c.failed == nil {
+			c.failed = map[string]bool{}
+		}
+		c.failed[txID] = true
+	}
+	return txID, nil
+}
+
+func (c *fakeChain) Wait(_ context.Context, txID string) error {
+	if c.unreachable {
+		return errors.New("access node unreachable")
+	}
+	if c.failed[txID] {
+		return &TxFailedError{TxID: txID, Err: errors.New("panic: oracle says no")}
+	}
+	return nil
+}
+
+// testSchedule is the run and game of fast_break_test.go: a POINTS stat of
+// 30 for a single top shot, opening at 100 with a deadline at 200
+func testSchedule() *Schedule {
+	return &Schedule{
+		Runs: []Run{{ID: "abc-123", Name: "R0", RunStart: 50, RunEnd: 1000, FatigueModeOn: true}},
+		Games: []Game{{
+			ID: "def-456", Name: "fb0", RunID: "abc-123", OpensAt: 100, SubmissionDeadline: 200, NumPlayers: 1,
+			Stats: []fastbreak.Stat{{Name: "POINTS", Type: fastbreak.Individual, ValueNeeded: 30}},
+		}},
+	}
+}
+
+type clock struct{ now int64 }
+
+func (c *clock) Now() time.Time { return time.Unix(c.now, 0) }
+
+func testOracle(t *testing.T, feed Feed, chain Chain, clock *clock, journalPath string) *Oracle {
+	t.Helper()
+	journal, err := LoadJournal(journalPath, "0xf8d6e0586b0a20c7")
+	require.NoError(t, err)
+	return &Oracle{
+		Feed:  feed,
+		Chain: chain,
+		Engine: fastbreak.Engine{Players: func(momentID uint64) (string, bool) {
+			players := map[uint64]string{1: "Lebron", 2: "Ja Morant"}
+			player, ok := players[momentID]
+			return player, ok
+		}},
+		Journal:     journal,
+		JournalPath: journalPath,
+		Now:         clock.Now,
+		Log:         log.New(io.Discard, "", 0),
+	}
+}
+
+func TestOracleDrivesGame(t *testing.T) {
+	feed := &memoryFeed{schedule: testSchedule(), boxScores: map[string]*fastbreak.BoxScores{}}
+	chain := &fakeChain{submissions: map[string][]fastbreak.Submission{
+		"def-456": {
+			{PlayerID: 1, SubmittedAt: 150, FastBreakGameID: "def-456", TopShots: []uint64{1}},
+			{PlayerID: 2, SubmittedAt: 120, FastBreakGameID: "def-456", TopShots: []uint64{2}},
+		},
+	}, addresses: map[uint64]string{1: "0x01cf0e2f2f715450", 2: "0x179b6b1cb6755e31"}}
+	now := &clock{now: 10}
+	oracle := testOracle(t, feed, chain, now, filepath.Join(t.TempDir(), "journal.json"))
+	ctx := context.Background()
+
+	require.NoError(t, oracle.Step(ctx))
+	assert.Equal(t, []string{
+		`fastbreak/oracle/create_run("abc-123", "R0", 50, 1000, true)`,
+		`fastbreak/oracle/create_game("def-456", "fb0", "abc-123", 200, 1)`,
+		`fastbreak/oracle/add_stat_to_game("def-456", "POINTS", 0, 30)`,
+	}, chain.sent)
+
+	// nothing is sent again until the game opens
+	require.NoError(t, oracle.Step(ctx))
+	assert.Len(t, chain.sent, 3)
+
+	now.now = 100
+	require.NoError(t, oracle.Step(ctx))
+	assert.Equal(t, `fastbreak/oracle/update_fast_break_game("def-456", 1, 0)`, chain.sent[3])
+
+	now.now = 200
+	require.NoError(t, oracle.Step(ctx))
+	assert.Equal(t, []string{
+		`fastbreak/oracle/update_fast_break_game("def-456", 2, 0)`,
+		`fastbreak/oracle/update_run_status("abc-123", 1)`,
+	}, chain.sent[4:])
+
+	// the game is only scored once its box scores are final
+	feed.boxScores["def-456"] = &fastbreak.BoxScores{Players: map[string]map[string]uint64{"Lebron": {"POINTS": 12}}}
+	require.NoError(t, oracle.Step(ctx))
+	assert.Len(t, chain.sent, 6)
+
+	feed.boxScores["def-456"] = &fastbreak.BoxScores{Final: true, Players: map[string]map[string]uint64{
+		"Lebron":    {"POINTS": 100},
+		"Ja Morant": {"POINTS": 29},
+	}}
+	require.NoError(t, oracle.Step(ctx))
+	assert.Equal(t, []string{
+		`fastbreak/oracle/score_fast_break_submission("def-456", 0x01cf0e2f2f715450, 100, true)`,
+		`fastbreak/oracle/score_fast_break_submission("def-456", 0x179b6b1cb6755e31, 29, false)`,
+		`fastbreak/oracle/update_fast_break_game("def-456", 3, 1)`,
+		`fastbreak/oracle/update_run_status("abc-123", 2)`,
+	}, chain.sent[6:])
+
+	// a closed game is done
+	require.NoError(t, oracle.Step(ctx))
+	assert.Len(t, chain.sent, 10)
+}
+
+func TestOracleResumesJournal(t *testing.T) {
+	journalPath := filepath.Join(t.TempDir(), "journal.json")
+	feed := &memoryFeed{schedule: testSchedule()}
+	chain := &fakeChain{unreachable: true}
+	now := &clock{now: 10}
+
+	// the create_run transaction is sent but its result never seen
+	err := testOracle(t, feed, chain, now, journalPath).Step(context.Background())
+	assert.ErrorContains(t, err, "run/abc-123/create: access node unreachable")
+	require.Len(t, chain.sent, 1)
+
+	// a restarted oracle waits for it rather than creating the run again
+	chain.unreachable = false
+	restarted := testOracle(t, feed, chain, now, journalPath)
+	assert.Equal(t, &Step{Status: Pending, TxID: "tx1"}, restarted.Journal.Steps["run/abc-123/create"])
+	require.NoError(t, restarted.Step(context.Background()))
+	assert.Len(t, chain.sent, 3)
+	assert.True(t, restarted.Journal.Done("run/abc-123/create"))
+
+	_, err = LoadJournal(journalPath, "0x0b2a3299cc857e29")
+	assert.ErrorContains(t, err, "not 0x0b2a3299cc857e29")
+}
+
+func TestOracleRetriesFailedTransaction(t *testing.T) {
+	feed := &memoryFeed{schedule: testSchedule()}
+	chain := &fakeChain{}
+	now := &clock{now: 10}
+	oracle := testOracle(t, feed, chain, now, "")
+
+	require.NoError(t, oracle.Step(context.Background()))
+	chain.failNext = true
+	now.now = 100
+	err := oracle.Step(context.Background())
+	assert.ErrorContains(t, err, "game def-456: game/def-456/status/OPEN: transaction tx4 failed")
+	assert.Equal(t, Failed, oracle.Journal.Steps["game/def-456/status/OPEN"].Status)
+
+	require.NoError(t, oracle.Step(context.Background()))
+	assert.Equal(t, &Step{Status: Done, TxID: "tx5"}, oracle.Journal.Steps["game/def-456/status/OPEN"])
+}
+
+func TestOracleReadsChain(t *testing.T) {
+	feed := &memoryFeed{schedule: testSchedule(), boxScores: map[string]*fastbreak.BoxScores{}}
+	chain := &fakeChain{
+		runs:  map[string]*fastbreak.Run{"abc-123": {ID: "abc-123", Status: fastbreak.RunScheduled}},
+		games: map[string]*fastbreak.Game{"def-456": {ID: "def-456", Status: fastbreak.GameScheduled}},
+	}
+	now := &clock{now: 10}
+	oracle := testOracle(t, feed, chain, now, filepath.Join(t.TempDir(), "journal.json"))
+	ctx := context.Background()
+
+	// the run and game created by an oracle without this journal are not created again
+	require.NoError(t, oracle.Step(ctx))
+	assert.Equal(t, []string{`fastbreak/oracle/add_stat_to_game("def-456", "POINTS", 0, 30)`}, chain.sent)
+	assert.Equal(t, &Step{Status: Done}, oracle.Journal.Steps["run/abc-123/create"])
+	assert.Equal(t, &Step{Status: Done}, oracle.Journal.Steps["game/def-456/create"])
+
+	// nor are statuses the games and runs have on chain sent again
+	chain.games["def-456"].Status = fastbreak.GameStarted
+	chain.runs["abc-123"].Status = fastbreak.RunRunning
+	now.now = 200
+	require.NoError(t, oracle.Step(ctx))
+	assert.Len(t, chain.sent, 1)
+	assert.True(t, oracle.Journal.Done("game/def-456/status/OPEN"))
+	assert.True(t, oracle.Journal.Done("run/abc-123/status/RUNNING"))
+
+	// and a game closed on chain is not scored again
+	chain.games["def-456"].Status = fastbreak.GameClosed
+	feed.boxScores["def-456"] = &fastbreak.BoxScores{Final: true, Players: map[string]map[string]uint64{"Lebron": {"POINTS": 100}}}
+	require.NoError(t, oracle.Step(ctx))
+	assert.Equal(t, []string{`fastbreak/oracle/update_run_status("abc-123", 2)`}, chain.sent[1:])
+}
+
+func TestOracleRejectsInvalidSchedule(t *testing.T) {
+	schedule := testSchedule()
+	schedule.Runs[0].ID, schedule.Games[0].RunID = "abc-\xff", "abc-\xff"
+	chain := &fakeChain{}
+	oracle := testOracle(t, &memoryFeed{schedule: schedule}, chain, &clock{now: 10}, "")
+
+	err := oracle.Step(context.Background())
+	assert.ErrorContains(t, err, "must be valid UTF-8")
+	assert.Empty(t, chain.sent)
+}
+
+func TestFileFeed(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "boxscores"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schedule.json"), []byte(`{
+		"runs": [{"id": "abc-123", "name": "R0", "runStart": 50, "runEnd": 1000}],
+		"games": [{"id": "def-456", "name": "fb0", "runId": "abc-123", "submissionDeadline": 200, "numPlayers": 5,
+			"stats": [{"name": "POINTS", "type": "CUMMULATIVE", "valueNeeded": 100}]}]
+	}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "boxscores", "def-456.json"), []byte(`{"final": true, "players": {"Lebron": {"POINTS": 30}}}`), 0o644))
+
+	feed := FileFeed{Dir: dir}
+	schedule, err := feed.Schedule(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []fastbreak.Stat{{Name: "POINTS", Type: fastbreak.Cumulative, ValueNeeded: 100}}, schedule.Games[0].Stats)
+
+	boxScores, err := feed.BoxScores(context.Background(), "def-456")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(30), boxScores.Players["Lebron"]["POINTS"])
+
+	boxScores, err = feed.BoxScores(context.Background(), "ghi-789")
+	require.NoError(t, err)
+	assert.Nil(t, boxScores)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schedule.json"), []byte(`{"games": [{"id": "def-456", "runId": "abc-123"}]}`), 0o644))
+	_, err = feed.Schedule(context.Background())
+	assert.ErrorContains(t, err, `game def-456: unknown run "abc-123"`)
+}
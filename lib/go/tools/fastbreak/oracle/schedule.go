@@ -0,0 +1,130 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+// Schedule is the Fast Break runs and games the oracle drives
+type Schedule struct {
+	Runs  []Run  `json:"runs"`
+	Games []Game `json:"games"`
+}
+
+// Run is a FastBreakRun, with its start and end as unix timestamps
+type Run struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	RunStart      uint64 `json:"runStart"`
+	RunEnd        uint64 `json:"runEnd"`
+	FatigueModeOn bool   `json:"fatigueModeOn"`
+}
+
+// Game is a FastBreakGame with its stats. Submissions open at OpensAt, or
+// as soon as the game is created when it is 0, and the game starts at its
+// submission deadline.
+type Game struct {
+	ID                 string           `json:"id"`
+	Name               string           `json:"name"`
+	RunID              string           `json:"runId"`
+	OpensAt            uint64           `json:"opensAt,omitempty"`
+	SubmissionDeadline uint64           `json:"submissionDeadline"`
+	NumPlayers         uint64           `json:"numPlayers"`
+	Stats              []fastbreak.Stat `json:"stats"`
+}
+
+// Validate checks that every game belongs to a run and can be played
+func (s *Schedule) Validate() error {
+	var errs []error
+	runs := map[string]bool{}
+	for _, run := range s.Runs {
+		switch {
+		case run.ID == "":
+			errs = append(errs, fmt.Errorf("run %q: missing id", run.Name))
+		case !validStrings(run.ID, run.Name):
+			errs = append(errs, fmt.Errorf("run %q: id and name must be valid UTF-8", run.ID))
+		case runs[run.ID]:
+			errs = append(errs, fmt.Errorf("run %s: duplicate id", run.ID))
+		case run.RunEnd < run.RunStart:
+			errs = append(errs, fmt.Errorf("run %s: ends before it starts", run.ID))
+		}
+		runs[run.ID] = true
+	}
+
+	games := map[string]bool{}
+	for _, game := range s.Games {
+		switch {
+		case game.ID == "":
+			errs = append(errs, fmt.Errorf("game %q: missing id", game.Name))
+		case !validStrings(game.ID, game.Name) || !validStatNames(game.Stats):
+			errs = append(errs, fmt.Errorf("game %q: id, name and stat names must be valid UTF-8", game.ID))
+		case games[game.ID]:
+			errs = append(errs, fmt.Errorf("game %s: duplicate id", game.ID))
+		case !runs[game.RunID]:
+			errs = append(errs, fmt.Errorf("game %s: unknown run %q", game.ID, game.RunID))
+		case game.NumPlayers == 0:
+			errs = append(errs, fmt.Errorf("game %s: numPlayers must be at least 1", game.ID))
+		case len(game.Stats) == 0:
+			errs = append(errs, fmt.Errorf("game %s: no stats", game.ID))
+		case game.OpensAt > game.SubmissionDeadline:
+			errs = append(errs, fmt.Errorf("game %s: opens after its submission deadline", game.ID))
+		}
+		games[game.ID] = true
+	}
+	return errors.Join(errs...)
+}
+
+// validStrings reports whether the strings can be passed as Cadence strings
+func validStrings(values ...string) bool {
+	for _, value := range values {
+		if !utf8.ValidString(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func validStatNames(stats []fastbreak.Stat) bool {
+	for _, stat := range stats {
+		if !utf8.ValidString(stat.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// model returns the run as the contract creates it
+func (r Run) model() *fastbreak.Run {
+	return &fastbreak.Run{ID: r.ID, Name: r.Name, RunStart: r.RunStart, RunEnd: r.RunEnd, FatigueModeOn: r.FatigueModeOn}
+}
+
+// model returns the game with its submissions
+func (g Game) model(submissions []fastbreak.Submission) *fastbreak.Game {
+	game := &fastbreak.Game{
+		ID:                 g.ID,
+		Name:               g.Name,
+		FastBreakRunID:     g.RunID,
+		SubmissionDeadline: g.SubmissionDeadline,
+		NumPlayers:         g.NumPlayers,
+		Stats:              g.Stats,
+		Submissions:        map[uint64]*fastbreak.Submission{},
+	}
+	for _, submission := range submissions {
+		game.Submissions[submission.PlayerID] = &submission
+	}
+	return game
+}
+
+// gamesOf returns the games of the run in the order of the schedule
+func (s *Schedule) gamesOf(runID string) []Game {
+	var games []Game
+	for _, game := range s.Games {
+		if game.RunID == runID {
+			games = append(games, game)
+		}
+	}
+	return games
+}
@@ -0,0 +1,145 @@
+package fastbreak
+
+import (
+	"sort"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+// BoxScores are the stats the NBA players of a game recorded, by the full
+// name of the player and the name of the stat. A game is only scored
+// once its box scores are final.
+type BoxScores struct {
+	Final   bool                         `json:"final"`
+	Players map[string]map[string]uint64 `json:"players"`
+}
+
+// Players returns the full name of the NBA player of a moment
+type Players func(momentID uint64) (string, bool)
+
+// ProjectionPlayers looks the players of moments up in the FullName of their play
+func ProjectionPlayers(p *projection.Projection) Players {
+	return func(momentID uint64) (string, bool) {
+		moment, ok := p.Moment(momentID)
+		if !ok {
+			return "", false
+		}
+		play, ok := p.Play(moment.PlayID)
+		if !ok || play.Metadata["FullName"] == "" {
+			return "", false
+		}
+		return play.Metadata["FullName"], true
+	}
+}
+
+// Score is the points and win flag of a submission
+type Score struct {
+	PlayerID uint64 `json:"playerId"`
+	Points   uint64 `json:"points"`
+	Win      bool   `json:"win"`
+}
+
+// ScoreSubmission scores the top shots of a submission against the box
+// scores of the game.
+//
+// Each top shot counts the stats its player recorded, nothing when the
+// player is unknown or did not play. An INDIVIDUAL stat is met when every
+// top shot reaches the value needed, a CUMMULATIVE stat when the top shots
+// reach it together. The points are the sum of the stats of every top shot,
+// and the submission wins when it meets every stat of the game. A
+// submission ValidPlaySubmission rejects scores nothing.
+func ScoreSubmission(game *Game, topShots []uint64, boxScores *BoxScores, players Players) (points uint64, win bool) {
+	if !ValidPlaySubmission(game, topShots) {
+		return 0, false
+	}
+
+	win = len(game.Stats) > 0
+	for _, stat := range game.Stats {
+		var total uint64
+		individualMet := true
+		for _, momentID := range topShots {
+			var value uint64
+			if player, ok := players(momentID); ok {
+				value = boxScores.Players[player][stat.Name]
+			}
+			total += value
+			if value < stat.ValueNeeded {
+				individualMet = false
+			}
+		}
+
+		points += total
+		switch stat.Type {
+		case Individual:
+			win = win && individualMet
+		case Cumulative:
+			win = win && total >= stat.ValueNeeded
+		}
+	}
+	return points, win
+}
+
+// Engine scores the games of runs
+type Engine struct {
+	Players Players
+	// Tiers is needed to score the games of runs in fatigue mode
+	Tiers Tiers
+}
+
+// ScoreGame scores every submission of the game, records the scores in
+// the run and the game as updateFastBreakScore does, and closes the game
+// with its winner. The scores are returned by player id, in the order the
+// oracle submits them.
+//
+// In fatigue mode, previous are the games of the run played before this
+// one. A submission playing a top shot more often than its tier allows
+// over the run scores nothing.
+func (e Engine) ScoreGame(run *Run, previous []*Game, game *Game, boxScores *BoxScores) ([]Score, error) {
+	var uses map[uint64]int
+	if run.FatigueModeOn {
+		uses = Uses(previous)
+	}
+
+	playerIDs := make([]uint64, 0, len(game.Submissions))
+	for playerID := range game.Submissions {
+		playerIDs = append(playerIDs, playerID)
+	}
+	sort.Slice(playerIDs, func(i, j int) bool { return playerIDs[i] < playerIDs[j] })
+
+	scores := make([]Score, 0, len(playerIDs))
+	for _, playerID := range playerIDs {
+		score := Score{PlayerID: playerID}
+		topShots := game.Submissions[playerID].TopShots
+		if !run.FatigueModeOn || len(e.Fatigued(topShots, uses)) == 0 {
+			score.Points, score.Win = ScoreSubmission(game, topShots, boxScores, e.Players)
+		}
+		if err := UpdateFastBreakScore(run, game, playerID, score.Points, score.Win); err != nil {
+			return nil, err
+		}
+		scores = append(scores, score)
+	}
+
+	game.Status, game.Winner = GameClosed, Winner(game)
+	return scores, nil
+}
+
+// Winner returns the player the game is closed with: of the winning
+// submissions, the one with the most points, then the earliest, then the
+// lowest player id. It is 0 when no submission wins.
+func Winner(game *Game) uint64 {
+	var winner *Submission
+	for _, submission := range game.Submissions {
+		if !submission.Win {
+			continue
+		}
+		if winner == nil || submission.Points > winner.Points ||
+			(submission.Points == winner.Points && (submission.SubmittedAt < winner.SubmittedAt ||
+				(submission.SubmittedAt == winner.SubmittedAt && submission.PlayerID < winner.PlayerID))) {
+			winner = submission
+		}
+	}
+	if winner == nil {
+		return 0
+	}
+	return winner.PlayerID
+}
@@ -0,0 +1,133 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+// State is what the checks read of FastBreakV1 and the player's account,
+// from scripts or an indexer
+type State interface {
+	// Game returns the game with its submissions, nil when it does not exist
+	Game(ctx context.Context, gameID string) (*fastbreak.Game, error)
+	// Run returns the run, nil when it does not exist
+	Run(ctx context.Context, runID string) (*fastbreak.Run, error)
+	// RunGames returns the games of the run with their submissions. It is
+	// only called for runs in fatigue mode.
+	RunGames(ctx context.Context, runID string) ([]*fastbreak.Game, error)
+	// PlayerID returns the id of the Fast Break player of the account, false
+	// when it has none
+	PlayerID(ctx context.Context, address string) (uint64, bool, error)
+	// Playable returns the top shots the account holds in its collection or
+	// sale collections, false when it has no TopShot collection
+	Playable(ctx context.Context, address string, topShots []uint64) ([]uint64, bool, error)
+}
+
+// FlowState reads the State with the Fast Break scripts of an access node
+type FlowState struct {
+	Client *grpc.Client
+	Env    templates.Environment
+	// GameIDs returns the ids of the games of a run, which FastBreakV1 does
+	// not index, e.g. from the schedule of the oracle
+	GameIDs func(ctx context.Context, runID string) ([]string, error)
+}
+
+// Game reads the game with get_fast_break
+func (s *FlowState) Game(ctx context.Context, gameID string) (*fastbreak.Game, error) {
+	value, err := s.optional(ctx, "get_fast_break", templates.GenerateGetFastBreakScript(s.Env), cadence.String(gameID))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return fastbreak.DecodeGame(value)
+}
+
+// Run reads the run with get_fast_break_run
+func (s *FlowState) Run(ctx context.Context, runID string) (*fastbreak.Run, error) {
+	value, err := s.optional(ctx, "get_fast_break_run", templates.GenerateGetFastBreakRunScript(s.Env), cadence.String(runID))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return fastbreak.DecodeRun(value)
+}
+
+// RunGames reads each game GameIDs returns for the run
+func (s *FlowState) RunGames(ctx context.Context, runID string) ([]*fastbreak.Game, error) {
+	if s.GameIDs == nil {
+		return nil, fmt.Errorf("run %s is in fatigue mode and the ids of its games are unknown", runID)
+	}
+	ids, err := s.GameIDs(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	var games []*fastbreak.Game
+	for _, id := range ids {
+		game, err := s.Game(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if game != nil {
+			games = append(games, game)
+		}
+	}
+	return games, nil
+}
+
+// PlayerID reads the player stored in the account with get_account_player_id
+func (s *FlowState) PlayerID(ctx context.Context, address string) (uint64, bool, error) {
+	value, err := s.optional(ctx, "get_account_player_id", templates.GenerateGetAccountPlayerIDScript(s.Env), cadence.NewAddress(flow.HexToAddress(address)))
+	if err != nil || value == nil {
+		return 0, false, err
+	}
+	id, ok := value.(cadence.UInt64)
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected player id %s of %s", value, address)
+	}
+	return uint64(id), true, nil
+}
+
+// Playable reads the top shots the account holds with get_playable_top_shots
+func (s *FlowState) Playable(ctx context.Context, address string, topShots []uint64) ([]uint64, bool, error) {
+	ids := make([]cadence.Value, len(topShots))
+	for i, id := range topShots {
+		ids[i] = cadence.NewUInt64(id)
+	}
+	value, err := s.optional(ctx, "get_playable_top_shots", templates.GenerateGetPlayableTopShotsScript(s.Env),
+		cadence.NewAddress(flow.HexToAddress(address)), cadence.NewArray(ids))
+	if err != nil || value == nil {
+		return nil, false, err
+	}
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected top shots %s of %s", value, address)
+	}
+	playable := make([]uint64, 0, len(array.Values))
+	for _, id := range array.Values {
+		momentID, ok := id.(cadence.UInt64)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected top shot %s of %s", id, address)
+		}
+		playable = append(playable, uint64(momentID))
+	}
+	return playable, true, nil
+}
+
+// optional executes a script returning an optional and returns its value,
+// nil when it is nil
+func (s *FlowState) optional(ctx context.Context, name string, script []byte, args ...cadence.Value) (cadence.Value, error) {
+	result, err := s.Client.ExecuteScriptAtLatestBlock(ctx, script, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	optional, ok := result.(cadence.Optional)
+	if !ok {
+		return nil, fmt.Errorf("%s: unexpected result %s", name, result)
+	}
+	return optional.Value, nil
+}
@@ -0,0 +1,205 @@
+// Package validate checks a Fast Break submission before the player signs
+// it, with the checks FastBreakV1 applies when the play transaction runs
+// and the rules the oracle scores it by. Each failed check is a Reason a
+// wallet can show, telling whether the transaction would revert or the
+// submission would score nothing.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+// Code identifies the check a submission failed
+type Code string
+
+const (
+	// GameNotFound is a game id FastBreakV1 has no game for
+	GameNotFound Code = "GAME_NOT_FOUND"
+	// DeadlinePassed is a game past its submission deadline, less the buffer
+	DeadlinePassed Code = "DEADLINE_PASSED"
+	// NoPlayer is an account without a Fast Break player, see create_player.cdc
+	NoPlayer Code = "NO_PLAYER"
+	// AlreadySubmitted is a player with a submission to the game, which
+	// update_submission.cdc changes instead
+	AlreadySubmitted Code = "ALREADY_SUBMITTED"
+	// NoMomentCollection is an account without a TopShot collection
+	NoMomentCollection Code = "NO_MOMENT_COLLECTION"
+	// NotOwned is a top shot neither in the collection of the account nor
+	// listed in its sale collections
+	NotOwned Code = "NOT_OWNED"
+	// DuplicateTopShot is a top shot submitted more than once
+	DuplicateTopShot Code = "DUPLICATE_TOP_SHOT"
+	// NoTopShots is a submission without top shots
+	NoTopShots Code = "NO_TOP_SHOTS"
+	// TooManyTopShots is a submission of more top shots than the game's numPlayers
+	TooManyTopShots Code = "TOO_MANY_TOP_SHOTS"
+	// Fatigued is a top shot played in as many games of a run in fatigue
+	// mode as its tier allows
+	Fatigued Code = "FATIGUED"
+)
+
+// Reason is a check the submission failed
+type Reason struct {
+	Code    Code   `json:"code"`
+	Message string `json:"message"`
+	// MomentID is the top shot the reason is about, if any
+	MomentID uint64 `json:"momentId,omitempty"`
+	// Reverts is whether the play transaction would revert. Otherwise the
+	// submission is recorded but scores nothing.
+	Reverts bool `json:"reverts"`
+}
+
+// Request is a submission a player is about to sign
+type Request struct {
+	// Address is the account of the player, 0x followed by 16 hex digits
+	Address  string   `json:"address"`
+	GameID   string   `json:"gameId"`
+	TopShots []uint64 `json:"topShots"`
+}
+
+// Result is the outcome of the checks of a submission
+type Result struct {
+	Valid bool `json:"valid"`
+	// PlayerID is the Fast Break player of the account, 0 when it has none
+	PlayerID uint64   `json:"playerId,omitempty"`
+	Reasons  []Reason `json:"reasons"`
+}
+
+// Validator checks submissions against the state of FastBreakV1 and TopShot
+type Validator struct {
+	State State
+	// Tiers gives the fatigue limits of top shots. Unknown tiers have the
+	// limit of a common top shot.
+	Tiers fastbreak.Tiers
+	// Now is the clock deadlines are compared to, time.Now when nil. It
+	// stands in for the timestamp of the block the transaction lands in.
+	Now func() time.Time
+}
+
+// Validate runs every check of the submission. The error is for the
+// state that could not be read, not for a submission that fails a check.
+func (v *Validator) Validate(ctx context.Context, request Request) (*Result, error) {
+	result := &Result{Reasons: []Reason{}}
+	reject := func(reason Reason) { result.Reasons = append(result.Reasons, reason) }
+
+	game, err := v.State.Game(ctx, request.GameID)
+	if err != nil {
+		return nil, err
+	}
+	if game == nil {
+		reject(Reason{Code: GameNotFound, Message: fmt.Sprintf("No such fast break game with gameId: %s", request.GameID), Reverts: true})
+		return result, nil
+	}
+
+	now := uint64(v.now().Unix())
+	if !fastbreak.IsValidSubmission(game.SubmissionDeadline, now) {
+		reject(Reason{
+			Code:    DeadlinePassed,
+			Message: fmt.Sprintf("Submissions closed %d seconds before the deadline of %s", fastbreak.SubmissionBuffer, time.Unix(int64(game.SubmissionDeadline), 0).UTC().Format(time.RFC3339)),
+			Reverts: true,
+		})
+	}
+
+	playerID, ok, err := v.State.PlayerID(ctx, request.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		reject(Reason{Code: NoPlayer, Message: "The account has not created a Fast Break player", Reverts: true})
+	} else {
+		result.PlayerID = playerID
+		if _, submitted := game.Submissions[playerID]; submitted {
+			reject(Reason{Code: AlreadySubmitted, Message: fmt.Sprintf("Player %d already submitted to this game", playerID), Reverts: true})
+		}
+	}
+
+	playable, ok, err := v.State.Playable(ctx, request.Address, request.TopShots)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		reject(Reason{Code: NoMomentCollection, Message: "The account has no TopShot collection", Reverts: true})
+	} else {
+		owned := map[uint64]bool{}
+		for _, momentID := range playable {
+			owned[momentID] = true
+		}
+		reported := map[uint64]bool{}
+		for _, momentID := range request.TopShots {
+			if !owned[momentID] && !reported[momentID] {
+				reject(Reason{Code: NotOwned, Message: fmt.Sprintf("Top shot %d is not owned in any collection of the account", momentID), MomentID: momentID, Reverts: true})
+				reported[momentID] = true
+			}
+		}
+	}
+
+	seen := map[uint64]int{}
+	for _, momentID := range request.TopShots {
+		if seen[momentID]++; seen[momentID] == 2 {
+			reject(Reason{Code: DuplicateTopShot, Message: fmt.Sprintf("Top shot %d is submitted more than once", momentID), MomentID: momentID})
+		}
+	}
+	switch {
+	case len(request.TopShots) == 0:
+		reject(Reason{Code: NoTopShots, Message: "The submission has no top shots"})
+	case !fastbreak.ValidPlaySubmission(game, request.TopShots):
+		reject(Reason{Code: TooManyTopShots, Message: fmt.Sprintf("The game takes at most %d top shots", game.NumPlayers)})
+	}
+
+	reasons, err := v.fatigue(ctx, game, request.TopShots)
+	if err != nil {
+		return nil, err
+	}
+	result.Reasons = append(result.Reasons, reasons...)
+
+	result.Valid = len(result.Reasons) == 0
+	return result, nil
+}
+
+// fatigue checks the top shots against their uses in the other games of
+// the run when it is in fatigue mode
+func (v *Validator) fatigue(ctx context.Context, game *fastbreak.Game, topShots []uint64) ([]Reason, error) {
+	run, err := v.State.Run(ctx, game.FastBreakRunID)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil || !run.FatigueModeOn {
+		return nil, nil
+	}
+
+	games, err := v.State.RunGames(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	var others []*fastbreak.Game
+	for _, other := range games {
+		if other.ID != game.ID {
+			others = append(others, other)
+		}
+	}
+	uses := fastbreak.Uses(others)
+
+	engine := fastbreak.Engine{Tiers: v.Tiers}
+	var reasons []Reason
+	for _, momentID := range engine.Fatigued(topShots, uses) {
+		tier := engine.Tier(momentID)
+		reasons = append(reasons, Reason{
+			Code: Fatigued,
+			Message: fmt.Sprintf("Top shot %d is %s, which can be played in %d games of run %s, and was played in %d",
+				momentID, tier, fastbreak.FatigueLimit(tier), run.Name, uses[momentID]),
+			MomentID: momentID,
+		})
+	}
+	return reasons, nil
+}
+
+func (v *Validator) now() time.Time {
+	if v.Now == nil {
+		return time.Now()
+	}
+	return v.Now()
+}
@@ -0,0 +1,158 @@
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/fastbreak"
+)
+
+const (
+	alice = "0x01cf0e2f2f715450"
+	bob   = "0x179b6b1cb6755e31"
+)
+
+type memoryState struct {
+	runs     map[string]*fastbreak.Run
+	games    map[string]*fastbreak.Game
+	players  map[string]uint64
+	holdings map[string][]uint64
+}
+
+func (s *memoryState) Game(_ context.Context, gameID string) (*fastbreak.Game, error) {
+	return s.games[gameID], nil
+}
+
+func (s *memoryState) Run(_ context.Context, runID string) (*fastbreak.Run, error) {
+	return s.runs[runID], nil
+}
+
+func (s *memoryState) RunGames(_ context.Context, runID string) ([]*fastbreak.Game, error) {
+	var games []*fastbreak.Game
+	for _, game := range s.games {
+		if game.FastBreakRunID == runID {
+			games = append(games, game)
+		}
+	}
+	return games, nil
+}
+
+func (s *memoryState) PlayerID(_ context.Context, address string) (uint64, bool, error) {
+	id, ok := s.players[address]
+	return id, ok, nil
+}
+
+func (s *memoryState) Playable(_ context.Context, address string, topShots []uint64) ([]uint64, bool, error) {
+	holdings, ok := s.holdings[address]
+	if !ok {
+		return nil, false, nil
+	}
+	held := map[uint64]bool{}
+	for _, id := range holdings {
+		held[id] = true
+	}
+	var playable []uint64
+	for _, id := range topShots {
+		if held[id] {
+			playable = append(playable, id)
+		}
+	}
+	return playable, true, nil
+}
+
+// testState is a run in fatigue mode of two games, where alice played her
+// common moment 1 and her rare moment 2 in the first
+func testState() *memoryState {
+	return &memoryState{
+		runs: map[string]*fastbreak.Run{"abc-123": {ID: "abc-123", Name: "R0", FatigueModeOn: true}},
+		games: map[string]*fastbreak.Game{
+			"def-456": {ID: "def-456", FastBreakRunID: "abc-123", SubmissionDeadline: 1000, NumPlayers: 3,
+				Submissions: map[uint64]*fastbreak.Submission{1: {PlayerID: 1, TopShots: []uint64{1, 2}}}},
+			"ghi-789": {ID: "ghi-789", FastBreakRunID: "abc-123", SubmissionDeadline: 2000, NumPlayers: 3,
+				Submissions: map[uint64]*fastbreak.Submission{}},
+		},
+		players:  map[string]uint64{alice: 1, bob: 2},
+		holdings: map[string][]uint64{alice: {1, 2, 3, 4}},
+	}
+}
+
+func testValidator(state State, now int64) *Validator {
+	tiers := map[uint64]fastbreak.Tier{1: fastbreak.Common, 2: fastbreak.Rare, 3: fastbreak.Legendary}
+	return &Validator{
+		State: state,
+		Tiers: func(momentID uint64) (fastbreak.Tier, bool) {
+			tier, ok := tiers[momentID]
+			return tier, ok
+		},
+		Now: func() time.Time { return time.Unix(now, 0) },
+	}
+}
+
+func codes(result *Result) []Code {
+	var codes []Code
+	for _, reason := range result.Reasons {
+		codes = append(codes, reason.Code)
+	}
+	return codes
+}
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		now      int64
+		request  Request
+		expected []Code
+	}{
+		{"valid", 100, Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{2, 3}}, nil},
+		{"unknown game", 100, Request{Address: alice, GameID: "xyz", TopShots: []uint64{2}}, []Code{GameNotFound}},
+		{"at the deadline less the buffer", 1940, Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{2}}, []Code{DeadlinePassed}},
+		{"just before the buffer", 1939, Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{2}}, nil},
+		{"already submitted", 100, Request{Address: alice, GameID: "def-456", TopShots: []uint64{3}}, []Code{AlreadySubmitted}},
+		{"no player", 100, Request{Address: "0xf3fcd2c1a78f5eee", GameID: "ghi-789", TopShots: []uint64{3}}, []Code{NoPlayer, NoMomentCollection}},
+		{"no moment collection", 100, Request{Address: bob, GameID: "ghi-789", TopShots: []uint64{3}}, []Code{NoMomentCollection}},
+		{"not owned", 100, Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{3, 9}}, []Code{NotOwned}},
+		{"duplicate", 100, Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{3, 3}}, []Code{DuplicateTopShot}},
+		{"no top shots", 100, Request{Address: alice, GameID: "ghi-789"}, []Code{NoTopShots}},
+		{"too many top shots", 100, Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{2, 3, 4, 3}}, []Code{DuplicateTopShot, TooManyTopShots}},
+		{"fatigued common", 100, Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{1, 3}}, []Code{Fatigued}},
+		{"rare played twice more", 100, Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{2, 2}}, []Code{DuplicateTopShot, Fatigued}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := testValidator(testState(), test.now).Validate(context.Background(), test.request)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, codes(result))
+			assert.Equal(t, len(test.expected) == 0, result.Valid)
+		})
+	}
+}
+
+func TestValidateWithoutFatigue(t *testing.T) {
+	state := testState()
+	state.runs["abc-123"].FatigueModeOn = false
+	result, err := testValidator(state, 100).Validate(context.Background(), Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{1}})
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	assert.Equal(t, uint64(1), result.PlayerID)
+}
+
+func TestReasons(t *testing.T) {
+	result, err := testValidator(testState(), 1950).Validate(context.Background(), Request{Address: alice, GameID: "ghi-789", TopShots: []uint64{1, 9}})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"valid": false,
+		"playerId": 1,
+		"reasons": [
+			{"code": "DEADLINE_PASSED", "message": "Submissions closed 60 seconds before the deadline of 1970-01-01T00:33:20Z", "reverts": true},
+			{"code": "NOT_OWNED", "message": "Top shot 9 is not owned in any collection of the account", "momentId": 9, "reverts": true},
+			{"code": "FATIGUED", "message": "Top shot 1 is COMMON, which can be played in 1 games of run R0, and was played in 1", "momentId": 1, "reverts": false}
+		]
+	}`, string(data))
+}
@@ -0,0 +1,45 @@
+// Package cdctest encodes the JSON-CDC events the tests of the tools read
+package cdctest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Event encodes an event of a contract deployed at 0b2a3299cc857e29 with its encoded fields
+func Event(contract, name string, fields ...string) string {
+	return fmt.Sprintf(`{"type":"Event","value":{"id":"A.0b2a3299cc857e29.%s.%s","fields":[%s]}}`, contract, name, strings.Join(fields, ","))
+}
+
+// Field encodes a field of a simple type, such as UInt64, UFix64 or String
+func Field(name, cadenceType string, value any) string {
+	return FieldOf(name, Value(cadenceType, value))
+}
+
+// FieldOf encodes a field with its encoded value
+func FieldOf(name, value string) string {
+	return fmt.Sprintf(`{"name":"%s","value":%s}`, name, value)
+}
+
+// Value encodes a value of a simple type
+func Value(cadenceType string, value any) string {
+	return fmt.Sprintf(`{"type":"%s","value":"%v"}`, cadenceType, value)
+}
+
+// OptionalAddress encodes an optional address, such as the owner of a Deposit,
+// which is nil when empty
+func OptionalAddress(addr string) string {
+	if addr == "" {
+		return `{"type":"Optional","value":null}`
+	}
+	return fmt.Sprintf(`{"type":"Optional","value":%s}`, Value("Address", addr))
+}
+
+// StringDictionary encodes a {String: String} dictionary of key and value pairs
+func StringDictionary(pairs ...string) string {
+	encoded := make([]string, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		encoded = append(encoded, fmt.Sprintf(`{"key":%s,"value":%s}`, Value("String", pairs[i]), Value("String", pairs[i+1])))
+	}
+	return fmt.Sprintf(`{"type":"Dictionary","value":[%s]}`, strings.Join(encoded, ","))
+}
@@ -0,0 +1,41 @@
+// Package eventfile reads the event files the tools take: JSON-CDC events,
+// or objects wrapping them, one per line in chain order.
+package eventfile
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxLine is the longest line read, an event such as a play with its metadata
+const maxLine = 16 * 1024 * 1024
+
+// Read calls apply with every line that is not blank, trimmed, and returns
+// the first error with the number of its line
+func Read(r io.Reader, apply func(line []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLine)
+	for number := 1; scanner.Scan(); number++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := apply(line); err != nil {
+			return fmt.Errorf("line %d: %w", number, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// NormalizeAddress returns a Flow address as 0x followed by 16 hex digits,
+// or an empty string when it is undefined
+func NormalizeAddress(addr string) string {
+	if addr == "" || addr == "undefined" {
+		return ""
+	}
+	addr = strings.TrimPrefix(strings.ToLower(addr), "0x")
+	return "0x" + strings.Repeat("0", max(0, 16-len(addr))) + addr
+}
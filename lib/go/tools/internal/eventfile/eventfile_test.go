@@ -0,0 +1,40 @@
+package eventfile
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	var lines []string
+	err := Read(strings.NewReader("  a \n\n\tb\nfail\nc"), func(line []byte) error {
+		if string(line) == "fail" {
+			return errors.New("bad event")
+		}
+		lines = append(lines, string(line))
+		return nil
+	})
+	assert.EqualError(t, err, "line 4: bad event")
+	assert.Equal(t, []string{"a", "b"}, lines)
+
+	long := strings.Repeat("x", 1024*1024)
+	assert.NoError(t, Read(strings.NewReader(long), func(line []byte) error {
+		assert.Len(t, line, len(long))
+		return nil
+	}))
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	for addr, expected := range map[string]string{
+		"0b2a3299cc857e29":   "0x0b2a3299cc857e29",
+		"0x0B2A3299CC857E29": "0x0b2a3299cc857e29",
+		"0xa1":               "0x00000000000000a1",
+		"undefined":          "",
+		"":                   "",
+	} {
+		assert.Equal(t, expected, NormalizeAddress(addr), addr)
+	}
+}
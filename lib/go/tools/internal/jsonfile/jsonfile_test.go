@@ -0,0 +1,25 @@
+package jsonfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "journal.json")
+	require.NoError(t, Write(path, map[string]int{"a": 1}))
+	require.NoError(t, Write(path, map[string]int{"b": 2}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"b\": 2\n}\n", string(data))
+
+	_, err = os.Stat(path + ".tmp")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	assert.Error(t, Write(path, func() {}))
+}
@@ -0,0 +1,382 @@
+// Package admin manages the locks of TopShotLocking for support staff: it
+// reads the lock of moments with get_moment_isLocked and
+// get_moment_lockExpiry, and sets the expiry of locks, marks them
+// unlockable or clears them all in batches. Every change is planned first,
+// for the moments it affects and those it skips to be previewed, and every
+// transaction it sends is recorded in an audit log, one JSON object per
+// line, which tools/locking reads as overrides.
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/locking"
+)
+
+// DefaultBatchSize is the number of moments changed by a transaction
+const DefaultBatchSize = 100
+
+// Audit statuses
+const (
+	Sent   = "SENT"
+	Sealed = "SEALED"
+	Failed = "FAILED"
+)
+
+// Moment is a moment in the collection of its owner
+type Moment struct {
+	Owner string `json:"owner"`
+	ID    uint64 `json:"id"`
+}
+
+// MomentLock is the lock of a moment read from the chain
+type MomentLock struct {
+	Moment
+	Locked bool `json:"locked"`
+	// Expiry is the expiry timestamp of the lock in UFix64 units
+	Expiry uint64 `json:"expiry,omitempty"`
+	// Error is why the lock could not be read, e.g. the moment is not in
+	// the collection of the owner
+	Error string `json:"error,omitempty"`
+}
+
+// Skip is a moment a plan leaves out, and why
+type Skip struct {
+	MomentLock
+	Reason string `json:"reason"`
+}
+
+// Plan is an admin action planned on the current locks of its moments
+type Plan struct {
+	Action locking.Action `json:"action"`
+	// Expiry is the expiry timestamp setLockExpiry sets, in UFix64 units
+	Expiry  uint64       `json:"expiry,omitempty"`
+	Moments []MomentLock `json:"moments,omitempty"`
+	Skipped []Skip       `json:"skipped,omitempty"`
+	// Locked is the number of locks unlockAll clears
+	Locked       int           `json:"locked,omitempty"`
+	Transactions []Transaction `json:"-"`
+}
+
+// AuditEntry is an admin transaction, recorded when it is sent and again
+// when it is sealed or failed
+type AuditEntry struct {
+	Time   time.Time      `json:"time"`
+	Admin  string         `json:"admin"`
+	Action locking.Action `json:"action"`
+	Owner  string         `json:"owner,omitempty"`
+	IDs    []uint64       `json:"ids,omitempty"`
+	// Expiry is the UFix64 expiry timestamp setLockExpiry sets
+	Expiry      string `json:"expiryTimestamp,omitempty"`
+	Status      string `json:"status"`
+	TxID        string `json:"txId,omitempty"`
+	BlockHeight uint64 `json:"blockHeight,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// Console plans and applies the admin actions of an account holding a
+// TopShotLocking admin
+type Console struct {
+	Chain Chain
+	Env   templates.Environment
+	// Admin is the account the transactions are signed with, for the audit log
+	Admin string
+	// BatchSize is the number of moments changed by a transaction,
+	// DefaultBatchSize when zero
+	BatchSize int
+	// Audit is where the audit log is appended to
+	Audit io.Writer
+
+	// Now is the clock expiries are checked against, time.Now when nil
+	Now func() time.Time
+}
+
+// Locks reads the lock of each moment. Moments whose lock cannot be read
+// hold the error.
+func (c *Console) Locks(ctx context.Context, moments []Moment) ([]MomentLock, error) {
+	locks := make([]MomentLock, 0, len(moments))
+	for _, moment := range moments {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		lock := MomentLock{Moment: moment}
+		locked, err := c.Chain.IsLocked(ctx, moment.Owner, moment.ID)
+		if err == nil && locked {
+			lock.Locked = true
+			lock.Expiry, err = c.Chain.LockExpiry(ctx, moment.Owner, moment.ID)
+		}
+		if err != nil {
+			lock.Error = err.Error()
+		}
+		locks = append(locks, lock)
+	}
+	return locks, nil
+}
+
+// PlanSetLockExpiry plans setting the expiry of the locks of the moments.
+// setLockExpiryByID locks a moment not locked, so those are skipped.
+func (c *Console) PlanSetLockExpiry(ctx context.Context, moments []Moment, expiry time.Time) (*Plan, error) {
+	if !expiry.After(c.now()) {
+		return nil, fmt.Errorf("expiry %s is not in the future", expiry.UTC().Format(time.RFC3339))
+	}
+	if expiry.Nanosecond()%10 != 0 {
+		return nil, fmt.Errorf("expiry %s is more precise than the 10ns of a UFix64", expiry.UTC().Format(time.RFC3339Nano))
+	}
+	plan := &Plan{Action: locking.SetLockExpiry, Expiry: uint64(expiry.Unix())*1e8 + uint64(expiry.Nanosecond()/10)}
+	if err := c.plan(ctx, plan, moments); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, len(plan.Moments))
+	for i, moment := range plan.Moments {
+		ids[i] = moment.ID
+	}
+	for _, batch := range c.batches(ids) {
+		plan.Transactions = append(plan.Transactions, Transaction{
+			Name:   "admin/set_nfts_lock_expiry",
+			Script: templates.GenerateTopShotLockingAdminSetLockedNFTsExpiryScript(c.Env),
+			Args:   []cadence.Value{uint64Array(batch), cadence.UFix64(plan.Expiry)},
+			IDs:    batch,
+		})
+	}
+	return plan, nil
+}
+
+// PlanMarkUnlockable plans marking the moments unlockable, in a batch per
+// owner. The mark of a moment not locked would make its next lock
+// unlockable at once, so those are skipped.
+func (c *Console) PlanMarkUnlockable(ctx context.Context, moments []Moment) (*Plan, error) {
+	plan := &Plan{Action: locking.MarkUnlockable}
+	if err := c.plan(ctx, plan, moments); err != nil {
+		return nil, err
+	}
+
+	var owners []string
+	byOwner := map[string][]uint64{}
+	for _, moment := range plan.Moments {
+		if _, ok := byOwner[moment.Owner]; !ok {
+			owners = append(owners, moment.Owner)
+		}
+		byOwner[moment.Owner] = append(byOwner[moment.Owner], moment.ID)
+	}
+	for _, owner := range owners {
+		for _, batch := range c.batches(byOwner[owner]) {
+			plan.Transactions = append(plan.Transactions, Transaction{
+				Name:   "admin/batch_mark_moments_unlockable",
+				Script: templates.GenerateAdminBatchMarkMomentsUnlockableScript(c.Env),
+				Args:   []cadence.Value{cadence.NewAddress(flow.HexToAddress(owner)), uint64Array(batch)},
+				Owner:  owner,
+				IDs:    batch,
+			})
+		}
+	}
+	return plan, nil
+}
+
+// PlanUnlockAll plans clearing every lock and unlockable mark
+func (c *Console) PlanUnlockAll(ctx context.Context) (*Plan, error) {
+	locked, err := c.Chain.LockedCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{
+		Action: locking.UnlockAll,
+		Locked: locked,
+		Transactions: []Transaction{{
+			Name:   "admin/unlock_all_moments",
+			Script: templates.GenerateAdminUnlockAllMomentsScript(c.Env),
+		}},
+	}, nil
+}
+
+// plan reads the locks of the moments into the plan, skipping the moments
+// whose lock cannot be read, e.g. listed with another owner, those not
+// locked and those already planned
+func (c *Console) plan(ctx context.Context, plan *Plan, moments []Moment) error {
+	locks, err := c.Locks(ctx, moments)
+	if err != nil {
+		return err
+	}
+	seen := map[uint64]bool{}
+	for _, lock := range locks {
+		switch {
+		case seen[lock.ID]:
+			plan.Skipped = append(plan.Skipped, Skip{MomentLock: lock, Reason: "duplicate"})
+		case lock.Error != "":
+			plan.Skipped = append(plan.Skipped, Skip{MomentLock: lock, Reason: "lock unreadable"})
+		case !lock.Locked:
+			plan.Skipped = append(plan.Skipped, Skip{MomentLock: lock, Reason: "not locked"})
+		default:
+			plan.Moments = append(plan.Moments, lock)
+			seen[lock.ID] = true
+		}
+	}
+	return nil
+}
+
+func (c *Console) batches(ids []uint64) [][]uint64 {
+	size := c.BatchSize
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+	var batches [][]uint64
+	for len(ids) > 0 {
+		n := min(size, len(ids))
+		batches = append(batches, ids[:n])
+		ids = ids[n:]
+	}
+	return batches
+}
+
+// Apply sends the transactions of the plan one after the other, recording
+// each in the audit log when sent and once sealed or failed. It stops at
+// the first transaction that fails; planning again resumes the action, as
+// every action is idempotent.
+func (c *Console) Apply(ctx context.Context, plan *Plan) error {
+	for i, tx := range plan.Transactions {
+		entry := AuditEntry{Admin: c.Admin, Action: plan.Action, Owner: tx.Owner, IDs: tx.IDs}
+		if plan.Action == locking.SetLockExpiry {
+			entry.Expiry = cadence.UFix64(plan.Expiry).String()
+		}
+
+		txID, err := c.Chain.Send(ctx, tx)
+		if err != nil {
+			entry.Status, entry.Error = Failed, err.Error()
+			return errors.Join(fmt.Errorf("transaction %d of %d: %w", i+1, len(plan.Transactions), err), c.audit(entry))
+		}
+		entry.Status, entry.TxID = Sent, txID
+		if err := c.audit(entry); err != nil {
+			return err
+		}
+
+		height, err := c.Chain.Wait(ctx, txID)
+		var failed *TxFailedError
+		switch {
+		case errors.As(err, &failed):
+			entry.Status, entry.Error = Failed, failed.Err.Error()
+		case err != nil:
+			// not known to be sealed nor failed: the SENT entry stands
+			return fmt.Errorf("transaction %d of %d: %w", i+1, len(plan.Transactions), err)
+		default:
+			entry.Status, entry.BlockHeight = Sealed, height
+		}
+		if auditErr := c.audit(entry); auditErr != nil || err != nil {
+			return errors.Join(err, auditErr)
+		}
+	}
+	return nil
+}
+
+func (c *Console) audit(entry AuditEntry) error {
+	entry.Time = c.now().UTC()
+	return json.NewEncoder(c.Audit).Encode(entry)
+}
+
+func (c *Console) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// WritePreview writes the moments the plan affects and skips as a table
+func (p *Plan) WritePreview(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	switch p.Action {
+	case locking.SetLockExpiry:
+		fmt.Fprintf(tw, "setLockExpiry to %s: %d moments in %d transactions\n", formatExpiry(p.Expiry), len(p.Moments), len(p.Transactions))
+	case locking.MarkUnlockable:
+		fmt.Fprintf(tw, "markUnlockable: %d moments in %d transactions\n", len(p.Moments), len(p.Transactions))
+	case locking.UnlockAll:
+		fmt.Fprintf(tw, "unlockAll: clears the locks of %d moments and every unlockable mark\n", p.Locked)
+	}
+	if len(p.Moments) > 0 {
+		fmt.Fprintln(tw, "MOMENT\tOWNER\tEXPIRY\tAFTER")
+		for _, moment := range p.Moments {
+			after := "unlockable"
+			if p.Action == locking.SetLockExpiry {
+				after = formatExpiry(p.Expiry)
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", moment.ID, moment.Owner, formatExpiry(moment.Expiry), after)
+		}
+	}
+	if len(p.Skipped) > 0 {
+		fmt.Fprintln(tw, "SKIPPED\tOWNER\tREASON")
+		for _, skip := range p.Skipped {
+			reason := skip.Reason
+			if skip.Error != "" {
+				reason += ": " + skip.Error
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\n", skip.ID, skip.Owner, reason)
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteLocks writes the locks of moments as a table
+func WriteLocks(w io.Writer, locks []MomentLock) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "MOMENT\tOWNER\tLOCKED\tEXPIRY")
+	for _, lock := range locks {
+		switch {
+		case lock.Error != "":
+			fmt.Fprintf(tw, "%d\t%s\t?\t%s\n", lock.ID, lock.Owner, lock.Error)
+		case lock.Locked:
+			fmt.Fprintf(tw, "%d\t%s\tyes\t%s\n", lock.ID, lock.Owner, formatExpiry(lock.Expiry))
+		default:
+			fmt.Fprintf(tw, "%d\t%s\tno\n", lock.ID, lock.Owner)
+		}
+	}
+	return tw.Flush()
+}
+
+// ReadMoments reads moments, one per line as the address of the owner and
+// the moment id separated by a space or a comma. Empty lines and lines
+// starting with # are skipped.
+func ReadMoments(r io.Reader) ([]Moment, error) {
+	var moments []Moment
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected an owner and a moment id, got %q", number, text)
+		}
+		id, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid moment id %q", number, fields[1])
+		}
+		moments = append(moments, Moment{Owner: flow.HexToAddress(fields[0]).HexWithPrefix(), ID: id})
+	}
+	return moments, scanner.Err()
+}
+
+func uint64Array(ids []uint64) cadence.Array {
+	values := make([]cadence.Value, len(ids))
+	for i, id := range ids {
+		values[i] = cadence.NewUInt64(id)
+	}
+	return cadence.NewArray(values)
+}
+
+// formatExpiry formats a UFix64 expiry timestamp as an RFC 3339 time, with
+// the fraction of a second it has
+func formatExpiry(expiry uint64) string {
+	return time.Unix(int64(expiry/1e8), int64(expiry%1e8)*10).UTC().Format(time.RFC3339Nano)
+}
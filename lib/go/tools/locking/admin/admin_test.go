@@ -0,0 +1,189 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/locking"
+)
+
+const (
+	ownerA = "0x01cf0e2f2f715450"
+	ownerB = "0x179b6b1cb6755e31"
+)
+
+// fakeChain holds the lock expiries of moments by owner, and seals the
+// transactions it is sent unless their name is in fail
+type fakeChain struct {
+	expiries map[Moment]uint64
+	fail     map[string]bool
+	sent     []Transaction
+}
+
+func (c *fakeChain) IsLocked(_ context.Context, owner string, id uint64) (bool, error) {
+	expiry, ok := c.expiries[Moment{Owner: owner, ID: id}]
+	if !ok {
+		return false, fmt.Errorf("moment %d is not in the collection of %s", id, owner)
+	}
+	return expiry > 0, nil
+}
+
+func (c *fakeChain) LockExpiry(_ context.Context, owner string, id uint64) (uint64, error) {
+	return c.expiries[Moment{Owner: owner, ID: id}], nil
+}
+
+func (c *fakeChain) LockedCount(context.Context) (int, error) {
+	count := 0
+	for _, expiry := range c.expiries {
+		if expiry > 0 {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (c *fakeChain) Send(_ context.Context, tx Transaction) (string, error) {
+	c.sent = append(c.sent, tx)
+	return fmt.Sprintf("tx%d", len(c.sent)), nil
+}
+
+func (c *fakeChain) Wait(_ context.Context, txID string) (uint64, error) {
+	if c.fail[c.sent[len(c.sent)-1].Name] {
+		return 0, &TxFailedError{TxID: txID, Err: errors.New("cannot set expiry in the past")}
+	}
+	return uint64(100 + len(c.sent)), nil
+}
+
+func testConsole(chain *fakeChain, audit *bytes.Buffer) *Console {
+	return &Console{
+		Chain:     chain,
+		Env:       templates.Environment{TopShotAddress: "0b2a3299cc857e29", TopShotLockingAddress: "0b2a3299cc857e29"},
+		Admin:     "0x0b2a3299cc857e29",
+		BatchSize: 2,
+		Audit:     audit,
+		Now:       func() time.Time { return time.Unix(1_000, 0) },
+	}
+}
+
+func testChain() *fakeChain {
+	return &fakeChain{expiries: map[Moment]uint64{
+		{Owner: ownerA, ID: 1}: 2_000 * 1e8,
+		{Owner: ownerA, ID: 2}: 3_000 * 1e8,
+		{Owner: ownerA, ID: 3}: 0,
+		{Owner: ownerA, ID: 4}: 4_000 * 1e8,
+		{Owner: ownerB, ID: 5}: 5_000 * 1e8,
+	}}
+}
+
+func TestPlanSetLockExpiry(t *testing.T) {
+	console := testConsole(testChain(), &bytes.Buffer{})
+	moments := []Moment{{ownerA, 1}, {ownerA, 2}, {ownerA, 3}, {ownerB, 4}, {ownerA, 4}, {ownerB, 5}, {ownerA, 1}}
+
+	plan, err := console.PlanSetLockExpiry(context.Background(), moments, time.Unix(9_000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, []MomentLock{
+		{Moment: Moment{ownerA, 1}, Locked: true, Expiry: 2_000 * 1e8},
+		{Moment: Moment{ownerA, 2}, Locked: true, Expiry: 3_000 * 1e8},
+		{Moment: Moment{ownerA, 4}, Locked: true, Expiry: 4_000 * 1e8},
+		{Moment: Moment{ownerB, 5}, Locked: true, Expiry: 5_000 * 1e8},
+	}, plan.Moments)
+	assert.Equal(t, []Skip{
+		{MomentLock: MomentLock{Moment: Moment{ownerA, 3}}, Reason: "not locked"},
+		{MomentLock: MomentLock{Moment: Moment{ownerB, 4}, Error: "moment 4 is not in the collection of " + ownerB}, Reason: "lock unreadable"},
+		{MomentLock: MomentLock{Moment: Moment{ownerA, 1}, Locked: true, Expiry: 2_000 * 1e8}, Reason: "duplicate"},
+	}, plan.Skipped)
+
+	require.Len(t, plan.Transactions, 2)
+	assert.Equal(t, "admin/set_nfts_lock_expiry", plan.Transactions[0].Name)
+	assert.Equal(t, []uint64{1, 2}, plan.Transactions[0].IDs)
+	assert.Equal(t, []cadence.Value{uint64Array([]uint64{4, 5}), cadence.UFix64(9_000 * 1e8)}, plan.Transactions[1].Args)
+
+	var preview bytes.Buffer
+	require.NoError(t, plan.WritePreview(&preview))
+	assert.Contains(t, preview.String(), "setLockExpiry to 1970-01-01T02:30:00Z: 4 moments in 2 transactions")
+	assert.Contains(t, preview.String(), "3        "+ownerA+"  not locked")
+
+	_, err = console.PlanSetLockExpiry(context.Background(), moments, time.Unix(1_000, 0))
+	assert.ErrorContains(t, err, "is not in the future")
+	plan, err = console.PlanSetLockExpiry(context.Background(), moments, time.Unix(9_000, 250_000_000))
+	require.NoError(t, err)
+	assert.Equal(t, cadence.UFix64(9_000*1e8+25_000_000), plan.Transactions[0].Args[1])
+	preview.Reset()
+	require.NoError(t, plan.WritePreview(&preview))
+	assert.Contains(t, preview.String(), "setLockExpiry to 1970-01-01T02:30:00.25Z")
+
+	_, err = console.PlanSetLockExpiry(context.Background(), moments, time.Unix(9_000, 5))
+	assert.ErrorContains(t, err, "more precise than the 10ns of a UFix64")
+}
+
+func TestPlanMarkUnlockable(t *testing.T) {
+	console := testConsole(testChain(), &bytes.Buffer{})
+	plan, err := console.PlanMarkUnlockable(context.Background(), []Moment{{ownerB, 5}, {ownerA, 1}, {ownerA, 2}, {ownerA, 4}})
+	require.NoError(t, err)
+
+	require.Len(t, plan.Transactions, 3)
+	for i, expected := range []Transaction{
+		{Owner: ownerB, IDs: []uint64{5}},
+		{Owner: ownerA, IDs: []uint64{1, 2}},
+		{Owner: ownerA, IDs: []uint64{4}},
+	} {
+		tx := plan.Transactions[i]
+		assert.Equal(t, "admin/batch_mark_moments_unlockable", tx.Name)
+		assert.Equal(t, templates.GenerateAdminBatchMarkMomentsUnlockableScript(console.Env), tx.Script)
+		assert.Equal(t, expected.Owner, tx.Owner)
+		assert.Equal(t, expected.IDs, tx.IDs)
+		assert.Equal(t, uint64Array(expected.IDs), tx.Args[1])
+	}
+}
+
+func TestApply(t *testing.T) {
+	chain := testChain()
+	var audit bytes.Buffer
+	console := testConsole(chain, &audit)
+
+	plan, err := console.PlanMarkUnlockable(context.Background(), []Moment{{ownerA, 1}, {ownerB, 5}})
+	require.NoError(t, err)
+	require.NoError(t, console.Apply(context.Background(), plan))
+
+	plan, err = console.PlanUnlockAll(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 4, plan.Locked)
+	chain.fail = map[string]bool{"admin/unlock_all_moments": true}
+	assert.ErrorContains(t, console.Apply(context.Background(), plan), "cannot set expiry in the past")
+
+	assert.Equal(t, strings.Join([]string{
+		`{"time":"1970-01-01T00:16:40Z","admin":"0x0b2a3299cc857e29","action":"markUnlockable","owner":"0x01cf0e2f2f715450","ids":[1],"status":"SENT","txId":"tx1"}`,
+		`{"time":"1970-01-01T00:16:40Z","admin":"0x0b2a3299cc857e29","action":"markUnlockable","owner":"0x01cf0e2f2f715450","ids":[1],"status":"SEALED","txId":"tx1","blockHeight":101}`,
+		`{"time":"1970-01-01T00:16:40Z","admin":"0x0b2a3299cc857e29","action":"markUnlockable","owner":"0x179b6b1cb6755e31","ids":[5],"status":"SENT","txId":"tx2"}`,
+		`{"time":"1970-01-01T00:16:40Z","admin":"0x0b2a3299cc857e29","action":"markUnlockable","owner":"0x179b6b1cb6755e31","ids":[5],"status":"SEALED","txId":"tx2","blockHeight":102}`,
+		`{"time":"1970-01-01T00:16:40Z","admin":"0x0b2a3299cc857e29","action":"unlockAll","status":"SENT","txId":"tx3"}`,
+		`{"time":"1970-01-01T00:16:40Z","admin":"0x0b2a3299cc857e29","action":"unlockAll","status":"FAILED","txId":"tx3","error":"cannot set expiry in the past"}`,
+	}, "\n")+"\n", audit.String())
+
+	// the audit log is read back as the overrides of the sealed transactions
+	overrides, err := locking.ReadOverrides(&audit)
+	require.NoError(t, err)
+	assert.Equal(t, []locking.Override{
+		{Action: locking.MarkUnlockable, IDs: []uint64{1}, BlockHeight: 101},
+		{Action: locking.MarkUnlockable, IDs: []uint64{5}, BlockHeight: 102},
+	}, overrides)
+}
+
+func TestReadMoments(t *testing.T) {
+	moments, err := ReadMoments(strings.NewReader("# owner, moment\n01cf0e2f2f715450,1\n\n0x179b6b1cb6755e31 5\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []Moment{{ownerA, 1}, {ownerB, 5}}, moments)
+
+	_, err = ReadMoments(strings.NewReader("0x01cf0e2f2f715450"))
+	assert.ErrorContains(t, err, "line 1: expected an owner and a moment id")
+}
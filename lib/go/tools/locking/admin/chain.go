@@ -0,0 +1,184 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/onflow/flow-go-sdk/crypto"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+)
+
+// Transaction is an admin transaction of the templates package
+type Transaction struct {
+	// Name is the name of the template in templates.Catalog
+	Name   string
+	Script []byte
+	Args   []cadence.Value
+	// Owner and IDs are the moments of the transaction, for the audit log
+	Owner string
+	IDs   []uint64
+}
+
+// Chain reads the locks of moments and sends the admin transactions
+type Chain interface {
+	// IsLocked reads whether the moment in the collection of the owner is locked
+	IsLocked(ctx context.Context, owner string, id uint64) (bool, error)
+	// LockExpiry reads the expiry timestamp of the lock of the moment, in UFix64 units
+	LockExpiry(ctx context.Context, owner string, id uint64) (uint64, error)
+	// LockedCount reads the number of locked moments
+	LockedCount(ctx context.Context) (int, error)
+	// Send signs and sends the transaction and returns its id
+	Send(ctx context.Context, tx Transaction) (string, error)
+	// Wait waits for the transaction to be sealed and returns the height of
+	// its block. It returns a *TxFailedError when the transaction failed,
+	// and other errors when it could not tell.
+	Wait(ctx context.Context, txID string) (uint64, error)
+}
+
+// TxFailedError is a transaction that was sealed with an error
+type TxFailedError struct {
+	TxID string
+	Err  error
+}
+
+func (e *TxFailedError) Error() string {
+	return fmt.Sprintf("transaction %s failed: %v", e.TxID, e.Err)
+}
+
+func (e *TxFailedError) Unwrap() error {
+	return e.Err
+}
+
+// FlowChain is the Chain of an access node, signing with a key of an
+// account holding a TopShotLocking admin
+type FlowChain struct {
+	Client   *grpc.Client
+	Env      templates.Environment
+	Admin    flow.Address
+	KeyIndex uint32
+	Signer   crypto.Signer
+	// ComputeLimit of the transactions, 9999 when zero
+	ComputeLimit uint64
+	// PollInterval between the status checks of Wait, a second when zero
+	PollInterval time.Duration
+}
+
+// IsLocked reads whether the moment is locked with get_moment_isLocked
+func (c *FlowChain) IsLocked(ctx context.Context, owner string, id uint64) (bool, error) {
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetMomentIsLockedScript(c.Env), []cadence.Value{
+		cadence.NewAddress(flow.HexToAddress(owner)),
+		cadence.NewUInt64(id),
+	})
+	if err != nil {
+		return false, fmt.Errorf("get_moment_isLocked %d: %w", id, err)
+	}
+	locked, ok := result.(cadence.Bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected lock %s of moment %d", result, id)
+	}
+	return bool(locked), nil
+}
+
+// LockExpiry reads the expiry of the lock of the moment with get_moment_lockExpiry
+func (c *FlowChain) LockExpiry(ctx context.Context, owner string, id uint64) (uint64, error) {
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetMomentLockExpiryScript(c.Env), []cadence.Value{
+		cadence.NewAddress(flow.HexToAddress(owner)),
+		cadence.NewUInt64(id),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get_moment_lockExpiry %d: %w", id, err)
+	}
+	expiry, ok := result.(cadence.UFix64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected lock expiry %s of moment %d", result, id)
+	}
+	return uint64(expiry), nil
+}
+
+// LockedCount reads the number of locked moments with get_locked_nfts_length
+func (c *FlowChain) LockedCount(ctx context.Context) (int, error) {
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetLockedNFTsLengthScript(c.Env), nil)
+	if err != nil {
+		return 0, fmt.Errorf("get_locked_nfts_length: %w", err)
+	}
+	count, ok := result.(cadence.Int)
+	if !ok {
+		return 0, fmt.Errorf("unexpected locked count %s", result)
+	}
+	return count.Int(), nil
+}
+
+// Send signs the transaction as proposer, payer and authorizer with the admin key
+func (c *FlowChain) Send(ctx context.Context, tx Transaction) (string, error) {
+	account, err := c.Client.GetAccount(ctx, c.Admin)
+	if err != nil {
+		return "", err
+	}
+	if int(c.KeyIndex) >= len(account.Keys) {
+		return "", fmt.Errorf("admin account %s has no key %d", c.Admin, c.KeyIndex)
+	}
+	block, err := c.Client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return "", err
+	}
+
+	computeLimit := c.ComputeLimit
+	if computeLimit == 0 {
+		computeLimit = 9999
+	}
+	flowTx := flow.NewTransaction().
+		SetScript(tx.Script).
+		SetComputeLimit(computeLimit).
+		SetReferenceBlockID(block.ID).
+		SetProposalKey(c.Admin, c.KeyIndex, account.Keys[c.KeyIndex].SequenceNumber).
+		SetPayer(c.Admin).
+		AddAuthorizer(c.Admin)
+	for _, arg := range tx.Args {
+		if err := flowTx.AddArgument(arg); err != nil {
+			return "", fmt.Errorf("%s: %w", tx.Name, err)
+		}
+	}
+	if err := flowTx.SignEnvelope(c.Admin, c.KeyIndex, c.Signer); err != nil {
+		return "", err
+	}
+
+	if err := c.Client.SendTransaction(ctx, *flowTx); err != nil {
+		return "", fmt.Errorf("%s: %w", tx.Name, err)
+	}
+	return flowTx.ID().String(), nil
+}
+
+// Wait polls the result of the transaction until it is sealed or expired
+func (c *FlowChain) Wait(ctx context.Context, txID string) (uint64, error) {
+	interval := c.PollInterval
+	if interval == 0 {
+		interval = time.Second
+	}
+	for {
+		result, err := c.Client.GetTransactionResult(ctx, flow.HexToID(txID))
+		if err != nil {
+			return 0, err
+		}
+		switch result.Status {
+		case flow.TransactionStatusSealed:
+			if result.Error != nil {
+				return 0, &TxFailedError{TxID: txID, Err: result.Error}
+			}
+			return result.BlockHeight, nil
+		case flow.TransactionStatusExpired:
+			return 0, &TxFailedError{TxID: txID, Err: errors.New("expired")}
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// Package locking indexes the locks of TopShotLocking by the time their
+// moments become unlockable, from MomentLocked and MomentUnlocked events,
+// the deposits, withdrawals and destructions of TopShot that give their
+// owners, and the admin overrides no event records.
+//
+// setLockExpiryByID and unlockByID emit MomentLocked and MomentUnlocked,
+// but markNFTUnlockable and unlockAll emit nothing, so they are read as
+// overrides of the block height of their transaction:
+//
+//	{"action": "markUnlockable", "ids": [42, 43], "blockHeight": 72000000}
+//	{"action": "unlockAll", "blockHeight": 72000100}
+//
+// The lines of an events file are bare JSON-CDC events or objects of an
+// event and the height of its block, which orders it with the overrides:
+//
+//	{"blockHeight": 72000000, "blockTimestamp": "2024-03-01T12:00:00Z", "event": {"type": "Event", ...}}
+package locking
+
+import (
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events/decoder"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/eventfile"
+)
+
+// Action is an admin action of TopShotLocking
+type Action string
+
+const (
+	// MarkUnlockable lets the owners of the moments unlock them before their expiry
+	MarkUnlockable Action = "markUnlockable"
+	// UnlockAll clears every lock and unlockable mark
+	UnlockAll Action = "unlockAll"
+	// SetLockExpiry sets the expiry of the locks of the moments, locking
+	// those not locked. It emits MomentLocked and is read from the events.
+	SetLockExpiry Action = "setLockExpiry"
+)
+
+// Override is an admin override sealed in a block
+type Override struct {
+	Action      Action   `json:"action"`
+	IDs         []uint64 `json:"ids,omitempty"`
+	BlockHeight uint64   `json:"blockHeight"`
+}
+
+// Lock is a locked moment
+type Lock struct {
+	MomentID uint64
+	// Owner is the account whose collection holds the moment, empty when
+	// its deposit is not in the events
+	Owner string
+	// Expiry is the expiry timestamp of the lock in UFix64 units
+	Expiry uint64
+	// Unlockable is whether an admin marked the moment unlockable before its expiry
+	Unlockable bool
+
+	// index is the position of the lock in the queue, -1 once it is due
+	index int
+}
+
+// ExpiresAt returns the time the lock expires
+func (l *Lock) ExpiresAt() time.Time {
+	return ufix64Time(l.Expiry)
+}
+
+// dueAt is the time the moment becomes unlockable: at once when marked so
+func (l *Lock) dueAt() uint64 {
+	if l.Unlockable {
+		return 0
+	}
+	return l.Expiry
+}
+
+// Index is the locks of TopShotLocking, queued by the time their moments
+// become unlockable
+type Index struct {
+	locks      map[uint64]*Lock
+	owners     map[uint64]string
+	unlockable map[uint64]bool
+	queue      queue
+	// released are the locks cleared by unlockAll, due at once
+	released []*Lock
+}
+
+func New() *Index {
+	return &Index{
+		locks:      map[uint64]*Lock{},
+		owners:     map[uint64]string{},
+		unlockable: map[uint64]bool{},
+	}
+}
+
+// Apply folds a JSON-CDC or CCF encoded event into the index, ignoring the
+// events of other contracts
+func (x *Index) Apply(payload []byte) error {
+	event, err := decoder.GetCadenceEvent(payload)
+	if err != nil {
+		return err
+	}
+
+	switch event.EventType.QualifiedIdentifier {
+	case events.TopShotEventDeposit:
+		deposit, err := events.DecodeDepositEvent(payload)
+		if err != nil {
+			return err
+		}
+		owner := eventfile.NormalizeAddress(deposit.To())
+		x.owners[deposit.Id()] = owner
+		if lock, ok := x.locks[deposit.Id()]; ok {
+			lock.Owner = owner
+		}
+	case events.EventWithdraw:
+		withdraw, err := events.DecodeWithdrawEvent(payload)
+		if err != nil {
+			return err
+		}
+		delete(x.owners, withdraw.Id())
+	case events.EventMomentDestroyed, events.EventMomentDestroyedV2:
+		destroyed, err := events.DecodeMomentDestroyedEvent(payload)
+		if err != nil {
+			return err
+		}
+		delete(x.owners, destroyed.Id())
+		x.remove(destroyed.Id())
+	case events.MomentLocked:
+		locked, err := events.DecodeMomentLockedEvent(payload)
+		if err != nil {
+			return err
+		}
+		x.lock(locked.FlowID(), locked.ExpiryTimestamp())
+	case events.MomentUnlocked:
+		unlocked, err := events.DecodeMomentUnlockedEvent(payload)
+		if err != nil {
+			return err
+		}
+		// unlockNFT clears the mark, unlockByID keeps it: the events do not
+		// tell them apart, and owners unlock far more often than admins
+		delete(x.unlockable, unlocked.FlowID())
+		x.remove(unlocked.FlowID())
+	}
+	return nil
+}
+
+// Override applies an admin override to the index
+func (x *Index) Override(o Override) error {
+	switch o.Action {
+	case MarkUnlockable:
+		for _, id := range o.IDs {
+			// the mark outlives the lock, and makes a later lock unlockable at once
+			x.unlockable[id] = true
+			// a lock no longer queued is unlockable already
+			if lock, ok := x.locks[id]; ok && lock.index >= 0 {
+				lock.Unlockable = true
+				heap.Fix(&x.queue, lock.index)
+			}
+		}
+	case UnlockAll:
+		for _, id := range x.Locks() {
+			x.released = append(x.released, x.locks[id])
+			x.remove(id)
+		}
+		x.unlockable = map[uint64]bool{}
+	default:
+		return fmt.Errorf("unknown override %q", o.Action)
+	}
+	return nil
+}
+
+func (x *Index) lock(id, expiry uint64) {
+	lock, ok := x.locks[id]
+	if !ok {
+		lock = &Lock{MomentID: id, Owner: x.owners[id], index: -1}
+		x.locks[id] = lock
+	}
+	lock.Expiry, lock.Unlockable = expiry, x.unlockable[id]
+	x.requeue(lock)
+}
+
+// requeue queues the lock again at the time it is due, e.g. once its
+// expiry is set again by setLockExpiryByID
+func (x *Index) requeue(lock *Lock) {
+	if lock.index >= 0 {
+		heap.Fix(&x.queue, lock.index)
+		return
+	}
+	heap.Push(&x.queue, lock)
+}
+
+func (x *Index) remove(id uint64) {
+	lock, ok := x.locks[id]
+	if !ok {
+		return
+	}
+	if lock.index >= 0 {
+		heap.Remove(&x.queue, lock.index)
+	}
+	delete(x.locks, id)
+}
+
+// Lock returns the lock of the moment
+func (x *Index) Lock(id uint64) (*Lock, bool) {
+	lock, ok := x.locks[id]
+	return lock, ok
+}
+
+// Locks returns the ids of the locked moments, in ascending order
+func (x *Index) Locks() []uint64 {
+	ids := make([]uint64, 0, len(x.locks))
+	for id := range x.locks {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Next returns the lock queued to become unlockable first
+func (x *Index) Next() (*Lock, bool) {
+	if len(x.queue) == 0 {
+		return nil, false
+	}
+	return x.queue[0], true
+}
+
+// Due takes the notifications of the locks released by unlockAll and of
+// the locks that became unlockable by the time, in the order they did.
+// The locks stay in the index, as the moments stay locked until their
+// owners unlock them, but are not due again unless locked again.
+func (x *Index) Due(now time.Time) []Notification {
+	var due []Notification
+	for _, lock := range x.released {
+		due = append(due, notification(lock, UnlockedAll))
+	}
+	x.released = nil
+
+	for len(x.queue) > 0 && !ufix64Time(x.queue[0].dueAt()).After(now) {
+		lock := heap.Pop(&x.queue).(*Lock)
+		reason := Expired
+		if lock.Unlockable {
+			reason = MarkedUnlockable
+		}
+		due = append(due, notification(lock, reason))
+	}
+	return due
+}
+
+// line is a line of an events file holding the height of the block of its event
+type line struct {
+	BlockHeight uint64          `json:"blockHeight"`
+	Event       json.RawMessage `json:"event"`
+}
+
+// Read folds the events of an event file into the index with the
+// overrides, each applied before the first event of a greater block height.
+// The overrides left once the events are read, and every override when the
+// events have no block height, are applied last.
+func (x *Index) Read(r io.Reader, overrides []Override) error {
+	overrides = append([]Override(nil), overrides...)
+	sort.SliceStable(overrides, func(i, j int) bool { return overrides[i].BlockHeight < overrides[j].BlockHeight })
+
+	err := eventfile.Read(r, func(payload []byte) error {
+		var envelope line
+		if err := json.Unmarshal(payload, &envelope); err == nil && len(envelope.Event) > 0 {
+			payload = envelope.Event
+			for len(overrides) > 0 && overrides[0].BlockHeight < envelope.BlockHeight {
+				if err := x.Override(overrides[0]); err != nil {
+					return err
+				}
+				overrides = overrides[1:]
+			}
+		}
+		return x.Apply(payload)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, o := range overrides {
+		if err := x.Override(o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadEvents builds an index from an event file and the overrides
+func ReadEvents(r io.Reader, overrides []Override) (*Index, error) {
+	x := New()
+	if err := x.Read(r, overrides); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// ReadOverrides reads overrides, one JSON object per line, such as the
+// audit log of tools/locking/admin. Lines of other actions, and lines with
+// an error or a status other than SEALED, of transactions that failed or
+// are not sealed yet, are skipped.
+func ReadOverrides(r io.Reader) ([]Override, error) {
+	var overrides []Override
+	err := eventfile.Read(r, func(text []byte) error {
+		var o struct {
+			Override
+			Status string `json:"status"`
+			Error  string `json:"error"`
+		}
+		if err := json.Unmarshal(text, &o); err != nil {
+			return err
+		}
+		if o.Error != "" || (o.Status != "" && o.Status != "SEALED") || (o.Action != MarkUnlockable && o.Action != UnlockAll) {
+			return nil
+		}
+		overrides = append(overrides, o.Override)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// queue is a min-heap of locks by the time they are due, then moment id
+type queue []*Lock
+
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool {
+	if q[i].dueAt() != q[j].dueAt() {
+		return q[i].dueAt() < q[j].dueAt()
+	}
+	return q[i].MomentID < q[j].MomentID
+}
+
+func (q queue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index, q[j].index = i, j
+}
+
+func (q *queue) Push(x any) {
+	lock := x.(*Lock)
+	lock.index = len(*q)
+	*q = append(*q, lock)
+}
+
+func (q *queue) Pop() any {
+	old := *q
+	lock := old[len(old)-1]
+	old[len(old)-1] = nil
+	lock.index = -1
+	*q = old[:len(old)-1]
+	return lock
+}
+
+// ufix64Time returns the time of a UFix64 unix timestamp
+func ufix64Time(timestamp uint64) time.Time {
+	return time.Unix(int64(timestamp/1e8), int64(timestamp%1e8)*10).UTC()
+}
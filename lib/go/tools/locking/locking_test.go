@@ -0,0 +1,245 @@
+package locking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/cdctest"
+)
+
+const (
+	ownerA = "0x01cf0e2f2f715450"
+	ownerB = "0x179b6b1cb6755e31"
+)
+
+func at(height uint64, event string) string {
+	return fmt.Sprintf(`{"blockHeight":%d,"event":%s}`, height, event)
+}
+
+func deposited(id uint64, to string) string {
+	return cdctest.Event("TopShot", "Deposit", cdctest.Field("id", "UInt64", id), cdctest.FieldOf("to", cdctest.OptionalAddress(to)))
+}
+
+func locked(id uint64, expiry int64) string {
+	return cdctest.Event("TopShotLocking", "MomentLocked", cdctest.Field("id", "UInt64", id), cdctest.Field("duration", "UFix64", "1.00000000"), cdctest.Field("expiryTimestamp", "UFix64", fmt.Sprintf("%d.00000000", expiry)))
+}
+
+func unlocked(id uint64) string {
+	return cdctest.Event("TopShotLocking", "MomentUnlocked", cdctest.Field("id", "UInt64", id))
+}
+
+func unix(seconds int64) time.Time {
+	return time.Unix(seconds, 0).UTC()
+}
+
+// testEvents locks moments 1, 2 and 3 of owner A and 4 of B, expiring at
+// 1000, 3000, 2000 and 5000, then extends the lock of 2 to 4000 at height
+// 20, unlocks 3 and locks 5 of B at height 30
+func testEvents() string {
+	return strings.Join([]string{
+		at(10, deposited(1, ownerA)),
+		at(10, deposited(2, ownerA)),
+		at(10, deposited(3, ownerA)),
+		at(10, deposited(4, ownerB)),
+		at(10, deposited(5, ownerB)),
+		at(11, locked(1, 1000)),
+		at(11, locked(2, 3000)),
+		at(11, locked(3, 2000)),
+		at(11, locked(4, 5000)),
+		at(20, locked(2, 4000)),
+		at(21, unlocked(3)),
+		at(30, locked(5, 6000)),
+	}, "\n")
+}
+
+func TestDue(t *testing.T) {
+	index, err := ReadEvents(strings.NewReader(testEvents()), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 4, 5}, index.Locks())
+
+	next, ok := index.Next()
+	require.True(t, ok)
+	assert.Equal(t, uint64(1), next.MomentID)
+
+	assert.Empty(t, index.Due(unix(999)))
+	assert.Equal(t, []Notification{
+		{MomentID: 1, Owner: ownerA, Reason: Expired, Expiry: unix(1000)},
+		{MomentID: 2, Owner: ownerA, Reason: Expired, Expiry: unix(4000)},
+	}, index.Due(unix(4000)))
+	assert.Empty(t, index.Due(unix(4000)))
+
+	// the moments stay locked until their owners unlock them
+	lock, ok := index.Lock(1)
+	require.True(t, ok)
+	assert.Equal(t, uint64(1000*1e8), lock.Expiry)
+}
+
+func TestOverrides(t *testing.T) {
+	overrides := []Override{
+		// marked before moment 5 is locked, which is then unlockable at
+		// once unless unlockAll clears the mark first
+		{Action: MarkUnlockable, IDs: []uint64{4, 5}, BlockHeight: 15},
+		{Action: UnlockAll, BlockHeight: 25},
+	}
+	index, err := ReadEvents(strings.NewReader(testEvents()), overrides)
+	require.NoError(t, err)
+
+	assert.Equal(t, []uint64{5}, index.Locks())
+	assert.Equal(t, []Notification{
+		{MomentID: 1, Owner: ownerA, Reason: UnlockedAll, Expiry: unix(1000)},
+		{MomentID: 2, Owner: ownerA, Reason: UnlockedAll, Expiry: unix(4000)},
+		{MomentID: 4, Owner: ownerB, Reason: UnlockedAll, Expiry: unix(5000)},
+	}, index.Due(unix(0)))
+
+	index, err = ReadEvents(strings.NewReader(testEvents()), overrides[:1])
+	require.NoError(t, err)
+	assert.Equal(t, []Notification{
+		{MomentID: 4, Owner: ownerB, Reason: MarkedUnlockable, Expiry: unix(5000)},
+		{MomentID: 5, Owner: ownerB, Reason: MarkedUnlockable, Expiry: unix(6000)},
+	}, index.Due(unix(0)))
+
+	_, err = ReadEvents(strings.NewReader(testEvents()), []Override{{Action: "unlockByID"}})
+	assert.ErrorContains(t, err, `unknown override "unlockByID"`)
+}
+
+func TestReadOverrides(t *testing.T) {
+	overrides, err := ReadOverrides(strings.NewReader(strings.Join([]string{
+		`{"action":"markUnlockable","ids":[4,5],"blockHeight":15}`,
+		`{"action":"setLockExpiry","ids":[4],"blockHeight":16}`,
+		`{"action":"unlockAll","blockHeight":17,"error":"transaction reverted"}`,
+		`{"action":"unlockAll","status":"SENT","txId":"a1"}`,
+		`{"action":"unlockAll","status":"SEALED","txId":"a1","blockHeight":18}`,
+		``,
+		`{"action":"unlockAll","blockHeight":25}`,
+	}, "\n")))
+	require.NoError(t, err)
+	assert.Equal(t, []Override{
+		{Action: MarkUnlockable, IDs: []uint64{4, 5}, BlockHeight: 15},
+		{Action: UnlockAll, BlockHeight: 18},
+		{Action: UnlockAll, BlockHeight: 25},
+	}, overrides)
+}
+
+func TestUnlockBatches(t *testing.T) {
+	notifications := []Notification{
+		{MomentID: 3, Owner: ownerA, Reason: Expired},
+		{MomentID: 1, Owner: ownerA, Reason: MarkedUnlockable},
+		{MomentID: 2, Owner: ownerA, Reason: Expired},
+		{MomentID: 6, Owner: ownerA, Reason: UnlockedAll},
+		{MomentID: 4, Owner: ownerB, Reason: Expired},
+	}
+	assert.Equal(t, []UnlockBatch{
+		{Owner: ownerA, MomentIDs: []uint64{1, 2}},
+		{Owner: ownerA, MomentIDs: []uint64{3}},
+	}, UnlockBatches(notifications, map[string]bool{ownerA: true}, 2))
+
+	env := templates.Environment{NFTAddress: "1d7e57aa55817448", TopShotAddress: "0b2a3299cc857e29"}
+	tx, err := UnlockBatch{Owner: ownerA, MomentIDs: []uint64{1, 2}}.Transaction(env)
+	require.NoError(t, err)
+	assert.Equal(t, templates.GenerateBatchUnlockMomentScript(env), tx.Script)
+	assert.Equal(t, []flow.Address{flow.HexToAddress(ownerA)}, tx.Authorizers)
+	require.Len(t, tx.Arguments, 1)
+	assert.JSONEq(t, `{"type":"Array","value":[{"type":"UInt64","value":"1"},{"type":"UInt64","value":"2"}]}`, string(tx.Arguments[0]))
+}
+
+func TestSinks(t *testing.T) {
+	notifications := []Notification{{MomentID: 1, Owner: ownerA, Reason: Expired, Expiry: unix(1000)}}
+
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+	require.NoError(t, FileSink{Path: path}.Notify(context.Background(), notifications))
+	require.NoError(t, FileSink{Path: path}.Notify(context.Background(), notifications))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	line := `{"momentId":1,"owner":"0x01cf0e2f2f715450","reason":"EXPIRED","expiry":"1970-01-01T00:16:40Z"}` + "\n"
+	assert.Equal(t, line+line, string(data))
+
+	var received []Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+	require.NoError(t, WebhookSink{URL: server.URL}.Notify(context.Background(), notifications))
+	assert.Equal(t, notifications, received)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	assert.ErrorContains(t, WebhookSink{URL: failing.URL}.Notify(context.Background(), notifications), "503")
+}
+
+// failingSink fails the first delivery, then records the deliveries
+type failingSink struct {
+	failed    bool
+	delivered [][]Notification
+}
+
+func (s *failingSink) Notify(_ context.Context, notifications []Notification) error {
+	if !s.failed {
+		s.failed = true
+		return fmt.Errorf("unreachable")
+	}
+	s.delivered = append(s.delivered, notifications)
+	return nil
+}
+
+func TestScheduler(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	sink := &failingSink{}
+	var batches []UnlockBatch
+	now := unix(1000)
+	scheduler := func() *Scheduler {
+		state, err := LoadState(statePath)
+		require.NoError(t, err)
+		return &Scheduler{
+			Index: func() (*Index, error) { return ReadEvents(strings.NewReader(testEvents()), nil) },
+			Sink:  sink,
+			Unlocks: func(b []UnlockBatch) error {
+				batches = append(batches, b...)
+				return nil
+			},
+			OptIn:     map[string]bool{ownerA: true},
+			State:     state,
+			StatePath: statePath,
+			Now:       func() time.Time { return now },
+			Log:       log.New(io.Discard, "", 0),
+		}
+	}
+
+	s := scheduler()
+	assert.ErrorContains(t, s.Step(context.Background()), "unreachable")
+	require.NoError(t, s.Step(context.Background()))
+	require.NoError(t, s.Step(context.Background()))
+
+	// a restarted scheduler delivers only what became due since
+	now = unix(5000)
+	require.NoError(t, scheduler().Step(context.Background()))
+
+	assert.Equal(t, [][]Notification{
+		{{MomentID: 1, Owner: ownerA, Reason: Expired, Expiry: unix(1000)}},
+		{
+			{MomentID: 2, Owner: ownerA, Reason: Expired, Expiry: unix(4000)},
+			{MomentID: 4, Owner: ownerB, Reason: Expired, Expiry: unix(5000)},
+		},
+	}, sink.delivered)
+	assert.Equal(t, []UnlockBatch{
+		{Owner: ownerA, MomentIDs: []uint64{1}},
+		{Owner: ownerA, MomentIDs: []uint64{2}},
+	}, batches)
+}
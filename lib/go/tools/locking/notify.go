@@ -0,0 +1,114 @@
+package locking
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Reason is why a moment can be unlocked
+type Reason string
+
+const (
+	// Expired is a lock past its expiry, which the owner can unlock
+	Expired Reason = "EXPIRED"
+	// MarkedUnlockable is a lock an admin marked unlockable, which the owner
+	// can unlock before its expiry
+	MarkedUnlockable Reason = "MARKED_UNLOCKABLE"
+	// UnlockedAll is a lock cleared by unlockAll, with nothing left to unlock
+	UnlockedAll Reason = "UNLOCKED_ALL"
+)
+
+// Notification is a moment that became unlockable
+type Notification struct {
+	MomentID uint64    `json:"momentId"`
+	Owner    string    `json:"owner,omitempty"`
+	Reason   Reason    `json:"reason"`
+	Expiry   time.Time `json:"expiry"`
+}
+
+func notification(lock *Lock, reason Reason) Notification {
+	return Notification{MomentID: lock.MomentID, Owner: lock.Owner, Reason: reason, Expiry: lock.ExpiresAt()}
+}
+
+// Key identifies the notification of a lock, which a moment locked again
+// is notified anew under
+func (n Notification) Key() string {
+	return fmt.Sprintf("%d/%d/%s", n.MomentID, n.Expiry.UnixNano(), n.Reason)
+}
+
+// Sink delivers notifications
+type Sink interface {
+	Notify(ctx context.Context, notifications []Notification) error
+}
+
+// WriterSink writes each notification as a line of JSON, e.g. to os.Stdout
+type WriterSink struct {
+	W io.Writer
+}
+
+func (s WriterSink) Notify(_ context.Context, notifications []Notification) error {
+	encoder := json.NewEncoder(s.W)
+	for _, n := range notifications {
+		if err := encoder.Encode(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FileSink appends each notification to a file as a line of JSON
+type FileSink struct {
+	Path string
+}
+
+func (s FileSink) Notify(ctx context.Context, notifications []Notification) error {
+	file, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := (WriterSink{W: file}).Notify(ctx, notifications); err != nil {
+		file.Close()
+		return fmt.Errorf("%s: %w", s.Path, err)
+	}
+	return file.Close()
+}
+
+// WebhookSink posts the notifications as a JSON array to a URL, e.g. a
+// service listening on localhost
+type WebhookSink struct {
+	URL string
+	// Client posts the notifications, http.DefaultClient when nil
+	Client *http.Client
+}
+
+func (s WebhookSink) Notify(ctx context.Context, notifications []Notification) error {
+	body, err := json.Marshal(notifications)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook %s responded %s", s.URL, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package locking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"time"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/jsonfile"
+)
+
+// State records the notifications delivered, so that a restarted
+// scheduler does not deliver them again
+type State struct {
+	Notified map[string]time.Time `json:"notified"`
+}
+
+// LoadState reads the state, which is new when the file does not exist
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &State{Notified: map[string]time.Time{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("invalid state %s: %w", path, err)
+	}
+	if state.Notified == nil {
+		state.Notified = map[string]time.Time{}
+	}
+	return state, nil
+}
+
+// Save writes the state, replacing the file only once it is fully written
+func (s *State) Save(path string) error {
+	return jsonfile.Write(path, s)
+}
+
+// Scheduler notifies the moments that become unlockable, and batches the
+// unlock transactions of the owners who opted in
+type Scheduler struct {
+	// Index returns the index of the events and overrides read so far
+	Index func() (*Index, error)
+	Sink  Sink
+
+	// Unlocks receives the unlock batches of the owners in OptIn, of at
+	// most BatchSize moments, when it is not nil
+	Unlocks   func(batches []UnlockBatch) error
+	OptIn     map[string]bool
+	BatchSize int
+
+	State     *State
+	StatePath string
+
+	// Now is the clock the expiries are compared to, time.Now when nil
+	Now func() time.Time
+	Log *log.Logger
+}
+
+// Run steps the scheduler at the interval until the context is cancelled.
+// Errors are logged and the step retried at the next interval.
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Step(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			s.Log.Printf("Step failed, retrying in %s: %v", interval, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step delivers the notifications due that were not delivered yet. They
+// are recorded once the sink and the unlocks took them, so a failed step
+// delivers them again.
+func (s *Scheduler) Step(ctx context.Context) error {
+	index, err := s.Index()
+	if err != nil {
+		return err
+	}
+	now := s.now()
+
+	var due []Notification
+	for _, n := range index.Due(now) {
+		if _, ok := s.State.Notified[n.Key()]; !ok {
+			due = append(due, n)
+		}
+	}
+	if len(due) == 0 {
+		return nil
+	}
+
+	if err := s.Sink.Notify(ctx, due); err != nil {
+		return fmt.Errorf("notifying %d moments: %w", len(due), err)
+	}
+	if s.Unlocks != nil {
+		if batches := UnlockBatches(due, s.OptIn, s.BatchSize); len(batches) > 0 {
+			if err := s.Unlocks(batches); err != nil {
+				return fmt.Errorf("batching unlocks: %w", err)
+			}
+		}
+	}
+
+	for _, n := range due {
+		s.State.Notified[n.Key()] = now
+	}
+	if s.StatePath != "" {
+		if err := s.State.Save(s.StatePath); err != nil {
+			return err
+		}
+	}
+	s.Log.Printf("Notified %d moments", len(due))
+	return nil
+}
+
+func (s *Scheduler) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
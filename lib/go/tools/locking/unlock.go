@@ -0,0 +1,73 @@
+package locking
+
+import (
+	"sort"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+)
+
+// DefaultBatchSize is the number of moments unlocked by a transaction,
+// well under the computation limit of batch_unlock_moments
+const DefaultBatchSize = 100
+
+// UnlockBatch is moments of an owner to unlock in a transaction
+type UnlockBatch struct {
+	Owner     string   `json:"owner"`
+	MomentIDs []uint64 `json:"momentIds"`
+}
+
+// UnlockBatches groups the moments of the notifications the owners who
+// opted in can unlock by owner, in batches of at most size moments.
+// Moments released by unlockAll have nothing left to unlock.
+func UnlockBatches(notifications []Notification, optIn map[string]bool, size int) []UnlockBatch {
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+	byOwner := map[string][]uint64{}
+	for _, n := range notifications {
+		if n.Reason == UnlockedAll || n.Owner == "" || !optIn[n.Owner] {
+			continue
+		}
+		byOwner[n.Owner] = append(byOwner[n.Owner], n.MomentID)
+	}
+
+	owners := make([]string, 0, len(byOwner))
+	for owner := range byOwner {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+
+	var batches []UnlockBatch
+	for _, owner := range owners {
+		ids := byOwner[owner]
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		for len(ids) > 0 {
+			n := min(size, len(ids))
+			batches = append(batches, UnlockBatch{Owner: owner, MomentIDs: ids[:n]})
+			ids = ids[n:]
+		}
+	}
+	return batches
+}
+
+// Transaction returns the batch_unlock_moments transaction of the batch,
+// authorized and paid by the owner. The owner sets its proposal key and
+// reference block when signing it.
+func (b UnlockBatch) Transaction(env templates.Environment) (*flow.Transaction, error) {
+	owner := flow.HexToAddress(b.Owner)
+	ids := make([]cadence.Value, len(b.MomentIDs))
+	for i, id := range b.MomentIDs {
+		ids[i] = cadence.NewUInt64(id)
+	}
+	tx := flow.NewTransaction().
+		SetScript(templates.GenerateBatchUnlockMomentScript(env)).
+		SetPayer(owner).
+		AddAuthorizer(owner)
+	if err := tx.AddArgument(cadence.NewArray(ids)); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
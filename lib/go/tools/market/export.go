@@ -0,0 +1,95 @@
+package market
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Report is the analytics of a market, with its OHLC series in buckets of Bucket
+type Report struct {
+	Bucket   string         `json:"bucket"`
+	Editions []EditionStats `json:"editions"`
+	Series   []Candle       `json:"series"`
+	Sellers  []SellerStats  `json:"sellers"`
+	// Unknown is the number of sales of moments whose edition is unknown
+	Unknown int `json:"unknown"`
+}
+
+// Report returns the analytics of the market
+func (m *Market) Report(bucket time.Duration) *Report {
+	report := &Report{
+		Bucket:   bucket.String(),
+		Editions: m.Editions(),
+		Series:   m.Series(bucket),
+		Sellers:  m.Sellers(),
+	}
+	for _, sale := range m.sales {
+		if !sale.Known {
+			report.Unknown++
+		}
+	}
+	return report
+}
+
+// WriteJSON writes the report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteEditionsCSV writes the stats of the editions as CSV, an empty cell
+// for a floor or last sale the edition does not have
+func (r *Report) WriteEditionsCSV(w io.Writer) error {
+	rows := [][]string{{"set_id", "play_id", "subedition_id", "floor", "listings", "last_sale", "last_sale_at", "volume", "sales"}}
+	for _, s := range r.Editions {
+		floor, lastSale, lastSaleAt := "", "", ""
+		if s.Floor != nil {
+			floor = s.Floor.String()
+		}
+		if s.LastSale != nil {
+			lastSale = s.LastSale.String()
+		}
+		if s.LastSaleAt != nil {
+			lastSaleAt = s.LastSaleAt.UTC().Format(time.RFC3339)
+		}
+		rows = append(rows, append(editionCells(s.Edition),
+			floor, strconv.Itoa(s.Listings), lastSale, lastSaleAt, s.Volume.String(), strconv.Itoa(s.Sales)))
+	}
+	return writeCSV(w, rows)
+}
+
+// WriteSeriesCSV writes the candles of the editions as CSV
+func (r *Report) WriteSeriesCSV(w io.Writer) error {
+	rows := [][]string{{"set_id", "play_id", "subedition_id", "start", "open", "high", "low", "close", "volume", "sales"}}
+	for _, c := range r.Series {
+		rows = append(rows, append(editionCells(c.Edition),
+			c.Start.Format(time.RFC3339), c.Open.String(), c.High.String(), c.Low.String(), c.Close.String(), c.Volume.String(), strconv.Itoa(c.Sales)))
+	}
+	return writeCSV(w, rows)
+}
+
+// WriteSellersCSV writes the stats of the sellers as CSV
+func (r *Report) WriteSellersCSV(w io.Writer) error {
+	rows := [][]string{{"seller", "listings", "sales", "volume", "editions"}}
+	for _, s := range r.Sellers {
+		rows = append(rows, []string{s.Seller, strconv.Itoa(s.Listings), strconv.Itoa(s.Sales), s.Volume.String(), strconv.Itoa(s.Editions)})
+	}
+	return writeCSV(w, rows)
+}
+
+func editionCells(e Edition) []string {
+	return []string{fmt.Sprint(e.SetID), fmt.Sprint(e.PlayID), fmt.Sprint(e.SubeditionID)}
+}
+
+func writeCSV(w io.Writer, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
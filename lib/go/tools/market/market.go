@@ -0,0 +1,210 @@
+// Package market folds the sales of TopShotMarketV3, joined with the
+// editions TopShot minted moments in, into price analytics: the floor,
+// last sale, volume and OHLC series of each edition, and the sales of each
+// seller.
+//
+// JSON-CDC events carry no time, so the lines of an events file are either
+// bare events or objects of an event and the timestamp of its block:
+//
+//	{"blockHeight": 72000000, "blockTimestamp": "2024-03-01T12:00:00Z", "event": {"type": "Event", ...}}
+//
+// Sales of bare events have no time and are left out of the OHLC series.
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events/decoder"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/eventfile"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+// Price is a UFix64 amount of DUC, in units of 10^-8
+type Price uint64
+
+func (p Price) String() string {
+	return cadence.UFix64(p).String()
+}
+
+func (p Price) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Price) UnmarshalText(text []byte) error {
+	value, err := cadence.NewUFix64(string(text))
+	if err != nil {
+		return err
+	}
+	*p = Price(value)
+	return nil
+}
+
+// Edition is the set, play and subedition moments are minted in
+type Edition struct {
+	SetID        uint32 `json:"setId"`
+	PlayID       uint32 `json:"playId"`
+	SubeditionID uint32 `json:"subeditionId"`
+}
+
+func (e Edition) String() string {
+	return fmt.Sprintf("%d/%d/%d", e.SetID, e.PlayID, e.SubeditionID)
+}
+
+// Listing is a moment for sale in a V3 sale collection
+type Listing struct {
+	MomentID uint64
+	Seller   string
+	Price    Price
+	ListedAt time.Time
+}
+
+// Sale is a purchase of a moment from a V3 sale collection
+type Sale struct {
+	MomentID uint64    `json:"momentId"`
+	Edition  Edition   `json:"edition"`
+	Seller   string    `json:"seller"`
+	Price    Price     `json:"price"`
+	At       time.Time `json:"at"`
+	// Known is whether the MomentMinted event of the moment was read, and so its edition
+	Known bool `json:"known"`
+}
+
+// Market is the projection of the mints of TopShot and the listings and
+// sales of TopShotMarketV3
+type Market struct {
+	editions map[uint64]Edition
+	listings map[uint64]*Listing
+	sales    []Sale
+}
+
+func New() *Market {
+	return &Market{
+		editions: map[uint64]Edition{},
+		listings: map[uint64]*Listing{},
+	}
+}
+
+// Apply folds a JSON-CDC or CCF encoded event, emitted in a block at the
+// time, into the market, ignoring the events of other contracts
+func (m *Market) Apply(payload []byte, at time.Time) error {
+	event, err := decoder.GetCadenceEvent(payload)
+	if err != nil {
+		return err
+	}
+
+	switch event.EventType.QualifiedIdentifier {
+	case events.EventMomentMinted:
+		minted, err := events.DecodeMomentMintedEvent(payload)
+		if err != nil {
+			return err
+		}
+		m.editions[minted.MomentId()] = Edition{SetID: minted.SetId(), PlayID: minted.PlayId(), SubeditionID: minted.SubeditionId()}
+	case events.EventSubeditionAddedToMoment:
+		added, err := events.DecodeSubeditionAddedToMomentEvent(payload)
+		if err != nil {
+			return err
+		}
+		if edition, ok := m.editions[added.MomentID()]; ok {
+			edition.SubeditionID = added.SubeditionID()
+			m.editions[added.MomentID()] = edition
+		}
+	case events.EventMarketV3MomentListed:
+		listed, err := events.DecodeMarketMomentListedEvent(payload)
+		if err != nil {
+			return err
+		}
+		m.listings[listed.Id()] = &Listing{MomentID: listed.Id(), Seller: eventfile.NormalizeAddress(listed.Seller()), Price: Price(listed.Price()), ListedAt: at}
+	case events.EventMarketV3MomentPriceChanged:
+		changed, err := events.DecodeMarketMomentPriceChangedEvent(payload)
+		if err != nil {
+			return err
+		}
+		if listing, ok := m.listings[changed.Id()]; ok {
+			listing.Price = Price(changed.NewPrice())
+		}
+	case events.EventMarketV3MomentWithdrawn:
+		withdrawn, err := events.DecodeMarketMomentWithdrawnEvent(payload)
+		if err != nil {
+			return err
+		}
+		delete(m.listings, withdrawn.Id())
+	case events.EventMarketV3MomentPurchased:
+		purchased, err := events.DecodeMarketMomentPurchasedEvent(payload)
+		if err != nil {
+			return err
+		}
+		edition, known := m.editions[purchased.Id()]
+		m.sales = append(m.sales, Sale{
+			MomentID: purchased.Id(),
+			Edition:  edition,
+			Seller:   eventfile.NormalizeAddress(purchased.Seller()),
+			Price:    Price(purchased.Price()),
+			At:       at,
+			Known:    known,
+		})
+		delete(m.listings, purchased.Id())
+	}
+	return nil
+}
+
+// line is a line of an events file holding the timestamp of the block of its event
+type line struct {
+	BlockTimestamp time.Time       `json:"blockTimestamp"`
+	Event          json.RawMessage `json:"event"`
+}
+
+// Read folds the events of an event file into the market
+func (m *Market) Read(r io.Reader) error {
+	return m.ReadWith(r, nil)
+}
+
+// ReadWith folds the events of an event file into the market and, when it
+// is not nil, into the projection, e.g. to check the listings against the
+// moments they list
+func (m *Market) ReadWith(r io.Reader, p *projection.Projection) error {
+	return eventfile.Read(r, func(payload []byte) error {
+		var timed line
+		if err := json.Unmarshal(payload, &timed); err == nil && len(timed.Event) > 0 {
+			payload = timed.Event
+		}
+		if err := m.Apply(payload, timed.BlockTimestamp); err != nil {
+			return err
+		}
+		if p != nil {
+			return p.Apply(payload)
+		}
+		return nil
+	})
+}
+
+// ReadEvents builds a market from an event file
+func ReadEvents(r io.Reader) (*Market, error) {
+	m := New()
+	if err := m.Read(r); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Sales returns every sale, in chain order
+func (m *Market) Sales() []Sale {
+	return m.sales
+}
+
+// Listing returns the listing of the moment
+func (m *Market) Listing(momentID uint64) (*Listing, bool) {
+	listing, ok := m.listings[momentID]
+	return listing, ok
+}
+
+// Edition returns the edition the moment was minted in
+func (m *Market) Edition(momentID uint64) (Edition, bool) {
+	edition, ok := m.editions[momentID]
+	return edition, ok
+}
@@ -0,0 +1,176 @@
+package market
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/cdctest"
+)
+
+const (
+	sellerA = "0x01cf0e2f2f715450"
+	sellerB = "0x179b6b1cb6755e31"
+	sellerC = "0xf3fcd2c1a78f5eee"
+)
+
+func timed(at, event string) string {
+	return fmt.Sprintf(`{"blockHeight":1,"blockTimestamp":"%s","event":%s}`, at, event)
+}
+
+func minted(momentID uint64, playID, subeditionID uint32) string {
+	return cdctest.Event("TopShot", "MomentMinted",
+		cdctest.Field("momentID", "UInt64", momentID),
+		cdctest.Field("playID", "UInt32", playID),
+		cdctest.Field("setID", "UInt32", 1),
+		cdctest.Field("serialNumber", "UInt32", momentID),
+		cdctest.Field("subeditionID", "UInt32", subeditionID),
+	)
+}
+
+func listed(momentID uint64, price, seller string) string {
+	return cdctest.Event("TopShotMarketV3", "MomentListed", cdctest.Field("id", "UInt64", momentID), cdctest.Field("price", "UFix64", price), cdctest.FieldOf("seller", cdctest.OptionalAddress(seller)))
+}
+
+func purchased(momentID uint64, price, seller string) string {
+	return cdctest.Event("TopShotMarketV3", "MomentPurchased",
+		cdctest.Field("id", "UInt64", momentID),
+		cdctest.Field("price", "UFix64", price),
+		cdctest.FieldOf("seller", cdctest.OptionalAddress(seller)),
+		cdctest.Field("momentName", "String", "Stephen Curry 3 Pointer"),
+		cdctest.Field("momentDescription", "String", ""),
+		cdctest.Field("momentThumbnailURL", "String", "https://assets.nbatopshot.com/media/1"),
+	)
+}
+
+// testMarket has moments 1 and 2 of edition 1/1/0, 3 of 1/2/0 and 4 of
+// 1/1/7. Seller A sells 1 and 2 on the first day, C sells 1 on the second
+// and lists it again, B withdraws 3, lists 4 and sells moment 9, which was
+// minted before the events begin, in an event without time.
+func testMarket(t *testing.T) *Market {
+	t.Helper()
+	history := []string{
+		minted(1, 1, 0),
+		minted(2, 1, 0),
+		minted(3, 2, 0),
+		minted(4, 1, 0),
+		cdctest.Event("TopShot", "SubeditionAddedToMoment", cdctest.Field("momentID", "UInt64", 4), cdctest.Field("subeditionID", "UInt32", 7)),
+		timed("2024-03-01T09:00:00Z", listed(1, "10.00000000", sellerA)),
+		timed("2024-03-01T09:00:00Z", listed(2, "12.00000000", sellerA)),
+		timed("2024-03-01T09:30:00Z", listed(3, "5.00000000", sellerB)),
+		timed("2024-03-01T09:45:00Z", cdctest.Event("TopShotMarketV3", "MomentPriceChanged", cdctest.Field("id", "UInt64", 2), cdctest.Field("newPrice", "UFix64", "8.00000000"), cdctest.FieldOf("seller", cdctest.OptionalAddress(sellerA)))),
+		timed("2024-03-01T10:00:00Z", purchased(1, "10.00000000", sellerA)),
+		timed("2024-03-01T11:00:00Z", cdctest.Event("TopShotMarketV3", "MomentWithdrawn", cdctest.Field("id", "UInt64", 3), cdctest.FieldOf("owner", cdctest.OptionalAddress(sellerB)))),
+		timed("2024-03-01T15:00:00Z", purchased(2, "8.00000000", sellerA)),
+		timed("2024-03-02T08:00:00Z", listed(1, "20.00000000", sellerC)),
+		timed("2024-03-02T09:00:00Z", purchased(1, "20.00000000", sellerC)),
+		timed("2024-03-02T10:00:00Z", listed(1, "25.00000000", sellerC)),
+		listed(4, "4.00000000", sellerB),
+		purchased(9, "3.00000000", sellerB),
+		cdctest.Event("FastBreakV1", "FastBreakPlayerCreated", cdctest.Field("id", "UInt64", 1), cdctest.Field("playerName", "String", "alice")),
+	}
+	m, err := ReadEvents(strings.NewReader(strings.Join(history, "\n")))
+	require.NoError(t, err)
+	return m
+}
+
+func price(value string) *Price {
+	var p Price
+	if err := p.UnmarshalText([]byte(value)); err != nil {
+		panic(err)
+	}
+	return &p
+}
+
+func at(value string) *time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err)
+	}
+	return &t
+}
+
+func TestApply(t *testing.T) {
+	m := testMarket(t)
+
+	listing, ok := m.Listing(1)
+	require.True(t, ok)
+	assert.Equal(t, &Listing{MomentID: 1, Seller: sellerC, Price: *price("25.0"), ListedAt: *at("2024-03-02T10:00:00Z")}, listing)
+	_, ok = m.Listing(3)
+	assert.False(t, ok)
+
+	edition, ok := m.Edition(4)
+	require.True(t, ok)
+	assert.Equal(t, Edition{SetID: 1, PlayID: 1, SubeditionID: 7}, edition)
+
+	sales := m.Sales()
+	require.Len(t, sales, 4)
+	assert.Equal(t, Sale{MomentID: 2, Edition: Edition{SetID: 1, PlayID: 1}, Seller: sellerA, Price: *price("8.0"), At: *at("2024-03-01T15:00:00Z"), Known: true}, sales[1])
+	assert.Equal(t, Sale{MomentID: 9, Seller: sellerB, Price: *price("3.0")}, sales[3])
+}
+
+func TestEditions(t *testing.T) {
+	assert.Equal(t, []EditionStats{
+		{Edition: Edition{SetID: 1, PlayID: 1}, Floor: price("25.0"), Listings: 1, LastSale: price("20.0"), LastSaleAt: at("2024-03-02T09:00:00Z"), Volume: *price("38.0"), Sales: 3},
+		{Edition: Edition{SetID: 1, PlayID: 1, SubeditionID: 7}, Floor: price("4.0"), Listings: 1},
+	}, testMarket(t).Editions())
+}
+
+func TestSeries(t *testing.T) {
+	edition := Edition{SetID: 1, PlayID: 1}
+	assert.Equal(t, []Candle{
+		{Edition: edition, Start: *at("2024-03-01T00:00:00Z"), Open: *price("10.0"), High: *price("10.0"), Low: *price("8.0"), Close: *price("8.0"), Volume: *price("18.0"), Sales: 2},
+		{Edition: edition, Start: *at("2024-03-02T00:00:00Z"), Open: *price("20.0"), High: *price("20.0"), Low: *price("20.0"), Close: *price("20.0"), Volume: *price("20.0"), Sales: 1},
+	}, testMarket(t).Series(24*time.Hour))
+
+	assert.Len(t, testMarket(t).Series(time.Hour), 3)
+}
+
+func TestSellers(t *testing.T) {
+	assert.Equal(t, []SellerStats{
+		{Seller: sellerA, Sales: 2, Volume: *price("18.0"), Editions: 1},
+		{Seller: sellerB, Listings: 1, Sales: 1, Volume: *price("3.0")},
+		{Seller: sellerC, Listings: 1, Sales: 1, Volume: *price("20.0"), Editions: 1},
+	}, testMarket(t).Sellers())
+}
+
+func TestReport(t *testing.T) {
+	report := testMarket(t).Report(24 * time.Hour)
+	assert.Equal(t, 1, report.Unknown)
+
+	var out bytes.Buffer
+	require.NoError(t, report.WriteEditionsCSV(&out))
+	assert.Equal(t, `set_id,play_id,subedition_id,floor,listings,last_sale,last_sale_at,volume,sales
+1,1,0,25.00000000,1,20.00000000,2024-03-02T09:00:00Z,38.00000000,3
+1,1,7,4.00000000,1,,,0.00000000,0
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, report.WriteSeriesCSV(&out))
+	assert.Equal(t, `set_id,play_id,subedition_id,start,open,high,low,close,volume,sales
+1,1,0,2024-03-01T00:00:00Z,10.00000000,10.00000000,8.00000000,8.00000000,18.00000000,2
+1,1,0,2024-03-02T00:00:00Z,20.00000000,20.00000000,20.00000000,20.00000000,20.00000000,1
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, report.WriteSellersCSV(&out))
+	assert.Equal(t, `seller,listings,sales,volume,editions
+0x01cf0e2f2f715450,0,2,18.00000000,1
+0x179b6b1cb6755e31,1,1,3.00000000,0
+0xf3fcd2c1a78f5eee,1,1,20.00000000,1
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, report.WriteJSON(&out))
+	var decoded Report
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, report, &decoded)
+	assert.Contains(t, out.String(), `"floor": "4.00000000"`)
+	assert.Contains(t, out.String(), `"lastSaleAt": null`)
+}
@@ -0,0 +1,95 @@
+package market
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// SaleMarket is the market contract whose sale collection holds a listing
+type SaleMarket string
+
+const (
+	MarketV3 SaleMarket = "V3"
+	// MarketV1 is a listing of the V1 sale collection a V3 one forwards to
+	MarketV1 SaleMarket = "V1"
+)
+
+// ufix64Factor is the scale of UFix64 values
+var ufix64Factor = big.NewInt(100_000_000)
+
+// ErrNotListed is returned for a purchase of a moment neither sale collection lists
+var ErrNotListed = errors.New("the moment is not listed in the sale collections")
+
+// PayoutListing is the listing a purchase settles
+type PayoutListing struct {
+	Market SaleMarket `json:"market"`
+	Price  Price      `json:"price"`
+	// CutPercentage is that of the sale collection holding the listing, which
+	// for a V1 listing is the V1 collection's and not that of the V3 one
+	// forwarding to it
+	CutPercentage Price `json:"cutPercentage"`
+}
+
+// Payout is how a purchase splits the price between the beneficiary of the
+// market and the owner of the sale collection
+type Payout struct {
+	PayoutListing
+	Beneficiary Price `json:"beneficiary"`
+	Owner       Price `json:"owner"`
+	// Truncated is the part of price*cutPercentage below 10^-8, in units of
+	// 10^-16, which UFix64 multiplication drops from the cut of the
+	// beneficiary and so goes to the owner
+	Truncated uint64 `json:"truncated"`
+}
+
+// CalculatePayout splits the price of the listing as purchase does in both
+// TopShotMarketV3 and Market: the beneficiary receives price*cutPercentage,
+// truncated to UFix64, and the owner the rest of the payment. It returns an
+// error for a listing the purchase of which reverts.
+func CalculatePayout(listing PayoutListing) (Payout, error) {
+	if listing.Market != MarketV3 && listing.Market != MarketV1 {
+		return Payout{}, fmt.Errorf("unknown market %q", listing.Market)
+	}
+
+	cut, truncated := new(big.Int).QuoRem(
+		new(big.Int).Mul(new(big.Int).SetUint64(uint64(listing.Price)), new(big.Int).SetUint64(uint64(listing.CutPercentage))),
+		ufix64Factor,
+		new(big.Int),
+	)
+	if !cut.IsUint64() || cut.Uint64() > uint64(listing.Price) {
+		return Payout{}, fmt.Errorf("the cut of %s at %s exceeds the payment, the purchase reverts", listing.Price, listing.CutPercentage)
+	}
+
+	return Payout{
+		PayoutListing: listing,
+		Beneficiary:   Price(cut.Uint64()),
+		Owner:         listing.Price - Price(cut.Uint64()),
+		Truncated:     truncated.Uint64(),
+	}, nil
+}
+
+// SaleCollections is what a purchase through the V3 sale collection of a
+// seller reads: its own listing of the moment, and the V1 sale collection it
+// forwards to when it was created in an account holding one
+type SaleCollections struct {
+	V3Price         *Price
+	V3CutPercentage Price
+	// V1Linked is whether the V3 sale collection forwards to a V1 one
+	V1Linked        bool
+	V1Price         *Price
+	V1CutPercentage Price
+}
+
+// Listing returns the listing a purchase settles: the V3 one when the V3
+// collection lists the moment, else the V1 one it forwards to
+func (s SaleCollections) Listing() (PayoutListing, error) {
+	switch {
+	case s.V3Price != nil:
+		return PayoutListing{Market: MarketV3, Price: *s.V3Price, CutPercentage: s.V3CutPercentage}, nil
+	case s.V1Linked && s.V1Price != nil:
+		return PayoutListing{Market: MarketV1, Price: *s.V1Price, CutPercentage: s.V1CutPercentage}, nil
+	default:
+		return PayoutListing{}, ErrNotListed
+	}
+}
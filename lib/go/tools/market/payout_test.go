@@ -0,0 +1,83 @@
+package market
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the expected payouts of testdata/payouts.json")
+
+// payoutCase is a purchase computed here, and replayed on the emulator by
+// TestMarketPayouts of lib/go/test, which checks the DUC balances
+type payoutCase struct {
+	Name    string        `json:"name"`
+	Listing PayoutListing `json:"listing"`
+	// Expected is the payout calculated, rewritten by -update
+	Expected Payout `json:"expected"`
+}
+
+func TestPayoutGoldens(t *testing.T) {
+	path := filepath.Join("testdata", "payouts.json")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var cases []payoutCase
+	require.NoError(t, json.Unmarshal(data, &cases))
+	require.NotEmpty(t, cases)
+
+	for i, c := range cases {
+		payout, err := CalculatePayout(c.Listing)
+		require.NoError(t, err, c.Name)
+		assert.Equal(t, payout.Price, payout.Beneficiary+payout.Owner, c.Name)
+		if *update {
+			cases[i].Expected = payout
+			continue
+		}
+		assert.Equal(t, c.Expected, payout, c.Name)
+	}
+
+	if *update {
+		data, err := json.MarshalIndent(cases, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, append(data, '\n'), 0o644))
+	}
+}
+
+func TestCalculatePayout(t *testing.T) {
+	payout, err := CalculatePayout(PayoutListing{Market: MarketV3, Price: *price("33.33333333"), CutPercentage: *price("0.05")})
+	require.NoError(t, err)
+	assert.Equal(t, *price("1.66666666"), payout.Beneficiary)
+	assert.Equal(t, *price("31.66666667"), payout.Owner)
+	assert.Equal(t, uint64(65_000_000), payout.Truncated)
+
+	_, err = CalculatePayout(PayoutListing{Market: MarketV3, Price: *price("10.0"), CutPercentage: *price("1.00000001")})
+	assert.ErrorContains(t, err, "exceeds the payment")
+
+	_, err = CalculatePayout(PayoutListing{Market: "V2", Price: *price("10.0")})
+	assert.ErrorContains(t, err, `unknown market "V2"`)
+
+	payout, err = CalculatePayout(PayoutListing{Market: MarketV3, Price: *price("184467440737.09551615"), CutPercentage: *price("1.0")})
+	require.NoError(t, err)
+	assert.Equal(t, Price(0), payout.Owner)
+}
+
+func TestSaleCollectionsListing(t *testing.T) {
+	collections := SaleCollections{V3CutPercentage: *price("0.15"), V1Linked: true, V1Price: price("20.0"), V1CutPercentage: *price("0.05")}
+
+	listing, err := collections.Listing()
+	require.NoError(t, err)
+	assert.Equal(t, PayoutListing{Market: MarketV1, Price: *price("20.0"), CutPercentage: *price("0.05")}, listing)
+
+	collections.V3Price = price("25.0")
+	listing, err = collections.Listing()
+	require.NoError(t, err)
+	assert.Equal(t, PayoutListing{Market: MarketV3, Price: *price("25.0"), CutPercentage: *price("0.15")}, listing)
+
+	_, err = SaleCollections{V1Price: price("20.0")}.Listing()
+	assert.ErrorIs(t, err, ErrNotListed)
+}
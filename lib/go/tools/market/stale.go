@@ -0,0 +1,118 @@
+package market
+
+import (
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+// DeadReason is why the purchase of a listing reverts
+type DeadReason string
+
+const (
+	// DeadDestroyed is a listing of a destroyed moment
+	DeadDestroyed DeadReason = "DESTROYED"
+	// DeadMoved is a listing of a moment no longer in the collection of the seller
+	DeadMoved DeadReason = "MOVED"
+	// DeadLocked is a listing of a moment locked in TopShotLocking, which the
+	// purchase cannot withdraw until it is unlocked, even past its expiry
+	DeadLocked DeadReason = "LOCKED"
+)
+
+// DeadListing is a listing of a V3 sale collection the purchase of which reverts
+type DeadListing struct {
+	MomentID uint64     `json:"momentId"`
+	Seller   string     `json:"seller"`
+	Price    Price      `json:"price"`
+	ListedAt time.Time  `json:"listedAt"`
+	Reason   DeadReason `json:"reason"`
+	// Owner is the account whose collection holds a moved moment, empty
+	// when it is held by none
+	Owner string `json:"owner,omitempty"`
+	// LockExpiry is the expiry timestamp of the lock of a locked moment, in UFix64 units
+	LockExpiry uint64 `json:"lockExpiry,omitempty"`
+}
+
+// SellerDeadListings are the dead listings of a seller, by moment id
+type SellerDeadListings struct {
+	Seller   string        `json:"seller"`
+	Listings []DeadListing `json:"listings"`
+}
+
+// DeadListingsReport are the dead listings of every seller
+type DeadListingsReport struct {
+	Sellers  []SellerDeadListings `json:"sellers"`
+	Listings int                  `json:"listings"`
+	Dead     int                  `json:"dead"`
+	// Unchecked is the number of listings of moments whose mint the events
+	// do not hold, which cannot be checked
+	Unchecked int `json:"unchecked"`
+}
+
+// Listings returns every listing of the V3 sale collections, by moment id
+func (m *Market) Listings() []*Listing {
+	listings := make([]*Listing, 0, len(m.listings))
+	for _, listing := range m.listings {
+		listings = append(listings, listing)
+	}
+	sort.Slice(listings, func(i, j int) bool { return listings[i].MomentID < listings[j].MomentID })
+	return listings
+}
+
+// DeadListings checks every listing against the moment it lists, as folded
+// into the projection from the same events: TopShotMarketV3 only clears a
+// listing when the moment is bought or the sale cancelled, so a listing of
+// a moment destroyed, moved out of the collection of the seller or locked
+// stays until a purchase of it reverts
+func (m *Market) DeadListings(p *projection.Projection) *DeadListingsReport {
+	report := &DeadListingsReport{Sellers: []SellerDeadListings{}}
+	bySeller := map[string][]DeadListing{}
+	for _, listing := range m.Listings() {
+		report.Listings++
+		moment, ok := p.Moment(listing.MomentID)
+		if !ok {
+			report.Unchecked++
+			continue
+		}
+
+		dead := DeadListing{MomentID: listing.MomentID, Seller: listing.Seller, Price: listing.Price, ListedAt: listing.ListedAt}
+		switch {
+		case moment.Destroyed:
+			dead.Reason = DeadDestroyed
+		case moment.Owner != listing.Seller:
+			dead.Reason, dead.Owner = DeadMoved, moment.Owner
+		case moment.Locked:
+			dead.Reason, dead.LockExpiry = DeadLocked, moment.LockExpiry
+		default:
+			continue
+		}
+		report.Dead++
+		bySeller[listing.Seller] = append(bySeller[listing.Seller], dead)
+	}
+
+	for seller, listings := range bySeller {
+		report.Sellers = append(report.Sellers, SellerDeadListings{Seller: seller, Listings: listings})
+	}
+	sort.Slice(report.Sellers, func(i, j int) bool { return report.Sellers[i].Seller < report.Sellers[j].Seller })
+	return report
+}
+
+// CancelTransaction returns the cancel sale transaction of TopShotMarketV3
+// that clears the listing, authorized and paid by the seller. The seller
+// sets its proposal key and reference block when signing it.
+func CancelTransaction(env templates.Environment, listing DeadListing) (*flow.Transaction, error) {
+	seller := flow.HexToAddress(listing.Seller)
+	tx := flow.NewTransaction().
+		SetScript(templates.GenerateCancelSaleV3Script(env)).
+		SetPayer(seller).
+		AddAuthorizer(seller)
+	if err := tx.AddArgument(cadence.NewUInt64(listing.MomentID)); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
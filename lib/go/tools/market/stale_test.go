@@ -0,0 +1,78 @@
+package market
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/cdctest"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+func deposited(momentID uint64, to string) string {
+	return cdctest.Event("TopShot", "Deposit", cdctest.Field("id", "UInt64", momentID), cdctest.FieldOf("to", cdctest.OptionalAddress(to)))
+}
+
+func withdrawn(momentID uint64, from string) string {
+	return cdctest.Event("TopShot", "Withdraw", cdctest.Field("id", "UInt64", momentID), cdctest.FieldOf("from", cdctest.OptionalAddress(from)))
+}
+
+// TestDeadListings lists moments 1 to 6 of seller A and 7 of seller B, then
+// moves 2 to B, destroys 3, locks 4, locks and unlocks 5 and moves 6 out and
+// back, and lists moment 9, minted before the events begin
+func TestDeadListings(t *testing.T) {
+	history := []string{}
+	for id := uint64(1); id <= 7; id++ {
+		seller := sellerA
+		if id == 7 {
+			seller = sellerB
+		}
+		history = append(history, minted(id, 1, 0), deposited(id, seller), listed(id, "10.00000000", seller))
+	}
+	history = append(history,
+		withdrawn(2, sellerA), deposited(2, sellerB),
+		withdrawn(3, sellerA), cdctest.Event("TopShot", "MomentDestroyed", cdctest.Field("id", "UInt64", 3)),
+		cdctest.Event("TopShotLocking", "MomentLocked", cdctest.Field("id", "UInt64", 4), cdctest.Field("duration", "UFix64", "86400.00000000"), cdctest.Field("expiryTimestamp", "UFix64", "1700000000.00000000")),
+		cdctest.Event("TopShotLocking", "MomentLocked", cdctest.Field("id", "UInt64", 5), cdctest.Field("duration", "UFix64", "1.00000000"), cdctest.Field("expiryTimestamp", "UFix64", "2.00000000")),
+		cdctest.Event("TopShotLocking", "MomentUnlocked", cdctest.Field("id", "UInt64", 5)),
+		withdrawn(6, sellerA), deposited(6, sellerA),
+		withdrawn(7, sellerB),
+		listed(9, "3.00000000", sellerB),
+	)
+
+	m, p := New(), projection.New()
+	require.NoError(t, m.ReadWith(strings.NewReader(strings.Join(history, "\n")), p))
+
+	report := m.DeadListings(p)
+	assert.Equal(t, &DeadListingsReport{
+		Sellers: []SellerDeadListings{
+			{Seller: sellerA, Listings: []DeadListing{
+				{MomentID: 2, Seller: sellerA, Price: *price("10.0"), Reason: DeadMoved, Owner: sellerB},
+				{MomentID: 3, Seller: sellerA, Price: *price("10.0"), Reason: DeadDestroyed},
+				{MomentID: 4, Seller: sellerA, Price: *price("10.0"), Reason: DeadLocked, LockExpiry: 1700000000 * 1e8},
+			}},
+			{Seller: sellerB, Listings: []DeadListing{
+				{MomentID: 7, Seller: sellerB, Price: *price("10.0"), Reason: DeadMoved},
+			}},
+		},
+		Listings:  8,
+		Dead:      4,
+		Unchecked: 1,
+	}, report)
+}
+
+func TestCancelTransaction(t *testing.T) {
+	env := templates.Environment{TopShotAddress: "877931736ee77cff", TopShotMarketV3Address: "c1e4f4f4c4257510"}
+	tx, err := CancelTransaction(env, DeadListing{MomentID: 4, Seller: sellerA})
+	require.NoError(t, err)
+
+	assert.Equal(t, templates.GenerateCancelSaleV3Script(env), tx.Script)
+	assert.Equal(t, flow.HexToAddress(sellerA), tx.Payer)
+	assert.Equal(t, []flow.Address{flow.HexToAddress(sellerA)}, tx.Authorizers)
+	require.Len(t, tx.Arguments, 1)
+	assert.JSONEq(t, `{"type":"UInt64","value":"4"}`, string(tx.Arguments[0]))
+}
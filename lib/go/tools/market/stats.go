@@ -0,0 +1,174 @@
+package market
+
+import (
+	"sort"
+	"time"
+)
+
+// EditionStats are the listings and sales of an edition
+type EditionStats struct {
+	Edition Edition `json:"edition"`
+	// Floor is the lowest price the edition is listed at, nil when no moment of it is listed
+	Floor    *Price `json:"floor"`
+	Listings int    `json:"listings"`
+	LastSale *Price `json:"lastSale"`
+	// LastSaleAt is nil when the last sale has no time
+	LastSaleAt *time.Time `json:"lastSaleAt"`
+	Volume     Price      `json:"volume"`
+	Sales      int        `json:"sales"`
+}
+
+// Candle is the open, high, low and close price of the sales of an edition
+// in a bucket of time
+type Candle struct {
+	Edition Edition   `json:"edition"`
+	Start   time.Time `json:"start"`
+	Open    Price     `json:"open"`
+	High    Price     `json:"high"`
+	Low     Price     `json:"low"`
+	Close   Price     `json:"close"`
+	Volume  Price     `json:"volume"`
+	Sales   int       `json:"sales"`
+}
+
+// SellerStats are the listings and sales of a seller
+type SellerStats struct {
+	Seller   string `json:"seller"`
+	Listings int    `json:"listings"`
+	Sales    int    `json:"sales"`
+	Volume   Price  `json:"volume"`
+	// Editions is the number of editions the seller sold moments of
+	Editions int `json:"editions"`
+}
+
+// Editions returns the stats of every edition listed or sold, by set, play
+// and subedition. Sales and listings of moments whose edition is unknown
+// are left out.
+func (m *Market) Editions() []EditionStats {
+	stats := map[Edition]*EditionStats{}
+	get := func(edition Edition) *EditionStats {
+		s, ok := stats[edition]
+		if !ok {
+			s = &EditionStats{Edition: edition}
+			stats[edition] = s
+		}
+		return s
+	}
+
+	for momentID, listing := range m.listings {
+		edition, ok := m.editions[momentID]
+		if !ok {
+			continue
+		}
+		s := get(edition)
+		s.Listings++
+		if s.Floor == nil || listing.Price < *s.Floor {
+			price := listing.Price
+			s.Floor = &price
+		}
+	}
+	for _, sale := range m.sales {
+		if !sale.Known {
+			continue
+		}
+		s := get(sale.Edition)
+		s.Sales++
+		s.Volume += sale.Price
+		price, at := sale.Price, sale.At
+		s.LastSale, s.LastSaleAt = &price, nil
+		if !at.IsZero() {
+			s.LastSaleAt = &at
+		}
+	}
+
+	editions := make([]EditionStats, 0, len(stats))
+	for _, s := range stats {
+		editions = append(editions, *s)
+	}
+	sort.Slice(editions, func(i, j int) bool { return editionLess(editions[i].Edition, editions[j].Edition) })
+	return editions
+}
+
+// Series returns the candles of the sales of every edition in buckets of
+// the duration, by edition then time. Sales without a time are left out.
+func (m *Market) Series(bucket time.Duration) []Candle {
+	candles := map[Edition]map[time.Time]*Candle{}
+	for _, sale := range m.sales {
+		if !sale.Known || sale.At.IsZero() {
+			continue
+		}
+		start := sale.At.UTC().Truncate(bucket)
+		if candles[sale.Edition] == nil {
+			candles[sale.Edition] = map[time.Time]*Candle{}
+		}
+		candle, ok := candles[sale.Edition][start]
+		if !ok {
+			candle = &Candle{Edition: sale.Edition, Start: start, Open: sale.Price, High: sale.Price, Low: sale.Price}
+			candles[sale.Edition][start] = candle
+		}
+		candle.High = max(candle.High, sale.Price)
+		candle.Low = min(candle.Low, sale.Price)
+		candle.Close = sale.Price
+		candle.Volume += sale.Price
+		candle.Sales++
+	}
+
+	series := []Candle{}
+	for _, buckets := range candles {
+		for _, candle := range buckets {
+			series = append(series, *candle)
+		}
+	}
+	sort.Slice(series, func(i, j int) bool {
+		if series[i].Edition != series[j].Edition {
+			return editionLess(series[i].Edition, series[j].Edition)
+		}
+		return series[i].Start.Before(series[j].Start)
+	})
+	return series
+}
+
+// Sellers returns the stats of every seller with a listing or a sale, by address
+func (m *Market) Sellers() []SellerStats {
+	stats := map[string]*SellerStats{}
+	editions := map[string]map[Edition]bool{}
+	get := func(seller string) *SellerStats {
+		s, ok := stats[seller]
+		if !ok {
+			s = &SellerStats{Seller: seller}
+			stats[seller] = s
+			editions[seller] = map[Edition]bool{}
+		}
+		return s
+	}
+
+	for _, listing := range m.listings {
+		get(listing.Seller).Listings++
+	}
+	for _, sale := range m.sales {
+		s := get(sale.Seller)
+		s.Sales++
+		s.Volume += sale.Price
+		if sale.Known {
+			editions[sale.Seller][sale.Edition] = true
+		}
+	}
+
+	sellers := make([]SellerStats, 0, len(stats))
+	for seller, s := range stats {
+		s.Editions = len(editions[seller])
+		sellers = append(sellers, *s)
+	}
+	sort.Slice(sellers, func(i, j int) bool { return sellers[i].Seller < sellers[j].Seller })
+	return sellers
+}
+
+func editionLess(a, b Edition) bool {
+	if a.SetID != b.SetID {
+		return a.SetID < b.SetID
+	}
+	if a.PlayID != b.PlayID {
+		return a.PlayID < b.PlayID
+	}
+	return a.SubeditionID < b.SubeditionID
+}
@@ -0,0 +1,161 @@
+// Package metadata builds the OpenSea compatible ERC721 metadata of moments
+// from a TopShot projection, the way the views of TopShot.NFT resolve it, and
+// serves it under the base token URI of BridgedTopShotMoments.
+package metadata
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+// Token is the ERC721 metadata of a moment
+type Token struct {
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Image        string      `json:"image"`
+	AnimationURL string      `json:"animation_url"`
+	ExternalURL  string      `json:"external_url"`
+	Attributes   []Attribute `json:"attributes"`
+}
+
+// Attribute is a trait of the Traits view of a moment
+type Attribute struct {
+	TraitType string `json:"trait_type"`
+	Value     any    `json:"value"`
+}
+
+// Contract is the collection level metadata returned by contractURI
+type Contract struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Image         string `json:"image"`
+	ExternalLink  string `json:"external_link"`
+	BannerImage   string `json:"banner_image,omitempty"`
+	FeaturedImage string `json:"featured_image,omitempty"`
+	// SellerFeeBasisPoints and FeeRecipient are the royalty of the collection
+	SellerFeeBasisPoints uint64 `json:"seller_fee_basis_points,omitempty"`
+	FeeRecipient         string `json:"fee_recipient,omitempty"`
+}
+
+// DefaultContract is the collection metadata of the NFTCollectionDisplay view of TopShot
+var DefaultContract = Contract{
+	Name:         "NBA Top Shot",
+	Description:  "NBA Top Shot is your chance to own, sell, and trade official digital collectibles of the NBA and WNBA's greatest plays and players",
+	Image:        "https://nbatopshot.com/static/favicon/favicon.svg",
+	ExternalLink: "https://nbatopshot.com",
+	BannerImage:  "https://nbatopshot.com/static/img/top-shot-logo-horizontal-white.svg",
+}
+
+const (
+	defaultAssetBaseURL  = "https://assets.nbatopshot.com/media/"
+	defaultMomentBaseURL = "https://nbatopshot.com/moment/"
+)
+
+// excludedTraits are the play metadata fields left out of the Traits view
+var excludedTraits = map[string]bool{"TeamAtMomentNBAID": true}
+
+// Builder builds the metadata of moments
+type Builder struct {
+	// Network is the network TopShot is deployed on, testnet media urls take a testnet parameter
+	Network string
+	// AssetBaseURL is the url media paths are appended to, the TopShot asset host when empty
+	AssetBaseURL string
+	// MomentBaseURL is the url external urls are made of, the TopShot moment page when empty
+	MomentBaseURL string
+}
+
+// Token returns the metadata of a moment, which fails when the moment was
+// never minted or was destroyed
+func (b Builder) Token(p *projection.Projection, id uint64) (*Token, error) {
+	moment, ok := p.Moment(id)
+	if !ok {
+		return nil, fmt.Errorf("moment %d was not minted", id)
+	}
+	if moment.Destroyed {
+		return nil, fmt.Errorf("moment %d was destroyed", id)
+	}
+
+	playMetadata := map[string]string{}
+	if play, ok := p.Play(moment.PlayID); ok {
+		playMetadata = play.Metadata
+	}
+	set, ok := p.Set(moment.SetID)
+	if !ok {
+		set = &projection.Set{ID: moment.SetID}
+	}
+
+	return &Token{
+		Name:         playMetadata["FullName"] + " " + playMetadata["PlayType"],
+		Description:  description(playMetadata, set, moment),
+		Image:        b.mediaURL(id, "?width=512", "&"),
+		AnimationURL: b.mediaURL(id, "/video", "?"),
+		ExternalURL:  b.momentBaseURL() + strconv.FormatUint(id, 10),
+		Attributes:   attributes(p, playMetadata, set, moment),
+	}, nil
+}
+
+// description is the tagline of the play, or else a description of the set and serial number
+func description(playMetadata map[string]string, set *projection.Set, moment *projection.Moment) string {
+	if tagline, ok := playMetadata["Tagline"]; ok {
+		return tagline
+	}
+	return fmt.Sprintf("A series %d %s moment with serial number %d", set.Series, set.Name, moment.SerialNumber)
+}
+
+// attributes are the traits of the moment, the set and serial traits and
+// every non-empty field of the play metadata, sorted by name
+func attributes(p *projection.Projection, playMetadata map[string]string, set *projection.Set, moment *projection.Moment) []Attribute {
+	subeditionName := "Standard"
+	if subedition, ok := p.Subedition(moment.SubeditionID); ok && moment.SubeditionID != 0 {
+		subeditionName = subedition.Name
+	}
+
+	traits := map[string]any{
+		"SeriesNumber": set.Series,
+		"SetName":      set.Name,
+		"SerialNumber": moment.SerialNumber,
+		"Locked":       moment.Locked,
+		"Subedition":   subeditionName,
+		"SubeditionID": moment.SubeditionID,
+	}
+	for name, value := range playMetadata {
+		if value != "" {
+			traits[name] = value
+		}
+	}
+
+	attributes := make([]Attribute, 0, len(traits))
+	for name, value := range traits {
+		if !excludedTraits[name] {
+			attributes = append(attributes, Attribute{TraitType: name, Value: value})
+		}
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].TraitType < attributes[j].TraitType
+	})
+	return attributes
+}
+
+// mediaURL is the url of a media of the moment, with the testnet parameter
+// appended after the delimiter on testnet
+func (b Builder) mediaURL(id uint64, path, delimiter string) string {
+	base := b.AssetBaseURL
+	if base == "" {
+		base = defaultAssetBaseURL
+	}
+	url := base + strconv.FormatUint(id, 10) + path
+	if b.Network == "testnet" {
+		url += delimiter + "testnet"
+	}
+	return url
+}
+
+func (b Builder) momentBaseURL() string {
+	if b.MomentBaseURL == "" {
+		return defaultMomentBaseURL
+	}
+	return b.MomentBaseURL
+}
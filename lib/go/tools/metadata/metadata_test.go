@@ -0,0 +1,129 @@
+package metadata
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/cdctest"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+func playCreated(id uint32, metadata map[string]string) string {
+	pairs := []string{}
+	for key, value := range metadata {
+		pairs = append(pairs, key, value)
+	}
+	return cdctest.Event("TopShot", "PlayCreated", cdctest.Field("id", "UInt32", id), cdctest.FieldOf("metadata", cdctest.StringDictionary(pairs...)))
+}
+
+func minted(momentID uint64, playID, serial, subeditionID uint32) string {
+	return cdctest.Event("TopShot", "MomentMinted",
+		cdctest.Field("momentID", "UInt64", momentID),
+		cdctest.Field("playID", "UInt32", playID),
+		cdctest.Field("setID", "UInt32", 1),
+		cdctest.Field("serialNumber", "UInt32", serial),
+		cdctest.Field("subeditionID", "UInt32", subeditionID),
+	)
+}
+
+// testProjection has a tagged play with moments 1 and 2, the second in a
+// subedition and locked, a play without tagline with moment 3, and a
+// destroyed moment 4
+func testProjection(t *testing.T) *projection.Projection {
+	t.Helper()
+	history := []string{
+		cdctest.Event("TopShot", "SetCreated", cdctest.Field("setID", "UInt32", 1), cdctest.Field("series", "UInt32", 2)),
+		playCreated(1, map[string]string{"FullName": "Stephen Curry", "PlayType": "3 Pointer", "Tagline": "From way downtown", "TeamAtMomentNBAID": "1610612744", "Weight": ""}),
+		playCreated(2, map[string]string{"FullName": "Ja Morant", "PlayType": "Dunk"}),
+		cdctest.Event("TopShot", "SubeditionCreated", cdctest.Field("subeditionID", "UInt32", 7), cdctest.Field("name", "String", "Parallel"),
+			cdctest.FieldOf("metadata", cdctest.StringDictionary())),
+		minted(1, 1, 1, 0),
+		minted(2, 1, 2, 7),
+		minted(3, 2, 1, 0),
+		minted(4, 2, 2, 0),
+		cdctest.Event("TopShotLocking", "MomentLocked", cdctest.Field("id", "UInt64", 2), cdctest.Field("duration", "UFix64", "1.00000000"), cdctest.Field("expiryTimestamp", "UFix64", "2.00000000")),
+		cdctest.Event("TopShot", "MomentDestroyed", cdctest.Field("id", "UInt64", 4)),
+	}
+	p, err := projection.ReadEvents(strings.NewReader(strings.Join(history, "\n")))
+	require.NoError(t, err)
+	p.NameSet(1, "Base Set")
+	return p
+}
+
+func TestToken(t *testing.T) {
+	p := testProjection(t)
+
+	token, err := Builder{}.Token(p, 2)
+	require.NoError(t, err)
+	assert.Equal(t, &Token{
+		Name:         "Stephen Curry 3 Pointer",
+		Description:  "From way downtown",
+		Image:        "https://assets.nbatopshot.com/media/2?width=512",
+		AnimationURL: "https://assets.nbatopshot.com/media/2/video",
+		ExternalURL:  "https://nbatopshot.com/moment/2",
+		Attributes: []Attribute{
+			{TraitType: "FullName", Value: "Stephen Curry"},
+			{TraitType: "Locked", Value: true},
+			{TraitType: "PlayType", Value: "3 Pointer"},
+			{TraitType: "SerialNumber", Value: uint32(2)},
+			{TraitType: "SeriesNumber", Value: uint32(2)},
+			{TraitType: "SetName", Value: "Base Set"},
+			{TraitType: "Subedition", Value: "Parallel"},
+			{TraitType: "SubeditionID", Value: uint32(7)},
+			{TraitType: "Tagline", Value: "From way downtown"},
+		},
+	}, token)
+
+	token, err = Builder{Network: "testnet", AssetBaseURL: "http://localhost:9000/media/"}.Token(p, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "A series 2 Base Set moment with serial number 1", token.Description)
+	assert.Equal(t, "http://localhost:9000/media/3?width=512&testnet", token.Image)
+	assert.Equal(t, "http://localhost:9000/media/3/video?testnet", token.AnimationURL)
+	assert.Contains(t, token.Attributes, Attribute{TraitType: "Subedition", Value: "Standard"})
+	assert.Contains(t, token.Attributes, Attribute{TraitType: "Locked", Value: false})
+
+	_, err = Builder{}.Token(p, 4)
+	assert.ErrorContains(t, err, "destroyed")
+	_, err = Builder{}.Token(p, 5)
+	assert.ErrorContains(t, err, "not minted")
+}
+
+func TestServer(t *testing.T) {
+	server := NewServer(testProjection(t), Builder{}, DefaultContract)
+	handler := server.Handler()
+
+	get := func(path string) (int, map[string]any) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+		body := map[string]any{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+		return recorder.Code, body
+	}
+
+	status, body := get("/1")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "Stephen Curry 3 Pointer", body["name"])
+	assert.Len(t, body["attributes"], 9)
+
+	status, body = get("/contract")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "NBA Top Shot", body["name"])
+	assert.Equal(t, "https://nbatopshot.com", body["external_link"])
+
+	status, _ = get("/4")
+	assert.Equal(t, http.StatusNotFound, status)
+	status, _ = get("/moment")
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	// an updated projection is served from then on
+	server.Update(projection.New())
+	status, _ = get("/1")
+	assert.Equal(t, http.StatusNotFound, status)
+}
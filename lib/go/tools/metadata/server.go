@@ -0,0 +1,67 @@
+package metadata
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/projection"
+)
+
+// Server serves the metadata of moments at /{id}, which the token URI of
+// BridgedTopShotMoments resolves to when its base token URI is the url of
+// the server, and the collection metadata at /contract
+type Server struct {
+	Builder  Builder
+	Contract Contract
+
+	mu         sync.RWMutex
+	projection *projection.Projection
+}
+
+func NewServer(p *projection.Projection, builder Builder, contract Contract) *Server {
+	return &Server{Builder: builder, Contract: contract, projection: p}
+}
+
+// Update replaces the projection served, e.g. with one read from newer events
+func (s *Server) Update(p *projection.Projection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projection = p
+}
+
+// Handler returns the handler of the metadata routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /contract", s.serveContract)
+	mux.HandleFunc("GET /{id}", s.serveToken)
+	return mux
+}
+
+func (s *Server) serveContract(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.Contract)
+}
+
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid token ID"})
+		return
+	}
+
+	s.mu.RLock()
+	token, err := s.Builder.Token(s.projection, id)
+	s.mu.RUnlock()
+	if err != nil {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, token)
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
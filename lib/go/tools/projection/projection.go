@@ -0,0 +1,278 @@
+// Package projection folds the events of TopShot and TopShotLocking into
+// the plays, sets, subeditions and moments they describe, so that tools can
+// serve and check TopShot state from an event history instead of a node.
+package projection
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events/decoder"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/eventfile"
+)
+
+// Play is a play created with its metadata
+type Play struct {
+	ID       uint32
+	Metadata map[string]string
+}
+
+// Set is a set of plays. SetCreated does not carry the name of the set,
+// which is only known once given with NameSet.
+type Set struct {
+	ID     uint32
+	Series uint32
+	Name   string
+	// Plays are the plays added to the set, in order
+	Plays []uint32
+	// Retired are the retired plays with the number of moments minted in their edition
+	Retired map[uint32]uint32
+	Locked  bool
+}
+
+// Subedition is a subedition created with its metadata
+type Subedition struct {
+	ID       uint32
+	Name     string
+	Metadata map[string]string
+}
+
+// Moment is a minted moment
+type Moment struct {
+	ID           uint64
+	PlayID       uint32
+	SetID        uint32
+	SerialNumber uint32
+	SubeditionID uint32
+	// Owner is the account whose collection holds the moment, 0x followed
+	// by 16 hex digits, or empty while the moment is withdrawn
+	Owner     string
+	Destroyed bool
+	Locked    bool
+	// LockExpiry is the expiry timestamp of the lock in UFix64 units
+	LockExpiry uint64
+}
+
+// Edition identifies the moments minted from a play in a set
+type Edition struct {
+	SetID  uint32
+	PlayID uint32
+}
+
+// Projection is the TopShot state folded from events in chain order
+type Projection struct {
+	plays       map[uint32]*Play
+	sets        map[uint32]*Set
+	subeditions map[uint32]*Subedition
+	moments     map[uint64]*Moment
+	editions    map[Edition]uint32
+}
+
+func New() *Projection {
+	return &Projection{
+		plays:       map[uint32]*Play{},
+		sets:        map[uint32]*Set{},
+		subeditions: map[uint32]*Subedition{},
+		moments:     map[uint64]*Moment{},
+		editions:    map[Edition]uint32{},
+	}
+}
+
+// Play returns the play with the ID
+func (p *Projection) Play(id uint32) (*Play, bool) {
+	play, ok := p.plays[id]
+	return play, ok
+}
+
+// Set returns the set with the ID
+func (p *Projection) Set(id uint32) (*Set, bool) {
+	set, ok := p.sets[id]
+	return set, ok
+}
+
+// Subedition returns the subedition with the ID
+func (p *Projection) Subedition(id uint32) (*Subedition, bool) {
+	subedition, ok := p.subeditions[id]
+	return subedition, ok
+}
+
+// Moment returns the moment with the ID, destroyed or not
+func (p *Projection) Moment(id uint64) (*Moment, bool) {
+	moment, ok := p.moments[id]
+	return moment, ok
+}
+
+// Moments returns the IDs of every moment minted, in order
+func (p *Projection) Moments() []uint64 {
+	ids := make([]uint64, 0, len(p.moments))
+	for id := range p.moments {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Sets returns the IDs of every set created, in order
+func (p *Projection) Sets() []uint32 {
+	ids := make([]uint32, 0, len(p.sets))
+	for id := range p.sets {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// NumMomentsInEdition is the number of moments minted in the edition
+func (p *Projection) NumMomentsInEdition(edition Edition) uint32 {
+	return p.editions[edition]
+}
+
+// NameSet names a set, created or not yet
+func (p *Projection) NameSet(id uint32, name string) {
+	p.set(id).Name = name
+}
+
+// Apply folds a JSON-CDC or CCF encoded event into the projection,
+// ignoring the events of other contracts
+func (p *Projection) Apply(payload []byte) error {
+	event, err := decoder.GetCadenceEvent(payload)
+	if err != nil {
+		return err
+	}
+
+	switch event.EventType.QualifiedIdentifier {
+	case events.EventPlayCreated:
+		created, err := events.DecodePlayCreatedEvent(payload)
+		if err != nil {
+			return err
+		}
+		metadata := map[string]string{}
+		for key, value := range created.MetaData() {
+			metadata[fmt.Sprint(key)] = fmt.Sprint(value)
+		}
+		p.plays[created.Id()] = &Play{ID: created.Id(), Metadata: metadata}
+	case events.EventSetCreated:
+		created, err := events.DecodeSetCreatedEvent(payload)
+		if err != nil {
+			return err
+		}
+		p.set(created.SetID()).Series = created.Series()
+	case events.EventPlayAddedToSet:
+		added, err := events.DecodePlayAddedToSetEvent(payload)
+		if err != nil {
+			return err
+		}
+		set := p.set(added.SetID())
+		set.Plays = append(set.Plays, added.PlayID())
+	case events.EventPlayRetiredFromSet:
+		retired, err := events.DecodeSetPlayRetiredEvent(payload)
+		if err != nil {
+			return err
+		}
+		p.set(retired.SetID()).Retired[retired.PlayID()] = retired.NumMoments()
+	case events.EventSetLocked:
+		locked, err := events.DecodeSetLockedEvent(payload)
+		if err != nil {
+			return err
+		}
+		p.set(locked.SetID()).Locked = true
+	case events.EventSubeditionCreated:
+		created, err := events.DecodeSubeditionCreatedEvent(payload)
+		if err != nil {
+			return err
+		}
+		metadata := map[string]string{}
+		for key, value := range created.MetaData() {
+			metadata[key] = fmt.Sprint(value)
+		}
+		p.subeditions[created.SubeditionId()] = &Subedition{ID: created.SubeditionId(), Name: created.Name(), Metadata: metadata}
+	case events.EventMomentMinted:
+		minted, err := events.DecodeMomentMintedEvent(payload)
+		if err != nil {
+			return err
+		}
+		p.moments[minted.MomentId()] = &Moment{
+			ID:           minted.MomentId(),
+			PlayID:       minted.PlayId(),
+			SetID:        minted.SetId(),
+			SerialNumber: minted.SerialNumber(),
+			SubeditionID: minted.SubeditionId(),
+		}
+		p.editions[Edition{SetID: minted.SetId(), PlayID: minted.PlayId()}]++
+	case events.EventSubeditionAddedToMoment:
+		added, err := events.DecodeSubeditionAddedToMomentEvent(payload)
+		if err != nil {
+			return err
+		}
+		if moment, ok := p.moments[added.MomentID()]; ok {
+			moment.SubeditionID = added.SubeditionID()
+		}
+	case events.TopShotEventDeposit:
+		deposit, err := events.DecodeDepositEvent(payload)
+		if err != nil {
+			return err
+		}
+		if moment, ok := p.moments[deposit.Id()]; ok {
+			moment.Owner = eventfile.NormalizeAddress(deposit.To())
+		}
+	case events.EventWithdraw:
+		withdraw, err := events.DecodeWithdrawEvent(payload)
+		if err != nil {
+			return err
+		}
+		if moment, ok := p.moments[withdraw.Id()]; ok {
+			moment.Owner = ""
+		}
+	case events.EventMomentDestroyed, events.EventMomentDestroyedV2:
+		destroyed, err := events.DecodeMomentDestroyedEvent(payload)
+		if err != nil {
+			return err
+		}
+		if moment, ok := p.moments[destroyed.Id()]; ok {
+			moment.Destroyed, moment.Owner = true, ""
+		}
+	case events.MomentLocked:
+		locked, err := events.DecodeMomentLockedEvent(payload)
+		if err != nil {
+			return err
+		}
+		if moment, ok := p.moments[locked.FlowID()]; ok {
+			moment.Locked, moment.LockExpiry = true, locked.ExpiryTimestamp()
+		}
+	case events.MomentUnlocked:
+		unlocked, err := events.DecodeMomentUnlockedEvent(payload)
+		if err != nil {
+			return err
+		}
+		if moment, ok := p.moments[unlocked.FlowID()]; ok {
+			moment.Locked, moment.LockExpiry = false, 0
+		}
+	}
+	return nil
+}
+
+// Read folds the events of an event file into the projection
+func (p *Projection) Read(r io.Reader) error {
+	return eventfile.Read(r, p.Apply)
+}
+
+// ReadEvents builds a projection from the events of an event file
+func ReadEvents(r io.Reader) (*Projection, error) {
+	p := New()
+	if err := p.Read(r); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// set returns the set with the ID, added when a set event comes before its SetCreated
+func (p *Projection) set(id uint32) *Set {
+	set, ok := p.sets[id]
+	if !ok {
+		set = &Set{ID: id, Retired: map[uint32]uint32{}}
+		p.sets[id] = set
+	}
+	return set
+}
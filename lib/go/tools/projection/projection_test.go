@@ -0,0 +1,94 @@
+package projection
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/cdctest"
+)
+
+func minted(momentID uint64, playID, setID, serial, subeditionID uint32) string {
+	return cdctest.Event("TopShot", "MomentMinted",
+		cdctest.Field("momentID", "UInt64", momentID),
+		cdctest.Field("playID", "UInt32", playID),
+		cdctest.Field("setID", "UInt32", setID),
+		cdctest.Field("serialNumber", "UInt32", serial),
+		cdctest.Field("subeditionID", "UInt32", subeditionID),
+	)
+}
+
+func TestReadEvents(t *testing.T) {
+	history := []string{
+		cdctest.Event("TopShot", "PlayCreated", cdctest.Field("id", "UInt32", 1), cdctest.FieldOf("metadata", cdctest.StringDictionary("FullName", "Ja Morant", "PlayType", "Dunk"))),
+		cdctest.Event("TopShot", "SetCreated", cdctest.Field("setID", "UInt32", 2), cdctest.Field("series", "UInt32", 4)),
+		cdctest.Event("TopShot", "PlayAddedToSet", cdctest.Field("setID", "UInt32", 2), cdctest.Field("playID", "UInt32", 1)),
+		cdctest.Event("TopShot", "SubeditionCreated", cdctest.Field("subeditionID", "UInt32", 3), cdctest.Field("name", "String", "Parallel"), cdctest.FieldOf("metadata", cdctest.StringDictionary("Color", "Gold"))),
+		minted(10, 1, 2, 1, 0),
+		minted(11, 1, 2, 2, 0),
+		cdctest.Event("TopShot", "SubeditionAddedToMoment", cdctest.Field("momentID", "UInt64", 11), cdctest.Field("subeditionID", "UInt32", 3), cdctest.Field("setID", "UInt32", 2), cdctest.Field("playID", "UInt32", 1)),
+		cdctest.Event("TopShot", "Deposit", cdctest.Field("id", "UInt64", 10), cdctest.FieldOf("to", cdctest.OptionalAddress("0xa1"))),
+		cdctest.Event("TopShot", "Deposit", cdctest.Field("id", "UInt64", 11), cdctest.FieldOf("to", cdctest.OptionalAddress("0xa1"))),
+		cdctest.Event("TopShotLocking", "MomentLocked", cdctest.Field("id", "UInt64", 10), cdctest.Field("duration", "UFix64", "86400.00000000"), cdctest.Field("expiryTimestamp", "UFix64", "1700000000.00000000")),
+		cdctest.Event("TopShot", "Withdraw", cdctest.Field("id", "UInt64", 11), cdctest.FieldOf("from", cdctest.OptionalAddress("0xa1"))),
+		cdctest.Event("TopShot", "MomentDestroyed", cdctest.Field("id", "UInt64", 11)),
+		cdctest.Event("TopShot", "PlayRetiredFromSet", cdctest.Field("setID", "UInt32", 2), cdctest.Field("playID", "UInt32", 1), cdctest.Field("numMoments", "UInt32", 2)),
+		cdctest.Event("TopShot", "SetLocked", cdctest.Field("setID", "UInt32", 2)),
+		cdctest.Event("FastBreakV1", "FastBreakGameWinner", cdctest.Field("playerId", "UInt64", 1)),
+		"",
+	}
+
+	p, err := ReadEvents(strings.NewReader(strings.Join(history, "\n")))
+	require.NoError(t, err)
+	p.NameSet(2, "Metallic Gold LE")
+
+	play, ok := p.Play(1)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"FullName": "Ja Morant", "PlayType": "Dunk"}, play.Metadata)
+
+	set, ok := p.Set(2)
+	require.True(t, ok)
+	assert.Equal(t, &Set{ID: 2, Series: 4, Name: "Metallic Gold LE", Plays: []uint32{1}, Retired: map[uint32]uint32{1: 2}, Locked: true}, set)
+	assert.Equal(t, []uint32{2}, p.Sets())
+
+	subedition, ok := p.Subedition(3)
+	require.True(t, ok)
+	assert.Equal(t, "Parallel", subedition.Name)
+	assert.Equal(t, "Gold", subedition.Metadata["Color"])
+
+	assert.Equal(t, []uint64{10, 11}, p.Moments())
+	assert.Equal(t, uint32(2), p.NumMomentsInEdition(Edition{SetID: 2, PlayID: 1}))
+
+	moment, ok := p.Moment(10)
+	require.True(t, ok)
+	assert.Equal(t, &Moment{ID: 10, PlayID: 1, SetID: 2, SerialNumber: 1, Owner: "0x00000000000000a1", Locked: true, LockExpiry: 1700000000 * 1e8}, moment)
+
+	moment, ok = p.Moment(11)
+	require.True(t, ok)
+	assert.True(t, moment.Destroyed)
+	assert.Empty(t, moment.Owner)
+	assert.Equal(t, uint32(3), moment.SubeditionID)
+}
+
+func TestUnlock(t *testing.T) {
+	p := New()
+	for _, payload := range []string{
+		minted(1, 1, 1, 1, 0),
+		cdctest.Event("TopShotLocking", "MomentLocked", cdctest.Field("id", "UInt64", 1), cdctest.Field("duration", "UFix64", "1.00000000"), cdctest.Field("expiryTimestamp", "UFix64", "2.00000000")),
+		cdctest.Event("TopShotLocking", "MomentUnlocked", cdctest.Field("id", "UInt64", 1)),
+	} {
+		require.NoError(t, p.Apply([]byte(payload)))
+	}
+
+	moment, _ := p.Moment(1)
+	assert.False(t, moment.Locked)
+	assert.Zero(t, moment.LockExpiry)
+}
+
+func TestReadEventsReportsLine(t *testing.T) {
+	_, err := ReadEvents(strings.NewReader(minted(1, 1, 1, 1, 0) + "\nnot an event\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+}
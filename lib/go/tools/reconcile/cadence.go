@@ -0,0 +1,109 @@
+package reconcile
+
+import (
+	"io"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events/decoder"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/eventfile"
+)
+
+// CadenceSnapshot is the collection holding each moment, folded from the
+// Withdraw, Deposit and MomentDestroyed events of TopShot in chain order
+type CadenceSnapshot struct {
+	owners    map[uint64]string
+	withdrawn map[uint64]string
+	destroyed map[uint64]bool
+}
+
+func NewCadenceSnapshot() *CadenceSnapshot {
+	return &CadenceSnapshot{
+		owners:    map[uint64]string{},
+		withdrawn: map[uint64]string{},
+		destroyed: map[uint64]bool{},
+	}
+}
+
+// Deposit records a moment deposited into the collection of an account,
+// or into a collection stored nowhere when to is undefined
+func (s *CadenceSnapshot) Deposit(event events.DepositEvent) {
+	to := eventfile.NormalizeAddress(event.To())
+	delete(s.withdrawn, event.Id())
+	if to == "" {
+		s.withdrawn[event.Id()] = ""
+		delete(s.owners, event.Id())
+		return
+	}
+	s.owners[event.Id()] = to
+}
+
+// Withdraw records a moment leaving the collection of an account
+func (s *CadenceSnapshot) Withdraw(event events.WithdrawEvent) {
+	delete(s.owners, event.Id())
+	s.withdrawn[event.Id()] = eventfile.NormalizeAddress(event.From())
+}
+
+// Destroy records a destroyed moment, which is no longer reconciled
+func (s *CadenceSnapshot) Destroy(event events.MomentDestroyedEvent) {
+	delete(s.owners, event.Id())
+	delete(s.withdrawn, event.Id())
+	s.destroyed[event.Id()] = true
+}
+
+// Owner returns the account whose collection holds the moment
+func (s *CadenceSnapshot) Owner(id uint64) (string, bool) {
+	owner, ok := s.owners[id]
+	return owner, ok
+}
+
+// Apply folds a JSON-CDC or CCF encoded event into the snapshot, ignoring
+// the events that do not move moments
+func (s *CadenceSnapshot) Apply(payload []byte) error {
+	event, err := decoder.GetCadenceEvent(payload)
+	if err != nil {
+		return err
+	}
+
+	switch event.EventType.QualifiedIdentifier {
+	case events.TopShotEventDeposit:
+		deposit, err := events.DecodeDepositEvent(payload)
+		if err != nil {
+			return err
+		}
+		s.Deposit(deposit)
+	case events.EventWithdraw:
+		withdraw, err := events.DecodeWithdrawEvent(payload)
+		if err != nil {
+			return err
+		}
+		s.Withdraw(withdraw)
+	case events.EventMomentDestroyed, events.EventMomentDestroyedV2:
+		destroyed, err := events.DecodeMomentDestroyedEvent(payload)
+		if err != nil {
+			return err
+		}
+		s.Destroy(destroyed)
+	}
+	return nil
+}
+
+// ReadCadenceEvents builds a snapshot from the events of an event file
+func ReadCadenceEvents(r io.Reader) (*CadenceSnapshot, error) {
+	snapshot := NewCadenceSnapshot()
+	if err := eventfile.Read(r, snapshot.Apply); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// ids returns every moment the snapshot saw that was not destroyed
+func (s *CadenceSnapshot) ids() map[uint64]bool {
+	ids := map[uint64]bool{}
+	for id := range s.owners {
+		ids[id] = true
+	}
+	for id := range s.withdrawn {
+		ids[id] = true
+	}
+	return ids
+}
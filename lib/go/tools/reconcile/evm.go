@@ -0,0 +1,137 @@
+package reconcile
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TransferTopic is the topic of the ERC721 Transfer(address,address,uint256) event
+var TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// EVMSnapshot is the owner of each token of the legacy bridge-deployed
+// ERC721 and of the BridgedTopShotMoments wrapper, folded from their
+// Transfer logs
+type EVMSnapshot struct {
+	LegacyAddr  common.Address
+	WrapperAddr common.Address
+
+	legacy  map[uint64]common.Address
+	wrapper map[uint64]common.Address
+}
+
+func NewEVMSnapshot(legacyAddr, wrapperAddr common.Address) *EVMSnapshot {
+	return &EVMSnapshot{
+		LegacyAddr:  legacyAddr,
+		WrapperAddr: wrapperAddr,
+		legacy:      map[uint64]common.Address{},
+		wrapper:     map[uint64]common.Address{},
+	}
+}
+
+// Apply folds Transfer logs into the snapshot in block order, ignoring
+// removed logs and the logs of other contracts and events
+func (s *EVMSnapshot) Apply(logs []types.Log) error {
+	sorted := slices.Clone(logs)
+	slices.SortStableFunc(sorted, func(a, b types.Log) int {
+		if a.BlockNumber != b.BlockNumber {
+			return cmp.Compare(a.BlockNumber, b.BlockNumber)
+		}
+		return cmp.Compare(a.Index, b.Index)
+	})
+
+	for _, log := range sorted {
+		owners := s.owners(log.Address)
+		// ERC20 Transfer logs have three topics, ERC721 ones index the token ID too
+		if log.Removed || owners == nil || len(log.Topics) != 4 || log.Topics[0] != TransferTopic {
+			continue
+		}
+
+		tokenID := log.Topics[3].Big()
+		if !tokenID.IsUint64() {
+			return fmt.Errorf("token %s of %s in tx %s is not a moment ID", tokenID, log.Address.Hex(), log.TxHash.Hex())
+		}
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		if to == (common.Address{}) {
+			delete(owners, tokenID.Uint64())
+		} else {
+			owners[tokenID.Uint64()] = to
+		}
+	}
+	return nil
+}
+
+// LegacyOwner returns the owner of a token of the legacy ERC721
+func (s *EVMSnapshot) LegacyOwner(id uint64) (common.Address, bool) {
+	owner, ok := s.legacy[id]
+	return owner, ok
+}
+
+// WrapperOwner returns the owner of a token of the wrapper
+func (s *EVMSnapshot) WrapperOwner(id uint64) (common.Address, bool) {
+	owner, ok := s.wrapper[id]
+	return owner, ok
+}
+
+func (s *EVMSnapshot) owners(contract common.Address) map[uint64]common.Address {
+	switch contract {
+	case s.LegacyAddr:
+		return s.legacy
+	case s.WrapperAddr:
+		return s.wrapper
+	}
+	return nil
+}
+
+// ReadLogs reads a recorded JSON array of logs, as returned by eth_getLogs
+func ReadLogs(r io.Reader) ([]types.Log, error) {
+	var logs []types.Log
+	if err := json.NewDecoder(r).Decode(&logs); err != nil {
+		return nil, fmt.Errorf("invalid log file: %w", err)
+	}
+	return logs, nil
+}
+
+// WriteLogs records logs so that a reconciliation can be replayed without the network
+func WriteLogs(w io.Writer, logs []types.Log) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(logs)
+}
+
+// FetchLogs fetches the Transfer logs of the contracts between two blocks
+// included, in ranges of at most blockRange blocks since RPC servers limit
+// the range of a query
+func FetchLogs(ctx context.Context, client ethereum.LogFilterer, contracts []common.Address, fromBlock, toBlock, blockRange uint64) ([]types.Log, error) {
+	if blockRange == 0 {
+		return nil, fmt.Errorf("the block range must be positive")
+	}
+
+	var logs []types.Log
+	for start := fromBlock; start <= toBlock; start += blockRange {
+		end := min(start+blockRange-1, toBlock)
+		found, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: contracts,
+			Topics:    [][]common.Hash{{TransferTopic}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch the logs of blocks %d to %d: %w", start, end, err)
+		}
+		logs = append(logs, found...)
+		if end == toBlock {
+			break
+		}
+	}
+	return logs, nil
+}
@@ -0,0 +1,277 @@
+// Package reconcile checks that every moment lives in exactly one place
+// across the Flow VMs: in a TopShot collection on Cadence, as a token of
+// the legacy ERC721 deployed by the VM bridge, or as a token of the
+// BridgedTopShotMoments wrapper. A moment in one VM is escrowed by the
+// bridge in the other, and a legacy token wrapped is held by the wrapper.
+package reconcile
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/eventfile"
+)
+
+// Holding kinds
+const (
+	HoldingCadence = "cadence"
+	HoldingLegacy  = "legacy"
+	HoldingWrapper = "wrapper"
+)
+
+// Holding statuses
+const (
+	// StatusHeld is a moment owned by an account
+	StatusHeld = "held"
+	// StatusEscrowed is a moment held by the bridge while it lives in the other VM
+	StatusEscrowed = "escrowed"
+	// StatusWrapped is a legacy token held by the wrapper
+	StatusWrapped = "wrapped"
+	// StatusWithdrawn is a moment withdrawn from a collection and deposited in none
+	StatusWithdrawn = "withdrawn"
+)
+
+// Issue kinds
+const (
+	// IssueDoubleCounted is a moment held in more than one place
+	IssueDoubleCounted = "double-counted"
+	// IssueMissing is a moment held nowhere
+	IssueMissing = "missing"
+	// IssueStuckWrapped is a legacy token held by the wrapper, with no wrapper token to unwrap it
+	IssueStuckWrapped = "stuck-wrapped"
+)
+
+// Config names the accounts of the bridge and the COAs of the owners
+type Config struct {
+	// CadenceEscrows are the Cadence accounts of the bridge, whose collections escrow moments
+	CadenceEscrows []string
+	// EVMEscrows are the EVM addresses of the bridge, such as its COA, which escrow tokens
+	EVMEscrows []common.Address
+	// COAs are the COAs of Cadence accounts, so that tokens are attributed to the accounts owning them
+	COAs map[string]common.Address
+}
+
+// Holding is a place a moment is found in
+type Holding struct {
+	Kind   string `json:"kind"`
+	Status string `json:"status"`
+	// Owner is the Cadence or EVM address holding the moment, or the last owner of a withdrawn moment
+	Owner string `json:"owner,omitempty"`
+}
+
+// Issue is a moment not held in exactly one place
+type Issue struct {
+	MomentID uint64    `json:"momentId"`
+	Kind     string    `json:"kind"`
+	Holdings []Holding `json:"holdings"`
+}
+
+// OwnerReport counts the moments of an owner, an account with its COA or an EVM address
+type OwnerReport struct {
+	// Account is the Cadence account, empty for an EVM address that is not a known COA
+	Account string `json:"account,omitempty"`
+	// EVMAddr is the COA of the account, or the EVM address
+	EVMAddr string `json:"evmAddr,omitempty"`
+	Cadence int    `json:"cadence"`
+	Legacy  int    `json:"legacy"`
+	Wrapped int    `json:"wrapped"`
+	// Issues are the moments with issues the owner holds or last held
+	Issues []uint64 `json:"issues,omitempty"`
+}
+
+// Key identifies the owner in reports
+func (o *OwnerReport) Key() string {
+	return cmp.Or(o.Account, o.EVMAddr)
+}
+
+// Report is the outcome of a reconciliation
+type Report struct {
+	Moments int            `json:"moments"`
+	Counts  map[string]int `json:"counts"`
+	Issues  []Issue        `json:"issues"`
+	Owners  []*OwnerReport `json:"owners"`
+}
+
+// Reconcile finds the moments of the snapshots that are not held in exactly one place
+func Reconcile(cadence *CadenceSnapshot, evm *EVMSnapshot, config Config) *Report {
+	r := newReconciler(evm, config)
+
+	ids := cadence.ids()
+	for id := range evm.legacy {
+		ids[id] = true
+	}
+	for id := range evm.wrapper {
+		ids[id] = true
+	}
+	for id := range cadence.destroyed {
+		delete(ids, id)
+	}
+
+	report := &Report{Moments: len(ids), Counts: map[string]int{}}
+	for _, id := range slices.Sorted(maps.Keys(ids)) {
+		holdings := r.holdings(cadence, evm, id)
+		if issue, ok := check(id, holdings); ok {
+			report.Issues = append(report.Issues, issue)
+			report.Counts[issue.Kind]++
+			r.attributeIssue(holdings, id)
+		}
+	}
+
+	report.Owners = slices.SortedFunc(maps.Values(r.owners), func(a, b *OwnerReport) int {
+		return strings.Compare(a.Key(), b.Key())
+	})
+	return report
+}
+
+type reconciler struct {
+	evm            *EVMSnapshot
+	cadenceEscrows map[string]bool
+	evmEscrows     map[common.Address]bool
+	accountsByCOA  map[common.Address]string
+	coas           map[string]common.Address
+	owners         map[string]*OwnerReport
+}
+
+func newReconciler(evm *EVMSnapshot, config Config) *reconciler {
+	r := &reconciler{
+		evm:            evm,
+		cadenceEscrows: map[string]bool{},
+		evmEscrows:     map[common.Address]bool{},
+		accountsByCOA:  map[common.Address]string{},
+		coas:           map[string]common.Address{},
+		owners:         map[string]*OwnerReport{},
+	}
+	for _, escrow := range config.CadenceEscrows {
+		r.cadenceEscrows[eventfile.NormalizeAddress(escrow)] = true
+	}
+	for _, escrow := range config.EVMEscrows {
+		r.evmEscrows[escrow] = true
+	}
+	for account, coa := range config.COAs {
+		r.accountsByCOA[coa] = eventfile.NormalizeAddress(account)
+		r.coas[eventfile.NormalizeAddress(account)] = coa
+	}
+	return r
+}
+
+func (r *reconciler) holdings(cadence *CadenceSnapshot, evm *EVMSnapshot, id uint64) []Holding {
+	var holdings []Holding
+	if owner, ok := cadence.owners[id]; ok {
+		status := StatusHeld
+		if r.cadenceEscrows[owner] {
+			status = StatusEscrowed
+		} else {
+			r.cadenceOwner(owner).Cadence++
+		}
+		holdings = append(holdings, Holding{Kind: HoldingCadence, Status: status, Owner: owner})
+	} else if from, ok := cadence.withdrawn[id]; ok {
+		holdings = append(holdings, Holding{Kind: HoldingCadence, Status: StatusWithdrawn, Owner: from})
+	}
+
+	if owner, ok := evm.legacy[id]; ok {
+		status := StatusHeld
+		switch {
+		case owner == evm.WrapperAddr:
+			status = StatusWrapped
+		case r.evmEscrows[owner]:
+			status = StatusEscrowed
+		default:
+			r.evmOwner(owner).Legacy++
+		}
+		holdings = append(holdings, Holding{Kind: HoldingLegacy, Status: status, Owner: formatEVMAddress(owner)})
+	}
+
+	if owner, ok := evm.wrapper[id]; ok {
+		status := StatusHeld
+		if r.evmEscrows[owner] {
+			status = StatusEscrowed
+		} else {
+			r.evmOwner(owner).Wrapped++
+		}
+		holdings = append(holdings, Holding{Kind: HoldingWrapper, Status: status, Owner: formatEVMAddress(owner)})
+	}
+	return holdings
+}
+
+func check(id uint64, holdings []Holding) (Issue, bool) {
+	held, wrapped, wrapperToken := 0, false, false
+	for _, holding := range holdings {
+		switch {
+		case holding.Status == StatusHeld:
+			held++
+		case holding.Status == StatusWrapped:
+			wrapped = true
+		}
+		if holding.Kind == HoldingWrapper {
+			wrapperToken = true
+		}
+	}
+
+	issue := Issue{MomentID: id, Holdings: holdings}
+	switch {
+	case held > 1:
+		issue.Kind = IssueDoubleCounted
+	case wrapped && !wrapperToken:
+		issue.Kind = IssueStuckWrapped
+	case held == 0:
+		issue.Kind = IssueMissing
+	default:
+		return Issue{}, false
+	}
+	return issue, true
+}
+
+// attributeIssue lists the moment under every owner that holds it or last held it
+func (r *reconciler) attributeIssue(holdings []Holding, id uint64) {
+	attributed := map[*OwnerReport]bool{}
+	for _, holding := range holdings {
+		if holding.Owner == "" || holding.Status == StatusEscrowed || holding.Status == StatusWrapped {
+			continue
+		}
+
+		var owner *OwnerReport
+		if holding.Kind == HoldingCadence {
+			owner = r.cadenceOwner(holding.Owner)
+		} else {
+			owner = r.evmOwner(common.HexToAddress(holding.Owner))
+		}
+		if !attributed[owner] {
+			owner.Issues = append(owner.Issues, id)
+			attributed[owner] = true
+		}
+	}
+}
+
+func (r *reconciler) cadenceOwner(account string) *OwnerReport {
+	owner, ok := r.owners[account]
+	if !ok {
+		owner = &OwnerReport{Account: account}
+		if coa, ok := r.coas[account]; ok {
+			owner.EVMAddr = formatEVMAddress(coa)
+		}
+		r.owners[account] = owner
+	}
+	return owner
+}
+
+func (r *reconciler) evmOwner(addr common.Address) *OwnerReport {
+	if account, ok := r.accountsByCOA[addr]; ok {
+		return r.cadenceOwner(account)
+	}
+
+	key := formatEVMAddress(addr)
+	owner, ok := r.owners[key]
+	if !ok {
+		owner = &OwnerReport{EVMAddr: key}
+		r.owners[key] = owner
+	}
+	return owner
+}
+
+func formatEVMAddress(addr common.Address) string {
+	return strings.ToLower(addr.Hex())
+}
@@ -0,0 +1,236 @@
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/cdctest"
+)
+
+var (
+	legacyAddr  = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	wrapperAddr = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	bridgeCOA   = common.HexToAddress("0x0000000000000000000000020000000000000000")
+	aliceCOA    = common.HexToAddress("0x00000000000000000000000200000000000000a1")
+	eve         = common.HexToAddress("0x00000000000000000000000000000000000000e5")
+)
+
+const (
+	alice  = "0x00000000000000a1"
+	bob    = "0x00000000000000b0"
+	bridge = "0x00000000000000f1"
+)
+
+// cadenceEvent encodes a TopShot event moving a moment, with the optional address field if any
+func cadenceEvent(name string, id uint64, field, addr string) string {
+	fields := []string{cdctest.Field("id", "UInt64", id)}
+	if field != "" {
+		fields = append(fields, cdctest.FieldOf(field, cdctest.OptionalAddress(addr)))
+	}
+	return cdctest.Event("TopShot", name, fields...)
+}
+
+func deposit(id uint64, to string) string    { return cadenceEvent("Deposit", id, "to", to) }
+func withdraw(id uint64, from string) string { return cadenceEvent("Withdraw", id, "from", from) }
+
+func transfer(contract common.Address, block uint64, index uint, from, to common.Address, id uint64) types.Log {
+	return types.Log{
+		Address: contract,
+		Topics: []common.Hash{
+			TransferTopic,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+			common.BigToHash(new(big.Int).SetUint64(id)),
+		},
+		Data:        []byte{},
+		BlockNumber: block,
+		Index:       index,
+	}
+}
+
+func readCadence(t *testing.T, lines ...string) *CadenceSnapshot {
+	t.Helper()
+	snapshot, err := ReadCadenceEvents(strings.NewReader(strings.Join(lines, "\n")))
+	require.NoError(t, err)
+	return snapshot
+}
+
+func TestCadenceSnapshot(t *testing.T) {
+	snapshot := readCadence(t,
+		deposit(1, alice),
+		deposit(2, alice),
+		withdraw(1, alice),
+		deposit(1, bob),
+		"",
+		withdraw(2, alice),
+		cadenceEvent("MomentMinted", 3, "", ""),
+		deposit(3, ""),
+		deposit(4, alice),
+		withdraw(4, alice),
+		cadenceEvent("MomentDestroyed", 4, "", ""),
+	)
+
+	owner, ok := snapshot.Owner(1)
+	assert.True(t, ok)
+	assert.Equal(t, bob, owner)
+	_, ok = snapshot.Owner(2)
+	assert.False(t, ok, "a withdrawn moment is in no collection")
+	assert.Equal(t, alice, snapshot.withdrawn[2])
+	assert.Equal(t, "", snapshot.withdrawn[3], "a deposit into a collection stored nowhere has no owner")
+	assert.Equal(t, map[uint64]bool{1: true, 2: true, 3: true}, snapshot.ids())
+
+	_, err := ReadCadenceEvents(strings.NewReader(deposit(1, alice) + "\n{"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestEVMSnapshot(t *testing.T) {
+	snapshot := NewEVMSnapshot(legacyAddr, wrapperAddr)
+	erc20Transfer := transfer(legacyAddr, 1, 5, common.Address{}, eve, 9)
+	erc20Transfer.Topics = erc20Transfer.Topics[:3]
+	removed := transfer(wrapperAddr, 4, 0, common.Address{}, eve, 9)
+	removed.Removed = true
+
+	require.NoError(t, snapshot.Apply([]types.Log{
+		// out of order, as fetched from several ranges
+		transfer(legacyAddr, 3, 0, eve, common.Address{}, 2),
+		transfer(legacyAddr, 1, 0, common.Address{}, eve, 1),
+		transfer(legacyAddr, 1, 1, common.Address{}, eve, 2),
+		transfer(legacyAddr, 2, 0, eve, wrapperAddr, 1),
+		transfer(wrapperAddr, 2, 1, common.Address{}, aliceCOA, 1),
+		transfer(eve, 2, 2, common.Address{}, eve, 3),
+		erc20Transfer,
+		removed,
+	}))
+
+	owner, ok := snapshot.LegacyOwner(1)
+	assert.True(t, ok)
+	assert.Equal(t, wrapperAddr, owner)
+	owner, ok = snapshot.WrapperOwner(1)
+	assert.True(t, ok)
+	assert.Equal(t, aliceCOA, owner)
+	_, ok = snapshot.LegacyOwner(2)
+	assert.False(t, ok, "a burned token has no owner")
+	assert.Len(t, snapshot.legacy, 1)
+	assert.Len(t, snapshot.wrapper, 1)
+
+	tooLarge := transfer(wrapperAddr, 5, 0, common.Address{}, eve, 0)
+	tooLarge.Topics[3] = common.MaxHash
+	assert.ErrorContains(t, snapshot.Apply([]types.Log{tooLarge}), "is not a moment ID")
+}
+
+func TestLogFile(t *testing.T) {
+	logs := []types.Log{transfer(legacyAddr, 1, 0, common.Address{}, eve, 1), transfer(wrapperAddr, 2, 3, eve, aliceCOA, 1)}
+	logs[0].TxHash = common.HexToHash("0x01")
+
+	var file bytes.Buffer
+	require.NoError(t, WriteLogs(&file, logs))
+	read, err := ReadLogs(&file)
+	require.NoError(t, err)
+	assert.Equal(t, logs, read)
+
+	_, err = ReadLogs(strings.NewReader(`{"address": "0x"}`))
+	assert.Error(t, err)
+}
+
+// rangeFilterer returns one log per query and records the ranges queried
+type rangeFilterer struct {
+	ranges [][2]uint64
+}
+
+func (f *rangeFilterer) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	f.ranges = append(f.ranges, [2]uint64{query.FromBlock.Uint64(), query.ToBlock.Uint64()})
+	return []types.Log{transfer(query.Addresses[0], query.FromBlock.Uint64(), 0, common.Address{}, eve, 1)}, nil
+}
+
+func (f *rangeFilterer) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func TestFetchLogs(t *testing.T) {
+	filterer := &rangeFilterer{}
+	logs, err := FetchLogs(context.Background(), filterer, []common.Address{legacyAddr, wrapperAddr}, 10, 34, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][2]uint64{{10, 19}, {20, 29}, {30, 34}}, filterer.ranges)
+	assert.Len(t, logs, 3)
+
+	_, err = FetchLogs(context.Background(), filterer, nil, 0, 1, 0)
+	assert.Error(t, err)
+}
+
+func TestReconcile(t *testing.T) {
+	cadence := readCadence(t,
+		// 1 is on Cadence
+		deposit(1, alice),
+		// 2 was bridged to alice's COA
+		deposit(2, alice), withdraw(2, alice),
+		// 3 is on Cadence and on EVM
+		deposit(3, alice),
+		// 4 left bob's collection and went nowhere
+		deposit(4, bob), withdraw(4, bob),
+		// 5 is escrowed on Cadence, its legacy token is wrapped but has no wrapper token
+		deposit(5, bridge),
+		// 6 was bridged as a legacy token then wrapped by eve
+		deposit(6, bob), withdraw(6, bob),
+		// 7 was bridged back to bob from the wrapper
+		deposit(7, bob),
+		// 8 was destroyed
+		deposit(8, bob), withdraw(8, bob), cadenceEvent("MomentDestroyed", 8, "", ""),
+	)
+
+	evm := NewEVMSnapshot(legacyAddr, wrapperAddr)
+	require.NoError(t, evm.Apply([]types.Log{
+		transfer(wrapperAddr, 1, 0, common.Address{}, aliceCOA, 2),
+		transfer(legacyAddr, 1, 1, common.Address{}, eve, 3),
+		transfer(legacyAddr, 1, 2, common.Address{}, eve, 5),
+		transfer(legacyAddr, 1, 3, eve, wrapperAddr, 5),
+		transfer(legacyAddr, 1, 4, common.Address{}, eve, 6),
+		transfer(legacyAddr, 1, 5, eve, wrapperAddr, 6),
+		transfer(wrapperAddr, 1, 6, common.Address{}, eve, 6),
+		transfer(wrapperAddr, 1, 7, common.Address{}, eve, 7),
+		transfer(wrapperAddr, 1, 8, eve, bridgeCOA, 7),
+	}))
+
+	report := Reconcile(cadence, evm, Config{
+		CadenceEscrows: []string{bridge},
+		EVMEscrows:     []common.Address{bridgeCOA},
+		COAs:           map[string]common.Address{"00000000000000a1": aliceCOA},
+	})
+
+	assert.Equal(t, 7, report.Moments)
+	require.Len(t, report.Issues, 3)
+	assert.Equal(t, Issue{MomentID: 3, Kind: IssueDoubleCounted, Holdings: []Holding{
+		{Kind: HoldingCadence, Status: StatusHeld, Owner: alice},
+		{Kind: HoldingLegacy, Status: StatusHeld, Owner: strings.ToLower(eve.Hex())},
+	}}, report.Issues[0])
+	assert.Equal(t, Issue{MomentID: 4, Kind: IssueMissing, Holdings: []Holding{
+		{Kind: HoldingCadence, Status: StatusWithdrawn, Owner: bob},
+	}}, report.Issues[1])
+	assert.Equal(t, uint64(5), report.Issues[2].MomentID)
+	assert.Equal(t, IssueStuckWrapped, report.Issues[2].Kind)
+	assert.Equal(t, map[string]int{IssueDoubleCounted: 1, IssueMissing: 1, IssueStuckWrapped: 1}, report.Counts)
+
+	owners := map[string]OwnerReport{}
+	for _, owner := range report.Owners {
+		owners[owner.Key()] = *owner
+	}
+	assert.Equal(t, OwnerReport{Account: alice, EVMAddr: strings.ToLower(aliceCOA.Hex()), Cadence: 2, Wrapped: 1, Issues: []uint64{3}}, owners[alice])
+	assert.Equal(t, OwnerReport{Account: bob, Cadence: 1, Issues: []uint64{4}}, owners[bob])
+	assert.Equal(t, OwnerReport{EVMAddr: strings.ToLower(eve.Hex()), Legacy: 1, Wrapped: 1, Issues: []uint64{3}}, owners[strings.ToLower(eve.Hex())])
+	assert.Len(t, owners, 3, "escrows are not owners")
+
+	var text bytes.Buffer
+	require.NoError(t, report.WriteText(&text))
+	assert.Contains(t, text.String(), "7 moments reconciled: 1 double-counted, 1 missing, 1 stuck wrapped")
+	assert.Contains(t, text.String(), "5       stuck-wrapped   cadence escrowed by "+bridge)
+}
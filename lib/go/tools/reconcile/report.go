@@ -0,0 +1,53 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// WriteJSON writes the report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteText writes the issues, then the moments and issues of each owner, as tables
+func (r *Report) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "%d moments reconciled: %d double-counted, %d missing, %d stuck wrapped\n\n",
+		r.Moments, r.Counts[IssueDoubleCounted], r.Counts[IssueMissing], r.Counts[IssueStuckWrapped])
+
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if len(r.Issues) > 0 {
+		fmt.Fprintln(table, "MOMENT\tISSUE\tHOLDINGS")
+		for _, issue := range r.Issues {
+			holdings := make([]string, len(issue.Holdings))
+			for i, holding := range issue.Holdings {
+				holdings[i] = fmt.Sprintf("%s %s by %s", holding.Kind, holding.Status, holding.Owner)
+			}
+			fmt.Fprintf(table, "%d\t%s\t%s\n", issue.MomentID, issue.Kind, strings.Join(holdings, ", "))
+		}
+		fmt.Fprintln(table)
+	}
+
+	fmt.Fprintln(table, "ACCOUNT\tEVM ADDRESS\tCADENCE\tLEGACY\tWRAPPED\tISSUES")
+	for _, owner := range r.Owners {
+		issues := make([]string, len(owner.Issues))
+		for i, id := range owner.Issues {
+			issues[i] = fmt.Sprint(id)
+		}
+		fmt.Fprintf(table, "%s\t%s\t%d\t%d\t%d\t%s\n",
+			dash(owner.Account), dash(owner.EVMAddr), owner.Cadence, owner.Legacy, owner.Wrapped, dash(strings.Join(issues, " ")))
+	}
+	return table.Flush()
+}
+
+func dash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,427 @@
+package supply
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Kind is the kind of a finding
+type Kind string
+
+const (
+	// SerialGap is a serial of an edition or subedition the contract
+	// assigned that no moment has
+	SerialGap Kind = "SERIAL_GAP"
+	// DuplicateSerial is a serial several moments of an edition or subedition have
+	DuplicateSerial Kind = "DUPLICATE_SERIAL"
+	// UnexpectedSerial is a serial of an edition or subedition the contract did not assign
+	UnexpectedSerial Kind = "UNEXPECTED_SERIAL"
+	// MintedAfterRetirement is a moment minted after PlayRetiredFromSet
+	MintedAfterRetirement Kind = "MINTED_AFTER_RETIREMENT"
+	// MintedAfterLock is a moment minted after SetLocked, reported with
+	// Options.LockedSetsClosed
+	MintedAfterLock Kind = "MINTED_AFTER_LOCK"
+	// RetiredCountMismatch is a PlayRetiredFromSet whose numMoments is not
+	// the number of moments of the edition minted before it
+	RetiredCountMismatch Kind = "RETIRED_COUNT_MISMATCH"
+	// EditionCountMismatch is a getNumMomentsInEdition that is not the
+	// number of moments of the edition minted
+	EditionCountMismatch Kind = "EDITION_COUNT_MISMATCH"
+	// SubeditionCountMismatch is a number minted per subedition that is not
+	// the number of moments minted with the subedition
+	SubeditionCountMismatch Kind = "SUBEDITION_COUNT_MISMATCH"
+	// SharedSubeditionCounter is subeditions of different editions counted
+	// under the same key by the SubeditionAdmin
+	SharedSubeditionCounter Kind = "SHARED_SUBEDITION_COUNTER"
+	// TotalSupplyMismatch is a totalSupply that is not the number of moments minted
+	TotalSupplyMismatch Kind = "TOTAL_SUPPLY_MISMATCH"
+	// MomentIDGap is the ids below the greatest id minted that no MomentMinted gives
+	MomentIDGap Kind = "MOMENT_ID_GAP"
+	// DuplicateMoment is a moment id several MomentMinted give
+	DuplicateMoment Kind = "DUPLICATE_MOMENT"
+)
+
+// Finding is an inconsistency of the supply
+type Finding struct {
+	Kind Kind `json:"kind"`
+	// Edition and SubeditionID are the edition and subedition of the
+	// finding, zero for the findings of the whole supply
+	Edition
+	SubeditionID uint32   `json:"subeditionId,omitempty"`
+	Serials      []uint32 `json:"serials,omitempty"`
+	MomentIDs    []uint64 `json:"momentIds,omitempty"`
+	Detail       string   `json:"detail"`
+}
+
+// Chain reads the counters of TopShot
+type Chain interface {
+	// NumMomentsInEdition reads getNumMomentsInEdition
+	NumMomentsInEdition(ctx context.Context, edition Edition) (uint32, error)
+	// NumMomentsInSubedition reads the number minted per subedition of the SubeditionAdmin
+	NumMomentsInSubedition(ctx context.Context, edition Edition, subeditionID uint32) (uint32, error)
+	// TotalSupply reads totalSupply
+	TotalSupply(ctx context.Context) (uint64, error)
+}
+
+// Options of an audit
+type Options struct {
+	// LockedSetsClosed reports the moments minted after their set was
+	// locked. A lock only stops plays from being added to the set, and
+	// TopShot keeps minting the plays of locked sets, so this is for
+	// histories whose sets are closed once locked.
+	LockedSetsClosed bool
+}
+
+// EditionReport is the supply of an edition
+type EditionReport struct {
+	Edition
+	Minted    uint32 `json:"minted"`
+	Destroyed uint32 `json:"destroyed"`
+	// NumMomentsInEdition is getNumMomentsInEdition, nil without a chain
+	NumMomentsInEdition *uint32             `json:"numMomentsInEdition,omitempty"`
+	Subeditions         []*SubeditionReport `json:"subeditions,omitempty"`
+	Retired             bool                `json:"retired"`
+	SetLocked           bool                `json:"setLocked"`
+}
+
+// SubeditionReport is the moments of an edition minted with a subedition
+type SubeditionReport struct {
+	SubeditionID uint32 `json:"subeditionId"`
+	Minted       uint32 `json:"minted"`
+	// NumberMinted is the number minted per subedition of the
+	// SubeditionAdmin, which counts the subeditions sharing its key, nil
+	// without a chain
+	NumberMinted *uint32 `json:"numberMinted,omitempty"`
+}
+
+// Report is the outcome of an audit
+type Report struct {
+	Minted    uint64 `json:"minted"`
+	Destroyed uint64 `json:"destroyed"`
+	// Supply is the moments minted and not destroyed
+	Supply uint64 `json:"supply"`
+	// TotalSupply is totalSupply, nil without a chain
+	TotalSupply *uint64          `json:"totalSupply,omitempty"`
+	Editions    []*EditionReport `json:"editions"`
+	Findings    []Finding        `json:"findings"`
+	Counts      map[Kind]int     `json:"counts"`
+}
+
+// seriesKey identifies the moments of an edition numbered alike: those
+// minted without a subedition, or with one
+type seriesKey struct {
+	edition        Edition
+	subeditionID   uint32
+	withSubedition bool
+}
+
+// series is the moments of an edition numbered alike
+type series struct {
+	seriesKey
+	// assigned are the serials the contract assigned, in ascending order
+	assigned []uint32
+	// moments are the moments of each serial
+	moments map[uint32][]uint64
+}
+
+// Audit checks the serials, retirements and moment ids of the history, and
+// the counters of the chain against it when chain is not nil
+func Audit(ctx context.Context, h *History, chain Chain, opts Options) (*Report, error) {
+	a := &auditor{history: h, report: &Report{Counts: map[Kind]int{}}}
+	a.editions()
+	a.serials()
+	a.mints(opts)
+	a.retirements()
+	a.momentIDs()
+	if chain != nil {
+		if err := a.counters(ctx, chain); err != nil {
+			return nil, err
+		}
+	}
+	return a.report, nil
+}
+
+type auditor struct {
+	history *History
+	report  *Report
+	// byEdition are the reports of the editions
+	byEdition map[Edition]*EditionReport
+	// series are the series of the editions, in the order of their first mint
+	series []*series
+	// keys are the series of each key of the SubeditionAdmin
+	keys map[string][]*series
+	// counted are the moments each key of the SubeditionAdmin counts
+	counted map[string]uint32
+}
+
+func (a *auditor) add(f Finding) {
+	a.report.Findings = append(a.report.Findings, f)
+	a.report.Counts[f.Kind]++
+}
+
+func (a *auditor) editions() {
+	a.byEdition = map[Edition]*EditionReport{}
+	for _, mint := range a.history.mints {
+		edition := a.edition(mint.Edition)
+		edition.Minted++
+		a.report.Minted++
+		if a.history.destroyed[mint.MomentID] {
+			edition.Destroyed++
+			a.report.Destroyed++
+		}
+	}
+	for edition := range a.history.retirements {
+		a.edition(edition)
+	}
+	a.report.Supply = a.report.Minted - a.report.Destroyed
+
+	a.report.Editions = slices.SortedFunc(maps.Values(a.byEdition), func(x, y *EditionReport) int {
+		return cmp.Or(cmp.Compare(x.SetID, y.SetID), cmp.Compare(x.PlayID, y.PlayID))
+	})
+}
+
+func (a *auditor) edition(e Edition) *EditionReport {
+	edition, ok := a.byEdition[e]
+	if !ok {
+		_, retired := a.history.retirements[e]
+		edition = &EditionReport{Edition: e, Retired: retired, SetLocked: a.history.locked[e.SetID]}
+		a.byEdition[e] = edition
+	}
+	return edition
+}
+
+// serials replays the numbering of the contract: a moment minted without a
+// subedition is numbered after every moment of its edition, and one minted
+// with a subedition after the moments counted under its key
+func (a *auditor) serials() {
+	bySeries := map[seriesKey]*series{}
+	minted := map[Edition]uint32{}
+	a.keys, a.counted = map[string][]*series{}, map[string]uint32{}
+
+	for _, mint := range a.history.mints {
+		key := seriesKey{edition: mint.Edition, subeditionID: mint.SubeditionID, withSubedition: mint.WithSubedition}
+		s, ok := bySeries[key]
+		if !ok {
+			s = &series{seriesKey: key, moments: map[uint32][]uint64{}}
+			bySeries[key] = s
+			a.series = append(a.series, s)
+		}
+
+		serial := minted[mint.Edition] + 1
+		if mint.WithSubedition {
+			key := subeditionKey(mint.Edition, mint.SubeditionID)
+			a.counted[key]++
+			serial = a.counted[key]
+			if !slices.Contains(a.keys[key], s) {
+				a.keys[key] = append(a.keys[key], s)
+			}
+		}
+		minted[mint.Edition]++
+		s.assigned = append(s.assigned, serial)
+		s.moments[mint.SerialNumber] = append(s.moments[mint.SerialNumber], mint.MomentID)
+	}
+
+	for _, s := range a.series {
+		slices.Sort(s.assigned)
+		assigned := map[uint32]bool{}
+		var gaps, unexpected []uint32
+		for _, serial := range s.assigned {
+			assigned[serial] = true
+			if _, ok := s.moments[serial]; !ok {
+				gaps = append(gaps, serial)
+			}
+		}
+		var unexpectedMoments []uint64
+		for _, serial := range slices.Sorted(maps.Keys(s.moments)) {
+			moments := s.moments[serial]
+			if !assigned[serial] {
+				unexpected = append(unexpected, serial)
+				unexpectedMoments = append(unexpectedMoments, moments...)
+			}
+			if len(moments) > 1 {
+				a.add(Finding{
+					Kind: DuplicateSerial, Edition: s.edition, SubeditionID: s.subeditionID,
+					Serials: []uint32{serial}, MomentIDs: moments,
+					Detail: fmt.Sprintf("%d moments of %s have serial %d", len(moments), s, serial),
+				})
+			}
+		}
+		if len(gaps) > 0 {
+			a.add(Finding{
+				Kind: SerialGap, Edition: s.edition, SubeditionID: s.subeditionID, Serials: gaps,
+				Detail: fmt.Sprintf("%d serials of %s assigned by the contract are missing", len(gaps), s),
+			})
+		}
+		if len(unexpected) > 0 {
+			a.add(Finding{
+				Kind: UnexpectedSerial, Edition: s.edition, SubeditionID: s.subeditionID,
+				Serials: unexpected, MomentIDs: unexpectedMoments,
+				Detail: fmt.Sprintf("%d serials of %s were not assigned by the contract", len(unexpected), s),
+			})
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(a.keys)) {
+		shared := a.keys[key]
+		editions := map[Edition]bool{}
+		for _, s := range shared {
+			editions[s.edition] = true
+		}
+		if len(editions) < 2 {
+			continue
+		}
+		names := make([]string, len(shared))
+		for i, s := range shared {
+			names[i] = s.String()
+		}
+		a.add(Finding{
+			Kind: SharedSubeditionCounter, Edition: shared[0].edition, SubeditionID: shared[0].subeditionID,
+			Detail: fmt.Sprintf("%s are counted under the key %q of the SubeditionAdmin", strings.Join(names, " and "), key),
+		})
+	}
+
+	for _, s := range a.series {
+		if !s.withSubedition {
+			continue
+		}
+		edition := a.byEdition[s.edition]
+		edition.Subeditions = append(edition.Subeditions, &SubeditionReport{SubeditionID: s.subeditionID, Minted: uint32(len(s.assigned))})
+	}
+	for _, edition := range a.report.Editions {
+		slices.SortFunc(edition.Subeditions, func(x, y *SubeditionReport) int {
+			return cmp.Compare(x.SubeditionID, y.SubeditionID)
+		})
+	}
+}
+
+func (s *series) String() string {
+	if !s.withSubedition {
+		return s.edition.String()
+	}
+	return fmt.Sprintf("%s subedition %d", s.edition, s.subeditionID)
+}
+
+// mints finds the moments minted after their edition was retired, or their
+// set locked when locked sets are closed
+func (a *auditor) mints(opts Options) {
+	retired, locked := map[Edition][]uint64{}, map[Edition][]uint64{}
+	for _, mint := range a.history.mints {
+		switch {
+		case mint.Retired:
+			retired[mint.Edition] = append(retired[mint.Edition], mint.MomentID)
+		case mint.Locked && opts.LockedSetsClosed:
+			locked[mint.Edition] = append(locked[mint.Edition], mint.MomentID)
+		}
+	}
+
+	for _, edition := range a.report.Editions {
+		if moments := retired[edition.Edition]; len(moments) > 0 {
+			a.add(Finding{
+				Kind: MintedAfterRetirement, Edition: edition.Edition, MomentIDs: moments,
+				Detail: fmt.Sprintf("%d moments of %s were minted after it was retired", len(moments), edition.Edition),
+			})
+		}
+		if moments := locked[edition.Edition]; len(moments) > 0 {
+			a.add(Finding{
+				Kind: MintedAfterLock, Edition: edition.Edition, MomentIDs: moments,
+				Detail: fmt.Sprintf("%d moments of %s were minted after its set was locked", len(moments), edition.Edition),
+			})
+		}
+	}
+}
+
+func (a *auditor) retirements() {
+	for _, edition := range a.report.Editions {
+		retirement, ok := a.history.retirements[edition.Edition]
+		if !ok || retirement.NumMoments == retirement.Minted {
+			continue
+		}
+		a.add(Finding{
+			Kind: RetiredCountMismatch, Edition: edition.Edition,
+			Detail: fmt.Sprintf("%s was retired with %d moments but %d were minted before", edition.Edition, retirement.NumMoments, retirement.Minted),
+		})
+	}
+}
+
+// momentIDs checks that the moment ids, which count the moments minted,
+// are each minted once from 1
+func (a *auditor) momentIDs() {
+	var last uint64
+	for _, mint := range a.history.mints {
+		last = max(last, mint.MomentID)
+	}
+	var gaps []uint64
+	for id := uint64(1); id <= last; id++ {
+		if _, ok := a.history.moments[id]; !ok {
+			gaps = append(gaps, id)
+		}
+	}
+	if len(gaps) > 0 {
+		a.add(Finding{
+			Kind: MomentIDGap, MomentIDs: gaps,
+			Detail: fmt.Sprintf("%d moment ids up to %d were never minted", len(gaps), last),
+		})
+	}
+
+	for _, duplicate := range a.history.duplicates {
+		first := a.history.moments[duplicate.MomentID]
+		a.add(Finding{
+			Kind: DuplicateMoment, Edition: duplicate.Edition, MomentIDs: []uint64{duplicate.MomentID},
+			Detail: fmt.Sprintf("moment %d was minted again as serial %d of %s, after serial %d of %s",
+				duplicate.MomentID, duplicate.SerialNumber, duplicate.Edition, first.SerialNumber, first.Edition),
+		})
+	}
+}
+
+// counters checks getNumMomentsInEdition, the number minted per subedition
+// and totalSupply against the history
+func (a *auditor) counters(ctx context.Context, chain Chain) error {
+	for _, edition := range a.report.Editions {
+		count, err := chain.NumMomentsInEdition(ctx, edition.Edition)
+		if err != nil {
+			return err
+		}
+		edition.NumMomentsInEdition = &count
+		if count != edition.Minted {
+			a.add(Finding{
+				Kind: EditionCountMismatch, Edition: edition.Edition,
+				Detail: fmt.Sprintf("getNumMomentsInEdition of %s is %d but %d moments were minted", edition.Edition, count, edition.Minted),
+			})
+		}
+
+		for _, subedition := range edition.Subeditions {
+			count, err := chain.NumMomentsInSubedition(ctx, edition.Edition, subedition.SubeditionID)
+			if err != nil {
+				return err
+			}
+			subedition.NumberMinted = &count
+			counted := a.counted[subeditionKey(edition.Edition, subedition.SubeditionID)]
+			if count != counted {
+				a.add(Finding{
+					Kind: SubeditionCountMismatch, Edition: edition.Edition, SubeditionID: subedition.SubeditionID,
+					Detail: fmt.Sprintf("getNumberMintedPerSubedition of %s subedition %d is %d but %d moments were minted", edition.Edition, subedition.SubeditionID, count, counted),
+				})
+			}
+		}
+	}
+
+	totalSupply, err := chain.TotalSupply(ctx)
+	if err != nil {
+		return err
+	}
+	a.report.TotalSupply = &totalSupply
+	// totalSupply is the id of the last moment minted and is not
+	// decremented when moments are destroyed
+	if totalSupply != a.report.Minted {
+		a.add(Finding{
+			Kind: TotalSupplyMismatch,
+			Detail: fmt.Sprintf("totalSupply is %d but %d moments were minted, %d of them destroyed",
+				totalSupply, a.report.Minted, a.report.Destroyed),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package supply
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/templates"
+)
+
+// FlowChain is the Chain of an access node or emulator
+type FlowChain struct {
+	Client *grpc.Client
+	Env    templates.Environment
+}
+
+// NumMomentsInEdition reads the counter of the edition with get_numMoments_in_edition
+func (c *FlowChain) NumMomentsInEdition(ctx context.Context, edition Edition) (uint32, error) {
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetNumMomentsInEditionScript(c.Env), []cadence.Value{
+		cadence.NewUInt32(edition.SetID),
+		cadence.NewUInt32(edition.PlayID),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get_numMoments_in_edition of %s: %w", edition, err)
+	}
+	count, ok := result.(cadence.UInt32)
+	if !ok {
+		return 0, fmt.Errorf("unexpected number of moments %s of %s", result, edition)
+	}
+	return uint32(count), nil
+}
+
+// NumMomentsInSubedition reads the counter of the subedition with get_numMoments_in_subedition
+func (c *FlowChain) NumMomentsInSubedition(ctx context.Context, edition Edition, subeditionID uint32) (uint32, error) {
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetNumMomentsInSubeditionScript(c.Env), []cadence.Value{
+		cadence.NewUInt32(edition.SetID),
+		cadence.NewUInt32(edition.PlayID),
+		cadence.NewUInt32(subeditionID),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get_numMoments_in_subedition of %s subedition %d: %w", edition, subeditionID, err)
+	}
+	count, ok := result.(cadence.UInt32)
+	if !ok {
+		return 0, fmt.Errorf("unexpected number of moments %s of %s subedition %d", result, edition, subeditionID)
+	}
+	return uint32(count), nil
+}
+
+// TotalSupply reads totalSupply with get_totalSupply
+func (c *FlowChain) TotalSupply(ctx context.Context) (uint64, error) {
+	result, err := c.Client.ExecuteScriptAtLatestBlock(ctx, templates.GenerateGetSupplyScript(c.Env), nil)
+	if err != nil {
+		return 0, fmt.Errorf("get_totalSupply: %w", err)
+	}
+	supply, ok := result.(cadence.UInt64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected totalSupply %s", result)
+	}
+	return uint64(supply), nil
+}
+
+// EventTypes are the events of TopShot an audit folds
+var EventTypes = []string{
+	events.EventMomentMinted,
+	events.EventSubeditionAddedToMoment,
+	events.EventMomentDestroyed,
+	events.EventMomentDestroyedV2,
+	events.EventPlayRetiredFromSet,
+	events.EventSetLocked,
+}
+
+// FetchEvents fetches the events of EventTypes of the TopShot contract at
+// the address from the blocks fromHeight to toHeight, blockRange blocks per
+// query, and returns them in chain order as JSON-CDC, the lines ReadEvents
+// reads
+func FetchEvents(ctx context.Context, client *grpc.Client, topShot flow.Address, fromHeight, toHeight, blockRange uint64) ([][]byte, error) {
+	type ordered struct {
+		height uint64
+		event  flow.Event
+	}
+	var fetched []ordered
+	for start := fromHeight; start <= toHeight; start += blockRange {
+		end := min(start+blockRange-1, toHeight)
+		for _, eventType := range EventTypes {
+			qualified := fmt.Sprintf("A.%s.%s", topShot.Hex(), eventType)
+			blocks, err := client.GetEventsForHeightRange(ctx, qualified, start, end)
+			if err != nil {
+				return nil, fmt.Errorf("%s of blocks %d to %d: %w", qualified, start, end, err)
+			}
+			for _, block := range blocks {
+				for _, event := range block.Events {
+					fetched = append(fetched, ordered{height: block.Height, event: event})
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(fetched, func(x, y ordered) int {
+		return cmp.Or(
+			cmp.Compare(x.height, y.height),
+			cmp.Compare(x.event.TransactionIndex, y.event.TransactionIndex),
+			cmp.Compare(x.event.EventIndex, y.event.EventIndex),
+		)
+	})
+	payloads := make([][]byte, len(fetched))
+	for i, f := range fetched {
+		payload, err := jsoncdc.Encode(f.event.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.event.Type, err)
+		}
+		payloads[i] = payload
+	}
+	return payloads, nil
+}
@@ -0,0 +1,60 @@
+package supply
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// maxListed is the number of serials or moments of a finding WriteText lists
+const maxListed = 10
+
+// WriteJSON writes the report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteText writes the supply and the findings as a table
+func (r *Report) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "%d editions audited: %d moments minted, %d destroyed, %d in supply", len(r.Editions), r.Minted, r.Destroyed, r.Supply)
+	if r.TotalSupply != nil {
+		fmt.Fprintf(w, ", totalSupply %d", *r.TotalSupply)
+	}
+	fmt.Fprintf(w, "\n%d findings\n", len(r.Findings))
+	if len(r.Findings) == 0 {
+		return nil
+	}
+
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "\nKIND\tSET\tPLAY\tSUBEDITION\tSERIALS\tMOMENTS\tDETAIL")
+	for _, f := range r.Findings {
+		set, play, subedition := "-", "-", "-"
+		if f.Edition != (Edition{}) {
+			set, play = fmt.Sprint(f.SetID), fmt.Sprint(f.PlayID)
+		}
+		if f.SubeditionID != 0 {
+			subedition = fmt.Sprint(f.SubeditionID)
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", f.Kind, set, play, subedition, list(f.Serials), list(f.MomentIDs), f.Detail)
+	}
+	return table.Flush()
+}
+
+// list lists the first values, and how many more there are
+func list[T uint32 | uint64](values []T) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	listed := make([]string, min(len(values), maxListed))
+	for i := range listed {
+		listed[i] = fmt.Sprint(values[i])
+	}
+	if len(values) > maxListed {
+		listed = append(listed, fmt.Sprintf("+%d", len(values)-maxListed))
+	}
+	return strings.Join(listed, " ")
+}
@@ -0,0 +1,196 @@
+// Package supply audits the supply of TopShot editions against their
+// history: the MomentMinted, SubeditionAddedToMoment, MomentDestroyed,
+// PlayRetiredFromSet and SetLocked events of TopShot, read in chain order
+// from its deployment, are replayed the way the contract numbers moments,
+// and checked against getNumMomentsInEdition, the number minted per
+// subedition of the SubeditionAdmin, and totalSupply.
+//
+// mintMoment numbers a moment after every moment minted of its edition,
+// while mintMomentWithSubedition numbers it after those minted of its
+// subedition, counted under the key the SubeditionAdmin builds by
+// concatenating the set, play and subedition ids, so that the serials of
+// an edition minted both ways are not contiguous, and subeditions whose
+// ids concatenate alike, such as set 1 play 23 and set 12 play 3, share a
+// counter.
+package supply
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/events/decoder"
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/eventfile"
+)
+
+// Edition is a play of a set
+type Edition struct {
+	SetID  uint32 `json:"setId"`
+	PlayID uint32 `json:"playId"`
+}
+
+func (e Edition) String() string {
+	return fmt.Sprintf("set %d play %d", e.SetID, e.PlayID)
+}
+
+// Mint is a moment minted
+type Mint struct {
+	MomentID uint64
+	Edition
+	SerialNumber uint32
+	// SubeditionID is the subedition the moment was minted with, zero when
+	// it was minted without
+	SubeditionID uint32
+	// WithSubedition is whether the moment was numbered by its subedition
+	WithSubedition bool
+	// Retired and Locked are whether the edition was retired, and its set
+	// locked, when the moment was minted
+	Retired bool
+	Locked  bool
+}
+
+// Retirement is an edition retired by PlayRetiredFromSet
+type Retirement struct {
+	// NumMoments is the number of moments of the edition the event gives
+	NumMoments uint32
+	// Minted is the number of moments of the edition minted before it
+	Minted uint32
+}
+
+// History is the mints, destructions, retirements and set locks of
+// TopShot, folded from its events in chain order
+type History struct {
+	mints       []*Mint
+	moments     map[uint64]*Mint
+	duplicates  []*Mint
+	destroyed   map[uint64]bool
+	minted      map[Edition]uint32
+	retirements map[Edition]Retirement
+	locked      map[uint32]bool
+}
+
+func NewHistory() *History {
+	return &History{
+		moments:     map[uint64]*Mint{},
+		destroyed:   map[uint64]bool{},
+		minted:      map[Edition]uint32{},
+		retirements: map[Edition]Retirement{},
+		locked:      map[uint32]bool{},
+	}
+}
+
+// Apply folds a JSON-CDC or CCF encoded event into the history, ignoring
+// the events that do not change the supply
+func (h *History) Apply(payload []byte) error {
+	event, err := decoder.GetCadenceEvent(payload)
+	if err != nil {
+		return err
+	}
+
+	switch event.EventType.QualifiedIdentifier {
+	case events.EventMomentMinted:
+		minted, err := events.DecodeMomentMintedEvent(payload)
+		if err != nil {
+			return err
+		}
+		edition := Edition{SetID: minted.SetId(), PlayID: minted.PlayId()}
+		_, retired := h.retirements[edition]
+		mint := &Mint{
+			MomentID:       minted.MomentId(),
+			Edition:        edition,
+			SerialNumber:   minted.SerialNumber(),
+			SubeditionID:   minted.SubeditionId(),
+			WithSubedition: minted.SubeditionId() != 0,
+			Retired:        retired,
+			Locked:         h.locked[edition.SetID],
+		}
+		if _, ok := h.moments[mint.MomentID]; ok {
+			h.duplicates = append(h.duplicates, mint)
+			return nil
+		}
+		h.mints = append(h.mints, mint)
+		h.moments[mint.MomentID] = mint
+		h.minted[edition]++
+	case events.EventSubeditionAddedToMoment:
+		added, err := events.DecodeSubeditionAddedToMomentEvent(payload)
+		if err != nil {
+			return err
+		}
+		// emitted after MomentMinted by mintMomentWithSubedition
+		if mint, ok := h.moments[added.MomentID()]; ok {
+			mint.SubeditionID, mint.WithSubedition = added.SubeditionID(), true
+		}
+	case events.EventMomentDestroyed, events.EventMomentDestroyedV2:
+		destroyed, err := events.DecodeMomentDestroyedEvent(payload)
+		if err != nil {
+			return err
+		}
+		h.destroyed[destroyed.Id()] = true
+	case events.EventPlayRetiredFromSet:
+		retired, err := events.DecodeSetPlayRetiredEvent(payload)
+		if err != nil {
+			return err
+		}
+		edition := Edition{SetID: retired.SetID(), PlayID: retired.PlayID()}
+		if _, ok := h.retirements[edition]; !ok {
+			h.retirements[edition] = Retirement{NumMoments: retired.NumMoments(), Minted: h.minted[edition]}
+		}
+	case events.EventSetLocked:
+		locked, err := events.DecodeSetLockedEvent(payload)
+		if err != nil {
+			return err
+		}
+		h.locked[locked.SetID()] = true
+	}
+	return nil
+}
+
+// Read folds the events of an event file into the history
+func (h *History) Read(r io.Reader) error {
+	return eventfile.Read(r, h.Apply)
+}
+
+// ReadEvents builds a history from an event file
+func ReadEvents(r io.Reader) (*History, error) {
+	h := NewHistory()
+	if err := h.Read(r); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Mints returns the moments minted, in the order they were
+func (h *History) Mints() []*Mint {
+	return h.mints
+}
+
+// Minted returns the number of moments of the edition minted
+func (h *History) Minted(edition Edition) uint32 {
+	return h.minted[edition]
+}
+
+// Destroyed returns whether the moment was destroyed
+func (h *History) Destroyed(id uint64) bool {
+	return h.destroyed[id]
+}
+
+// Retirement returns the retirement of the edition
+func (h *History) Retirement(edition Edition) (Retirement, bool) {
+	retirement, ok := h.retirements[edition]
+	return retirement, ok
+}
+
+// SetLocked returns whether the set was locked
+func (h *History) SetLocked(setID uint32) bool {
+	return h.locked[setID]
+}
+
+// subeditionKey is the key of the number of moments minted with a
+// subedition in the SubeditionAdmin, which getSetPlaySubeditionString
+// concatenates without separators
+func subeditionKey(edition Edition, subeditionID uint32) string {
+	return strconv.FormatUint(uint64(edition.SetID), 10) +
+		strconv.FormatUint(uint64(edition.PlayID), 10) +
+		strconv.FormatUint(uint64(subeditionID), 10)
+}
@@ -0,0 +1,223 @@
+package supply
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapperlabs/nba-smart-contracts/lib/go/tools/internal/cdctest"
+)
+
+func minted(id uint64, setID, playID, serial, subeditionID uint32) string {
+	return cdctest.Event("TopShot", "MomentMinted",
+		cdctest.Field("momentID", "UInt64", id),
+		cdctest.Field("playID", "UInt32", playID),
+		cdctest.Field("setID", "UInt32", setID),
+		cdctest.Field("serialNumber", "UInt32", serial),
+		cdctest.Field("subeditionID", "UInt32", subeditionID),
+	)
+}
+
+// mintedWithSubedition is the events of mintMomentWithSubedition
+func mintedWithSubedition(id uint64, setID, playID, serial, subeditionID uint32) string {
+	return minted(id, setID, playID, serial, subeditionID) + "\n" + cdctest.Event("TopShot", "SubeditionAddedToMoment",
+		cdctest.Field("momentID", "UInt64", id),
+		cdctest.Field("subeditionID", "UInt32", subeditionID),
+		cdctest.Field("setID", "UInt32", setID),
+		cdctest.Field("playID", "UInt32", playID),
+	)
+}
+
+func retired(setID, playID, numMoments uint32) string {
+	return cdctest.Event("TopShot", "PlayRetiredFromSet", cdctest.Field("setID", "UInt32", setID), cdctest.Field("playID", "UInt32", playID), cdctest.Field("numMoments", "UInt32", numMoments))
+}
+
+func setLocked(setID uint32) string {
+	return cdctest.Event("TopShot", "SetLocked", cdctest.Field("setID", "UInt32", setID))
+}
+
+func destroyed(id uint64) string {
+	return cdctest.Event("TopShot", "MomentDestroyed", cdctest.Field("id", "UInt64", id))
+}
+
+func readHistory(t *testing.T, lines ...string) *History {
+	t.Helper()
+	h, err := ReadEvents(strings.NewReader(strings.Join(lines, "\n")))
+	require.NoError(t, err)
+	return h
+}
+
+// testHistory mints set 1 play 1 with and without subedition 5, and set 1
+// play 23 and set 12 play 3 with subedition 1, which share a counter, then
+// destroys moment 2 and retires set 1 play 1
+func testHistory() []string {
+	return []string{
+		minted(1, 1, 1, 1, 0),
+		minted(2, 1, 1, 2, 0),
+		mintedWithSubedition(3, 1, 1, 1, 5),
+		// numbered after the three moments of the edition
+		minted(4, 1, 1, 4, 0),
+		mintedWithSubedition(5, 1, 23, 1, 1),
+		mintedWithSubedition(6, 12, 3, 2, 1),
+		setLocked(1),
+		mintedWithSubedition(7, 1, 1, 2, 5),
+		destroyed(2),
+		retired(1, 1, 5),
+	}
+}
+
+func TestAuditHistory(t *testing.T) {
+	h := readHistory(t, testHistory()...)
+	report, err := Audit(context.Background(), h, nil, Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(7), report.Minted)
+	assert.Equal(t, uint64(6), report.Supply)
+	require.Len(t, report.Editions, 3)
+	assert.Equal(t, &EditionReport{
+		Edition:     Edition{SetID: 1, PlayID: 1},
+		Minted:      5,
+		Destroyed:   1,
+		Subeditions: []*SubeditionReport{{SubeditionID: 5, Minted: 2}},
+		Retired:     true,
+		SetLocked:   true,
+	}, report.Editions[0])
+	assert.Equal(t, []Finding{{
+		Kind: SharedSubeditionCounter, Edition: Edition{SetID: 1, PlayID: 23}, SubeditionID: 1,
+		Detail: `set 1 play 23 subedition 1 and set 12 play 3 subedition 1 are counted under the key "1231" of the SubeditionAdmin`,
+	}}, report.Findings)
+
+	report, err = Audit(context.Background(), h, nil, Options{LockedSetsClosed: true})
+	require.NoError(t, err)
+	assert.Equal(t, map[Kind]int{SharedSubeditionCounter: 1, MintedAfterLock: 1}, report.Counts)
+	assert.Equal(t, []uint64{7}, report.Findings[1].MomentIDs)
+}
+
+func TestAuditIncidents(t *testing.T) {
+	h := readHistory(t, append(testHistory(),
+		// minted after its retirement
+		minted(8, 1, 1, 6, 0),
+		// two moments with serial 1, none with 2
+		minted(9, 2, 1, 1, 0),
+		minted(10, 2, 1, 1, 0),
+		// moment 11 is missing and the subedition skips serial 1
+		mintedWithSubedition(12, 2, 1, 2, 7),
+		retired(2, 1, 2),
+		minted(12, 2, 2, 1, 0),
+	)...)
+	report, err := Audit(context.Background(), h, nil, Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[Kind]int{
+		SharedSubeditionCounter: 1,
+		DuplicateSerial:         1,
+		SerialGap:               2,
+		UnexpectedSerial:        1,
+		MintedAfterRetirement:   1,
+		RetiredCountMismatch:    1,
+		MomentIDGap:             1,
+		DuplicateMoment:         1,
+	}, report.Counts)
+
+	byKind := map[Kind][]Finding{}
+	for _, f := range report.Findings {
+		byKind[f.Kind] = append(byKind[f.Kind], f)
+	}
+	assert.Equal(t, []uint64{9, 10}, byKind[DuplicateSerial][0].MomentIDs)
+	assert.Equal(t, []uint32{2}, byKind[SerialGap][0].Serials)
+	assert.Equal(t, Finding{
+		Kind: SerialGap, Edition: Edition{SetID: 2, PlayID: 1}, SubeditionID: 7, Serials: []uint32{1},
+		Detail: "1 serials of set 2 play 1 subedition 7 assigned by the contract are missing",
+	}, byKind[SerialGap][1])
+	assert.Equal(t, []uint64{12}, byKind[UnexpectedSerial][0].MomentIDs)
+	assert.Equal(t, []uint64{8}, byKind[MintedAfterRetirement][0].MomentIDs)
+	assert.Equal(t, "set 2 play 1 was retired with 2 moments but 3 were minted before", byKind[RetiredCountMismatch][0].Detail)
+	assert.Equal(t, []uint64{11}, byKind[MomentIDGap][0].MomentIDs)
+	assert.Equal(t, "moment 12 was minted again as serial 1 of set 2 play 2, after serial 2 of set 2 play 1", byKind[DuplicateMoment][0].Detail)
+}
+
+// fakeChain holds the counters of the chain
+type fakeChain struct {
+	editions    map[Edition]uint32
+	subeditions map[string]uint32
+	totalSupply uint64
+}
+
+func (c *fakeChain) NumMomentsInEdition(_ context.Context, edition Edition) (uint32, error) {
+	count, ok := c.editions[edition]
+	if !ok {
+		return 0, fmt.Errorf("could not find %s", edition)
+	}
+	return count, nil
+}
+
+func (c *fakeChain) NumMomentsInSubedition(_ context.Context, edition Edition, subeditionID uint32) (uint32, error) {
+	return c.subeditions[subeditionKey(edition, subeditionID)], nil
+}
+
+func (c *fakeChain) TotalSupply(context.Context) (uint64, error) {
+	return c.totalSupply, nil
+}
+
+func TestAuditCounters(t *testing.T) {
+	h := readHistory(t, testHistory()...)
+	chain := &fakeChain{
+		editions: map[Edition]uint32{
+			{SetID: 1, PlayID: 1}:  5,
+			{SetID: 1, PlayID: 23}: 1,
+			{SetID: 12, PlayID: 3}: 1,
+		},
+		subeditions: map[string]uint32{"115": 2, "1231": 2},
+		totalSupply: 7,
+	}
+	report, err := Audit(context.Background(), h, chain, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, map[Kind]int{SharedSubeditionCounter: 1}, report.Counts)
+	assert.Equal(t, uint64(7), *report.TotalSupply)
+	assert.Equal(t, uint32(2), *report.Editions[0].Subeditions[0].NumberMinted)
+
+	// a mint whose events are missing
+	chain.editions[Edition{SetID: 1, PlayID: 1}] = 6
+	chain.subeditions["115"] = 3
+	chain.totalSupply = 8
+	report, err = Audit(context.Background(), h, chain, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []Finding{
+		{
+			Kind: SharedSubeditionCounter, Edition: Edition{SetID: 1, PlayID: 23}, SubeditionID: 1,
+			Detail: `set 1 play 23 subedition 1 and set 12 play 3 subedition 1 are counted under the key "1231" of the SubeditionAdmin`,
+		},
+		{
+			Kind: EditionCountMismatch, Edition: Edition{SetID: 1, PlayID: 1},
+			Detail: "getNumMomentsInEdition of set 1 play 1 is 6 but 5 moments were minted",
+		},
+		{
+			Kind: SubeditionCountMismatch, Edition: Edition{SetID: 1, PlayID: 1}, SubeditionID: 5,
+			Detail: "getNumberMintedPerSubedition of set 1 play 1 subedition 5 is 3 but 2 moments were minted",
+		},
+		{
+			Kind:   TotalSupplyMismatch,
+			Detail: "totalSupply is 8 but 7 moments were minted, 1 of them destroyed",
+		},
+	}, report.Findings)
+
+	delete(chain.editions, Edition{SetID: 12, PlayID: 3})
+	_, err = Audit(context.Background(), h, chain, Options{})
+	assert.ErrorContains(t, err, "could not find set 12 play 3")
+}
+
+func TestWriteText(t *testing.T) {
+	h := readHistory(t, testHistory()...)
+	report, err := Audit(context.Background(), h, nil, Options{})
+	require.NoError(t, err)
+
+	var text bytes.Buffer
+	require.NoError(t, report.WriteText(&text))
+	assert.Contains(t, text.String(), "3 editions audited: 7 moments minted, 1 destroyed, 6 in supply\n1 findings\n")
+	assert.Contains(t, text.String(), "SHARED_SUBEDITION_COUNTER  1    23    1           -        -")
+}